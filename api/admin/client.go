@@ -5,6 +5,7 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/DioneProtocol/odysseygo/api"
 	"github.com/DioneProtocol/odysseygo/ids"
@@ -23,6 +24,19 @@ type Client interface {
 	Alias(ctx context.Context, endpoint string, alias string, options ...rpc.Option) error
 	AliasChain(ctx context.Context, chainID string, alias string, options ...rpc.Option) error
 	GetChainAliases(ctx context.Context, chainID string, options ...rpc.Option) ([]string, error)
+	SnapshotChain(ctx context.Context, chainID string, name string, options ...rpc.Option) error
+	RollbackChain(ctx context.Context, chainID string, name string, options ...rpc.Option) error
+	RestartChain(ctx context.Context, chainID string, options ...rpc.Option) error
+	TrackSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.Option) error
+	RepairAtomicUTXOs(ctx context.Context, sourceChain string, utxoIDs []ids.ID, options ...rpc.Option) ([]ids.ID, error)
+	Bench(ctx context.Context, nodeID ids.NodeID, chainID string, duration time.Duration, reason string, options ...rpc.Option) error
+	Unbench(ctx context.Context, nodeID ids.NodeID, chainID string, options ...rpc.Option) (bool, error)
+	GetBenched(context.Context, ...rpc.Option) (map[ids.ID][]BenchedNode, error)
+	GetOutstandingRequests(ctx context.Context, chainID string, options ...rpc.Option) ([]OutstandingRequest, error)
+	CompactDB(ctx context.Context, chainID string, options ...rpc.Option) error
+	DBStats(context.Context, ...rpc.Option) (*DBStatsReply, error)
+	GetStakingCert(ctx context.Context, includeKey bool, options ...rpc.Option) (*StakingCertReply, error)
+	NewStakingCert(ctx context.Context, keyPath string, certPath string, options ...rpc.Option) (*StakingCertReply, error)
 	Stacktrace(context.Context, ...rpc.Option) error
 	LoadVMs(context.Context, ...rpc.Option) (map[ids.ID][]string, map[ids.ID]string, error)
 	SetLoggerLevel(ctx context.Context, loggerName, logLevel, displayLevel string, options ...rpc.Option) error
@@ -80,6 +94,102 @@ func (c *client) GetChainAliases(ctx context.Context, chain string, options ...r
 	return res.Aliases, err
 }
 
+func (c *client) SnapshotChain(ctx context.Context, chain, name string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.snapshotChain", &ChainSnapshotArgs{
+		Chain: chain,
+		Name:  name,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) RollbackChain(ctx context.Context, chain, name string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.rollbackChain", &ChainSnapshotArgs{
+		Chain: chain,
+		Name:  name,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) RestartChain(ctx context.Context, chain string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.restartChain", &RestartChainArgs{
+		Chain: chain,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) TrackSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.trackSubnet", &TrackSubnetArgs{
+		SubnetID: subnetID,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) RepairAtomicUTXOs(ctx context.Context, sourceChain string, utxoIDs []ids.ID, options ...rpc.Option) ([]ids.ID, error) {
+	res := &RepairAtomicUTXOsReply{}
+	err := c.requester.SendRequest(ctx, "admin.repairAtomicUTXOs", &RepairAtomicUTXOsArgs{
+		SourceChain: sourceChain,
+		UTXOIDs:     utxoIDs,
+	}, res, options...)
+	return res.RemovedUTXOIDs, err
+}
+
+func (c *client) Bench(ctx context.Context, nodeID ids.NodeID, chain string, duration time.Duration, reason string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.bench", &BenchArgs{
+		NodeID:   nodeID,
+		Chain:    chain,
+		Duration: duration,
+		Reason:   reason,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) Unbench(ctx context.Context, nodeID ids.NodeID, chain string, options ...rpc.Option) (bool, error) {
+	res := &UnbenchReply{}
+	err := c.requester.SendRequest(ctx, "admin.unbench", &UnbenchArgs{
+		NodeID: nodeID,
+		Chain:  chain,
+	}, res, options...)
+	return res.WasBenched, err
+}
+
+func (c *client) GetBenched(ctx context.Context, options ...rpc.Option) (map[ids.ID][]BenchedNode, error) {
+	res := &GetBenchedReply{}
+	err := c.requester.SendRequest(ctx, "admin.getBenched", struct{}{}, res, options...)
+	return res.Benched, err
+}
+
+func (c *client) GetOutstandingRequests(ctx context.Context, chain string, options ...rpc.Option) ([]OutstandingRequest, error) {
+	res := &GetOutstandingRequestsReply{}
+	err := c.requester.SendRequest(ctx, "admin.getOutstandingRequests", &GetOutstandingRequestsArgs{
+		Chain: chain,
+	}, res, options...)
+	return res.Requests, err
+}
+
+func (c *client) CompactDB(ctx context.Context, chain string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.compactDB", &CompactDBArgs{
+		Chain: chain,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) DBStats(ctx context.Context, options ...rpc.Option) (*DBStatsReply, error) {
+	res := &DBStatsReply{}
+	err := c.requester.SendRequest(ctx, "admin.dbStats", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) GetStakingCert(ctx context.Context, includeKey bool, options ...rpc.Option) (*StakingCertReply, error) {
+	res := &StakingCertReply{}
+	err := c.requester.SendRequest(ctx, "admin.getStakingCert", &GetStakingCertArgs{
+		IncludeKey: includeKey,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) NewStakingCert(ctx context.Context, keyPath, certPath string, options ...rpc.Option) (*StakingCertReply, error) {
+	res := &StakingCertReply{}
+	err := c.requester.SendRequest(ctx, "admin.newStakingCert", &NewStakingCertArgs{
+		KeyPath:  keyPath,
+		CertPath: certPath,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) Stacktrace(ctx context.Context, options ...rpc.Option) error {
 	return c.requester.SendRequest(ctx, "admin.stacktrace", struct{}{}, &api.EmptyReply{}, options...)
 }
@@ -7,11 +7,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/DioneProtocol/odysseygo/api"
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/json"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/rpc"
 )
@@ -60,6 +62,24 @@ func (mc *mockClient) SendRequest(_ context.Context, _ string, _ interface{}, re
 	case *GetChainAliasesReply:
 		response := mc.response.(*GetChainAliasesReply)
 		*p = *response
+	case *UnbenchReply:
+		response := mc.response.(*UnbenchReply)
+		*p = *response
+	case *GetBenchedReply:
+		response := mc.response.(*GetBenchedReply)
+		*p = *response
+	case *GetOutstandingRequestsReply:
+		response := mc.response.(*GetOutstandingRequestsReply)
+		*p = *response
+	case *RepairAtomicUTXOsReply:
+		response := mc.response.(*RepairAtomicUTXOsReply)
+		*p = *response
+	case *DBStatsReply:
+		response := mc.response.(*DBStatsReply)
+		*p = *response
+	case *StakingCertReply:
+		response := mc.response.(*StakingCertReply)
+		*p = *response
 	case *LoadVMsReply:
 		response := mc.response.(*LoadVMsReply)
 		*p = *response
@@ -168,6 +188,242 @@ func TestGetChainAliases(t *testing.T) {
 	})
 }
 
+func TestSnapshotChain(t *testing.T) {
+	require := require.New(t)
+
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.Err)}
+		err := mockClient.SnapshotChain(context.Background(), "chain", "snapshot")
+		require.ErrorIs(err, test.Err)
+	}
+}
+
+func TestRollbackChain(t *testing.T) {
+	require := require.New(t)
+
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.Err)}
+		err := mockClient.RollbackChain(context.Background(), "chain", "snapshot")
+		require.ErrorIs(err, test.Err)
+	}
+}
+
+func TestRestartChain(t *testing.T) {
+	require := require.New(t)
+
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.Err)}
+		err := mockClient.RestartChain(context.Background(), "chain")
+		require.ErrorIs(err, test.Err)
+	}
+}
+
+func TestTrackSubnet(t *testing.T) {
+	require := require.New(t)
+
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.Err)}
+		err := mockClient.TrackSubnet(context.Background(), ids.GenerateTestID())
+		require.ErrorIs(err, test.Err)
+	}
+}
+
+func TestRepairAtomicUTXOs(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		expectedRemoved := []ids.ID{ids.GenerateTestID()}
+		mockClient := client{requester: NewMockClient(&RepairAtomicUTXOsReply{
+			RemovedUTXOIDs: expectedRemoved,
+		}, nil)}
+
+		removed, err := mockClient.RepairAtomicUTXOs(context.Background(), "A", expectedRemoved)
+		require.NoError(err)
+		require.Equal(expectedRemoved, removed)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&RepairAtomicUTXOsReply{}, errTest)}
+		_, err := mockClient.RepairAtomicUTXOs(context.Background(), "A", nil)
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestBench(t *testing.T) {
+	require := require.New(t)
+
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.Err)}
+		err := mockClient.Bench(context.Background(), ids.GenerateTestNodeID(), "chain", time.Hour, "reason")
+		require.ErrorIs(err, test.Err)
+	}
+}
+
+func TestUnbench(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		mockClient := client{requester: NewMockClient(&UnbenchReply{
+			WasBenched: true,
+		}, nil)}
+
+		wasBenched, err := mockClient.Unbench(context.Background(), ids.GenerateTestNodeID(), "chain")
+		require.NoError(err)
+		require.True(wasBenched)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&UnbenchReply{}, errTest)}
+		_, err := mockClient.Unbench(context.Background(), ids.GenerateTestNodeID(), "chain")
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestGetBenched(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		expectedReply := map[ids.ID][]BenchedNode{
+			ids.GenerateTestID(): {{
+				NodeID:       ids.GenerateTestNodeID(),
+				Reason:       "reason",
+				BenchedUntil: time.Unix(1, 0),
+			}},
+		}
+		mockClient := client{requester: NewMockClient(&GetBenchedReply{
+			Benched: expectedReply,
+		}, nil)}
+
+		reply, err := mockClient.GetBenched(context.Background())
+		require.NoError(err)
+		require.Equal(expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&GetBenchedReply{}, errTest)}
+		_, err := mockClient.GetBenched(context.Background())
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestGetOutstandingRequests(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		expectedReply := []OutstandingRequest{{
+			NodeID:      ids.GenerateTestNodeID(),
+			RequestID:   1,
+			ContainerID: ids.GenerateTestID(),
+			Age:         time.Second,
+			Benched:     true,
+		}}
+		mockClient := client{requester: NewMockClient(&GetOutstandingRequestsReply{
+			Requests: expectedReply,
+		}, nil)}
+
+		reply, err := mockClient.GetOutstandingRequests(context.Background(), "chain")
+		require.NoError(err)
+		require.Equal(expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&GetOutstandingRequestsReply{}, errTest)}
+		_, err := mockClient.GetOutstandingRequests(context.Background(), "chain")
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestCompactDB(t *testing.T) {
+	require := require.New(t)
+
+	tests := GetSuccessResponseTests()
+
+	for _, test := range tests {
+		mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.Err)}
+		err := mockClient.CompactDB(context.Background(), "chain")
+		require.ErrorIs(err, test.Err)
+	}
+}
+
+func TestDBStats(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		expectedReply := &DBStatsReply{
+			Size: 2048,
+			Chains: map[ids.ID]json.Uint64{
+				ids.GenerateTestID(): 1024,
+			},
+		}
+		mockClient := client{requester: NewMockClient(expectedReply, nil)}
+
+		reply, err := mockClient.DBStats(context.Background())
+		require.NoError(err)
+		require.Equal(expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&DBStatsReply{}, errTest)}
+		_, err := mockClient.DBStats(context.Background())
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestGetStakingCert(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		expectedReply := &StakingCertReply{
+			NodeID:      ids.GenerateTestNodeID(),
+			Certificate: "certificate",
+			Key:         "key",
+		}
+		mockClient := client{requester: NewMockClient(expectedReply, nil)}
+
+		reply, err := mockClient.GetStakingCert(context.Background(), true)
+		require.NoError(err)
+		require.Equal(expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&StakingCertReply{}, errTest)}
+		_, err := mockClient.GetStakingCert(context.Background(), false)
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
+func TestNewStakingCert(t *testing.T) {
+	t.Run("successful", func(t *testing.T) {
+		require := require.New(t)
+
+		expectedReply := &StakingCertReply{
+			NodeID:      ids.GenerateTestNodeID(),
+			Certificate: "certificate",
+		}
+		mockClient := client{requester: NewMockClient(expectedReply, nil)}
+
+		reply, err := mockClient.NewStakingCert(context.Background(), "staker.key", "staker.crt")
+		require.NoError(err)
+		require.Equal(expectedReply, reply)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mockClient := client{requester: NewMockClient(&StakingCertReply{}, errTest)}
+		_, err := mockClient.NewStakingCert(context.Background(), "staker.key", "staker.crt")
+		require.ErrorIs(t, err, errTest)
+	})
+}
+
 func TestStacktrace(t *testing.T) {
 	require := require.New(t)
 
@@ -4,9 +4,11 @@
 package admin
 
 import (
+	"crypto/tls"
 	"errors"
 	"net/http"
 	"path"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 
@@ -15,8 +17,11 @@ import (
 	"github.com/DioneProtocol/odysseygo/api"
 	"github.com/DioneProtocol/odysseygo/api/server"
 	"github.com/DioneProtocol/odysseygo/chains"
+	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/snow/networking/benchlist"
+	"github.com/DioneProtocol/odysseygo/staking"
 	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/json"
@@ -37,6 +42,9 @@ const (
 var (
 	errAliasTooLong = errors.New("alias length is too long")
 	errNoLogLevel   = errors.New("need to specify either displayLevel or logLevel")
+
+	errNonPositiveBenchDuration = errors.New("bench duration must be positive")
+	errNoStakingCertPath        = errors.New("keyPath and certPath must be provided")
 )
 
 type Config struct {
@@ -45,9 +53,14 @@ type Config struct {
 	LogFactory   logging.Factory
 	NodeConfig   interface{}
 	ChainManager chains.Manager
+	DB           database.Database
 	HTTPServer   server.PathAdderWithReadLock
 	VMRegistry   registry.VMRegistry
 	VMManager    vms.Manager
+	Benchlist    benchlist.Manager
+	// StakingTLSCert is the staking certificate and key the node is running
+	// with
+	StakingTLSCert *tls.Certificate
 }
 
 // Admin is the API service for node admin management
@@ -193,6 +206,415 @@ func (a *Admin) GetChainAliases(_ *http.Request, args *GetChainAliasesArgs, repl
 	return err
 }
 
+// ChainSnapshotArgs are the arguments for calling SnapshotChain and
+// RollbackChain
+type ChainSnapshotArgs struct {
+	Chain string `json:"chain"`
+	Name  string `json:"name"`
+}
+
+// SnapshotChain copies the current state of a chain into the named snapshot.
+// Requires chain snapshots to be enabled.
+func (a *Admin) SnapshotChain(_ *http.Request, args *ChainSnapshotArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "snapshotChain"),
+		logging.UserString("chain", args.Chain),
+		logging.UserString("name", args.Name),
+	)
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+	return a.ChainManager.SnapshotChain(chainID, args.Name)
+}
+
+// RollbackChain rolls back the state of a chain to the named snapshot. The
+// rollback is applied the next time the chain is created, either when the node
+// is restarted or when the chain is restarted with RestartChain. Requires chain
+// snapshots to be enabled.
+func (a *Admin) RollbackChain(_ *http.Request, args *ChainSnapshotArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "rollbackChain"),
+		logging.UserString("chain", args.Chain),
+		logging.UserString("name", args.Name),
+	)
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+	return a.ChainManager.RollbackChain(chainID, args.Name)
+}
+
+// RestartChainArgs are the arguments for calling RestartChain
+type RestartChainArgs struct {
+	Chain string `json:"chain"`
+}
+
+// RestartChain stops a chain and creates it again, re-initializing its VM and
+// re-running bootstrapping, without restarting the node. The chain is
+// restarted asynchronously. The O-chain can't be restarted.
+func (a *Admin) RestartChain(_ *http.Request, args *RestartChainArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "restartChain"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+	return a.ChainManager.RestartChain(chainID)
+}
+
+// TrackSubnetArgs are the arguments for calling TrackSubnet
+type TrackSubnetArgs struct {
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// TrackSubnet starts tracking a subnet without restarting the node. The
+// subnet's validator set is registered and its chains are created and
+// bootstrapped. The subnet stops being tracked when the node restarts unless
+// it is added to the node's config.
+func (a *Admin) TrackSubnet(_ *http.Request, args *TrackSubnetArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "trackSubnet"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	return a.ChainManager.TrackSubnet(args.SubnetID)
+}
+
+// RepairAtomicUTXOsArgs are the arguments for calling RepairAtomicUTXOs
+type RepairAtomicUTXOsArgs struct {
+	SourceChain string   `json:"sourceChain"`
+	UTXOIDs     []ids.ID `json:"utxoIDs"`
+}
+
+// RepairAtomicUTXOsReply is the response from calling RepairAtomicUTXOs
+type RepairAtomicUTXOsReply struct {
+	// IDs of the UTXOs that were removed from shared memory
+	RemovedUTXOIDs []ids.ID `json:"removedUTXOIDs"`
+}
+
+// RepairAtomicUTXOs removes UTXOs exported to the O-chain from [SourceChain]
+// from shared memory if the O-chain already imported them. Such orphaned UTXOs
+// are reported by omega.inspectAtomicUTXOs.
+func (a *Admin) RepairAtomicUTXOs(_ *http.Request, args *RepairAtomicUTXOsArgs, reply *RepairAtomicUTXOsReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "repairAtomicUTXOs"),
+		logging.UserString("sourceChain", args.SourceChain),
+		zap.Int("numUTXOs", len(args.UTXOIDs)),
+	)
+
+	sourceChainID, err := a.ChainManager.Lookup(args.SourceChain)
+	if err != nil {
+		return err
+	}
+	reply.RemovedUTXOIDs, err = a.ChainManager.RepairAtomicUTXOs(sourceChainID, args.UTXOIDs)
+	return err
+}
+
+// BenchArgs are the arguments for calling Bench
+type BenchArgs struct {
+	NodeID   ids.NodeID    `json:"nodeID"`
+	Chain    string        `json:"chain"`
+	Duration time.Duration `json:"duration"`
+	Reason   string        `json:"reason"`
+}
+
+// Bench benches a node on a chain for the provided duration, so that queries
+// to it fail immediately instead of waiting for a timeout. Manually benched
+// nodes don't count towards the maximum portion of stake that may be benched.
+// If the node is already benched, its expiry and reason are replaced.
+func (a *Admin) Bench(_ *http.Request, args *BenchArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "bench"),
+		zap.Stringer("nodeID", args.NodeID),
+		logging.UserString("chain", args.Chain),
+		zap.Duration("duration", args.Duration),
+		logging.UserString("reason", args.Reason),
+	)
+
+	if args.Duration <= 0 {
+		return errNonPositiveBenchDuration
+	}
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+	return a.Benchlist.Bench(chainID, args.NodeID, args.Duration, args.Reason)
+}
+
+// UnbenchArgs are the arguments for calling Unbench
+type UnbenchArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	Chain  string     `json:"chain"`
+}
+
+// UnbenchReply is the response from calling Unbench
+type UnbenchReply struct {
+	// True if the node was benched on the chain
+	WasBenched bool `json:"wasBenched"`
+}
+
+// Unbench removes a node from the bench of a chain, whether it was benched
+// manually or after failing queries.
+func (a *Admin) Unbench(_ *http.Request, args *UnbenchArgs, reply *UnbenchReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "unbench"),
+		zap.Stringer("nodeID", args.NodeID),
+		logging.UserString("chain", args.Chain),
+	)
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+	reply.WasBenched, err = a.Benchlist.Unbench(chainID, args.NodeID)
+	return err
+}
+
+// BenchedNode is a node that is currently benched on a chain
+type BenchedNode struct {
+	NodeID       ids.NodeID `json:"nodeID"`
+	Reason       string     `json:"reason"`
+	BenchedUntil time.Time  `json:"benchedUntil"`
+}
+
+// GetBenchedReply is the response from calling GetBenched
+type GetBenchedReply struct {
+	// Chain ID --> nodes benched on the chain, sorted by expiry
+	Benched map[ids.ID][]BenchedNode `json:"benched"`
+}
+
+// GetBenched returns the nodes that are currently benched on each chain
+func (a *Admin) GetBenched(_ *http.Request, _ *struct{}, reply *GetBenchedReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "getBenched"),
+	)
+
+	benched := a.Benchlist.GetBenchedNodes()
+	reply.Benched = make(map[ids.ID][]BenchedNode, len(benched))
+	for chainID, nodes := range benched {
+		replyNodes := make([]BenchedNode, len(nodes))
+		for i, node := range nodes {
+			replyNodes[i] = BenchedNode{
+				NodeID:       node.NodeID,
+				Reason:       node.Reason,
+				BenchedUntil: node.BenchedUntil,
+			}
+		}
+		reply.Benched[chainID] = replyNodes
+	}
+	return nil
+}
+
+// GetOutstandingRequestsArgs are the arguments for calling
+// GetOutstandingRequests
+type GetOutstandingRequestsArgs struct {
+	Chain string `json:"chain"`
+}
+
+// OutstandingRequest is a container request that hasn't been responded to yet
+type OutstandingRequest struct {
+	NodeID      ids.NodeID    `json:"nodeID"`
+	RequestID   json.Uint32   `json:"requestID"`
+	ContainerID ids.ID        `json:"containerID"`
+	Age         time.Duration `json:"age"`
+	// True if the node the request was sent to is benched on the chain
+	Benched bool `json:"benched"`
+}
+
+// GetOutstandingRequestsReply is the response from calling
+// GetOutstandingRequests
+type GetOutstandingRequestsReply struct {
+	// Sorted from oldest to newest
+	Requests []OutstandingRequest `json:"requests"`
+}
+
+// GetOutstandingRequests returns the container requests that the consensus
+// engine of a chain is waiting on. This allows identifying the peers that a
+// stalled chain is waiting on.
+func (a *Admin) GetOutstandingRequests(_ *http.Request, args *GetOutstandingRequestsArgs, reply *GetOutstandingRequestsReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "getOutstandingRequests"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+	requests, err := a.ChainManager.OutstandingRequests(chainID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	reply.Requests = make([]OutstandingRequest, len(requests))
+	for i, request := range requests {
+		reply.Requests[i] = OutstandingRequest{
+			NodeID:      request.NodeID,
+			RequestID:   json.Uint32(request.RequestID),
+			ContainerID: request.ContainerID,
+			Age:         now.Sub(request.Issued),
+			Benched:     request.Benched,
+		}
+	}
+	return nil
+}
+
+// CompactDBArgs are the arguments for calling CompactDB
+type CompactDBArgs struct {
+	// If empty, the entire database is compacted
+	Chain string `json:"chain"`
+}
+
+// CompactDB compacts the node's database, discarding deleted and overwritten
+// values to reclaim disk space. If a chain is provided, only the state of that
+// chain is compacted. Compacting a single chain requires chain snapshots to be
+// enabled.
+func (a *Admin) CompactDB(_ *http.Request, args *CompactDBArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "compactDB"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	if args.Chain == "" {
+		return a.DB.Compact(nil, nil)
+	}
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+	return a.ChainManager.CompactChainDB(chainID)
+}
+
+// DBStatsReply is the response from calling DBStats
+type DBStatsReply struct {
+	// Approximate number of bytes used on disk by the database
+	Size json.Uint64 `json:"size"`
+	// Approximate number of bytes used on disk by the state of each running
+	// chain. Only reported when chain snapshots are enabled.
+	Chains map[ids.ID]json.Uint64 `json:"chains,omitempty"`
+}
+
+// DBStats returns the approximate on-disk size of the node's database, broken
+// down by chain.
+func (a *Admin) DBStats(_ *http.Request, _ *struct{}, reply *DBStatsReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "dbStats"),
+	)
+
+	size, err := database.ApproximateSize(a.DB, nil, nil)
+	if err != nil {
+		return err
+	}
+	reply.Size = json.Uint64(size)
+
+	chainSizes, err := a.ChainManager.ChainDBSizes()
+	if errors.Is(err, chains.ErrChainStateNotPrefixed) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	reply.Chains = make(map[ids.ID]json.Uint64, len(chainSizes))
+	for chainID, size := range chainSizes {
+		reply.Chains[chainID] = json.Uint64(size)
+	}
+	return nil
+}
+
+// GetStakingCertArgs are the arguments for calling GetStakingCert
+type GetStakingCertArgs struct {
+	// If true, the private key of the staking certificate is returned too
+	IncludeKey bool `json:"includeKey"`
+}
+
+// StakingCertReply describes a staking certificate
+type StakingCertReply struct {
+	// NodeID derived from the certificate
+	NodeID ids.NodeID `json:"nodeID"`
+	// PEM encoded certificate
+	Certificate string `json:"certificate"`
+	// PEM encoded private key, if requested
+	Key string `json:"key,omitempty"`
+}
+
+// GetStakingCert returns the staking certificate the node is running with and
+// the NodeID derived from it. The private key is only returned if requested,
+// so that the node's identity can be backed up before moving it to another
+// machine.
+func (a *Admin) GetStakingCert(_ *http.Request, args *GetStakingCertArgs, reply *StakingCertReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "getStakingCert"),
+		zap.Bool("includeKey", args.IncludeKey),
+	)
+
+	certBytes, keyBytes, err := staking.EncodeTLSCertToPEM(a.StakingTLSCert)
+	if err != nil {
+		return err
+	}
+	if args.IncludeKey {
+		a.Log.Warn("exporting staking key")
+		reply.Key = string(keyBytes)
+	}
+	reply.NodeID = ids.NodeIDFromCert(staking.CertificateFromX509(a.StakingTLSCert.Leaf))
+	reply.Certificate = string(certBytes)
+	return nil
+}
+
+// NewStakingCertArgs are the arguments for calling NewStakingCert
+type NewStakingCertArgs struct {
+	// Path the new private key is written to
+	KeyPath string `json:"keyPath"`
+	// Path the new certificate is written to
+	CertPath string `json:"certPath"`
+}
+
+// NewStakingCert generates a new staking certificate and key and writes them
+// to the provided paths, without changing the certificate the node is running
+// with. Existing files are never overwritten. The new certificate is used once
+// the node is restarted with it.
+func (a *Admin) NewStakingCert(_ *http.Request, args *NewStakingCertArgs, reply *StakingCertReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "newStakingCert"),
+		logging.UserString("keyPath", args.KeyPath),
+		logging.UserString("certPath", args.CertPath),
+	)
+
+	if args.KeyPath == "" || args.CertPath == "" {
+		return errNoStakingCertPath
+	}
+	cert, err := staking.NewNodeStakingKeyPair(args.KeyPath, args.CertPath)
+	if err != nil {
+		return err
+	}
+	certBytes, _, err := staking.EncodeTLSCertToPEM(cert)
+	if err != nil {
+		return err
+	}
+	reply.NodeID = ids.NodeIDFromCert(staking.CertificateFromX509(cert.Leaf))
+	reply.Certificate = string(certBytes)
+	return nil
+}
+
 // Stacktrace returns the current global stacktrace
 func (a *Admin) Stacktrace(_ *http.Request, _ *struct{}, _ *api.EmptyReply) error {
 	a.Log.Debug("API called",
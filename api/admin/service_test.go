@@ -5,6 +5,7 @@ package admin
 
 import (
 	"net/http"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/staking"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/vms"
 	"github.com/DioneProtocol/odysseygo/vms/registry"
@@ -117,3 +119,45 @@ func TestLoadVMsGetAliasesFails(t *testing.T) {
 	err := resources.admin.LoadVMs(&http.Request{}, nil, &reply)
 	require.ErrorIs(err, errTest)
 }
+
+func TestStakingCert(t *testing.T) {
+	require := require.New(t)
+
+	cert, err := staking.NewTLSCert()
+	require.NoError(err)
+	nodeID := ids.NodeIDFromCert(staking.CertificateFromX509(cert.Leaf))
+
+	admin := &Admin{Config: Config{
+		Log:            logging.NoLog{},
+		StakingTLSCert: cert,
+	}}
+
+	reply := StakingCertReply{}
+	require.NoError(admin.GetStakingCert(nil, &GetStakingCertArgs{}, &reply))
+	require.Equal(nodeID, reply.NodeID)
+	require.Empty(reply.Key)
+
+	require.NoError(admin.GetStakingCert(nil, &GetStakingCertArgs{IncludeKey: true}, &reply))
+	exportedCert, err := staking.LoadTLSCertFromBytes([]byte(reply.Key), []byte(reply.Certificate))
+	require.NoError(err)
+	require.Equal(cert.Leaf.Raw, exportedCert.Leaf.Raw)
+
+	dir := t.TempDir()
+	args := &NewStakingCertArgs{
+		KeyPath:  filepath.Join(dir, "staker.key"),
+		CertPath: filepath.Join(dir, "staker.crt"),
+	}
+	newReply := StakingCertReply{}
+	require.NoError(admin.NewStakingCert(nil, args, &newReply))
+	require.NotEqual(nodeID, newReply.NodeID)
+
+	newCert, err := staking.LoadTLSCertFromFiles(args.KeyPath, args.CertPath)
+	require.NoError(err)
+	require.Equal(newReply.NodeID, ids.NodeIDFromCert(staking.CertificateFromX509(newCert.Leaf)))
+
+	err = admin.NewStakingCert(nil, args, &newReply)
+	require.ErrorIs(err, staking.ErrStakingKeyExists)
+
+	err = admin.NewStakingCert(nil, &NewStakingCertArgs{}, &newReply)
+	require.ErrorIs(err, errNoStakingCertPath)
+}
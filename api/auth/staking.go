@@ -0,0 +1,207 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/staking"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+)
+
+const (
+	// StakingTimestampHeader is the header containing the unix time, in
+	// seconds, at which a request was signed.
+	StakingTimestampHeader = "Staking-Timestamp"
+	// StakingSignatureHeader is the header containing the base64 encoded
+	// signature of a request by the node's staking key.
+	StakingSignatureHeader = "Staking-Signature"
+
+	// maxStakingRequestSkew is how far the timestamp of a signed request may
+	// differ from the current time.
+	maxStakingRequestSkew = 30 * time.Second
+)
+
+var (
+	errNoStakingSignature          = errors.New("calls to this method must be signed by the node's staking key")
+	errStakingTimestampNotParsable = errors.New("couldn't parse the signed request timestamp")
+	errStakingSignatureNotParsable = errors.New("couldn't parse the signed request signature")
+	errStakingRequestExpired       = errors.New("the signed request timestamp is too far from the current time")
+	errStakingRequestReplayed      = errors.New("the signed request was already used")
+	errInvalidStakingSignature     = errors.New("the request wasn't signed by the node's staking key")
+
+	_ StakingAuth = (*stakingAuth)(nil)
+)
+
+// StakingAuth requires calls to sensitive API methods to be signed by the
+// staking key of the node. This allows the operator of a validator to
+// authenticate API calls with the key the validator already holds, rather than
+// sharing a static token.
+//
+// A signed request must include the [StakingTimestampHeader] and
+// [StakingSignatureHeader] headers. The signature is over the message returned
+// by [StakingRequestMessage]. Each signed message is only accepted once, and
+// only if the timestamp is within [maxStakingRequestSkew] of the node's time.
+type StakingAuth interface {
+	// WrapHandler wraps an http.Handler. Before passing a call to a protected
+	// method to the provided handler, the request signature is verified.
+	WrapHandler(h http.Handler) http.Handler
+}
+
+type stakingAuth struct {
+	// Used to mock time.
+	clock mockable.Clock
+
+	cert *staking.Certificate
+	// JSON-RPC methods, such as "admin.restartChain", that require a signed
+	// request.
+	methods set.Set[string]
+
+	lock sync.Mutex
+	// Hash of the signed message -> time after which the signed request would
+	// be rejected as expired anyway. The message is tracked rather than the
+	// signature, so that a request can't be replayed with a different encoding
+	// of its signature.
+	used map[hashing.Hash256]time.Time
+}
+
+// NewStakingAuth returns a StakingAuth that requires calls to [methods] to be
+// signed by the key of [cert].
+func NewStakingAuth(cert *staking.Certificate, methods []string) StakingAuth {
+	return &stakingAuth{
+		cert:    cert,
+		methods: set.Of(methods...),
+		used:    make(map[hashing.Hash256]time.Time),
+	}
+}
+
+func (a *stakingAuth) WrapHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// JSON-RPC calls are always made with POST requests
+		if r.Method != http.MethodPost {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeUnauthorizedResponse(w, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		// The method is parsed the same way the JSON-RPC server parses it, so
+		// that any call to a protected method is verified. If the method can't
+		// be parsed, the call will be rejected by the JSON-RPC server.
+		var request struct {
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(bytes.NewReader(body)).Decode(&request); err != nil || !a.methods.Contains(request.Method) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		if err := a.authenticate(r, body); err != nil {
+			writeUnauthorizedResponse(w, err)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (a *stakingAuth) authenticate(r *http.Request, body []byte) error {
+	rawTimestamp := r.Header.Get(StakingTimestampHeader)
+	rawSignature := r.Header.Get(StakingSignatureHeader)
+	if rawTimestamp == "" || rawSignature == "" {
+		return errNoStakingSignature
+	}
+
+	timestamp, err := strconv.ParseInt(rawTimestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errStakingTimestampNotParsable, err)
+	}
+	signature, err := base64.StdEncoding.DecodeString(rawSignature)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errStakingSignatureNotParsable, err)
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	now := a.clock.Time()
+	for msgHash, expiry := range a.used {
+		if now.After(expiry) {
+			delete(a.used, msgHash)
+		}
+	}
+
+	signedAt := time.Unix(timestamp, 0)
+	if signedAt.Before(now.Add(-maxStakingRequestSkew)) || signedAt.After(now.Add(maxStakingRequestSkew)) {
+		return errStakingRequestExpired
+	}
+
+	msg := StakingRequestMessage(timestamp, r.URL.Path, body)
+	msgHash := hashing.ComputeHash256Array(msg)
+	if _, ok := a.used[msgHash]; ok {
+		return errStakingRequestReplayed
+	}
+
+	if err := staking.CheckSignature(a.cert, msg, signature); err != nil {
+		return fmt.Errorf("%w: %w", errInvalidStakingSignature, err)
+	}
+
+	a.used[msgHash] = signedAt.Add(maxStakingRequestSkew)
+	return nil
+}
+
+// StakingRequestMessage returns the message that is signed by the staking key
+// to authenticate a request, signed at unix time [timestamp], to the API path
+// [path] with [body].
+func StakingRequestMessage(timestamp int64, path string, body []byte) []byte {
+	header := fmt.Sprintf("%d\n%s\n", timestamp, path)
+	return append([]byte(header), body...)
+}
+
+// SignStakingRequest signs [r] at time [now] with the staking key [signer], so
+// that it will be accepted by a StakingAuth using the corresponding
+// certificate.
+func SignStakingRequest(r *http.Request, signer crypto.Signer, now time.Time) error {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	timestamp := now.Unix()
+	msg := StakingRequestMessage(timestamp, r.URL.Path, body)
+	signature, err := signer.Sign(
+		rand.Reader,
+		hashing.ComputeHash256(msg),
+		crypto.SHA256,
+	)
+	if err != nil {
+		return err
+	}
+
+	r.Header.Set(StakingTimestampHeader, strconv.FormatInt(timestamp, 10))
+	r.Header.Set(StakingSignatureHeader, base64.StdEncoding.EncodeToString(signature))
+	return nil
+}
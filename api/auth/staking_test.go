@@ -0,0 +1,189 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package auth
+
+import (
+	"crypto"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/staking"
+)
+
+const (
+	protectedBody   = `{"jsonrpc":"2.0","id":1,"method":"admin.restartChain","params":{}}`
+	unprotectedBody = `{"jsonrpc":"2.0","id":1,"method":"admin.getChainAliases","params":{}}`
+)
+
+// Generating staking keys is slow, so the same key is used by every test.
+var (
+	testStakingCertOnce sync.Once
+	testStakingCert     *tls.Certificate
+	testStakingCertErr  error
+)
+
+func newTestStakingAuth(t *testing.T) (*stakingAuth, crypto.Signer) {
+	testStakingCertOnce.Do(func() {
+		testStakingCert, testStakingCertErr = staking.NewTLSCert()
+	})
+	require.NoError(t, testStakingCertErr)
+	tlsCert := testStakingCert
+
+	cert := staking.CertificateFromX509(tlsCert.Leaf)
+	a := NewStakingAuth(cert, []string{"admin.restartChain"}).(*stakingAuth)
+	return a, tlsCert.PrivateKey.(crypto.Signer)
+}
+
+func newStakingRequest(body string) *http.Request {
+	return httptest.NewRequest(http.MethodPost, "http://127.0.0.1:9650/ext/admin", strings.NewReader(body))
+}
+
+func TestStakingAuthUnprotectedMethod(t *testing.T) {
+	require := require.New(t)
+
+	a, _ := newTestStakingAuth(t)
+	handler := a.WrapHandler(dummyHandler)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, newStakingRequest(unprotectedBody))
+	require.Equal(http.StatusOK, rr.Code)
+}
+
+func TestStakingAuthHappyPath(t *testing.T) {
+	require := require.New(t)
+
+	a, signer := newTestStakingAuth(t)
+	now := time.Now()
+	a.clock.Set(now)
+
+	var receivedBody string
+	handler := a.WrapHandler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(err)
+		receivedBody = string(body)
+	}))
+
+	req := newStakingRequest(protectedBody)
+	require.NoError(SignStakingRequest(req, signer, now))
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	require.Equal(http.StatusOK, rr.Code)
+	// The wrapped handler must still be able to read the request
+	require.Equal(protectedBody, receivedBody)
+}
+
+func TestStakingAuthRejectsInvalidRequests(t *testing.T) {
+	otherCert, err := staking.NewTLSCert()
+	require.NoError(t, err)
+	otherSigner := otherCert.PrivateKey.(crypto.Signer)
+
+	tests := []struct {
+		name        string
+		sign        func(*http.Request, crypto.Signer, time.Time) error
+		expectedErr error
+	}{
+		{
+			name: "unsigned",
+			sign: func(*http.Request, crypto.Signer, time.Time) error {
+				return nil
+			},
+			expectedErr: errNoStakingSignature,
+		},
+		{
+			name: "wrong key",
+			sign: func(r *http.Request, _ crypto.Signer, now time.Time) error {
+				return SignStakingRequest(r, otherSigner, now)
+			},
+			expectedErr: errInvalidStakingSignature,
+		},
+		{
+			name: "expired",
+			sign: func(r *http.Request, signer crypto.Signer, now time.Time) error {
+				return SignStakingRequest(r, signer, now.Add(-2*maxStakingRequestSkew))
+			},
+			expectedErr: errStakingRequestExpired,
+		},
+		{
+			name: "modified path",
+			sign: func(r *http.Request, signer crypto.Signer, now time.Time) error {
+				if err := SignStakingRequest(r, signer, now); err != nil {
+					return err
+				}
+				r.URL.Path = "/ext/info"
+				return nil
+			},
+			expectedErr: errInvalidStakingSignature,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			a, signer := newTestStakingAuth(t)
+			now := time.Now()
+			a.clock.Set(now)
+
+			req := newStakingRequest(protectedBody)
+			require.NoError(test.sign(req, signer, now))
+
+			rr := httptest.NewRecorder()
+			a.WrapHandler(dummyHandler).ServeHTTP(rr, req)
+			require.Equal(http.StatusUnauthorized, rr.Code)
+			require.Contains(rr.Body.String(), test.expectedErr.Error())
+		})
+	}
+}
+
+func TestStakingAuthRejectsReplay(t *testing.T) {
+	require := require.New(t)
+
+	a, signer := newTestStakingAuth(t)
+	now := time.Now()
+	a.clock.Set(now)
+
+	req := newStakingRequest(protectedBody)
+	require.NoError(SignStakingRequest(req, signer, now))
+
+	handler := a.WrapHandler(dummyHandler)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	require.Equal(http.StatusOK, rr.Code)
+
+	replayed := newStakingRequest(protectedBody)
+	replayed.Header = req.Header.Clone()
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, replayed)
+	require.Equal(http.StatusUnauthorized, rr.Code)
+	require.Contains(rr.Body.String(), errStakingRequestReplayed.Error())
+
+	// Re-encoding the signature doesn't allow the request to be replayed. The
+	// base64 decoder ignores newlines, so this is the same signature.
+	rawSignature := req.Header.Get(StakingSignatureHeader)
+	reencoded := newStakingRequest(protectedBody)
+	reencoded.Header = req.Header.Clone()
+	reencoded.Header.Set(StakingSignatureHeader, rawSignature[:4]+"\n"+rawSignature[4:])
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, reencoded)
+	require.Equal(http.StatusUnauthorized, rr.Code)
+	require.Contains(rr.Body.String(), errStakingRequestReplayed.Error())
+
+	// Used messages are forgotten once they would be expired anyway
+	later := now.Add(2 * maxStakingRequestSkew)
+	a.clock.Set(later)
+	req = newStakingRequest(protectedBody)
+	require.NoError(SignStakingRequest(req, signer, later))
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	require.Equal(http.StatusOK, rr.Code)
+	require.Len(a.used, 1)
+}
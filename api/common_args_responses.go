@@ -4,6 +4,8 @@
 package api
 
 import (
+	"time"
+
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/formatting"
 	"github.com/DioneProtocol/odysseygo/utils/json"
@@ -81,6 +83,19 @@ type GetBlockResponse struct {
 	// If GetBlockResponse.Encoding is formatting.JSON, GetBlockResponse.Block
 	// is the actual block returned as a JSON.
 	Encoding formatting.Encoding `json:"encoding"`
+	// AcceptedAt is the local time that the block was accepted at. It is
+	// omitted if the block hasn't been accepted or if its acceptance time
+	// wasn't recorded.
+	AcceptedAt *time.Time `json:"acceptedAt,omitempty"`
+	// ProposerNodeID is the node that proposed the block, as recorded by the
+	// proposervm block that wrapped it. It is only populated by chains that
+	// resolve their proposervm blocks, and is omitted if the wrapping block
+	// wasn't signed.
+	ProposerNodeID *ids.NodeID `json:"proposerNodeID,omitempty"`
+	// Timestamp is the timestamp of the proposervm block that wrapped the
+	// block. It is omitted if the block wasn't wrapped or if the wrapping
+	// block couldn't be resolved.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
 }
 
 type GetHeightResponse struct {
@@ -115,6 +130,27 @@ type FormattedTx struct {
 	Encoding formatting.Encoding `json:"encoding"`
 }
 
+// FormattedTxs defines a JSON formatted struct containing Txs as strings
+type FormattedTxs struct {
+	Txs      []string            `json:"txs"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// IssueTxResult is the outcome of issuing a single tx of a batch
+type IssueTxResult struct {
+	// TxID is empty if the tx couldn't be decoded or parsed
+	TxID ids.ID `json:"txID"`
+	// Error is the reason the tx wasn't issued. It is omitted if the tx was
+	// issued.
+	Error string `json:"error,omitempty"`
+}
+
+// IssueTxsReply defines the results of issuing a batch of txs
+type IssueTxsReply struct {
+	// Results[i] is the outcome of issuing the i-th tx of the batch
+	Results []IssueTxResult `json:"results"`
+}
+
 // Index is an address and an associated UTXO.
 // Marks a starting or stopping point when fetching UTXOs. Used for pagination.
 type Index struct {
@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/formatting"
 	"github.com/DioneProtocol/odysseygo/utils/rpc"
+	"github.com/DioneProtocol/odysseygo/vms/components/txdecoder"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 )
 
@@ -25,9 +27,12 @@ type Client interface {
 	GetBlockchainID(context.Context, string, ...rpc.Option) (ids.ID, error)
 	Peers(context.Context, ...rpc.Option) ([]Peer, error)
 	IsBootstrapped(context.Context, string, ...rpc.Option) (bool, error)
+	GetBootstrapProgress(context.Context, string, ...rpc.Option) (*GetBootstrapProgressReply, error)
 	GetTxFee(context.Context, ...rpc.Option) (*GetTxFeeResponse, error)
 	Uptime(context.Context, ids.ID, ...rpc.Option) (*UptimeResponse, error)
 	GetVMs(context.Context, ...rpc.Option) (map[ids.ID][]string, error)
+	GetVMVersions(context.Context, ...rpc.Option) (map[ids.ID]string, error)
+	DecodeTx(context.Context, string, []byte, ...rpc.Option) (*txdecoder.Description, error)
 }
 
 // Client implementation for an Info API Client
@@ -94,6 +99,14 @@ func (c *client) IsBootstrapped(ctx context.Context, chainID string, options ...
 	return res.IsBootstrapped, err
 }
 
+func (c *client) GetBootstrapProgress(ctx context.Context, chainID string, options ...rpc.Option) (*GetBootstrapProgressReply, error) {
+	res := &GetBootstrapProgressReply{}
+	err := c.requester.SendRequest(ctx, "info.getBootstrapProgress", &GetBootstrapProgressArgs{
+		Chain: chainID,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetTxFee(ctx context.Context, options ...rpc.Option) (*GetTxFeeResponse, error) {
 	res := &GetTxFeeResponse{}
 	err := c.requester.SendRequest(ctx, "info.getTxFee", struct{}{}, res, options...)
@@ -114,6 +127,26 @@ func (c *client) GetVMs(ctx context.Context, options ...rpc.Option) (map[ids.ID]
 	return res.VMs, err
 }
 
+func (c *client) GetVMVersions(ctx context.Context, options ...rpc.Option) (map[ids.ID]string, error) {
+	res := &GetVMsReply{}
+	err := c.requester.SendRequest(ctx, "info.getVMs", struct{}{}, res, options...)
+	return res.Versions, err
+}
+
+func (c *client) DecodeTx(ctx context.Context, chain string, txBytes []byte, options ...rpc.Option) (*txdecoder.Description, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	res := &DecodeTxReply{}
+	err = c.requester.SendRequest(ctx, "info.decodeTx", &DecodeTxArgs{
+		Chain:    chain,
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res.Tx, err
+}
+
 // AwaitBootstrapped polls the node every [freq] to check if [chainID] has
 // finished bootstrapping. Returns true once [chainID] reports that it has
 // finished bootstrapping.
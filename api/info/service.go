@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 
@@ -20,11 +21,13 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow/networking/benchlist"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/formatting"
 	"github.com/DioneProtocol/odysseygo/utils/ips"
 	"github.com/DioneProtocol/odysseygo/utils/json"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/version"
 	"github.com/DioneProtocol/odysseygo/vms"
+	"github.com/DioneProtocol/odysseygo/vms/components/txdecoder"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 )
 
@@ -57,6 +60,7 @@ type Parameters struct {
 	AddSubnetValidatorFee         uint64
 	AddSubnetDelegatorFee         uint64
 	VMManager                     vms.Manager
+	TxDecoders                    txdecoder.Registry
 }
 
 // NewService returns a new admin API service
@@ -282,6 +286,61 @@ func (i *Info) IsBootstrapped(_ *http.Request, args *IsBootstrappedArgs, reply *
 	return nil
 }
 
+// GetBootstrapProgressArgs are the arguments for calling GetBootstrapProgress
+type GetBootstrapProgressArgs struct {
+	// Alias of the chain
+	// Can also be the string representation of the chain's ID
+	Chain string `json:"chain"`
+}
+
+// GetBootstrapProgressReply are the results from calling
+// GetBootstrapProgress
+type GetBootstrapProgressReply struct {
+	// True iff the chain is done bootstrapping
+	IsBootstrapped bool `json:"isBootstrapped"`
+	// Number of containers fetched so far
+	Fetched json.Uint64 `json:"fetched"`
+	// Number of containers expected to be fetched. Zero if unknown.
+	ToFetch json.Uint64 `json:"toFetch"`
+	// Number of fetched containers executed so far
+	Executed json.Uint64 `json:"executed"`
+	// Number of fetched containers to execute
+	ToExecute json.Uint64 `json:"toExecute"`
+	// Estimated time until the containers are fetched or, once executing has
+	// started, until they are executed
+	ETA time.Duration `json:"eta"`
+}
+
+// GetBootstrapProgress returns the progress of the most recent bootstrapping
+// of [args.Chain]
+func (i *Info) GetBootstrapProgress(_ *http.Request, args *GetBootstrapProgressArgs, reply *GetBootstrapProgressReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getBootstrapProgress"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	if args.Chain == "" {
+		return errNoChainProvided
+	}
+	chainID, err := i.chainManager.Lookup(args.Chain)
+	if err != nil {
+		return fmt.Errorf("there is no chain with alias/ID '%s'", args.Chain)
+	}
+	progress, err := i.chainManager.BootstrapProgress(chainID)
+	if err != nil {
+		return err
+	}
+
+	reply.IsBootstrapped = i.chainManager.IsBootstrapped(chainID)
+	reply.Fetched = json.Uint64(progress.Fetched)
+	reply.ToFetch = json.Uint64(progress.ToFetch)
+	reply.Executed = json.Uint64(progress.Executed)
+	reply.ToExecute = json.Uint64(progress.ToExecute)
+	reply.ETA = progress.ETA
+	return nil
+}
+
 // UptimeResponse are the results from calling Uptime
 type UptimeResponse struct {
 	// RewardingStakePercentage shows what percent of network stake thinks we're
@@ -353,9 +412,13 @@ func (i *Info) GetTxFee(_ *http.Request, _ *struct{}, reply *GetTxFeeResponse) e
 // GetVMsReply contains the response metadata for GetVMs
 type GetVMsReply struct {
 	VMs map[ids.ID][]string `json:"vms"`
+	// Versions the VMs reported when they were registered. VMs that didn't
+	// report a version are omitted.
+	Versions map[ids.ID]string `json:"versions"`
 }
 
-// GetVMs lists the virtual machines installed on the node
+// GetVMs lists the virtual machines installed on the node along with their
+// aliases and versions
 func (i *Info) GetVMs(_ *http.Request, _ *struct{}, reply *GetVMsReply) error {
 	i.log.Debug("API called",
 		zap.String("service", "info"),
@@ -369,5 +432,62 @@ func (i *Info) GetVMs(_ *http.Request, _ *struct{}, reply *GetVMsReply) error {
 	}
 
 	reply.VMs, err = ids.GetRelevantAliases(i.VMManager, vmIDs)
+	if err != nil {
+		return err
+	}
+
+	versions, err := i.VMManager.Versions()
+	if err != nil {
+		return err
+	}
+	reply.Versions = make(map[ids.ID]string, len(versions))
+	for _, vmID := range vmIDs {
+		alias, err := i.VMManager.PrimaryAlias(vmID)
+		if err != nil {
+			return err
+		}
+		if version, ok := versions[alias]; ok {
+			reply.Versions[vmID] = version
+		}
+	}
+	return nil
+}
+
+// DecodeTxArgs are the arguments for calling DecodeTx
+type DecodeTxArgs struct {
+	// Alias of the chain the tx was issued to
+	// Can also be the string representation of the chain's ID
+	Chain    string              `json:"chain"`
+	Tx       string              `json:"tx"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// DecodeTxReply is the response from calling DecodeTx
+type DecodeTxReply struct {
+	Tx *txdecoder.Description `json:"tx"`
+}
+
+// DecodeTx returns a VM agnostic description of [args.Tx]
+func (i *Info) DecodeTx(r *http.Request, args *DecodeTxArgs, reply *DecodeTxReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "decodeTx"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	if args.Chain == "" {
+		return errNoChainProvided
+	}
+	chainID, err := i.chainManager.Lookup(args.Chain)
+	if err != nil {
+		return fmt.Errorf("there is no chain with alias/ID '%s'", args.Chain)
+	}
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+
+	reply.Tx, err = i.TxDecoders.Decode(r.Context(), chainID, txBytes)
 	return err
 }
@@ -4,16 +4,22 @@
 package info
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"go.uber.org/mock/gomock"
 
+	"github.com/DioneProtocol/odysseygo/chains"
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/formatting"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/vms"
+	"github.com/DioneProtocol/odysseygo/vms/components/txdecoder"
 )
 
 var errTest = errors.New("non-nil error")
@@ -66,10 +72,15 @@ func TestGetVMsSuccess(t *testing.T) {
 	resources.mockVMManager.EXPECT().ListFactories().Times(1).Return(vmIDs, nil)
 	resources.mockVMManager.EXPECT().Aliases(id1).Times(1).Return(alias1, nil)
 	resources.mockVMManager.EXPECT().Aliases(id2).Times(1).Return(alias2, nil)
+	// only the first vm reported a version
+	resources.mockVMManager.EXPECT().Versions().Times(1).Return(map[string]string{id1.String(): "v1.2.3"}, nil)
+	resources.mockVMManager.EXPECT().PrimaryAlias(id1).Times(1).Return(id1.String(), nil)
+	resources.mockVMManager.EXPECT().PrimaryAlias(id2).Times(1).Return(id2.String(), nil)
 
 	reply := GetVMsReply{}
 	require.NoError(resources.info.GetVMs(nil, nil, &reply))
 	require.Equal(expectedVMRegistry, reply.VMs)
+	require.Equal(map[ids.ID]string{id1: "v1.2.3"}, reply.Versions)
 }
 
 // Tests GetVMs if we fail to list our vms.
@@ -102,3 +113,45 @@ func TestGetVMsGetAliasesFails(t *testing.T) {
 	err := resources.info.GetVMs(nil, nil, &reply)
 	require.ErrorIs(t, err, errTest)
 }
+
+type testTxDecoder struct{}
+
+func (testTxDecoder) DecodeTx(_ context.Context, txBytes []byte) (*txdecoder.Description, error) {
+	return &txdecoder.Description{
+		Type: string(txBytes),
+	}, nil
+}
+
+func TestDecodeTx(t *testing.T) {
+	require := require.New(t)
+
+	chainID := ids.GenerateTestID()
+	registry := txdecoder.NewRegistry()
+	require.NoError(registry.Register(chainID, testTxDecoder{}))
+
+	service := Info{
+		Parameters: Parameters{
+			TxDecoders: registry,
+		},
+		log:          logging.NoLog{},
+		chainManager: chains.TestManager,
+	}
+
+	txStr, err := formatting.Encode(formatting.Hex, []byte("BaseTx"))
+	require.NoError(err)
+
+	reply := DecodeTxReply{}
+	require.NoError(service.DecodeTx(httptest.NewRequest(http.MethodPost, "/", nil), &DecodeTxArgs{
+		Chain:    chainID.String(),
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, &reply))
+	require.Equal("BaseTx", reply.Tx.Type)
+
+	err = service.DecodeTx(httptest.NewRequest(http.MethodPost, "/", nil), &DecodeTxArgs{
+		Chain:    ids.GenerateTestID().String(),
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, &reply)
+	require.ErrorIs(err, txdecoder.ErrNoDecoder)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/api"
 	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/json"
 	"github.com/DioneProtocol/odysseygo/utils/rpc"
 )
 
@@ -27,6 +28,10 @@ type Client interface {
 	ImportUser(ctx context.Context, importTo api.UserPass, exportedUser []byte, options ...rpc.Option) error
 	// Delete the given user
 	DeleteUser(context.Context, api.UserPass, ...rpc.Option) error
+	// Returns up to [limit] keystore events starting with the event with
+	// sequence [startSequence], and the sequence to fetch the following events
+	// from
+	GetEvents(ctx context.Context, startSequence uint64, limit uint32, options ...rpc.Option) ([]Event, uint64, error)
 }
 
 // Client implementation for Odyssey Keystore API Endpoint
@@ -79,3 +84,12 @@ func (c *client) ImportUser(ctx context.Context, user api.UserPass, account []by
 func (c *client) DeleteUser(ctx context.Context, user api.UserPass, options ...rpc.Option) error {
 	return c.requester.SendRequest(ctx, "keystore.deleteUser", &user, &api.EmptyReply{}, options...)
 }
+
+func (c *client) GetEvents(ctx context.Context, startSequence uint64, limit uint32, options ...rpc.Option) ([]Event, uint64, error) {
+	res := &GetEventsReply{}
+	err := c.requester.SendRequest(ctx, "keystore.getEvents", &GetEventsArgs{
+		StartSequence: json.Uint64(startSequence),
+		Limit:         json.Uint32(limit),
+	}, res, options...)
+	return res.Events, uint64(res.NextSequence), err
+}
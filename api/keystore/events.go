@@ -0,0 +1,214 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package keystore
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+)
+
+const (
+	UserCreated  EventType = "userCreated"
+	UserDeleted  EventType = "userDeleted"
+	UserImported EventType = "userImported"
+	// AddressAdded is emitted when a key is stored for a new address of a user,
+	// regardless of whether the key was generated or imported.
+	AddressAdded EventType = "addressAdded"
+
+	// maxEventsPerRequest is the maximum number of events returned by a single
+	// call to GetEvents
+	maxEventsPerRequest = 1024
+)
+
+var (
+	errNilEventHandler = errors.New("nil event handler")
+
+	_ database.Database = (*addressObserverDB)(nil)
+)
+
+// EventType describes the kind of keystore mutation an Event records.
+type EventType string
+
+// Event records a mutation of the keystore. Events are numbered by a sequence
+// that starts at 0 and is incremented by 1 for every event, so a consumer that
+// mirrors the keystore can detect any events it missed.
+type Event struct {
+	Sequence  json.Uint64 `serialize:"true" json:"sequence"`
+	Type      EventType   `serialize:"true" json:"type"`
+	Username  string      `serialize:"true" json:"username"`
+	Timestamp json.Uint64 `serialize:"true" json:"timestamp"`
+	// BlockchainID and Address are only populated for AddressAdded events.
+	BlockchainID ids.ID      `serialize:"true" json:"blockchainID"`
+	Address      ids.ShortID `serialize:"true" json:"address"`
+}
+
+// EventHandler is notified of every keystore event after the mutation it
+// records has been persisted. Events are delivered in sequence order.
+type EventHandler interface {
+	HandleEvent(Event)
+}
+
+// addressObserverDB records an AddressAdded event whenever a new address is
+// written into a user's blockchain database. VMs store the key of each address
+// a user controls under the address itself.
+type addressObserverDB struct {
+	database.Database
+
+	ks           *keystore
+	username     string
+	blockchainID ids.ID
+}
+
+func (db *addressObserverDB) Put(key, value []byte) error {
+	if len(key) != ids.ShortIDLen {
+		return db.Database.Put(key, value)
+	}
+
+	exists, err := db.Database.Has(key)
+	if err != nil {
+		return err
+	}
+	if err := db.Database.Put(key, value); err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	address, err := ids.ToShortID(key)
+	if err != nil {
+		return err
+	}
+
+	db.ks.lock.Lock()
+	defer db.ks.lock.Unlock()
+
+	return db.ks.emit(nil, Event{
+		Type:         AddressAdded,
+		Username:     db.username,
+		BlockchainID: db.blockchainID,
+		Address:      address,
+	})
+}
+
+// loadEventSequence sets the sequence of the next event from the persisted
+// events. Assumes the lock is held.
+func (ks *keystore) loadEventSequence() error {
+	if ks.eventSequenceLoaded {
+		return nil
+	}
+
+	it := ks.eventDB.NewIterator()
+	defer it.Release()
+
+	// Sequences are stored big endian, so the last key is the last event.
+	var lastKey []byte
+	for it.Next() {
+		lastKey = it.Key()
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if lastKey != nil {
+		lastSequence, err := database.ParseUInt64(lastKey)
+		if err != nil {
+			return err
+		}
+		ks.nextEventSequence = lastSequence + 1
+	}
+	ks.eventSequenceLoaded = true
+	return nil
+}
+
+// emit persists [event] and notifies the registered event handlers. If [batch]
+// is non-nil, the event is written into [batch] alongside the mutation it
+// records, and is only persisted once [batch] is written. Assumes the lock is
+// held.
+func (ks *keystore) emit(batch database.Batch, event Event) error {
+	if err := ks.loadEventSequence(); err != nil {
+		return err
+	}
+
+	event.Sequence = json.Uint64(ks.nextEventSequence)
+	event.Timestamp = json.Uint64(ks.clock.Unix())
+	eventBytes, err := c.Marshal(codecVersion, &event)
+	if err != nil {
+		return err
+	}
+
+	key := database.PackUInt64(ks.nextEventSequence)
+	if batch == nil {
+		if err := ks.eventDB.Put(key, eventBytes); err != nil {
+			return err
+		}
+		ks.publish(event)
+	} else {
+		if err := batch.Put(key, eventBytes); err != nil {
+			return err
+		}
+		ks.pendingEvents = append(ks.pendingEvents, event)
+	}
+	ks.nextEventSequence++
+	return nil
+}
+
+// publish notifies the registered event handlers of [event]. Assumes the lock
+// is held.
+func (ks *keystore) publish(event Event) {
+	for _, handler := range ks.eventHandlers {
+		handler.HandleEvent(event)
+	}
+}
+
+// commitEvents publishes the events that were written into a batch once the
+// batch has been written. If the batch failed to be written, the sequence is
+// rolled back. Assumes the lock is held.
+func (ks *keystore) commitEvents(err error) error {
+	if err != nil {
+		ks.nextEventSequence -= uint64(len(ks.pendingEvents))
+	} else {
+		for _, event := range ks.pendingEvents {
+			ks.publish(event)
+		}
+	}
+	ks.pendingEvents = ks.pendingEvents[:0]
+	return err
+}
+
+func (ks *keystore) RegisterEventHandler(handler EventHandler) error {
+	if handler == nil {
+		return errNilEventHandler
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+
+	ks.eventHandlers = append(ks.eventHandlers, handler)
+	return nil
+}
+
+func (ks *keystore) GetEvents(startSequence uint64, limit int) ([]Event, error) {
+	if limit <= 0 || limit > maxEventsPerRequest {
+		limit = maxEventsPerRequest
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+
+	it := ks.eventDB.NewIteratorWithStart(database.PackUInt64(startSequence))
+	defer it.Release()
+
+	events := []Event{}
+	for len(events) < limit && it.Next() {
+		var event Event
+		if _, err := c.Unmarshal(it.Value(), &event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, it.Error()
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/gorilla/rpc/v2"
@@ -20,22 +21,31 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/json"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/password"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 )
 
 const (
 	// maxUserLen is the maximum allowed length of a username
 	maxUserLen = 1024
+
+	// ReservedUsernamePrefix prefixes the usernames of the in-memory key
+	// sessions that the Alpha chain accepts in place of keystore users. Users
+	// can't be created with this prefix so that they are never shadowed by a
+	// key session.
+	ReservedUsernamePrefix = "keySession-"
 )
 
 var (
 	errEmptyUsername     = errors.New("empty username")
 	errUserMaxLength     = fmt.Errorf("username exceeds maximum length of %d chars", maxUserLen)
+	errReservedUsername  = fmt.Errorf("username can't start with %q", ReservedUsernamePrefix)
 	errUserAlreadyExists = errors.New("user already exists")
 	errIncorrectPassword = errors.New("incorrect password")
 	errNonexistentUser   = errors.New("user doesn't exist")
 
-	usersPrefix = []byte("users")
-	bcsPrefix   = []byte("bcs")
+	usersPrefix  = []byte("users")
+	bcsPrefix    = []byte("bcs")
+	eventsPrefix = []byte("events")
 
 	_ Keystore = (*keystore)(nil)
 )
@@ -76,6 +86,14 @@ type Keystore interface {
 	// with encrypted database values.
 	ExportUser(username, pw string) ([]byte, error)
 
+	// RegisterEventHandler registers [handler] to be notified of every event
+	// emitted after the handler is registered.
+	RegisterEventHandler(handler EventHandler) error
+
+	// GetEvents returns up to [limit] events, in sequence order, starting with
+	// the event with sequence [startSequence].
+	GetEvents(startSequence uint64, limit int) ([]Event, error)
+
 	// Get the password that is used by [username]. If [username] doesn't exist,
 	// no error is returned and a nil password hash is returned.
 	getPassword(username string) (*password.Hash, error)
@@ -93,8 +111,9 @@ type user struct {
 }
 
 type keystore struct {
-	lock sync.Mutex
-	log  logging.Logger
+	lock  sync.Mutex
+	log   logging.Logger
+	clock mockable.Clock
 
 	// Key: username
 	// Value: The hash of that user's password
@@ -110,6 +129,14 @@ type keystore struct {
 	//               Usr     Usr    Usr
 	//             /  |  \
 	//          BID  BID  BID
+
+	// Used to persist events, keyed by their sequence
+	eventDB             database.Database
+	eventSequenceLoaded bool
+	nextEventSequence   uint64
+	// Events that were written into a batch that hasn't been written yet
+	pendingEvents []Event
+	eventHandlers []EventHandler
 }
 
 func New(log logging.Logger, dbManager manager.Manager) Keystore {
@@ -119,6 +146,7 @@ func New(log logging.Logger, dbManager manager.Manager) Keystore {
 		usernameToPassword: make(map[string]*password.Hash),
 		userDB:             prefixdb.New(usersPrefix, currentDB.Database),
 		bcDB:               prefixdb.New(bcsPrefix, currentDB.Database),
+		eventDB:            prefixdb.New(eventsPrefix, currentDB.Database),
 	}
 }
 
@@ -166,7 +194,12 @@ func (ks *keystore) GetRawDatabase(bID ids.ID, username, pw string) (database.Da
 
 	userDB := prefixdb.New([]byte(username), ks.bcDB)
 	bcDB := prefixdb.NewNested(bID[:], userDB)
-	return bcDB, nil
+	return &addressObserverDB{
+		Database:     bcDB,
+		ks:           ks,
+		username:     username,
+		blockchainID: bID,
+	}, nil
 }
 
 func (ks *keystore) CreateUser(username, pw string) error {
@@ -176,6 +209,9 @@ func (ks *keystore) CreateUser(username, pw string) error {
 	if len(username) > maxUserLen {
 		return errUserMaxLength
 	}
+	if strings.HasPrefix(username, ReservedUsernamePrefix) {
+		return errReservedUsername
+	}
 
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
@@ -202,7 +238,20 @@ func (ks *keystore) CreateUser(username, pw string) error {
 		return err
 	}
 
-	if err := ks.userDB.Put([]byte(username), passwordBytes); err != nil {
+	userBatch := ks.userDB.NewBatch()
+	if err := userBatch.Put([]byte(username), passwordBytes); err != nil {
+		return err
+	}
+
+	eventBatch := ks.eventDB.NewBatch()
+	if err := ks.emit(eventBatch, Event{
+		Type:     UserCreated,
+		Username: username,
+	}); err != nil {
+		return ks.commitEvents(err)
+	}
+
+	if err := ks.commitEvents(atomic.WriteAll(userBatch, eventBatch)); err != nil {
 		return err
 	}
 	ks.usernameToPassword[username] = passwordHash
@@ -254,7 +303,15 @@ func (ks *keystore) DeleteUser(username, pw string) error {
 		return err
 	}
 
-	if err := atomic.WriteAll(dataBatch, userBatch); err != nil {
+	eventBatch := ks.eventDB.NewBatch()
+	if err := ks.emit(eventBatch, Event{
+		Type:     UserDeleted,
+		Username: username,
+	}); err != nil {
+		return ks.commitEvents(err)
+	}
+
+	if err := ks.commitEvents(atomic.WriteAll(dataBatch, userBatch, eventBatch)); err != nil {
 		return err
 	}
 
@@ -284,6 +341,9 @@ func (ks *keystore) ImportUser(username, pw string, userBytes []byte) error {
 	if len(username) > maxUserLen {
 		return errUserMaxLength
 	}
+	if strings.HasPrefix(username, ReservedUsernamePrefix) {
+		return errReservedUsername
+	}
 
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
@@ -322,7 +382,15 @@ func (ks *keystore) ImportUser(username, pw string, userBytes []byte) error {
 		}
 	}
 
-	if err := atomic.WriteAll(dataBatch, userBatch); err != nil {
+	eventBatch := ks.eventDB.NewBatch()
+	if err := ks.emit(eventBatch, Event{
+		Type:     UserImported,
+		Username: username,
+	}); err != nil {
+		return ks.commitEvents(err)
+	}
+
+	if err := ks.commitEvents(atomic.WriteAll(dataBatch, userBatch, eventBatch)); err != nil {
 		return err
 	}
 	ks.usernameToPassword[username] = &userData.Hash
@@ -13,6 +13,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/database/manager"
 	"github.com/DioneProtocol/odysseygo/database/memdb"
 	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/json"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/version"
 )
@@ -116,6 +117,40 @@ func (s *service) ExportUser(_ *http.Request, args *ExportUserArgs, reply *Expor
 	return nil
 }
 
+type GetEventsArgs struct {
+	// The sequence of the first event to return
+	StartSequence json.Uint64 `json:"startSequence"`
+	// The maximum number of events to return. If 0 or greater than 1024,
+	// 1024 events are returned.
+	Limit json.Uint32 `json:"limit"`
+}
+
+type GetEventsReply struct {
+	Events []Event `json:"events"`
+	// The sequence to start from to fetch the events after [Events]
+	NextSequence json.Uint64 `json:"nextSequence"`
+}
+
+func (s *service) GetEvents(_ *http.Request, args *GetEventsArgs, reply *GetEventsReply) error {
+	s.ks.log.Warn("deprecated API called",
+		zap.String("service", "keystore"),
+		zap.String("method", "getEvents"),
+		zap.Uint64("startSequence", uint64(args.StartSequence)),
+	)
+
+	events, err := s.ks.GetEvents(uint64(args.StartSequence), int(args.Limit))
+	if err != nil {
+		return err
+	}
+
+	reply.Events = events
+	reply.NextSequence = args.StartSequence
+	if len(events) > 0 {
+		reply.NextSequence = events[len(events)-1].Sequence + 1
+	}
+	return nil
+}
+
 // CreateTestKeystore returns a new keystore that can be utilized for testing
 func CreateTestKeystore() (Keystore, error) {
 	dbManager, err := manager.NewManagerFromDBs([]*manager.VersionedDatabase{
@@ -11,9 +11,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/DioneProtocol/odysseygo/api"
+	"github.com/DioneProtocol/odysseygo/database/manager"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/password"
+	"github.com/DioneProtocol/odysseygo/version"
 )
 
 // strongPassword defines a password used for the following tests that
@@ -151,6 +155,34 @@ func TestServiceCreateUserNoName(t *testing.T) {
 	require.ErrorIs(err, errEmptyUsername)
 }
 
+func TestServiceCreateUserReservedPrefix(t *testing.T) {
+	require := require.New(t)
+
+	ks, err := CreateTestKeystore()
+	require.NoError(err)
+	s := service{ks: ks.(*keystore)}
+
+	// A keystore user named like a key session could be shadowed by it
+	username := ReservedUsernamePrefix + "bob"
+	err = s.CreateUser(nil, &api.UserPass{
+		Username: username,
+		Password: strongPassword,
+	}, &api.EmptyReply{})
+	require.ErrorIs(err, errReservedUsername)
+
+	err = s.ImportUser(nil, &ImportUserArgs{
+		UserPass: api.UserPass{
+			Username: username,
+			Password: strongPassword,
+		},
+	}, &api.EmptyReply{})
+	require.ErrorIs(err, errReservedUsername)
+
+	reply := ListUsersReply{}
+	require.NoError(s.ListUsers(nil, nil, &reply))
+	require.Empty(reply.Users)
+}
+
 func TestServiceUseBlockchainDB(t *testing.T) {
 	require := require.New(t)
 
@@ -346,3 +378,93 @@ func TestServiceDeleteUser(t *testing.T) {
 		})
 	}
 }
+
+type eventRecorder struct {
+	events []Event
+}
+
+func (r *eventRecorder) HandleEvent(event Event) {
+	r.events = append(r.events, event)
+}
+
+func TestServiceGetEvents(t *testing.T) {
+	require := require.New(t)
+
+	ks, err := CreateTestKeystore()
+	require.NoError(err)
+	s := service{ks: ks.(*keystore)}
+
+	recorder := &eventRecorder{}
+	require.NoError(ks.RegisterEventHandler(recorder))
+
+	userPass := api.UserPass{
+		Username: "bob",
+		Password: strongPassword,
+	}
+	require.NoError(s.CreateUser(nil, &userPass, &api.EmptyReply{}))
+
+	// Failed mutations don't emit events
+	err = s.CreateUser(nil, &userPass, &api.EmptyReply{})
+	require.ErrorIs(err, errUserAlreadyExists)
+
+	address := ids.GenerateTestShortID()
+	{
+		db, err := ks.GetDatabase(ids.Empty, "bob", strongPassword)
+		require.NoError(err)
+		require.NoError(db.Put(address[:], []byte("key")))
+		// Overwriting an address doesn't emit an event
+		require.NoError(db.Put(address[:], []byte("key")))
+		// Neither do writes that aren't addresses
+		require.NoError(db.Put([]byte("hello"), []byte("world")))
+	}
+
+	require.NoError(s.DeleteUser(nil, &userPass, &api.EmptyReply{}))
+
+	expectedEvents := []Event{
+		{
+			Sequence: 0,
+			Type:     UserCreated,
+			Username: "bob",
+		},
+		{
+			Sequence: 1,
+			Type:     AddressAdded,
+			Username: "bob",
+			Address:  address,
+		},
+		{
+			Sequence: 2,
+			Type:     UserDeleted,
+			Username: "bob",
+		},
+	}
+	for i := range recorder.events {
+		recorder.events[i].Timestamp = 0
+	}
+	require.Equal(expectedEvents, recorder.events)
+
+	reply := GetEventsReply{}
+	require.NoError(s.GetEvents(nil, &GetEventsArgs{
+		StartSequence: 1,
+		Limit:         1,
+	}, &reply))
+	require.Len(reply.Events, 1)
+	reply.Events[0].Timestamp = 0
+	require.Equal(expectedEvents[1], reply.Events[0])
+	require.Equal(json.Uint64(2), reply.NextSequence)
+
+	// A new keystore on the same database continues the sequence
+	newKS := New(logging.NoLog{}, manager.NewMemDB(version.Semantic1_0_0)).(*keystore)
+	newKS.eventDB = s.ks.eventDB
+	newKS.userDB = s.ks.userDB
+	newS := service{ks: newKS}
+	require.NoError(newS.CreateUser(nil, &userPass, &api.EmptyReply{}))
+
+	reply = GetEventsReply{}
+	require.NoError(newS.GetEvents(nil, &GetEventsArgs{
+		StartSequence: reply.NextSequence,
+	}, &reply))
+	require.Len(reply.Events, 4)
+	require.Equal(json.Uint64(3), reply.Events[3].Sequence)
+	require.Equal(json.Uint64(4), reply.NextSequence)
+}
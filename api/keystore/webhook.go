@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package keystore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+)
+
+const (
+	// webhookQueueSize is the maximum number of events that can be waiting to
+	// be sent to the webhook. Events that don't fit are dropped, and can be
+	// fetched with GetEvents.
+	webhookQueueSize = 1024
+	webhookTimeout   = 10 * time.Second
+)
+
+var _ EventHandler = (*Webhook)(nil)
+
+// Webhook POSTs every keystore event, JSON encoded, to a URL. Events are sent
+// one at a time, in sequence order. Delivery is best effort; the receiver
+// should use the event sequence to detect missed events and fetch them with
+// GetEvents.
+type Webhook struct {
+	log    logging.Logger
+	url    string
+	client *http.Client
+
+	events chan Event
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewWebhook returns a Webhook that sends events to [url] until it is shut
+// down.
+func NewWebhook(log logging.Logger, url string) *Webhook {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &Webhook{
+		log: log,
+		url: url,
+		client: &http.Client{
+			Timeout: webhookTimeout,
+		},
+		events: make(chan Event, webhookQueueSize),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	w.wg.Add(1)
+	go w.dispatch()
+	return w
+}
+
+func (w *Webhook) HandleEvent(event Event) {
+	select {
+	case w.events <- event:
+	default:
+		w.log.Warn("dropping keystore event",
+			zap.String("reason", "webhook queue is full"),
+			zap.Uint64("sequence", uint64(event.Sequence)),
+		)
+	}
+}
+
+// Shutdown stops sending events and waits for an in-flight send to finish.
+func (w *Webhook) Shutdown() {
+	w.cancel()
+	w.wg.Wait()
+}
+
+func (w *Webhook) dispatch() {
+	defer w.wg.Done()
+
+	for {
+		select {
+		case event := <-w.events:
+			if err := w.send(event); err != nil {
+				w.log.Warn("failed to send keystore event to webhook",
+					zap.Uint64("sequence", uint64(event.Sequence)),
+					zap.Error(err),
+				)
+			}
+		case <-w.ctx.Done():
+			return
+		}
+	}
+}
+
+func (w *Webhook) send(event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(w.ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := w.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("received status code: %d", response.StatusCode)
+	}
+	return nil
+}
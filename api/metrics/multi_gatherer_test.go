@@ -35,6 +35,18 @@ func TestMultiGathererDuplicatedPrefix(t *testing.T) {
 	require.NoError(g.Register("lol", og))
 }
 
+func TestMultiGathererDeregister(t *testing.T) {
+	require := require.New(t)
+
+	g := NewMultiGatherer()
+	og := NewOptionalGatherer()
+
+	require.False(g.Deregister("lol"))
+	require.NoError(g.Register("lol", og))
+	require.True(g.Deregister("lol"))
+	require.NoError(g.Register("lol", og))
+}
+
 func TestMultiGathererAddedError(t *testing.T) {
 	require := require.New(t)
 
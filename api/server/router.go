@@ -18,6 +18,7 @@ var (
 	errUnknownBaseURL  = errors.New("unknown base url")
 	errUnknownEndpoint = errors.New("unknown endpoint")
 	errAlreadyReserved = errors.New("route is either already aliased or already maps to a handle")
+	errAlreadyExists   = errors.New("endpoint already exists")
 )
 
 type router struct {
@@ -85,7 +86,7 @@ func (r *router) forceAddRouter(base, endpoint string, handler http.Handler) err
 	}
 	url := base + endpoint
 	if _, exists := endpoints[endpoint]; exists {
-		return fmt.Errorf("failed to create endpoint as %s already exists", url)
+		return fmt.Errorf("failed to create endpoint %s: %w", url, errAlreadyExists)
 	}
 
 	endpoints[endpoint] = handler
@@ -109,6 +110,44 @@ func (r *router) forceAddRouter(base, endpoint string, handler http.Handler) err
 	return err
 }
 
+// ReplaceRouter adds the route, replacing the handler of the route if it has
+// already been added.
+func (r *router) ReplaceRouter(base, endpoint string, handler http.Handler) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.routeLock.Lock()
+	defer r.routeLock.Unlock()
+
+	if r.reservedRoutes.Contains(base) {
+		return fmt.Errorf("%w: %s", errAlreadyReserved, base)
+	}
+
+	return r.forceReplaceRouter(base, endpoint, handler)
+}
+
+func (r *router) forceReplaceRouter(base, endpoint string, handler http.Handler) error {
+	endpoints := r.routes[base]
+	if _, exists := endpoints[endpoint]; !exists {
+		return r.forceAddRouter(base, endpoint, handler)
+	}
+	endpoints[endpoint] = handler
+
+	url := base + endpoint
+	route := r.router.Get(url)
+	if route == nil {
+		return fmt.Errorf("failed to find route for %s", url)
+	}
+	route.Handler(handler)
+
+	var err error
+	for _, alias := range r.aliases[base] {
+		if innerErr := r.forceReplaceRouter(alias, endpoint, handler); err == nil {
+			err = innerErr
+		}
+	}
+	return err
+}
+
 func (r *router) AddAlias(base string, aliases ...string) error {
 	r.lock.Lock()
 	defer r.lock.Unlock()
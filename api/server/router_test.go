@@ -5,6 +5,7 @@ package server
 
 import (
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -48,6 +49,33 @@ func TestAliasing(t *testing.T) {
 	require.Equal(handler1, handler)
 }
 
+func TestReplaceRouter(t *testing.T) {
+	require := require.New(t)
+
+	r := newRouter()
+	require.NoError(r.AddAlias("/1", "/2"))
+
+	handler1 := &testHandler{}
+	require.NoError(r.ReplaceRouter("/1", "", handler1))
+
+	handler2 := &testHandler{}
+	err := r.AddRouter("/1", "", handler2)
+	require.ErrorIs(err, errAlreadyExists)
+	require.NoError(r.ReplaceRouter("/1", "", handler2))
+
+	handler, err := r.GetHandler("/1", "")
+	require.NoError(err)
+	require.Equal(handler2, handler)
+
+	handler, err = r.GetHandler("/2", "")
+	require.NoError(err)
+	require.Equal(handler2, handler)
+
+	r.ServeHTTP(nil, &http.Request{URL: &url.URL{Path: "/2"}, Method: http.MethodGet})
+	require.False(handler1.called)
+	require.True(handler2.called)
+}
+
 func TestBlock(t *testing.T) {
 	require := require.New(t)
 	r := newRouter()
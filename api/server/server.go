@@ -237,7 +237,9 @@ func (s *server) addChainRoute(chainName string, handler *common.HTTPHandler, ct
 	// Apply middleware to reject calls to the handler before the chain finishes bootstrapping
 	h = rejectMiddleware(h, ctx)
 	h = s.metrics.wrapHandler(chainName, h)
-	return s.router.AddRouter(url, endpoint, h)
+	// A chain is registered again after it is restarted, in which case the
+	// handlers of the previous instance of the chain are replaced.
+	return s.router.ReplaceRouter(url, endpoint, h)
 }
 
 func (s *server) AddRoute(handler *common.HTTPHandler, lock *sync.RWMutex, base, endpoint string) error {
@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
+)
+
+var ErrChainStateNotPrefixed = errors.New("chain state isn't stored under a single prefix, chain snapshots must be enabled")
+
+// CompactChainDB compacts the key range that holds the state of the chain
+// [chainID].
+//
+// The chain's state is only stored under a single prefix when chain snapshots
+// are enabled. See newChainDBManager.
+func (m *manager) CompactChainDB(chainID ids.ID) error {
+	if !m.ChainSnapshotsEnabled {
+		return ErrChainStateNotPrefixed
+	}
+
+	m.chainsLock.Lock()
+	_, exists := m.chains[chainID]
+	m.chainsLock.Unlock()
+	if !exists {
+		return fmt.Errorf("%w: %s", errUnknownChain, chainID)
+	}
+
+	m.Log.Info("compacting chain database",
+		zap.Stringer("chainID", chainID),
+	)
+	startTime := time.Now()
+	start, limit := chainStateRange(chainID)
+	if err := m.DBManager.Current().Database.Compact(start, limit); err != nil {
+		return err
+	}
+	m.Log.Info("compacted chain database",
+		zap.Stringer("chainID", chainID),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+	return nil
+}
+
+// ChainDBSizes returns the approximate number of bytes used on disk to store
+// the state of each of the chains that are running.
+//
+// The chain's state is only stored under a single prefix when chain snapshots
+// are enabled. See newChainDBManager.
+func (m *manager) ChainDBSizes() (map[ids.ID]uint64, error) {
+	if !m.ChainSnapshotsEnabled {
+		return nil, ErrChainStateNotPrefixed
+	}
+
+	m.chainsLock.Lock()
+	chainIDs := make([]ids.ID, 0, len(m.chains))
+	for chainID := range m.chains {
+		chainIDs = append(chainIDs, chainID)
+	}
+	m.chainsLock.Unlock()
+
+	db := m.DBManager.Current().Database
+	sizes := make(map[ids.ID]uint64, len(chainIDs))
+	for _, chainID := range chainIDs {
+		start, limit := chainStateRange(chainID)
+		size, err := database.ApproximateSize(db, start, limit)
+		if err != nil {
+			return nil, err
+		}
+		sizes[chainID] = size
+	}
+	return sizes, nil
+}
+
+// chainStateRange returns the key range of the node's database that
+// chainStateDB writes the state of the chain [chainID] to.
+func chainStateRange(chainID ids.ID) ([]byte, []byte) {
+	start := hashing.ComputeHash256(chainID[:])
+	limit := make([]byte, len(start))
+	copy(limit, start)
+	for i := len(limit) - 1; i >= 0; i-- {
+		limit[i]++
+		if limit[i] != 0 {
+			return start, limit
+		}
+	}
+	// The prefix was all 0xff bytes, so the range extends to the end of the
+	// database.
+	return start, nil
+}
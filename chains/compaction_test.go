@@ -0,0 +1,88 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/version"
+
+	dbManager "github.com/DioneProtocol/odysseygo/database/manager"
+)
+
+func TestChainStateRange(t *testing.T) {
+	require := require.New(t)
+
+	m := &manager{
+		ManagerConfig: ManagerConfig{
+			Log:                   logging.NoLog{},
+			DBManager:             dbManager.NewMemDB(version.Semantic1_0_0),
+			ChainSnapshotsEnabled: true,
+		},
+	}
+
+	chainID := ids.GenerateTestID()
+	for _, id := range []ids.ID{chainID, ids.GenerateTestID()} {
+		chainDBManager, err := m.newChainDBManager(id, prometheus.NewRegistry())
+		require.NoError(err)
+		// Nested prefixes must be included in the range.
+		db := chainDBManager.NewPrefixDBManager([]byte("vm")).Current().Database
+		require.NoError(db.Put([]byte("key"), id[:]))
+	}
+
+	start, limit := chainStateRange(chainID)
+	it := m.DBManager.Current().Database.NewIteratorWithStart(start)
+	defer it.Release()
+
+	var values [][]byte
+	for it.Next() && bytes.Compare(it.Key(), limit) < 0 {
+		values = append(values, it.Value())
+	}
+	require.NoError(it.Error())
+	require.Equal([][]byte{chainID[:]}, values)
+}
+
+func TestChainStateRangeOverflow(t *testing.T) {
+	require := require.New(t)
+
+	// Find a chain ID whose prefix ends in 0xff so that computing the limit
+	// carries.
+	chainID := ids.Empty
+	for i := 0; ; i++ {
+		chainID[0] = byte(i)
+		chainID[1] = byte(i >> 8)
+		start, _ := chainStateRange(chainID)
+		if start[len(start)-1] == 0xff {
+			break
+		}
+	}
+
+	start, limit := chainStateRange(chainID)
+	require.Len(limit, len(start))
+	require.Zero(limit[len(limit)-1])
+	require.Positive(bytes.Compare(limit, start))
+}
+
+func TestChainDBDisabled(t *testing.T) {
+	require := require.New(t)
+
+	m := &manager{
+		ManagerConfig: ManagerConfig{
+			DBManager: dbManager.NewMemDB(version.Semantic1_0_0),
+		},
+	}
+
+	err := m.CompactChainDB(ids.GenerateTestID())
+	require.ErrorIs(err, ErrChainStateNotPrefixed)
+
+	_, err = m.ChainDBSizes()
+	require.ErrorIs(err, ErrChainStateNotPrefixed)
+}
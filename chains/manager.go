@@ -18,6 +18,8 @@ import (
 
 	"go.uber.org/zap"
 
+	"golang.org/x/exp/slices"
+
 	"github.com/DioneProtocol/odysseygo/api/health"
 	"github.com/DioneProtocol/odysseygo/api/keystore"
 	"github.com/DioneProtocol/odysseygo/api/metrics"
@@ -36,6 +38,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow/engine/odyssey/vertex"
 	"github.com/DioneProtocol/odysseygo/snow/engine/snowman/block"
 	"github.com/DioneProtocol/odysseygo/snow/engine/snowman/syncer"
+	"github.com/DioneProtocol/odysseygo/snow/networking/appcrypto"
 	"github.com/DioneProtocol/odysseygo/snow/networking/handler"
 	"github.com/DioneProtocol/odysseygo/snow/networking/router"
 	"github.com/DioneProtocol/odysseygo/snow/networking/sender"
@@ -53,8 +56,10 @@ import (
 	"github.com/DioneProtocol/odysseygo/version"
 	"github.com/DioneProtocol/odysseygo/vms"
 	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
+	"github.com/DioneProtocol/odysseygo/vms/components/txdecoder"
 	"github.com/DioneProtocol/odysseygo/vms/metervm"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+	"github.com/DioneProtocol/odysseygo/vms/policyvm"
 	"github.com/DioneProtocol/odysseygo/vms/proposervm"
 	"github.com/DioneProtocol/odysseygo/vms/tracedvm"
 
@@ -89,6 +94,9 @@ var (
 	// Bootstrapping prefixes for ChainVMs
 	bootstrappingDB = []byte("bs")
 
+	// Prefix of the journal of blocks processing in the Snowman engine
+	journalDBPrefix = []byte("journal")
+
 	errUnknownVMType           = errors.New("the vm should have type odyssey.DAGVM or snowman.ChainVM")
 	errCreateOmegaVM           = errors.New("attempted to create a chain running the OmegaVM")
 	errNotBootstrapped         = errors.New("subnets not bootstrapped")
@@ -133,6 +141,45 @@ type Manager interface {
 	// be called once.
 	StartChainCreator(omegaChain ChainParameters) error
 
+	// Copies the current state of the chain with the given ID into the named
+	// snapshot. Returns an error if chain snapshots are disabled.
+	SnapshotChain(chainID ids.ID, name string) error
+
+	// Schedules the state of the chain with the given ID to be rolled back to
+	// the named snapshot the next time the chain is created. Returns an error
+	// if chain snapshots are disabled.
+	RollbackChain(chainID ids.ID, name string) error
+
+	// Stops the chain with the given ID and creates it again, re-initializing
+	// its VM and re-running bootstrapping. The chain is restarted
+	// asynchronously.
+	RestartChain(chainID ids.ID) error
+
+	// Returns the container requests that the consensus engine of the chain
+	// with the given ID is waiting on, oldest first.
+	OutstandingRequests(chainID ids.ID) ([]OutstandingRequest, error)
+
+	// Returns the progress of the most recent bootstrapping of the chain with
+	// the given ID.
+	BootstrapProgress(chainID ids.ID) (snow.BootstrapProgress, error)
+
+	// Compacts the on-disk state of the chain with the given ID. Returns an
+	// error if chain snapshots are disabled.
+	CompactChainDB(chainID ids.ID) error
+
+	// Returns the approximate number of bytes used on disk by the state of
+	// each running chain. Returns an error if chain snapshots are disabled.
+	ChainDBSizes() (map[ids.ID]uint64, error)
+
+	// Starts tracking the subnet with the given ID without restarting the
+	// node, creating its chains.
+	TrackSubnet(subnetID ids.ID) error
+
+	// Removes the given UTXOs, exported to the O-chain from the chain with the
+	// given ID, from shared memory if the O-chain already imported them.
+	// Returns the IDs of the removed UTXOs.
+	RepairAtomicUTXOs(sourceChainID ids.ID, utxoIDs []ids.ID) ([]ids.ID, error)
+
 	Shutdown()
 }
 
@@ -152,6 +199,14 @@ type ChainParameters struct {
 	CustomBeacons validators.Set
 }
 
+// OutstandingRequest is a container request sent by the consensus engine of a
+// chain that hasn't been responded to yet.
+type OutstandingRequest struct {
+	common.OutstandingRequest
+	// True if the node the request was sent to is benched on the chain
+	Benched bool
+}
+
 type chain struct {
 	Name    string
 	Context *snow.ConsensusContext
@@ -166,6 +221,9 @@ type chain struct {
 type ChainConfig struct {
 	Config  []byte
 	Upgrade []byte
+	// Policies that the chain's blocks must follow, in addition to the VM's
+	// rules. See policyvm.New for the format.
+	Policies []byte
 }
 
 type ManagerConfig struct {
@@ -210,6 +268,7 @@ type ManagerConfig struct {
 
 	AcceptedFrontierGossipFrequency time.Duration
 	ConsensusAppConcurrency         int
+	ConsensusChitsHeightsEnabled    bool
 
 	// Max Time to spend fetching a container and its
 	// ancestors when responding to a GetAncestors
@@ -219,6 +278,9 @@ type ManagerConfig struct {
 	// This node will only consider the first [AncestorsMaxContainersReceived]
 	// containers in an ancestors message it receives.
 	BootstrapAncestorsMaxContainersReceived int
+	// Max age of a beacon's accepted frontier for it to be reused when
+	// bootstrapping is retried
+	BootstrapFrontierCacheTTL time.Duration
 
 	ApricotPhase4Time            time.Time
 	ApricotPhase4MinOChainHeight uint64
@@ -229,6 +291,13 @@ type ManagerConfig struct {
 	StateSyncBeacons []ids.NodeID
 
 	ChainDataDir string
+
+	// If true, the state of each chain is stored under a single prefix so that
+	// it can be snapshotted and rolled back.
+	ChainSnapshotsEnabled bool
+
+	// Registry of the tx decoders exposed by the VMs of the created chains.
+	TxDecoders txdecoder.Registry
 }
 
 type manager struct {
@@ -258,9 +327,25 @@ type manager struct {
 	// Key: Chain's ID
 	// Value: The chain
 	chains map[ids.ID]handler.Handler
+	// Key: Chain's ID
+	// Value: The parameters the chain was created with
+	chainParams map[ids.ID]ChainParameters
+	// Key: Chain's ID
+	// Value: The health check registered for the chain
+	healthChecks map[ids.ID]*chainHealthCheck
+	// Chains that are currently being restarted
+	restarting set.Set[ids.ID]
 
 	// snowman++ related interface to allow validators retrieval
 	validatorState validators.State
+
+	// Used to start tracking subnets while the node is running. Set when the
+	// O-chain is created.
+	subnetTracker SubnetTracker
+
+	// Used to remove the atomic UTXOs that the O-chain already imported from
+	// shared memory. Set when the O-chain is created.
+	atomicUTXORepairer AtomicUTXORepairer
 }
 
 // New returns a new Manager
@@ -272,6 +357,8 @@ func New(config *ManagerConfig) Manager {
 		stakingCert:            staking.CertificateFromX509(config.StakingTLSCert.Leaf),
 		subnets:                make(map[ids.ID]subnets.Subnet),
 		chains:                 make(map[ids.ID]handler.Handler),
+		chainParams:            make(map[ids.ID]ChainParameters),
+		healthChecks:           make(map[ids.ID]*chainHealthCheck),
 		chainsQueue:            buffer.NewUnboundedBlockingDeque[ChainParameters](initialQueueSize),
 		unblockChainCreatorCh:  make(chan struct{}),
 		chainCreatorShutdownCh: make(chan struct{}),
@@ -336,6 +423,10 @@ func (m *manager) createChain(chainParams ChainParameters) {
 	sb := m.subnets[chainParams.SubnetID]
 	m.subnetsLock.RUnlock()
 
+	m.chainsLock.Lock()
+	m.chainParams[chainParams.ID] = chainParams
+	m.chainsLock.Unlock()
+
 	// Note: buildChain builds all chain's relevant objects (notably engine and handler)
 	// but does not start their operations. Starting of the handler (which could potentially
 	// issue some internal messages), is delayed until chain dispatching is started and
@@ -369,7 +460,8 @@ func (m *manager) createChain(chainParams ChainParameters) {
 		// node may not be properly validating the subnet they expect to be
 		// validating.
 		healthCheckErr := fmt.Errorf("failed to create chain on subnet: %s", chainParams.SubnetID)
-		err := m.Health.RegisterHealthCheck(
+		err := m.registerHealthCheck(
+			chainParams.ID,
 			chainAlias,
 			health.CheckerFunc(func(context.Context) (interface{}, error) {
 				return nil, healthCheckErr
@@ -392,14 +484,17 @@ func (m *manager) createChain(chainParams ChainParameters) {
 	m.chains[chainParams.ID] = chain.Handler
 	m.chainsLock.Unlock()
 
-	// Associate the newly created chain with its default alias
-	if err := m.Alias(chainParams.ID, chainParams.ID.String()); err != nil {
-		m.Log.Error("failed to alias the new chain with itself",
-			zap.Stringer("subnetID", chainParams.SubnetID),
-			zap.Stringer("chainID", chainParams.ID),
-			zap.Stringer("vmID", chainParams.VMID),
-			zap.Error(err),
-		)
+	// Associate the newly created chain with its default alias. A restarted
+	// chain is already associated with it.
+	if _, err := m.Lookup(chainParams.ID.String()); err != nil {
+		if err := m.Alias(chainParams.ID, chainParams.ID.String()); err != nil {
+			m.Log.Error("failed to alias the new chain with itself",
+				zap.Stringer("subnetID", chainParams.SubnetID),
+				zap.Stringer("chainID", chainParams.ID),
+				zap.Stringer("vmID", chainParams.VMID),
+				zap.Error(err),
+			)
+		}
 	}
 
 	// Notify those that registered to be notified when a new chain is created
@@ -447,22 +542,17 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 	}
 
 	consensusMetrics := prometheus.NewRegistry()
-	chainNamespace := fmt.Sprintf("%s_%s", constants.PlatformName, primaryAlias)
+	chainNamespace, odysseyDAGNamespace, vmNamespace := chainMetricsNamespaces(primaryAlias)
 	if err := m.Metrics.Register(chainNamespace, consensusMetrics); err != nil {
 		return nil, fmt.Errorf("error while registering chain's metrics %w", err)
 	}
 
-	// This converts the prefix for all the Odyssey consensus metrics from
-	// `odyssey_{chainID}_` into `odyssey_{chainID}_odyssey_` so that
-	// there are no conflicts when registering the Snowman consensus metrics.
 	odysseyConsensusMetrics := prometheus.NewRegistry()
-	odysseyDAGNamespace := fmt.Sprintf("%s_odyssey", chainNamespace)
 	if err := m.Metrics.Register(odysseyDAGNamespace, odysseyConsensusMetrics); err != nil {
 		return nil, fmt.Errorf("error while registering DAG metrics %w", err)
 	}
 
 	vmMetrics := metrics.NewOptionalGatherer()
-	vmNamespace := fmt.Sprintf("%s_vm", chainNamespace)
 	if err := m.Metrics.Register(vmNamespace, vmMetrics); err != nil {
 		return nil, fmt.Errorf("error while registering vm's metrics %w", err)
 	}
@@ -548,6 +638,9 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 	if !hasValidators {
 		return nil, fmt.Errorf("couldn't get validator set of subnet with ID %s. The subnet may not exist", chainParams.SubnetID)
 	}
+	if err := verifySubnetConfig(chainLog, chainParams.SubnetID, sb.Config(), vdrs); err != nil {
+		return nil, err
+	}
 
 	var chain *chain
 	switch vm := vm.(type) {
@@ -590,9 +683,29 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 		return nil, err
 	}
 
+	// Expose the VM's tx decoder, if it has one
+	if decoder, ok := vm.(txdecoder.Decoder); ok && m.TxDecoders != nil {
+		if err := m.TxDecoders.Register(chainParams.ID, decoder); err != nil {
+			return nil, err
+		}
+	}
+
 	return chain, nil
 }
 
+// newAppSealer returns the sealer of the app messages of [sb]'s chains, or nil
+// if they don't need to be sealed.
+func (m *manager) newAppSealer(sb subnets.Subnet) (appcrypto.Sealer, error) {
+	if !sb.Config().EncryptAppMessages {
+		return nil, nil
+	}
+	sealer, err := appcrypto.New(m.NodeID, m.stakingSigner, m.Net)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't initialize app message sealer: %w", err)
+	}
+	return sealer, nil
+}
+
 func (m *manager) AddRegistrant(r Registrant) {
 	m.registrants = append(m.registrants, r)
 }
@@ -614,11 +727,10 @@ func (m *manager) createOdysseyChain(
 		State: snow.Initializing,
 	})
 
-	meterDBManager, err := m.DBManager.NewMeterDBManager("db", ctx.Registerer)
+	prefixDBManager, err := m.newChainDBManager(ctx.ChainID, ctx.Registerer)
 	if err != nil {
 		return nil, err
 	}
-	prefixDBManager := meterDBManager.NewPrefixDBManager(ctx.ChainID[:])
 	vmDBManager := prefixDBManager.NewPrefixDBManager(vmDBPrefix)
 
 	db := prefixDBManager.Current()
@@ -640,6 +752,11 @@ func (m *manager) createOdysseyChain(
 		return nil, err
 	}
 
+	appSealer, err := m.newAppSealer(sb)
+	if err != nil {
+		return nil, err
+	}
+
 	// Passes messages from the odyssey engines to the network
 	odysseyMessageSender, err := sender.New(
 		ctx,
@@ -682,6 +799,9 @@ func (m *manager) createOdysseyChain(
 		return nil, fmt.Errorf("couldn't initialize odyssey sender: %w", err)
 	}
 
+	if appSealer != nil {
+		snowmanMessageSender = sender.Seal(snowmanMessageSender, ctx, appSealer, vdrs, sb)
+	}
 	if m.TracingEnabled {
 		snowmanMessageSender = sender.Trace(snowmanMessageSender, m.Tracer)
 	}
@@ -848,6 +968,7 @@ func (m *manager) createOdysseyChain(
 		validators.UnhandledSubnetConnector, // odyssey chains don't use subnet connector
 		sb,
 		connectedValidators,
+		appSealer,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing network handler: %w", err)
@@ -871,6 +992,7 @@ func (m *manager) createOdysseyChain(
 		MaxTimeGetAncestors:            m.BootstrapMaxTimeGetAncestors,
 		AncestorsMaxContainersSent:     m.BootstrapAncestorsMaxContainersSent,
 		AncestorsMaxContainersReceived: m.BootstrapAncestorsMaxContainersReceived,
+		FrontierCacheTTL:               m.BootstrapFrontierCacheTTL,
 		SharedCfg:                      &common.SharedConfig{},
 	}
 	snowGetHandler, err := snowgetter.New(vmWrappingProposerVM, snowmanCommonCfg)
@@ -893,6 +1015,9 @@ func (m *manager) createOdysseyChain(
 		Validators:    vdrs,
 		Params:        consensusParams,
 		Consensus:     snowmanConsensus,
+		Journal:       prefixdb.New(journalDBPrefix, db.Database),
+
+		IncludeChitsHeights: m.ConsensusChitsHeightsEnabled,
 	}
 	snowmanEngine, err := smeng.New(snowmanEngineConfig)
 	if err != nil {
@@ -936,6 +1061,7 @@ func (m *manager) createOdysseyChain(
 		MaxTimeGetAncestors:            m.BootstrapMaxTimeGetAncestors,
 		AncestorsMaxContainersSent:     m.BootstrapAncestorsMaxContainersSent,
 		AncestorsMaxContainersReceived: m.BootstrapAncestorsMaxContainersReceived,
+		FrontierCacheTTL:               m.BootstrapFrontierCacheTTL,
 		SharedCfg:                      &common.SharedConfig{},
 	}
 
@@ -989,7 +1115,7 @@ func (m *manager) createOdysseyChain(
 	})
 
 	// Register health check for this chain
-	if err := m.Health.RegisterHealthCheck(chainAlias, h, ctx.SubnetID.String()); err != nil {
+	if err := m.registerHealthCheck(ctx.ChainID, chainAlias, h, ctx.SubnetID.String()); err != nil {
 		return nil, fmt.Errorf("couldn't add health check for chain %s: %w", chainAlias, err)
 	}
 
@@ -1019,11 +1145,10 @@ func (m *manager) createSnowmanChain(
 		State: snow.Initializing,
 	})
 
-	meterDBManager, err := m.DBManager.NewMeterDBManager("db", ctx.Registerer)
+	prefixDBManager, err := m.newChainDBManager(ctx.ChainID, ctx.Registerer)
 	if err != nil {
 		return nil, err
 	}
-	prefixDBManager := meterDBManager.NewPrefixDBManager(ctx.ChainID[:])
 	vmDBManager := prefixDBManager.NewPrefixDBManager(vmDBPrefix)
 
 	db := prefixDBManager.Current()
@@ -1034,6 +1159,11 @@ func (m *manager) createSnowmanChain(
 		return nil, err
 	}
 
+	appSealer, err := m.newAppSealer(sb)
+	if err != nil {
+		return nil, err
+	}
+
 	// Passes messages from the consensus engine to the network
 	messageSender, err := sender.New(
 		ctx,
@@ -1048,6 +1178,9 @@ func (m *manager) createSnowmanChain(
 		return nil, fmt.Errorf("couldn't initialize sender: %w", err)
 	}
 
+	if appSealer != nil {
+		messageSender = sender.Seal(messageSender, ctx, appSealer, vdrs, sb)
+	}
 	if m.TracingEnabled {
 		messageSender = sender.Trace(messageSender, m.Tracer)
 	}
@@ -1108,6 +1241,11 @@ func (m *manager) createSnowmanChain(
 		if !ok {
 			return nil, fmt.Errorf("expected validators.SubnetConnector but got %T", vm)
 		}
+
+		// Tracking subnets at runtime and repairing atomic UTXOs are optional
+		// for the O-chain VM
+		m.subnetTracker, _ = vm.(SubnetTracker)
+		m.atomicUTXORepairer, _ = vm.(AtomicUTXORepairer)
 	}
 
 	// Initialize the ProposerVM and the vm wrapped inside it
@@ -1131,6 +1269,17 @@ func (m *manager) createSnowmanChain(
 		zap.Uint64("numHistoricalBlocks", numHistoricalBlocks),
 	)
 
+	policies, err := policyvm.New(chainConfig.Policies)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create block policies: %w", err)
+	}
+	if len(policies) > 0 {
+		m.Log.Info("enforcing block policies",
+			zap.Int("numPolicies", len(policies)),
+		)
+		vm = policyvm.NewBlockVM(vm, policies)
+	}
+
 	chainAlias := m.PrimaryAliasOrDefault(ctx.ChainID)
 	if m.TracingEnabled {
 		vm = tracedvm.NewBlockVM(vm, chainAlias, m.Tracer)
@@ -1196,6 +1345,7 @@ func (m *manager) createSnowmanChain(
 		subnetConnector,
 		sb,
 		connectedValidators,
+		appSealer,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't initialize message handler: %w", err)
@@ -1219,6 +1369,7 @@ func (m *manager) createSnowmanChain(
 		MaxTimeGetAncestors:            m.BootstrapMaxTimeGetAncestors,
 		AncestorsMaxContainersSent:     m.BootstrapAncestorsMaxContainersSent,
 		AncestorsMaxContainersReceived: m.BootstrapAncestorsMaxContainersReceived,
+		FrontierCacheTTL:               m.BootstrapFrontierCacheTTL,
 		SharedCfg:                      &common.SharedConfig{},
 	}
 
@@ -1243,6 +1394,9 @@ func (m *manager) createSnowmanChain(
 		Params:        consensusParams,
 		Consensus:     consensus,
 		PartialSync:   m.PartialSyncPrimaryNetwork && commonCfg.Ctx.ChainID == constants.OmegaChainID,
+		Journal:       prefixdb.New(journalDBPrefix, db.Database),
+
+		IncludeChitsHeights: m.ConsensusChitsHeightsEnabled,
 	}
 	engine, err := smeng.New(engineConfig)
 	if err != nil {
@@ -1302,7 +1456,7 @@ func (m *manager) createSnowmanChain(
 	})
 
 	// Register health checks
-	if err := m.Health.RegisterHealthCheck(chainAlias, h, ctx.SubnetID.String()); err != nil {
+	if err := m.registerHealthCheck(ctx.ChainID, chainAlias, h, ctx.SubnetID.String()); err != nil {
 		return nil, fmt.Errorf("couldn't add health check for chain %s: %w", chainAlias, err)
 	}
 
@@ -1325,6 +1479,54 @@ func (m *manager) IsBootstrapped(id ids.ID) bool {
 	return chain.Context().State.Get().State == snow.NormalOp
 }
 
+func (m *manager) OutstandingRequests(chainID ids.ID) ([]OutstandingRequest, error) {
+	m.chainsLock.Lock()
+	chain, exists := m.chains[chainID]
+	m.chainsLock.Unlock()
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", errUnknownChain, chainID)
+	}
+
+	ctx := chain.Context()
+	ctx.Lock.Lock()
+	defer ctx.Lock.Unlock()
+
+	var (
+		engineManager = chain.GetEngineManager()
+		requests      []OutstandingRequest
+	)
+	for _, engine := range []*handler.Engine{engineManager.Odyssey, engineManager.Snowman} {
+		consensus, ok := engine.Get(snow.NormalOp)
+		if !ok {
+			continue
+		}
+		reporter, ok := consensus.(common.OutstandingRequestsReporter)
+		if !ok {
+			continue
+		}
+		for _, request := range reporter.OutstandingRequests() {
+			requests = append(requests, OutstandingRequest{
+				OutstandingRequest: request,
+				Benched:            m.TimeoutManager.IsBenched(request.NodeID, chainID),
+			})
+		}
+	}
+	slices.SortFunc(requests, func(i, j OutstandingRequest) bool {
+		return i.Issued.Before(j.Issued)
+	})
+	return requests, nil
+}
+
+func (m *manager) BootstrapProgress(chainID ids.ID) (snow.BootstrapProgress, error) {
+	m.chainsLock.Lock()
+	chain, exists := m.chains[chainID]
+	m.chainsLock.Unlock()
+	if !exists {
+		return snow.BootstrapProgress{}, fmt.Errorf("%w: %s", errUnknownChain, chainID)
+	}
+	return chain.Context().BootstrapProgress.Get(), nil
+}
+
 func (m *manager) subnetsNotBootstrapped() []ids.ID {
 	m.subnetsLock.RLock()
 	defer m.subnetsLock.RUnlock()
@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/snow/networking/handler"
+	"github.com/DioneProtocol/odysseygo/snow/networking/timeout"
+)
+
+type testReporterEngine struct {
+	common.EngineTest
+
+	requests []common.OutstandingRequest
+}
+
+func (e *testReporterEngine) OutstandingRequests() []common.OutstandingRequest {
+	return e.requests
+}
+
+func TestOutstandingRequests(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	var (
+		chainID      = ids.GenerateTestID()
+		benchedNode  = ids.GenerateTestNodeID()
+		healthyNode  = ids.GenerateTestNodeID()
+		now          = time.Now()
+		newerRequest = common.OutstandingRequest{
+			NodeID:      healthyNode,
+			RequestID:   2,
+			ContainerID: ids.GenerateTestID(),
+			Issued:      now,
+		}
+		olderRequest = common.OutstandingRequest{
+			NodeID:      benchedNode,
+			RequestID:   1,
+			ContainerID: ids.GenerateTestID(),
+			Issued:      now.Add(-time.Minute),
+		}
+	)
+
+	h := handler.NewMockHandler(ctrl)
+	h.EXPECT().Context().Return(snow.DefaultConsensusContextTest()).AnyTimes()
+	h.EXPECT().GetEngineManager().Return(&handler.EngineManager{
+		Snowman: &handler.Engine{
+			Consensus: &testReporterEngine{
+				requests: []common.OutstandingRequest{newerRequest, olderRequest},
+			},
+		},
+	}).AnyTimes()
+
+	tm := timeout.NewMockManager(ctrl)
+	tm.EXPECT().IsBenched(benchedNode, chainID).Return(true)
+	tm.EXPECT().IsBenched(healthyNode, chainID).Return(false)
+
+	m := &manager{
+		ManagerConfig: ManagerConfig{
+			TimeoutManager: tm,
+		},
+		chains: map[ids.ID]handler.Handler{
+			chainID: h,
+		},
+	}
+
+	requests, err := m.OutstandingRequests(chainID)
+	require.NoError(err)
+	require.Equal([]OutstandingRequest{
+		{
+			OutstandingRequest: olderRequest,
+			Benched:            true,
+		},
+		{
+			OutstandingRequest: newerRequest,
+			Benched:            false,
+		},
+	}, requests)
+
+	_, err = m.OutstandingRequests(ids.GenerateTestID())
+	require.ErrorIs(err, errUnknownChain)
+}
@@ -0,0 +1,28 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+)
+
+var errNoAtomicUTXORepairer = errors.New("the O-chain doesn't support repairing atomic UTXOs")
+
+// AtomicUTXORepairer is implemented by the O-chain VM to remove the UTXOs that
+// it already imported from shared memory.
+type AtomicUTXORepairer interface {
+	// RepairAtomicUTXOs removes [utxoIDs], exported to the O-chain from
+	// [sourceChainID], from shared memory if they were imported by an accepted
+	// tx. Returns the IDs of the removed UTXOs.
+	RepairAtomicUTXOs(sourceChainID ids.ID, utxoIDs []ids.ID) ([]ids.ID, error)
+}
+
+func (m *manager) RepairAtomicUTXOs(sourceChainID ids.ID, utxoIDs []ids.ID) ([]ids.ID, error) {
+	if m.atomicUTXORepairer == nil {
+		return nil, errNoAtomicUTXORepairer
+	}
+	return m.atomicUTXORepairer.RepairAtomicUTXOs(sourceChainID, utxoIDs)
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+)
+
+type testAtomicUTXORepairer struct {
+	removed []ids.ID
+	err     error
+}
+
+func (r testAtomicUTXORepairer) RepairAtomicUTXOs(ids.ID, []ids.ID) ([]ids.ID, error) {
+	return r.removed, r.err
+}
+
+func TestRepairAtomicUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	m := &manager{}
+	_, err := m.RepairAtomicUTXOs(ids.GenerateTestID(), nil)
+	require.ErrorIs(err, errNoAtomicUTXORepairer)
+
+	m.atomicUTXORepairer = testAtomicUTXORepairer{err: errTest}
+	_, err = m.RepairAtomicUTXOs(ids.GenerateTestID(), nil)
+	require.ErrorIs(err, errTest)
+
+	removed := []ids.ID{ids.GenerateTestID()}
+	m.atomicUTXORepairer = testAtomicUTXORepairer{removed: removed}
+	gotRemoved, err := m.RepairAtomicUTXOs(ids.GenerateTestID(), nil)
+	require.NoError(err)
+	require.Equal(removed, gotRemoved)
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/api/health"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+)
+
+var (
+	errRestartOmegaChain = errors.New("the O-chain can't be restarted")
+	errChainRestarting   = errors.New("chain is already restarting")
+)
+
+// chainHealthCheck reports the health of the current instance of a chain. This
+// allows a chain to be restarted without registering a new health check.
+type chainHealthCheck struct {
+	checker utils.Atomic[health.Checker]
+}
+
+func (c *chainHealthCheck) HealthCheck(ctx context.Context) (interface{}, error) {
+	return c.checker.Get().HealthCheck(ctx)
+}
+
+// RestartChain stops the chain [chainID] and creates it again from the
+// parameters it was originally created with. The VM of the chain is shut down
+// and initialized again, and the chain re-runs bootstrapping. Chains that
+// failed to be created can be restarted as well.
+//
+// The restart is performed asynchronously, as re-registering the chain's APIs
+// can't happen while an API call is being handled.
+//
+// The O-chain can't be restarted, as the rest of the node depends on its VM.
+func (m *manager) RestartChain(chainID ids.ID) error {
+	if chainID == constants.OmegaChainID {
+		return errRestartOmegaChain
+	}
+
+	m.chainsLock.Lock()
+	defer m.chainsLock.Unlock()
+
+	chainParams, exists := m.chainParams[chainID]
+	if !exists {
+		return fmt.Errorf("%w: %s", errUnknownChain, chainID)
+	}
+	if m.restarting.Contains(chainID) {
+		return fmt.Errorf("%w: %s", errChainRestarting, chainID)
+	}
+	m.restarting.Add(chainID)
+
+	go m.restartChain(chainParams)
+	return nil
+}
+
+func (m *manager) restartChain(chainParams ChainParameters) {
+	m.Log.Info("restarting chain",
+		zap.Stringer("subnetID", chainParams.SubnetID),
+		zap.Stringer("chainID", chainParams.ID),
+		zap.Stringer("vmID", chainParams.VMID),
+	)
+
+	// Stop routing messages to the chain and wait for its engine and VM to
+	// shut down. Removing the chain from the router doesn't cause the node to
+	// shut down, even if the chain is critical.
+	m.ManagerConfig.Router.RemoveChain(context.TODO(), chainParams.ID)
+
+	m.chainsLock.Lock()
+	delete(m.chains, chainParams.ID)
+	m.chainsLock.Unlock()
+
+	m.deregisterChain(chainParams.ID)
+
+	m.createChain(chainParams)
+
+	m.chainsLock.Lock()
+	m.restarting.Remove(chainParams.ID)
+	m.chainsLock.Unlock()
+}
+
+// deregisterChain removes everything registered by buildChain for the chain
+// [chainID], so that the chain can be built again.
+func (m *manager) deregisterChain(chainID ids.ID) {
+	chainNamespace, odysseyDAGNamespace, vmNamespace := chainMetricsNamespaces(m.PrimaryAliasOrDefault(chainID))
+	m.Metrics.Deregister(chainNamespace)
+	m.Metrics.Deregister(odysseyDAGNamespace)
+	m.Metrics.Deregister(vmNamespace)
+
+	m.TimeoutManager.DeregisterChain(chainID)
+	if m.TxDecoders != nil {
+		m.TxDecoders.Deregister(chainID)
+	}
+
+	// The acceptors may not have been registered if the chain failed to be
+	// built, so errors are ignored.
+	_ = m.VertexAcceptorGroup.DeregisterAcceptor(chainID, "gossip")
+	_ = m.BlockAcceptorGroup.DeregisterAcceptor(chainID, "gossip")
+}
+
+// registerHealthCheck registers [checker] as the health check of the chain
+// [chainID]. If the chain already has a health check, because it is being
+// restarted, the existing check reports the result of [checker] instead.
+func (m *manager) registerHealthCheck(chainID ids.ID, chainAlias string, checker health.Checker, tags ...string) error {
+	m.chainsLock.Lock()
+	defer m.chainsLock.Unlock()
+
+	if check, ok := m.healthChecks[chainID]; ok {
+		check.checker.Set(checker)
+		return nil
+	}
+
+	check := &chainHealthCheck{}
+	check.checker.Set(checker)
+	if err := m.Health.RegisterHealthCheck(chainAlias, check, tags...); err != nil {
+		return err
+	}
+	m.healthChecks[chainID] = check
+	return nil
+}
+
+// chainMetricsNamespaces returns the namespaces of the consensus, Odyssey
+// consensus, and VM metrics of the chain with the primary alias [alias].
+func chainMetricsNamespaces(alias string) (string, string, string) {
+	chainNamespace := fmt.Sprintf("%s_%s", constants.PlatformName, alias)
+	// This converts the prefix for all the Odyssey consensus metrics from
+	// `odyssey_{chainID}_` into `odyssey_{chainID}_odyssey_` so that
+	// there are no conflicts when registering the Snowman consensus metrics.
+	odysseyDAGNamespace := fmt.Sprintf("%s_odyssey", chainNamespace)
+	vmNamespace := fmt.Sprintf("%s_vm", chainNamespace)
+	return chainNamespace, odysseyDAGNamespace, vmNamespace
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/api/health"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+)
+
+var errTest = errors.New("non-nil error")
+
+func TestRestartChainInvalid(t *testing.T) {
+	require := require.New(t)
+
+	chainID := ids.GenerateTestID()
+	m := &manager{
+		chainParams: map[ids.ID]ChainParameters{
+			chainID: {ID: chainID},
+		},
+	}
+	m.restarting.Add(chainID)
+
+	err := m.RestartChain(constants.OmegaChainID)
+	require.ErrorIs(err, errRestartOmegaChain)
+
+	err = m.RestartChain(ids.GenerateTestID())
+	require.ErrorIs(err, errUnknownChain)
+
+	err = m.RestartChain(chainID)
+	require.ErrorIs(err, errChainRestarting)
+}
+
+func TestRegisterHealthCheckReplacesChecker(t *testing.T) {
+	require := require.New(t)
+
+	h, err := health.New(logging.NoLog{}, prometheus.NewRegistry())
+	require.NoError(err)
+
+	chainID := ids.GenerateTestID()
+	m := &manager{
+		ManagerConfig: ManagerConfig{
+			Health: h,
+		},
+		healthChecks: make(map[ids.ID]*chainHealthCheck),
+	}
+
+	require.NoError(m.registerHealthCheck(chainID, "chain", health.CheckerFunc(func(context.Context) (interface{}, error) {
+		return nil, errTest
+	})))
+	_, err = m.healthChecks[chainID].HealthCheck(context.Background())
+	require.ErrorIs(err, errTest)
+
+	// Registering the check of a restarted chain replaces the previous checker
+	require.NoError(m.registerHealthCheck(chainID, "chain", health.CheckerFunc(func(context.Context) (interface{}, error) {
+		return "healthy", nil
+	})))
+	details, err := m.healthChecks[chainID].HealthCheck(context.Background())
+	require.NoError(err)
+	require.Equal("healthy", details)
+}
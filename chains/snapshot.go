@@ -0,0 +1,211 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+
+	dbManager "github.com/DioneProtocol/odysseygo/database/manager"
+)
+
+// snapshotWriteSize is the size of the batches written while copying a chain's
+// state.
+const snapshotWriteSize = 4 * 1024 * 1024 // 4 MiB
+
+var (
+	// Key: Chain ID + Snapshot name
+	// Value: Time the snapshot was taken
+	snapshotIndexPrefix = []byte("chainSnapshotIndex")
+	// Holds the copied state of every snapshot
+	snapshotStatePrefix = []byte("chainSnapshotState")
+	// Key: Chain ID
+	// Value: Name of the snapshot to restore when the chain is next created
+	pendingRollbackPrefix = []byte("chainPendingRollback")
+
+	errChainSnapshotsDisabled = errors.New("chain snapshots are disabled")
+	errEmptySnapshotName      = errors.New("snapshot name can't be empty")
+	errUnknownChain           = errors.New("unknown chain")
+	errUnknownSnapshot        = errors.New("unknown snapshot")
+)
+
+// newChainDBManager returns the database manager that holds the state of the
+// chain [chainID].
+//
+// When chain snapshots are enabled, the chain's database is metered after
+// being prefixed. Because the meterdb separates the chain's prefix from the
+// prefixes applied by the engine and the VM, all of the chain's state is stored
+// under the chain's prefix and can be copied as a unit. Any pending rollback of
+// the chain is applied before the manager is returned.
+func (m *manager) newChainDBManager(chainID ids.ID, registerer prometheus.Registerer) (dbManager.Manager, error) {
+	if !m.ChainSnapshotsEnabled {
+		meterDBManager, err := m.DBManager.NewMeterDBManager("db", registerer)
+		if err != nil {
+			return nil, err
+		}
+		return meterDBManager.NewPrefixDBManager(chainID[:]), nil
+	}
+
+	if err := m.applyPendingRollback(chainID); err != nil {
+		return nil, fmt.Errorf("failed to roll back chain: %w", err)
+	}
+	return m.DBManager.NewPrefixDBManager(chainID[:]).NewMeterDBManager("db", registerer)
+}
+
+// SnapshotChain copies the current state of the chain [chainID] into the
+// snapshot [name], replacing any previous snapshot with the same name.
+func (m *manager) SnapshotChain(chainID ids.ID, name string) error {
+	if !m.ChainSnapshotsEnabled {
+		return errChainSnapshotsDisabled
+	}
+	if len(name) == 0 {
+		return errEmptySnapshotName
+	}
+
+	m.chainsLock.Lock()
+	chain, exists := m.chains[chainID]
+	m.chainsLock.Unlock()
+	if !exists {
+		return fmt.Errorf("%w: %s", errUnknownChain, chainID)
+	}
+
+	// Holding the context lock guarantees that the engine and the VM aren't
+	// modifying the chain's state while it is being copied.
+	ctx := chain.Context()
+	ctx.Lock.Lock()
+	defer ctx.Lock.Unlock()
+
+	var (
+		db         = m.DBManager.Current().Database
+		snapshotDB = snapshotStateDB(db, chainID, name)
+	)
+	if err := database.Clear(snapshotDB, snapshotWriteSize); err != nil {
+		return err
+	}
+	if err := copyDB(snapshotDB, chainStateDB(db, chainID)); err != nil {
+		return err
+	}
+	if err := database.PutTimestamp(prefixdb.New(snapshotIndexPrefix, db), snapshotKey(chainID, name), time.Now()); err != nil {
+		return err
+	}
+
+	m.Log.Info("snapshotted chain",
+		zap.Stringer("chainID", chainID),
+		zap.String("snapshot", name),
+	)
+	return nil
+}
+
+// RollbackChain schedules the state of the chain [chainID] to be replaced with
+// the snapshot [name]. Because the engine and the VM may cache state in memory,
+// the rollback is applied the next time the chain is created.
+func (m *manager) RollbackChain(chainID ids.ID, name string) error {
+	if !m.ChainSnapshotsEnabled {
+		return errChainSnapshotsDisabled
+	}
+
+	db := m.DBManager.Current().Database
+	has, err := prefixdb.New(snapshotIndexPrefix, db).Has(snapshotKey(chainID, name))
+	if err != nil {
+		return err
+	}
+	if !has {
+		return fmt.Errorf("%w %q of chain %s", errUnknownSnapshot, name, chainID)
+	}
+	if err := prefixdb.New(pendingRollbackPrefix, db).Put(chainID[:], []byte(name)); err != nil {
+		return err
+	}
+
+	m.Log.Info("scheduled chain rollback",
+		zap.Stringer("chainID", chainID),
+		zap.String("snapshot", name),
+	)
+	return nil
+}
+
+// applyPendingRollback replaces the state of the chain [chainID] with the
+// snapshot scheduled by RollbackChain, if any.
+func (m *manager) applyPendingRollback(chainID ids.ID) error {
+	var (
+		db                = m.DBManager.Current().Database
+		pendingRollbackDB = prefixdb.New(pendingRollbackPrefix, db)
+	)
+	name, err := pendingRollbackDB.Get(chainID[:])
+	if err == database.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	stateDB := chainStateDB(db, chainID)
+	if err := database.Clear(stateDB, snapshotWriteSize); err != nil {
+		return err
+	}
+	if err := copyDB(stateDB, snapshotStateDB(db, chainID, string(name))); err != nil {
+		return err
+	}
+	if err := pendingRollbackDB.Delete(chainID[:]); err != nil {
+		return err
+	}
+
+	m.Log.Info("rolled back chain",
+		zap.Stringer("chainID", chainID),
+		zap.String("snapshot", string(name)),
+	)
+	return nil
+}
+
+// chainStateDB returns the database holding all of the state of the chain
+// [chainID] when chain snapshots are enabled.
+func chainStateDB(db database.Database, chainID ids.ID) database.Database {
+	return prefixdb.New(chainID[:], db)
+}
+
+func snapshotStateDB(db database.Database, chainID ids.ID, name string) database.Database {
+	return prefixdb.NewNested([]byte(name), prefixdb.New(chainID[:], prefixdb.New(snapshotStatePrefix, db)))
+}
+
+func snapshotKey(chainID ids.ID, name string) []byte {
+	key := make([]byte, len(chainID)+len(name))
+	copy(key, chainID[:])
+	copy(key[len(chainID):], name)
+	return key
+}
+
+// copyDB writes all the key-value pairs of [src] into [dst].
+func copyDB(dst database.Database, src database.Iteratee) error {
+	it := src.NewIterator()
+	defer it.Release()
+
+	b := dst.NewBatch()
+	for it.Next() {
+		if err := b.Put(it.Key(), it.Value()); err != nil {
+			return err
+		}
+
+		// Avoid too much memory pressure by periodically writing to the
+		// database.
+		if b.Size() < snapshotWriteSize {
+			continue
+		}
+		if err := b.Write(); err != nil {
+			return err
+		}
+		b.Reset()
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return b.Write()
+}
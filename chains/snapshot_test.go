@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/snow/networking/handler"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/version"
+
+	dbManager "github.com/DioneProtocol/odysseygo/database/manager"
+)
+
+func TestSnapshotAndRollbackChain(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	chainID := ids.GenerateTestID()
+	h := handler.NewMockHandler(ctrl)
+	h.EXPECT().Context().Return(snow.DefaultConsensusContextTest()).AnyTimes()
+
+	m := &manager{
+		ManagerConfig: ManagerConfig{
+			Log:                   logging.NoLog{},
+			DBManager:             dbManager.NewMemDB(version.Semantic1_0_0),
+			ChainSnapshotsEnabled: true,
+		},
+		chains: map[ids.ID]handler.Handler{
+			chainID: h,
+		},
+	}
+
+	chainDBManager, err := m.newChainDBManager(chainID, prometheus.NewRegistry())
+	require.NoError(err)
+	// Nested prefixes must be included in the snapshot.
+	db := chainDBManager.NewPrefixDBManager([]byte("vm")).Current().Database
+	require.NoError(db.Put([]byte("key"), []byte("snapshotted")))
+
+	require.NoError(m.SnapshotChain(chainID, "snapshot"))
+
+	require.NoError(db.Put([]byte("key"), []byte("modified")))
+	require.NoError(db.Put([]byte("other"), []byte("added")))
+
+	err = m.RollbackChain(chainID, "unknown")
+	require.ErrorIs(err, errUnknownSnapshot)
+	require.NoError(m.RollbackChain(chainID, "snapshot"))
+
+	// The rollback is applied when the chain's database is next created.
+	value, err := db.Get([]byte("key"))
+	require.NoError(err)
+	require.Equal([]byte("modified"), value)
+
+	chainDBManager, err = m.newChainDBManager(chainID, prometheus.NewRegistry())
+	require.NoError(err)
+	db = chainDBManager.NewPrefixDBManager([]byte("vm")).Current().Database
+
+	value, err = db.Get([]byte("key"))
+	require.NoError(err)
+	require.Equal([]byte("snapshotted"), value)
+
+	has, err := db.Has([]byte("other"))
+	require.NoError(err)
+	require.False(has)
+}
+
+func TestSnapshotChainDisabled(t *testing.T) {
+	require := require.New(t)
+
+	m := &manager{
+		ManagerConfig: ManagerConfig{
+			DBManager: dbManager.NewMemDB(version.Semantic1_0_0),
+		},
+	}
+
+	err := m.SnapshotChain(ids.GenerateTestID(), "snapshot")
+	require.ErrorIs(err, errChainSnapshotsDisabled)
+
+	err = m.RollbackChain(ids.GenerateTestID(), "snapshot")
+	require.ErrorIs(err, errChainSnapshotsDisabled)
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/subnets"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+)
+
+// verifySubnetConfig verifies the consensus and gossip overrides of
+// [subnetID] before a chain of the subnet is created with them.
+//
+// The validator set of a subnet changes over time, so a sample size larger
+// than the current number of validators is only reported, as polls will fail
+// until enough validators have joined.
+func verifySubnetConfig(
+	log logging.Logger,
+	subnetID ids.ID,
+	config subnets.Config,
+	vdrs validators.Set,
+) error {
+	if err := config.Valid(); err != nil {
+		return fmt.Errorf("invalid config for subnet %s: %w", subnetID, err)
+	}
+
+	numVdrs := vdrs.Len()
+	if k := config.ConsensusParameters.K; numVdrs > 0 && k > numVdrs {
+		log.Warn("consensus sample size exceeds the number of subnet validators",
+			zap.Stringer("subnetID", subnetID),
+			zap.Int("k", k),
+			zap.Int("numValidators", numVdrs),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/consensus/snowball"
+	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/subnets"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+)
+
+func TestVerifySubnetConfig(t *testing.T) {
+	require := require.New(t)
+
+	subnetID := ids.GenerateTestID()
+	vdrs := validators.NewSet()
+	require.NoError(vdrs.Add(ids.GenerateTestNodeID(), nil, ids.Empty, 1))
+
+	config := subnets.Config{
+		ConsensusParameters: snowball.DefaultParameters,
+	}
+	require.NoError(verifySubnetConfig(logging.NoLog{}, subnetID, config, vdrs))
+
+	config.ConsensusParameters.K = 1
+	config.ConsensusParameters.Alpha = 1
+	require.NoError(verifySubnetConfig(logging.NoLog{}, subnetID, config, vdrs))
+
+	config.ConsensusParameters.Alpha = 2
+	err := verifySubnetConfig(logging.NoLog{}, subnetID, config, vdrs)
+	require.ErrorIs(err, snowball.ErrParametersInvalid)
+}
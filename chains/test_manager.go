@@ -5,6 +5,7 @@ package chains
 
 import (
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/networking/router"
 )
 
@@ -48,6 +49,42 @@ func (testManager) StartChainCreator(ChainParameters) error {
 	return nil
 }
 
+func (testManager) SnapshotChain(ids.ID, string) error {
+	return nil
+}
+
+func (testManager) RollbackChain(ids.ID, string) error {
+	return nil
+}
+
+func (testManager) RestartChain(ids.ID) error {
+	return nil
+}
+
+func (testManager) OutstandingRequests(ids.ID) ([]OutstandingRequest, error) {
+	return nil, nil
+}
+
+func (testManager) BootstrapProgress(ids.ID) (snow.BootstrapProgress, error) {
+	return snow.BootstrapProgress{}, nil
+}
+
+func (testManager) CompactChainDB(ids.ID) error {
+	return nil
+}
+
+func (testManager) ChainDBSizes() (map[ids.ID]uint64, error) {
+	return nil, nil
+}
+
+func (testManager) TrackSubnet(ids.ID) error {
+	return nil
+}
+
+func (testManager) RepairAtomicUTXOs(ids.ID, []ids.ID) ([]ids.ID, error) {
+	return nil, nil
+}
+
 func (testManager) SubnetID(ids.ID) (ids.ID, error) {
 	return ids.ID{}, nil
 }
@@ -0,0 +1,52 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/version"
+)
+
+var (
+	errTrackPrimaryNetwork = errors.New("the primary network is always tracked")
+	errNoSubnetTracker     = errors.New("the O-chain doesn't support tracking subnets")
+)
+
+// SubnetTracker is implemented by the O-chain VM to start tracking a subnet
+// while the node is running.
+type SubnetTracker interface {
+	// TrackSubnet registers the validator set of [subnetID] with the node and
+	// queues the creation of the subnet's chains.
+	TrackSubnet(subnetID ids.ID) error
+}
+
+// TrackSubnet starts tracking [subnetID] without restarting the node. The
+// O-chain registers the subnet's validator set and queues the creation of its
+// chains, which then bootstrap like any other chain. The connections to the
+// subnet's validators are restarted so that the subnet is included in the
+// handshake with them.
+//
+// Chains of the subnet are created with the subnet's config if one was loaded
+// at startup, and with the primary network's config otherwise.
+func (m *manager) TrackSubnet(subnetID ids.ID) error {
+	if subnetID == constants.PrimaryNetworkID {
+		return errTrackPrimaryNetwork
+	}
+	if m.subnetTracker == nil {
+		return errNoSubnetTracker
+	}
+
+	if err := m.subnetTracker.TrackSubnet(subnetID); err != nil {
+		return err
+	}
+
+	// Mark this node as connected on the subnet, as is done for the subnets
+	// tracked at startup when the O-chain is registered.
+	m.ManagerConfig.Router.Connected(m.NodeID, version.CurrentApp, subnetID)
+	m.Net.TrackSubnet(subnetID)
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+)
+
+type testSubnetTracker struct {
+	err error
+}
+
+func (t testSubnetTracker) TrackSubnet(ids.ID) error {
+	return t.err
+}
+
+func TestTrackSubnetInvalid(t *testing.T) {
+	require := require.New(t)
+
+	m := &manager{}
+	err := m.TrackSubnet(constants.PrimaryNetworkID)
+	require.ErrorIs(err, errTrackPrimaryNetwork)
+
+	err = m.TrackSubnet(ids.GenerateTestID())
+	require.ErrorIs(err, errNoSubnetTracker)
+
+	m.subnetTracker = testSubnetTracker{err: errTest}
+	err = m.TrackSubnet(ids.GenerateTestID())
+	require.ErrorIs(err, errTest)
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/api/server"
 	"github.com/DioneProtocol/odysseygo/chains"
+	"github.com/DioneProtocol/odysseygo/database/manager"
 	"github.com/DioneProtocol/odysseygo/genesis"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/ipcs"
@@ -54,10 +55,11 @@ import (
 )
 
 const (
-	chainConfigFileName  = "config"
-	chainUpgradeFileName = "upgrade"
-	subnetConfigFileExt  = ".json"
-	ipResolutionTimeout  = 30 * time.Second
+	chainConfigFileName   = "config"
+	chainUpgradeFileName  = "upgrade"
+	chainPoliciesFileName = "policies"
+	subnetConfigFileExt   = ".json"
+	ipResolutionTimeout   = 30 * time.Second
 )
 
 var (
@@ -78,6 +80,7 @@ var (
 	errStakeMaxConsumptionBelowMin            = errors.New("stake max consumption can't be less than min stake consumption")
 	errStakeMintingPeriodBelowMin             = errors.New("stake minting period can't be less than max stake duration")
 	errCannotTrackPrimaryNetwork              = errors.New("cannot track primary network")
+	errChainSnapshotsOnPublicNetwork          = errors.New("chain snapshots enabled on public network")
 	errStakingKeyContentUnset                 = fmt.Errorf("%s key not set but %s set", StakingTLSKeyContentKey, StakingCertContentKey)
 	errStakingCertContentUnset                = fmt.Errorf("%s key set but %s not set", StakingTLSKeyContentKey, StakingCertContentKey)
 	errMissingStakingSigningKeyFile           = errors.New("missing staking signing key file")
@@ -104,6 +107,8 @@ func getConsensusConfig(v *viper.Viper) snowball.Parameters {
 		OptimalProcessing:     v.GetInt(SnowOptimalProcessingKey),
 		MaxOutstandingItems:   v.GetInt(SnowMaxProcessingKey),
 		MaxItemProcessingTime: v.GetDuration(SnowMaxTimeProcessingKey),
+		MaxProcessingDepth:    v.GetInt(SnowMaxProcessingDepthKey),
+		MinK:                  v.GetInt(SnowMinSampleSizeKey),
 	}
 }
 
@@ -135,7 +140,8 @@ func getLoggingConfig(v *viper.Viper) (logging.Config, error) {
 
 func getAPIAuthConfig(v *viper.Viper) (node.APIAuthConfig, error) {
 	config := node.APIAuthConfig{
-		APIRequireAuthToken: v.GetBool(APIAuthRequiredKey),
+		APIRequireAuthToken:   v.GetBool(APIAuthRequiredKey),
+		APIStakingAuthMethods: v.GetStringSlice(APIStakingAuthMethodsKey),
 	}
 	if !config.APIRequireAuthToken {
 		return config, nil
@@ -225,6 +231,8 @@ func getHTTPConfig(v *viper.Viper) (node.HTTPConfig, error) {
 			KeystoreAPIEnabled: v.GetBool(KeystoreAPIEnabledKey),
 			MetricsAPIEnabled:  v.GetBool(MetricsAPIEnabledKey),
 			HealthAPIEnabled:   v.GetBool(HealthAPIEnabledKey),
+
+			KeystoreEventsWebhookURL: v.GetString(KeystoreEventsWebhookURLKey),
 		},
 		HTTPHost:           v.GetString(HTTPHostKey),
 		HTTPPort:           uint16(v.GetUint(HTTPPortKey)),
@@ -399,6 +407,7 @@ func getNetworkConfig(
 
 		MaxClockDifference:           v.GetDuration(NetworkMaxClockDifferenceKey),
 		CompressionType:              compressionType,
+		CompressionThreshold:         int(v.GetUint(NetworkCompressionThresholdKey)),
 		PingFrequency:                v.GetDuration(NetworkPingFrequencyKey),
 		AllowPrivateIPs:              allowPrivateIPs,
 		UptimeMetricFreq:             v.GetDuration(UptimeMetricFreqKey),
@@ -446,22 +455,15 @@ func getNetworkConfig(
 	return config, nil
 }
 
-func getBenchlistConfig(v *viper.Viper, consensusParameters snowball.Parameters) (benchlist.Config, error) {
+func getBenchlistConfig(v *viper.Viper, consensusParameters snowball.Parameters) subnets.BenchlistConfig {
 	alpha := consensusParameters.Alpha
 	k := consensusParameters.K
-	config := benchlist.Config{
-		Threshold:              v.GetInt(BenchlistFailThresholdKey),
-		Duration:               v.GetDuration(BenchlistDurationKey),
-		MinimumFailingDuration: v.GetDuration(BenchlistMinFailingDurationKey),
-		MaxPortion:             (1.0 - (float64(alpha) / float64(k))) / 3.0,
-	}
-	switch {
-	case config.Duration < 0:
-		return benchlist.Config{}, fmt.Errorf("%q must be >= 0", BenchlistDurationKey)
-	case config.MinimumFailingDuration < 0:
-		return benchlist.Config{}, fmt.Errorf("%q must be >= 0", BenchlistMinFailingDurationKey)
+	return subnets.BenchlistConfig{
+		BenchlistFailThreshold:      v.GetInt(BenchlistFailThresholdKey),
+		BenchlistDuration:           v.GetDuration(BenchlistDurationKey),
+		BenchlistMinFailingDuration: v.GetDuration(BenchlistMinFailingDurationKey),
+		BenchlistMaxPortion:         (1.0 - (float64(alpha) / float64(k))) / 3.0,
 	}
-	return config, nil
 }
 
 func getStateSyncConfig(v *viper.Viper) (node.StateSyncConfig, error) {
@@ -510,6 +512,7 @@ func getBootstrapConfig(v *viper.Viper, networkID uint32) (node.BootstrapConfig,
 		BootstrapMaxTimeGetAncestors:            v.GetDuration(BootstrapMaxTimeGetAncestorsKey),
 		BootstrapAncestorsMaxContainersSent:     int(v.GetUint(BootstrapAncestorsMaxContainersSentKey)),
 		BootstrapAncestorsMaxContainersReceived: int(v.GetUint(BootstrapAncestorsMaxContainersReceivedKey)),
+		BootstrapFrontierCacheTTL:               v.GetDuration(BootstrapFrontierCacheTTLKey),
 	}
 
 	// TODO: Add a "BootstrappersKey" flag to more clearly enforce ID and IP
@@ -688,7 +691,7 @@ func getStakingTLSCertFromFile(v *viper.Viper) (tls.Certificate, error) {
 	// Load and parse the staking key/cert
 	cert, err := staking.LoadTLSCertFromFiles(stakingKeyPath, stakingCertPath)
 	if err != nil {
-		return tls.Certificate{}, fmt.Errorf("couldn't read staking certificate: %w", err)
+		return tls.Certificate{}, fmt.Errorf("couldn't load staking key %s and certificate %s: %w", stakingKeyPath, stakingCertPath, err)
 	}
 	return *cert, nil
 }
@@ -782,6 +785,9 @@ func getStakingConfig(v *viper.Viper, networkID uint32) (node.StakingConfig, err
 		StakingKeyPath:                GetExpandedArg(v, StakingTLSKeyPathKey),
 		StakingCertPath:               GetExpandedArg(v, StakingCertPathKey),
 		StakingSignerPath:             GetExpandedArg(v, StakingSignerKeyPathKey),
+		// An ephemeral certificate changes the node ID on every restart.
+		StakingAllowNodeIDChange:    v.GetBool(StakingAllowNodeIDChangeKey) || v.GetBool(StakingEphemeralCertEnabledKey),
+		StakingEphemeralCertEnabled: v.GetBool(StakingEphemeralCertEnabledKey),
 	}
 	if !config.SybilProtectionEnabled && config.SybilProtectionDisabledWeight == 0 {
 		return node.StakingConfig{}, errSybilProtectionDisabledStakerWeights
@@ -922,8 +928,13 @@ func getDatabaseConfig(v *viper.Viper, networkID uint32) (node.DatabaseConfig, e
 		}
 	}
 
+	name := v.GetString(DBTypeKey)
+	if _, err := manager.GetBackend(name); err != nil {
+		return node.DatabaseConfig{}, err
+	}
+
 	return node.DatabaseConfig{
-		Name: v.GetString(DBTypeKey),
+		Name: name,
 		Path: filepath.Join(
 			GetExpandedArg(v, DBPathKey),
 			constants.NetworkName(networkID),
@@ -1076,9 +1087,16 @@ func readChainConfigPath(chainConfigPath string) (map[string]chains.ChainConfig,
 			return chainConfigMap, err
 		}
 
+		// chainconfigdir/chainId/policies.*
+		policiesData, err := storage.ReadFileWithName(chainDir, chainPoliciesFileName)
+		if err != nil {
+			return chainConfigMap, err
+		}
+
 		chainConfigMap[dirInfo.Name()] = chains.ChainConfig{
-			Config:  configData,
-			Upgrade: upgradeData,
+			Config:   configData,
+			Upgrade:  upgradeData,
+			Policies: policiesData,
 		}
 	}
 	return chainConfigMap, nil
@@ -1173,10 +1191,12 @@ func getSubnetConfigsFromDir(v *viper.Viper, subnetIDs []ids.ID) (map[ids.ID]sub
 }
 
 func getDefaultSubnetConfig(v *viper.Viper) subnets.Config {
+	consensusParameters := getConsensusConfig(v)
 	return subnets.Config{
-		ConsensusParameters:         getConsensusConfig(v),
+		ConsensusParameters:         consensusParameters,
 		ValidatorOnly:               false,
 		GossipConfig:                getGossipConfig(v),
+		BenchlistConfig:             getBenchlistConfig(v, consensusParameters),
 		ProposerMinBlockDelay:       proposervm.DefaultMinBlockDelay,
 		ProposerNumHistoricalBlocks: proposervm.DefaultNumHistoricalBlocks,
 	}
@@ -1315,6 +1335,8 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 		return node.Config{}, fmt.Errorf("%s must be > 0", ConsensusAppConcurrencyKey)
 	}
 
+	nodeConfig.ConsensusChitsHeightsEnabled = v.GetBool(ConsensusChitsHeightsEnabledKey)
+
 	nodeConfig.UseCurrentHeight = v.GetBool(ProposerVMUseCurrentHeightKey)
 
 	// Logging
@@ -1352,6 +1374,7 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	if err != nil {
 		return node.Config{}, err
 	}
+	nodeConfig.TrackValidatedSubnets = v.GetBool(TrackValidatedSubnetsKey)
 
 	// HTTP APIs
 	nodeConfig.HTTPConfig, err = getHTTPConfig(v)
@@ -1405,16 +1428,19 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 
 	primaryNetworkConfig := getDefaultSubnetConfig(v)
 	if err := primaryNetworkConfig.Valid(); err != nil {
-		return node.Config{}, fmt.Errorf("invalid consensus parameters: %w", err)
+		return node.Config{}, fmt.Errorf("invalid primary network config: %w", err)
 	}
 	subnetConfigs[constants.PrimaryNetworkID] = primaryNetworkConfig
 
 	nodeConfig.SubnetConfigs = subnetConfigs
 
 	// Benchlist
-	nodeConfig.BenchlistConfig, err = getBenchlistConfig(v, primaryNetworkConfig.ConsensusParameters)
-	if err != nil {
-		return node.Config{}, err
+	nodeConfig.BenchlistConfig = benchlist.Config{
+		Threshold:              primaryNetworkConfig.BenchlistFailThreshold,
+		MinimumFailingDuration: primaryNetworkConfig.BenchlistMinFailingDuration,
+		Duration:               primaryNetworkConfig.BenchlistDuration,
+		MaxPortion:             primaryNetworkConfig.BenchlistMaxPortion,
+		SubnetConfigs:          subnetConfigs,
 	}
 
 	// File Descriptor Limit
@@ -1499,6 +1525,11 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 
 	nodeConfig.ChainDataDir = GetExpandedArg(v, ChainDataDirKey)
 
+	nodeConfig.ChainSnapshotsEnabled = v.GetBool(ChainSnapshotsEnabledKey)
+	if nodeConfig.ChainSnapshotsEnabled && (nodeConfig.NetworkID == constants.MainnetID || nodeConfig.NetworkID == constants.TestnetID) {
+		return node.Config{}, errChainSnapshotsOnPublicNetwork
+	}
+
 	nodeConfig.ProcessContextFilePath = GetExpandedArg(v, ProcessContextFileKey)
 
 	nodeConfig.ProvidedFlags = providedFlags(v)
@@ -8,13 +8,14 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
 	"github.com/DioneProtocol/odysseygo/database/leveldb"
-	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/database/manager"
 	"github.com/DioneProtocol/odysseygo/genesis"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowball"
 	"github.com/DioneProtocol/odysseygo/trace"
@@ -103,7 +104,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Uint64(AddSubnetDelegatorFeeKey, genesis.LocalParams.AddSubnetDelegatorFee, "Transaction fee, in nDIONE, for transactions that add new subnet delegators")
 
 	// Database
-	fs.String(DBTypeKey, leveldb.Name, fmt.Sprintf("Database type to use. Should be one of {%s, %s}", leveldb.Name, memdb.Name))
+	fs.String(DBTypeKey, leveldb.Name, fmt.Sprintf("Database type to use. Should be one of {%s}", strings.Join(manager.BackendNames(), ", ")))
 	fs.String(DBPathKey, defaultDBDir, "Path to database directory")
 	fs.String(DBConfigFileKey, "", fmt.Sprintf("Path to database config file. Ignored if %s is specified", DBConfigContentKey))
 	fs.String(DBConfigContentKey, "", "Specifies base64 encoded database config content")
@@ -149,6 +150,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(NetworkPingFrequencyKey, constants.DefaultPingFrequency, "Frequency of pinging other peers")
 
 	fs.String(NetworkCompressionTypeKey, constants.DefaultNetworkCompressionType.String(), fmt.Sprintf("Compression type for outbound messages. Must be one of [%s, %s, %s]", compression.TypeGzip, compression.TypeZstd, compression.TypeNone))
+	fs.Uint(NetworkCompressionThresholdKey, constants.DefaultNetworkCompressionThreshold, "Size, in bytes, below which outbound messages are sent uncompressed")
 
 	fs.Duration(NetworkMaxClockDifferenceKey, constants.DefaultNetworkMaxClockDifference, "Max allowed clock difference value between this node and peers")
 	// Note: The default value is set to false here because the default
@@ -178,6 +180,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(ConsensusAcceptedFrontierGossipFrequencyKey, constants.DefaultAcceptedFrontierGossipFrequency, "Frequency of gossiping accepted frontiers")
 	fs.Uint(ConsensusAppConcurrencyKey, constants.DefaultConsensusAppConcurrency, "Maximum number of goroutines to use when handling App messages on a chain")
 	fs.Duration(ConsensusShutdownTimeoutKey, constants.DefaultConsensusShutdownTimeout, "Timeout before killing an unresponsive chain")
+	fs.Bool(ConsensusChitsHeightsEnabledKey, true, "If true, chits sent by this node include the heights of its preferred and last accepted blocks, allowing peers to detect when they are far behind")
 	fs.Uint(ConsensusGossipAcceptedFrontierValidatorSizeKey, constants.DefaultConsensusGossipAcceptedFrontierValidatorSize, "Number of validators to gossip to when gossiping accepted frontier")
 	fs.Uint(ConsensusGossipAcceptedFrontierNonValidatorSizeKey, constants.DefaultConsensusGossipAcceptedFrontierNonValidatorSize, "Number of non-validators to gossip to when gossiping accepted frontier")
 	fs.Uint(ConsensusGossipAcceptedFrontierPeerSizeKey, constants.DefaultConsensusGossipAcceptedFrontierPeerSize, "Number of peers to gossip to when gossiping accepted frontier")
@@ -224,11 +227,13 @@ func addNodeFlags(fs *pflag.FlagSet) {
 		fmt.Sprintf("Password file used to initially create/validate API authorization tokens. Ignored if %s is specified. Leading and trailing whitespace is removed from the password. Can be changed via API call",
 			APIAuthPasswordKey))
 	fs.String(APIAuthPasswordKey, "", "Specifies password for API authorization tokens")
+	fs.StringSlice(APIStakingAuthMethodsKey, nil, "API methods, such as admin.restartChain, that must be called with a request signed by the node's staking key")
 
 	// Enable/Disable APIs
 	fs.Bool(AdminAPIEnabledKey, false, "If true, this node exposes the Admin API")
 	fs.Bool(InfoAPIEnabledKey, true, "If true, this node exposes the Info API")
 	fs.Bool(KeystoreAPIEnabledKey, false, "If true, this node exposes the Keystore API")
+	fs.String(KeystoreEventsWebhookURLKey, "", "If non-empty, every keystore event is POSTed to this URL")
 	fs.Bool(MetricsAPIEnabledKey, true, "If true, this node exposes the Metrics API")
 	fs.Bool(HealthAPIEnabledKey, true, "If true, this node exposes the Health API")
 	fs.Bool(IpcAPIEnabledKey, false, "If true, IPCs can be opened")
@@ -251,6 +256,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.String(StakingHostKey, "", "Address of the consensus server") // Bind to all interfaces by default.
 	fs.Uint(StakingPortKey, DefaultStakingPort, "Port of the consensus server")
 	fs.Bool(StakingEphemeralCertEnabledKey, false, "If true, the node uses an ephemeral staking TLS key and certificate, and has an ephemeral node ID")
+	fs.Bool(StakingAllowNodeIDChangeKey, false, fmt.Sprintf("If true, the node starts even if its node ID differs from the one recorded in its database. Implied by %s", StakingEphemeralCertEnabledKey))
 	fs.String(StakingTLSKeyPathKey, defaultStakingTLSKeyPath, fmt.Sprintf("Path to the TLS private key for staking. Ignored if %s is specified", StakingTLSKeyContentKey))
 	fs.String(StakingTLSKeyContentKey, "", "Specifies base64 encoded TLS private key for staking")
 	fs.String(StakingCertPathKey, defaultStakingCertPath, fmt.Sprintf("Path to the TLS certificate for staking. Ignored if %s is specified", StakingCertContentKey))
@@ -290,6 +296,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(MintingPeriodKey, 0, "Minting period")
 	// Subnets
 	fs.String(TrackSubnetsKey, "", "List of subnets for the node to track. A node tracking a subnet will track the uptimes of the subnet validators and attempt to sync all the chains in the subnet. Before validating a subnet, a node should be tracking the subnet to avoid impacting their subnet validation uptime")
+	fs.Bool(TrackValidatedSubnetsKey, false, "If true, the node will also track every subnet that it is a current or pending validator of, including subnets that it becomes a validator of while running")
 
 	// State syncing
 	fs.String(StateSyncIPsKey, "", "Comma separated list of state sync peer ips to connect to. Example: 127.0.0.1:9630,127.0.0.1:9631")
@@ -305,6 +312,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(BootstrapMaxTimeGetAncestorsKey, 50*time.Millisecond, "Max Time to spend fetching a container and its ancestors when responding to a GetAncestors")
 	fs.Uint(BootstrapAncestorsMaxContainersSentKey, 2000, "Max number of containers in an Ancestors message sent by this node")
 	fs.Uint(BootstrapAncestorsMaxContainersReceivedKey, 2000, "This node reads at most this many containers from an incoming Ancestors message")
+	fs.Duration(BootstrapFrontierCacheTTLKey, 30*time.Second, "Max age of a beacon's accepted frontier for it to be reused when bootstrapping is retried, rather than requested again. If 0, accepted frontiers are always requested")
 
 	// Consensus
 	fs.Int(SnowSampleSizeKey, snowball.DefaultParameters.K, "Number of nodes to query for each network poll")
@@ -317,6 +325,8 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Int(SnowOptimalProcessingKey, snowball.DefaultParameters.OptimalProcessing, "Optimal number of processing containers in consensus")
 	fs.Int(SnowMaxProcessingKey, snowball.DefaultParameters.MaxOutstandingItems, "Maximum number of processing items to be considered healthy")
 	fs.Duration(SnowMaxTimeProcessingKey, snowball.DefaultParameters.MaxItemProcessingTime, "Maximum amount of time an item should be processing and still be healthy")
+	fs.Int(SnowMaxProcessingDepthKey, snowball.DefaultParameters.MaxProcessingDepth, "Maximum number of blocks a processing block may be past the last accepted block before locally built blocks stop being issued and the chain is reported unhealthy")
+	fs.Int(SnowMinSampleSizeKey, snowball.DefaultParameters.MinK, "Minimum number of nodes to query for each network poll while many queries are failing. If 0, the sample size isn't reduced")
 
 	// ProposerVM
 	fs.Bool(ProposerVMUseCurrentHeightKey, false, "Have the ProposerVM always report the last accepted O-chain block height")
@@ -341,6 +351,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 
 	// Chain Data Directory
 	fs.String(ChainDataDirKey, defaultChainDataDir, "Chain specific data directory")
+	fs.Bool(ChainSnapshotsEnabledKey, false, "If true, chain state can be snapshotted and rolled back through the Admin API. Not allowed on public networks. Chain state written before changing this flag is not accessible after changing it")
 
 	// Profiles
 	fs.String(ProfileDirKey, defaultProfileDir, "Path to the profile directory")
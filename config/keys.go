@@ -64,6 +64,7 @@ const (
 	APIAuthRequiredKey                                 = "api-auth-required"
 	APIAuthPasswordKey                                 = "api-auth-password"
 	APIAuthPasswordFileKey                             = "api-auth-password-file"
+	APIStakingAuthMethodsKey                           = "api-staking-auth-methods"
 	StateSyncIPsKey                                    = "state-sync-ips"
 	StateSyncIDsKey                                    = "state-sync-ids"
 	BootstrapIPsKey                                    = "bootstrap-ips"
@@ -71,6 +72,7 @@ const (
 	StakingHostKey                                     = "staking-host"
 	StakingPortKey                                     = "staking-port"
 	StakingEphemeralCertEnabledKey                     = "staking-ephemeral-cert-enabled"
+	StakingAllowNodeIDChangeKey                        = "staking-allow-node-id-change"
 	StakingTLSKeyPathKey                               = "staking-tls-key-file"
 	StakingTLSKeyContentKey                            = "staking-tls-key-file-content"
 	StakingCertPathKey                                 = "staking-tls-cert-file"
@@ -103,6 +105,7 @@ const (
 	NetworkPingFrequencyKey                            = "network-ping-frequency"
 	NetworkMaxReconnectDelayKey                        = "network-max-reconnect-delay"
 	NetworkCompressionTypeKey                          = "network-compression-type"
+	NetworkCompressionThresholdKey                     = "network-compression-threshold"
 	NetworkMaxClockDifferenceKey                       = "network-max-clock-difference"
 	NetworkAllowPrivateIPsKey                          = "network-allow-private-ips"
 	NetworkRequireValidatorToConnectKey                = "network-require-validator-to-connect"
@@ -135,11 +138,15 @@ const (
 	SnowOptimalProcessingKey                           = "snow-optimal-processing"
 	SnowMaxProcessingKey                               = "snow-max-processing"
 	SnowMaxTimeProcessingKey                           = "snow-max-time-processing"
+	SnowMaxProcessingDepthKey                          = "snow-max-processing-depth"
+	SnowMinSampleSizeKey                               = "snow-min-sample-size"
 	PartialSyncPrimaryNetworkKey                       = "partial-sync-primary-network"
 	TrackSubnetsKey                                    = "track-subnets"
+	TrackValidatedSubnetsKey                           = "track-validated-subnets"
 	AdminAPIEnabledKey                                 = "api-admin-enabled"
 	InfoAPIEnabledKey                                  = "api-info-enabled"
 	KeystoreAPIEnabledKey                              = "api-keystore-enabled"
+	KeystoreEventsWebhookURLKey                        = "keystore-events-webhook-url"
 	MetricsAPIEnabledKey                               = "api-metrics-enabled"
 	HealthAPIEnabledKey                                = "api-health-enabled"
 	IpcAPIEnabledKey                                   = "api-ipcs-enabled"
@@ -148,6 +155,7 @@ const (
 	MeterVMsEnabledKey                                 = "meter-vms-enabled"
 	ConsensusAcceptedFrontierGossipFrequencyKey        = "consensus-accepted-frontier-gossip-frequency"
 	ConsensusAppConcurrencyKey                         = "consensus-app-concurrency"
+	ConsensusChitsHeightsEnabledKey                    = "consensus-chits-heights-enabled"
 	ConsensusGossipAcceptedFrontierValidatorSizeKey    = "consensus-accepted-frontier-gossip-validator-size"
 	ConsensusGossipAcceptedFrontierNonValidatorSizeKey = "consensus-accepted-frontier-gossip-non-validator-size"
 	ConsensusGossipAcceptedFrontierPeerSizeKey         = "consensus-accepted-frontier-gossip-peer-size"
@@ -173,7 +181,9 @@ const (
 	BootstrapMaxTimeGetAncestorsKey                    = "bootstrap-max-time-get-ancestors"
 	BootstrapAncestorsMaxContainersSentKey             = "bootstrap-ancestors-max-containers-sent"
 	BootstrapAncestorsMaxContainersReceivedKey         = "bootstrap-ancestors-max-containers-received"
+	BootstrapFrontierCacheTTLKey                       = "bootstrap-frontier-cache-ttl"
 	ChainDataDirKey                                    = "chain-data-dir"
+	ChainSnapshotsEnabledKey                           = "chain-snapshots-enabled"
 	ChainConfigDirKey                                  = "chain-config-dir"
 	ChainConfigContentKey                              = "chain-config-content"
 	SubnetConfigDirKey                                 = "subnet-config-dir"
@@ -71,6 +71,13 @@ func (db *Database) Compact(start []byte, limit []byte) error {
 	return db.handleError(db.Database.Compact(start, limit))
 }
 
+func (db *Database) ApproximateSize(start []byte, limit []byte) (uint64, error) {
+	if err := db.corrupted(); err != nil {
+		return 0, err
+	}
+	return database.ApproximateSize(db.Database, start, limit)
+}
+
 func (db *Database) Close() error {
 	return db.handleError(db.Database.Close())
 }
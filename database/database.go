@@ -84,6 +84,20 @@ type Compacter interface {
 	Compact(start []byte, limit []byte) error
 }
 
+// ApproximateSizer wraps the ApproximateSize method of a backing data store.
+// It is optionally implemented by databases that persist their data to disk.
+type ApproximateSizer interface {
+	// ApproximateSize returns the approximate number of bytes used on disk to
+	// store the keys in the range [start, limit).
+	//
+	// A nil start is treated as a key before all keys in the DB.
+	// And a nil limit is treated as a key after all keys in the DB.
+	//
+	// Note: [start] and [limit] are safe to modify and read after calling
+	// ApproximateSize.
+	ApproximateSize(start []byte, limit []byte) (uint64, error)
+}
+
 // Database contains all the methods required to allow handling different
 // key-value data stores backing the database.
 type Database interface {
@@ -7,6 +7,7 @@ import "errors"
 
 // common errors
 var (
-	ErrClosed   = errors.New("closed")
-	ErrNotFound = errors.New("not found")
+	ErrClosed         = errors.New("closed")
+	ErrNotFound       = errors.New("not found")
+	ErrSizeNotTracked = errors.New("database doesn't track its size")
 )
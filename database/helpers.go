@@ -263,3 +263,44 @@ func ClearPrefix(db Database, prefix []byte, writeSize int) error {
 	}
 	return it.Error()
 }
+
+// Copy writes all key-value pairs of [src] into [dst].
+// Writes each batch when it reaches [writeSize].
+func Copy(dst Batcher, src Iteratee, writeSize int) error {
+	b := dst.NewBatch()
+	it := src.NewIterator()
+	defer it.Release()
+
+	for it.Next() {
+		if err := b.Put(it.Key(), it.Value()); err != nil {
+			return err
+		}
+
+		// Avoid too much memory pressure by periodically writing to the
+		// database.
+		if b.Size() < writeSize {
+			continue
+		}
+
+		if err := b.Write(); err != nil {
+			return err
+		}
+		b.Reset()
+	}
+
+	if err := b.Write(); err != nil {
+		return err
+	}
+	return it.Error()
+}
+
+// ApproximateSize returns the approximate number of bytes [db] uses on disk to
+// store the keys in the range [start, limit). Returns ErrSizeNotTracked if
+// [db] doesn't implement ApproximateSizer.
+func ApproximateSize(db Database, start, limit []byte) (uint64, error) {
+	sizer, ok := db.(ApproximateSizer)
+	if !ok {
+		return 0, ErrSizeNotTracked
+	}
+	return sizer.ApproximateSize(start, limit)
+}
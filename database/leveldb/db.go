@@ -355,6 +355,36 @@ func (db *Database) Compact(start []byte, limit []byte) error {
 	return updateError(db.DB.CompactRange(util.Range{Start: start, Limit: limit}))
 }
 
+func (db *Database) ApproximateSize(start []byte, limit []byte) (uint64, error) {
+	// goleveldb measures a range by the offsets of its bounds, so a nil limit
+	// must be converted into the total size of the tables.
+	sizes, err := db.DB.SizeOf([]util.Range{{Limit: start}})
+	if err != nil {
+		return 0, updateError(err)
+	}
+	startOffset := sizes.Sum()
+
+	var limitOffset int64
+	if limit == nil {
+		stats := leveldb.DBStats{}
+		if err := db.DB.Stats(&stats); err != nil {
+			return 0, updateError(err)
+		}
+		limitOffset = stats.LevelSizes.Sum()
+	} else {
+		sizes, err := db.DB.SizeOf([]util.Range{{Limit: limit}})
+		if err != nil {
+			return 0, updateError(err)
+		}
+		limitOffset = sizes.Sum()
+	}
+
+	if limitOffset <= startOffset {
+		return 0, nil
+	}
+	return uint64(limitOffset - startOffset), nil
+}
+
 func (db *Database) Close() error {
 	db.closed.Set(true)
 	db.closeOnce.Do(func() {
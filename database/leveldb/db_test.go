@@ -26,6 +26,42 @@ func TestInterface(t *testing.T) {
 	}
 }
 
+func TestApproximateSize(t *testing.T) {
+	require := require.New(t)
+
+	db, err := New(t.TempDir(), nil, logging.NoLog{}, "", prometheus.NewRegistry())
+	require.NoError(err)
+	defer db.Close()
+
+	size, err := database.ApproximateSize(db, nil, nil)
+	require.NoError(err)
+	require.Zero(size)
+
+	value := make([]byte, 1024)
+	for i := 0; i < 1024; i++ {
+		require.NoError(db.Put([]byte{'a', byte(i >> 8), byte(i)}, value))
+		require.NoError(db.Put([]byte{'b', byte(i >> 8), byte(i)}, value))
+	}
+	// Flush the keys into tables so that their sizes are measurable.
+	require.NoError(db.Compact(nil, nil))
+
+	aSize, err := database.ApproximateSize(db, []byte{'a'}, []byte{'b'})
+	require.NoError(err)
+	require.Positive(aSize)
+
+	bSize, err := database.ApproximateSize(db, []byte{'b'}, nil)
+	require.NoError(err)
+	require.Positive(bSize)
+
+	size, err = database.ApproximateSize(db, nil, nil)
+	require.NoError(err)
+	require.Equal(aSize+bSize, size)
+
+	size, err = database.ApproximateSize(db, []byte{'c'}, nil)
+	require.NoError(err)
+	require.Zero(size)
+}
+
 func FuzzKeyValue(f *testing.F) {
 	folder := f.TempDir()
 	db, err := New(folder, nil, logging.NoLog{}, "", prometheus.NewRegistry())
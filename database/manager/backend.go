@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/DioneProtocol/odysseygo/database/leveldb"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/database/rocksdb"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/version"
+)
+
+var (
+	errUnknownBackend   = errors.New("unknown database backend")
+	errDuplicateBackend = errors.New("duplicate database backend")
+
+	backendsLock sync.RWMutex
+	backends     = map[string]Backend{
+		leveldb.Name: BackendFunc(newLevelDBBackend),
+		memdb.Name:   BackendFunc(newMemDBBackend),
+		// Binaries built without RocksDB support fail to create its databases.
+		rocksdb.Name: BackendFunc(newRocksDBBackend),
+	}
+)
+
+// BackendConfig is the configuration a Backend creates a Manager from.
+type BackendConfig struct {
+	// Path to the directory holding the versioned databases
+	Path string
+	// Backend specific configuration
+	Config         []byte
+	Log            logging.Logger
+	CurrentVersion *version.Semantic
+	// Namespace of the metrics reported by the backend
+	Namespace  string
+	Registerer prometheus.Registerer
+}
+
+// Backend is a database type the node can store its data in.
+type Backend interface {
+	// NewManager returns a Manager of the databases described by [config].
+	NewManager(config BackendConfig) (Manager, error)
+}
+
+// BackendFunc is an adapter to allow the use of ordinary functions as a
+// Backend.
+type BackendFunc func(config BackendConfig) (Manager, error)
+
+func (f BackendFunc) NewManager(config BackendConfig) (Manager, error) {
+	return f(config)
+}
+
+// RegisterBackend makes [backend] selectable as the database type [name].
+func RegisterBackend(name string, backend Backend) error {
+	backendsLock.Lock()
+	defer backendsLock.Unlock()
+
+	if _, ok := backends[name]; ok {
+		return fmt.Errorf("%w: %q", errDuplicateBackend, name)
+	}
+	backends[name] = backend
+	return nil
+}
+
+// GetBackend returns the backend of the database type [name].
+func GetBackend(name string) (Backend, error) {
+	backendsLock.RLock()
+	defer backendsLock.RUnlock()
+
+	backend, ok := backends[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q: should be one of {%s}",
+			errUnknownBackend,
+			name,
+			strings.Join(backendNames(), ", "),
+		)
+	}
+	return backend, nil
+}
+
+// BackendNames returns the sorted names of the registered database types.
+func BackendNames() []string {
+	backendsLock.RLock()
+	defer backendsLock.RUnlock()
+
+	return backendNames()
+}
+
+// Invariant: Assumes [backendsLock] is held.
+func backendNames() []string {
+	names := maps.Keys(backends)
+	slices.Sort(names)
+	return names
+}
+
+func newLevelDBBackend(config BackendConfig) (Manager, error) {
+	return NewLevelDB(
+		config.Path,
+		config.Config,
+		config.Log,
+		config.CurrentVersion,
+		config.Namespace,
+		config.Registerer,
+	)
+}
+
+func newRocksDBBackend(config BackendConfig) (Manager, error) {
+	return NewRocksDB(
+		config.Path,
+		config.Config,
+		config.Log,
+		config.CurrentVersion,
+		config.Namespace,
+		config.Registerer,
+	)
+}
+
+func newMemDBBackend(config BackendConfig) (Manager, error) {
+	return NewMemDB(config.CurrentVersion), nil
+}
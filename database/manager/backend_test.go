@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package manager
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database/leveldb"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/database/rocksdb"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/version"
+)
+
+func TestGetBackend(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal([]string{leveldb.Name, memdb.Name, rocksdb.Name}, BackendNames())
+
+	for _, name := range BackendNames() {
+		backend, err := GetBackend(name)
+		require.NoError(err)
+
+		m, err := backend.NewManager(BackendConfig{
+			Path:           t.TempDir(),
+			Log:            logging.NoLog{},
+			CurrentVersion: version.Semantic1_0_0,
+			Namespace:      "db",
+			Registerer:     prometheus.NewRegistry(),
+		})
+		if name == rocksdb.Name && !rocksdb.Supported {
+			require.ErrorIs(err, rocksdb.ErrUnsupported)
+			continue
+		}
+		require.NoError(err)
+		require.Equal(version.Semantic1_0_0, m.Current().Version)
+		require.NoError(m.Close())
+	}
+
+	_, err := GetBackend("pebble")
+	require.ErrorIs(err, errUnknownBackend)
+}
+
+func TestRegisterBackend(t *testing.T) {
+	require := require.New(t)
+
+	const name = "testdb"
+	backend := BackendFunc(newMemDBBackend)
+	require.NoError(RegisterBackend(name, backend))
+	defer func() {
+		backendsLock.Lock()
+		delete(backends, name)
+		backendsLock.Unlock()
+	}()
+
+	require.Contains(BackendNames(), name)
+	_, err := GetBackend(name)
+	require.NoError(err)
+
+	err = RegisterBackend(memdb.Name, backend)
+	require.ErrorIs(err, errDuplicateBackend)
+}
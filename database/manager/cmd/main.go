@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/spf13/cobra"
+
+	"github.com/DioneProtocol/odysseygo/database/leveldb"
+	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/database/rocksdb"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/version"
+)
+
+var (
+	errSrcDirRequired = errors.New("--src-db-dir is required")
+	errDstDirRequired = errors.New("--dst-db-dir is required")
+	errSameDir        = errors.New("--src-db-dir and --dst-db-dir must differ")
+)
+
+func main() {
+	var (
+		srcType       string
+		srcDir        string
+		srcConfigFile string
+		dstType       string
+		dstDir        string
+		dstConfigFile string
+	)
+	rootCmd := &cobra.Command{
+		Use:   "dbmigrate",
+		Short: "Copy the database of a stopped node into a database of another type",
+		RunE: func(*cobra.Command, []string) error {
+			switch {
+			case len(srcDir) == 0:
+				return errSrcDirRequired
+			case len(dstDir) == 0:
+				return errDstDirRequired
+			case srcDir == dstDir:
+				return errSameDir
+			}
+			// Opening a missing source would create an empty database.
+			if _, err := os.Stat(srcDir); err != nil {
+				return err
+			}
+
+			srcBackend, err := manager.GetBackend(srcType)
+			if err != nil {
+				return err
+			}
+			dstBackend, err := manager.GetBackend(dstType)
+			if err != nil {
+				return err
+			}
+			srcConfig, err := readConfigFile(srcConfigFile)
+			if err != nil {
+				return err
+			}
+			dstConfig, err := readConfigFile(dstConfigFile)
+			if err != nil {
+				return err
+			}
+
+			log := logging.NewLogger(
+				"dbmigrate",
+				logging.NewWrappedCore(
+					logging.Info,
+					os.Stdout,
+					logging.Colors.ConsoleEncoder(),
+				),
+			)
+			src, err := srcBackend.NewManager(manager.BackendConfig{
+				Path:           srcDir,
+				Config:         srcConfig,
+				Log:            log,
+				CurrentVersion: version.CurrentDatabase,
+				Registerer:     prometheus.NewRegistry(),
+			})
+			if err != nil {
+				return err
+			}
+			// Drop any potential error closing the source to report the
+			// original error
+			defer src.Close()
+
+			if err := manager.Migrate(src, dstBackend, dstDir, dstConfig, log); err != nil {
+				return err
+			}
+			return src.Close()
+		},
+	}
+	backends := strings.Join(manager.BackendNames(), ", ")
+	rootCmd.PersistentFlags().StringVar(&srcType, "src-db-type", leveldb.Name, fmt.Sprintf("Database type of the source. Should be one of {%s}", backends))
+	rootCmd.PersistentFlags().StringVar(&srcDir, "src-db-dir", "", "Path to the database directory of the source, including the network name")
+	rootCmd.PersistentFlags().StringVar(&srcConfigFile, "src-db-config-file", "", "Path to the database config file of the source")
+	rootCmd.PersistentFlags().StringVar(&dstType, "dst-db-type", rocksdb.Name, fmt.Sprintf("Database type of the destination. Should be one of {%s}", backends))
+	rootCmd.PersistentFlags().StringVar(&dstDir, "dst-db-dir", "", "Path to the database directory of the destination, including the network name")
+	rootCmd.PersistentFlags().StringVar(&dstConfigFile, "dst-db-config-file", "", "Path to the database config file of the destination")
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "dbmigrate failed: %v\n", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func readConfigFile(path string) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+	return os.ReadFile(path)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/database/memdb"
 	"github.com/DioneProtocol/odysseygo/database/meterdb"
 	"github.com/DioneProtocol/odysseygo/database/prefixdb"
+	"github.com/DioneProtocol/odysseygo/database/rocksdb"
 	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/wrappers"
@@ -96,6 +97,29 @@ func NewLevelDB(
 	)
 }
 
+// NewRocksDB creates a database manager of rocksDBs at [filePath] by creating a
+// database instance from each directory with a version <= [currentVersion]. If
+// [includePreviousVersions], opens previous database versions and includes them
+// in the returned Manager.
+func NewRocksDB(
+	dbDirPath string,
+	dbConfig []byte,
+	log logging.Logger,
+	currentVersion *version.Semantic,
+	namespace string,
+	reg prometheus.Registerer,
+) (Manager, error) {
+	return new(
+		rocksdb.New,
+		dbDirPath,
+		dbConfig,
+		log,
+		currentVersion,
+		namespace,
+		reg,
+	)
+}
+
 // new creates a database manager at [filePath] by creating a database instance
 // from each directory with a version <= [currentVersion]. If
 // [includePreviousVersions], opens previous database versions and includes them
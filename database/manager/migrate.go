@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package manager
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/utils/wrappers"
+)
+
+// migrationWriteSize is the number of bytes buffered before they are written
+// to the destination database during a migration.
+const migrationWriteSize = 4 * units.MiB
+
+var errDestinationNotEmpty = errors.New("destination database isn't empty")
+
+// Migrate copies every database managed by [src] into a database of the same
+// version created by [dst] at [dstPath], using the backend specific
+// configuration [dstConfig]. The destination databases must be empty.
+func Migrate(
+	src Manager,
+	dst Backend,
+	dstPath string,
+	dstConfig []byte,
+	log logging.Logger,
+) error {
+	for _, srcDB := range src.GetDatabases() {
+		log.Info("migrating database",
+			zap.Stringer("dbVersion", srcDB.Version),
+		)
+
+		dstManager, err := dst.NewManager(BackendConfig{
+			Path:           dstPath,
+			Config:         dstConfig,
+			Log:            log,
+			CurrentVersion: srcDB.Version,
+			// Each version is opened separately, so their metrics would
+			// conflict if they were reported together.
+			Registerer: prometheus.NewRegistry(),
+		})
+		if err != nil {
+			return err
+		}
+
+		errs := wrappers.Errs{}
+		errs.Add(
+			migrateDatabase(dstManager.Current().Database, srcDB.Database),
+			dstManager.Close(),
+		)
+		if errs.Errored() {
+			return fmt.Errorf("couldn't migrate database %s: %w", srcDB.Version, errs.Err)
+		}
+	}
+	return nil
+}
+
+func migrateDatabase(dst database.Database, src database.Database) error {
+	isEmpty, err := database.IsEmpty(dst)
+	if err != nil {
+		return err
+	}
+	if !isEmpty {
+		return errDestinationNotEmpty
+	}
+	return database.Copy(dst, src, migrationWriteSize)
+}
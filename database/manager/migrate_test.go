@@ -0,0 +1,81 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package manager
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/version"
+)
+
+func TestMigrate(t *testing.T) {
+	require := require.New(t)
+
+	srcDir := t.TempDir()
+	dstDir := t.TempDir()
+
+	// Create a previous database version so that every version is migrated
+	v1_0_0 := &version.Semantic{Major: 1, Minor: 0, Patch: 0}
+	v1_1_0 := &version.Semantic{Major: 1, Minor: 1, Patch: 0}
+	previousDB, err := NewLevelDB(srcDir, nil, logging.NoLog{}, v1_0_0, "", prometheus.NewRegistry())
+	require.NoError(err)
+	require.NoError(previousDB.Current().Database.Put([]byte("previous"), []byte("value")))
+	require.NoError(previousDB.Close())
+
+	src, err := NewLevelDB(srcDir, nil, logging.NoLog{}, v1_1_0, "", prometheus.NewRegistry())
+	require.NoError(err)
+	defer func() {
+		require.NoError(src.Close())
+	}()
+	require.Len(src.GetDatabases(), 2)
+
+	currentDB := src.Current().Database
+	for i := 0; i < 1024; i++ {
+		require.NoError(currentDB.Put([]byte{byte(i >> 8), byte(i)}, []byte{byte(i)}))
+	}
+
+	dst := BackendFunc(newLevelDBBackend)
+	require.NoError(Migrate(src, dst, dstDir, nil, logging.NoLog{}))
+	require.DirExists(filepath.Join(dstDir, v1_0_0.String()))
+	require.DirExists(filepath.Join(dstDir, v1_1_0.String()))
+
+	migrated, err := NewLevelDB(dstDir, nil, logging.NoLog{}, v1_1_0, "", prometheus.NewRegistry())
+	require.NoError(err)
+	dbs := migrated.GetDatabases()
+	require.Len(dbs, 2)
+	for i, srcDB := range src.GetDatabases() {
+		require.Equal(srcDB.Version.String(), dbs[i].Version.String())
+		requireSameContents(t, srcDB.Database, dbs[i].Database)
+	}
+	require.NoError(migrated.Close())
+
+	// Migrating into a database that already has data fails
+	err = Migrate(src, dst, dstDir, nil, logging.NoLog{})
+	require.ErrorIs(err, errDestinationNotEmpty)
+}
+
+func requireSameContents(t *testing.T, expected database.Iteratee, actual database.Iteratee) {
+	require := require.New(t)
+
+	expectedIt := expected.NewIterator()
+	defer expectedIt.Release()
+	actualIt := actual.NewIterator()
+	defer actualIt.Release()
+
+	for expectedIt.Next() {
+		require.True(actualIt.Next())
+		require.Equal(expectedIt.Key(), actualIt.Key())
+		require.Equal(expectedIt.Value(), actualIt.Value())
+	}
+	require.False(actualIt.Next())
+	require.NoError(expectedIt.Error())
+	require.NoError(actualIt.Error())
+}
@@ -124,6 +124,10 @@ func (db *Database) Compact(start, limit []byte) error {
 	return err
 }
 
+func (db *Database) ApproximateSize(start, limit []byte) (uint64, error) {
+	return database.ApproximateSize(db.db, start, limit)
+}
+
 func (db *Database) Close() error {
 	start := db.clock.Time()
 	err := db.db.Close()
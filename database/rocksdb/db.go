@@ -0,0 +1,524 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+//go:build cgo && rocksdballowed
+// +build cgo,rocksdballowed
+
+package rocksdb
+
+// #cgo LDFLAGS: -lrocksdb
+// #include <stdlib.h>
+// #include "rocksdb/c.h"
+import "C"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.uber.org/zap"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/perms"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+)
+
+const (
+	// Supported is true if this binary was built with RocksDB support.
+	Supported = true
+
+	// DefaultBlockCacheSize is the number of bytes to use for block caching in
+	// rocksdb.
+	DefaultBlockCacheSize = 512 * 1024 * 1024
+
+	// DefaultMemtableMemoryBudget is the number of bytes that the memtables
+	// may use before they are flushed.
+	DefaultMemtableMemoryBudget = 512 * 1024 * 1024
+
+	// DefaultHandleCap is the number of files descriptors to cap rocksdb to
+	// use.
+	DefaultHandleCap = 4096
+
+	// DefaultBitsPerKey is the number of bits to add to the bloom filter per
+	// key.
+	DefaultBitsPerKey = 10
+)
+
+var (
+	_ database.Database = (*Database)(nil)
+	_ database.Batch    = (*batch)(nil)
+	_ database.Iterator = (*iter)(nil)
+)
+
+// Database is a persistent key-value store backed by RocksDB. Apart from basic
+// data storage functionality it also supports batch writes and iterating over
+// the keyspace in binary-alphabetical order.
+type Database struct {
+	// lock prevents the C handles from being freed while they are in use
+	lock      sync.RWMutex
+	db        *C.rocksdb_t
+	opts      *C.rocksdb_options_t
+	readOpts  *C.rocksdb_readoptions_t
+	writeOpts *C.rocksdb_writeoptions_t
+	// iterators that haven't been released yet. They are released when the
+	// database is closed, as RocksDB requires.
+	iterators set.Set[*iter]
+	closed    bool
+}
+
+type config struct {
+	// BlockCacheSize is the number of bytes to use for block caching.
+	//
+	// The default value is 512MiB.
+	BlockCacheSize uint64 `json:"blockCacheSize"`
+	// MemtableMemoryBudget is the number of bytes that the memtables may use
+	// before they are flushed. The other compaction settings are derived from
+	// it.
+	//
+	// The default value is 512MiB.
+	MemtableMemoryBudget uint64 `json:"memtableMemoryBudget"`
+	// MaxOpenFiles is the maximum number of files rocksdb keeps open. Use -1
+	// to keep all files open.
+	//
+	// The default value is 4096.
+	MaxOpenFiles int `json:"maxOpenFiles"`
+	// FilterBitsPerKey is the number of bits to add to the bloom filter per
+	// key.
+	//
+	// The default value is 10.
+	FilterBitsPerKey int `json:"filterBitsPerKey"`
+	// Parallelism is the number of background threads used for flushes and
+	// compactions.
+	//
+	// The default value is the number of CPUs.
+	Parallelism int `json:"parallelism"`
+}
+
+// New returns a wrapped RocksDB object.
+func New(file string, configBytes []byte, log logging.Logger, _ string, _ prometheus.Registerer) (database.Database, error) {
+	parsedConfig := config{
+		BlockCacheSize:       DefaultBlockCacheSize,
+		MemtableMemoryBudget: DefaultMemtableMemoryBudget,
+		MaxOpenFiles:         DefaultHandleCap,
+		FilterBitsPerKey:     DefaultBitsPerKey,
+		Parallelism:          runtime.NumCPU(),
+	}
+	if len(configBytes) > 0 {
+		if err := json.Unmarshal(configBytes, &parsedConfig); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+		}
+	}
+
+	log.Info("creating rocksdb",
+		zap.Reflect("config", parsedConfig),
+	)
+
+	// RocksDB only creates the last directory of the path.
+	if err := os.MkdirAll(file, perms.ReadWriteExecute); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCouldNotOpen, err)
+	}
+
+	blockOpts := C.rocksdb_block_based_options_create()
+	defer C.rocksdb_block_based_options_destroy(blockOpts)
+
+	blockCache := C.rocksdb_cache_create_lru(C.size_t(parsedConfig.BlockCacheSize))
+	defer C.rocksdb_cache_destroy(blockCache)
+
+	C.rocksdb_block_based_options_set_block_cache(blockOpts, blockCache)
+	// The table options take ownership of the filter policy.
+	C.rocksdb_block_based_options_set_filter_policy(
+		blockOpts,
+		C.rocksdb_filterpolicy_create_bloom(C.double(parsedConfig.FilterBitsPerKey)),
+	)
+
+	opts := C.rocksdb_options_create()
+	C.rocksdb_options_set_create_if_missing(opts, 1)
+	C.rocksdb_options_increase_parallelism(opts, C.int(parsedConfig.Parallelism))
+	C.rocksdb_options_optimize_level_style_compaction(opts, C.uint64_t(parsedConfig.MemtableMemoryBudget))
+	C.rocksdb_options_set_max_open_files(opts, C.int(parsedConfig.MaxOpenFiles))
+	C.rocksdb_options_set_block_based_table_factory(opts, blockOpts)
+
+	cFile := C.CString(file)
+	defer C.free(unsafe.Pointer(cFile))
+
+	var cErr *C.char
+	db := C.rocksdb_open(opts, cFile, &cErr)
+	if err := toError(cErr); err != nil {
+		C.rocksdb_options_destroy(opts)
+		return nil, fmt.Errorf("%w: %w", ErrCouldNotOpen, err)
+	}
+
+	return &Database{
+		db:        db,
+		opts:      opts,
+		readOpts:  C.rocksdb_readoptions_create(),
+		writeOpts: C.rocksdb_writeoptions_create(),
+		iterators: set.Set[*iter]{},
+	}, nil
+}
+
+// Has returns if the key is set in the database
+func (db *Database) Has(key []byte) (bool, error) {
+	_, err := db.get(key, false)
+	switch err {
+	case nil:
+		return true, nil
+	case database.ErrNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// Get returns the value the key maps to in the database
+func (db *Database) Get(key []byte) ([]byte, error) {
+	return db.get(key, true)
+}
+
+// get returns ErrNotFound if [key] isn't in the database. If [copyValue], the
+// value of [key] is returned.
+func (db *Database) get(key []byte, copyValue bool) ([]byte, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+
+	var cErr *C.char
+	slice := C.rocksdb_get_pinned(db.db, db.readOpts, toCBytes(key), C.size_t(len(key)), &cErr)
+	if err := toError(cErr); err != nil {
+		return nil, err
+	}
+	if slice == nil {
+		return nil, database.ErrNotFound
+	}
+	defer C.rocksdb_pinnableslice_destroy(slice)
+
+	if !copyValue {
+		return nil, nil
+	}
+	var valueLen C.size_t
+	value := C.rocksdb_pinnableslice_value(slice, &valueLen)
+	return toGoBytes(value, valueLen), nil
+}
+
+// Put sets the value of the provided key to the provided value
+func (db *Database) Put(key []byte, value []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+
+	var cErr *C.char
+	C.rocksdb_put(
+		db.db,
+		db.writeOpts,
+		toCBytes(key),
+		C.size_t(len(key)),
+		toCBytes(value),
+		C.size_t(len(value)),
+		&cErr,
+	)
+	return toError(cErr)
+}
+
+// Delete removes the key from the database
+func (db *Database) Delete(key []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+
+	var cErr *C.char
+	C.rocksdb_delete(db.db, db.writeOpts, toCBytes(key), C.size_t(len(key)), &cErr)
+	return toError(cErr)
+}
+
+// NewBatch creates a write/delete-only buffer that is atomically committed to
+// the database when write is called
+func (db *Database) NewBatch() database.Batch {
+	return &batch{db: db}
+}
+
+// NewIterator creates a lexicographically ordered iterator over the database
+func (db *Database) NewIterator() database.Iterator {
+	return db.NewIteratorWithStartAndPrefix(nil, nil)
+}
+
+// NewIteratorWithStart creates a lexicographically ordered iterator over the
+// database starting at the provided key
+func (db *Database) NewIteratorWithStart(start []byte) database.Iterator {
+	return db.NewIteratorWithStartAndPrefix(start, nil)
+}
+
+// NewIteratorWithPrefix creates a lexicographically ordered iterator over the
+// database ignoring keys that do not start with the provided prefix
+func (db *Database) NewIteratorWithPrefix(prefix []byte) database.Iterator {
+	return db.NewIteratorWithStartAndPrefix(nil, prefix)
+}
+
+// NewIteratorWithStartAndPrefix creates a lexicographically ordered iterator
+// over the database starting at start and ignoring keys that do not start with
+// the provided prefix
+func (db *Database) NewIteratorWithStartAndPrefix(start, prefix []byte) database.Iterator {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.closed {
+		return &database.IteratorError{
+			Err: database.ErrClosed,
+		}
+	}
+
+	it := &iter{
+		db:     db,
+		iter:   C.rocksdb_create_iterator(db.db, db.readOpts),
+		prefix: slices.Clone(prefix),
+	}
+	seek := prefix
+	if bytes.Compare(start, prefix) == 1 {
+		seek = start
+	}
+	if len(seek) == 0 {
+		C.rocksdb_iter_seek_to_first(it.iter)
+	} else {
+		C.rocksdb_iter_seek(it.iter, toCBytes(seek), C.size_t(len(seek)))
+	}
+	db.iterators.Add(it)
+	return it
+}
+
+// Compact the underlying DB for the given key range.
+// Specifically, deleted and overwritten versions are discarded,
+// and the data is rearranged to reduce the cost of operations
+// needed to access the data. This operation should typically only
+// be invoked by users who understand the underlying implementation.
+//
+// A nil start is treated as a key before all keys in the DB.
+// And a nil limit is treated as a key after all keys in the DB.
+// Therefore if both are nil then it will compact entire DB.
+func (db *Database) Compact(start []byte, limit []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+
+	C.rocksdb_compact_range(
+		db.db,
+		toCBytes(start),
+		C.size_t(len(start)),
+		toCBytes(limit),
+		C.size_t(len(limit)),
+	)
+	return nil
+}
+
+func (db *Database) Close() error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	if db.closed {
+		return database.ErrClosed
+	}
+	db.closed = true
+
+	// RocksDB requires every iterator to be released before the database is
+	// closed.
+	for it := range db.iterators {
+		it.release()
+	}
+	db.iterators = nil
+
+	C.rocksdb_readoptions_destroy(db.readOpts)
+	C.rocksdb_writeoptions_destroy(db.writeOpts)
+	C.rocksdb_close(db.db)
+	C.rocksdb_options_destroy(db.opts)
+	return nil
+}
+
+func (db *Database) HealthCheck(context.Context) (interface{}, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.closed {
+		return nil, database.ErrClosed
+	}
+	return nil, nil
+}
+
+// batch buffers the operations to write them atomically into a RocksDB write
+// batch.
+type batch struct {
+	database.BatchOps
+
+	db *Database
+}
+
+// Write flushes any accumulated data to disk.
+func (b *batch) Write() error {
+	b.db.lock.RLock()
+	defer b.db.lock.RUnlock()
+
+	if b.db.closed {
+		return database.ErrClosed
+	}
+
+	writeBatch := C.rocksdb_writebatch_create()
+	defer C.rocksdb_writebatch_destroy(writeBatch)
+
+	for _, op := range b.Ops {
+		if op.Delete {
+			C.rocksdb_writebatch_delete(writeBatch, toCBytes(op.Key), C.size_t(len(op.Key)))
+		} else {
+			C.rocksdb_writebatch_put(
+				writeBatch,
+				toCBytes(op.Key),
+				C.size_t(len(op.Key)),
+				toCBytes(op.Value),
+				C.size_t(len(op.Value)),
+			)
+		}
+	}
+
+	var cErr *C.char
+	C.rocksdb_write(b.db.db, b.db.writeOpts, writeBatch, &cErr)
+	return toError(cErr)
+}
+
+// Inner returns itself
+func (b *batch) Inner() database.Batch {
+	return b
+}
+
+type iter struct {
+	db *Database
+	// iter is nil once the iterator is released
+	iter   *C.rocksdb_iterator_t
+	prefix []byte
+
+	started bool
+	// exhausted is true once the iterator moved past its last key
+	exhausted bool
+	key       []byte
+	val       []byte
+	err       error
+}
+
+// Next moves the iterator to the next key/value pair. It returns whether the
+// iterator is exhausted.
+func (it *iter) Next() bool {
+	it.db.lock.RLock()
+	defer it.db.lock.RUnlock()
+
+	it.key = nil
+	it.val = nil
+	switch {
+	case it.db.closed:
+		it.err = database.ErrClosed
+		return false
+	case it.iter == nil, it.exhausted:
+		return false
+	}
+
+	if it.started {
+		C.rocksdb_iter_next(it.iter)
+	}
+	it.started = true
+
+	if C.rocksdb_iter_valid(it.iter) == 0 {
+		var cErr *C.char
+		C.rocksdb_iter_get_error(it.iter, &cErr)
+		it.err = toError(cErr)
+		it.exhausted = true
+		return false
+	}
+
+	var keyLen C.size_t
+	key := toGoBytes(C.rocksdb_iter_key(it.iter, &keyLen), keyLen)
+	if !bytes.HasPrefix(key, it.prefix) {
+		it.exhausted = true
+		return false
+	}
+
+	var valLen C.size_t
+	it.key = key
+	it.val = toGoBytes(C.rocksdb_iter_value(it.iter, &valLen), valLen)
+	return true
+}
+
+func (it *iter) Error() error {
+	return it.err
+}
+
+func (it *iter) Key() []byte {
+	return it.key
+}
+
+func (it *iter) Value() []byte {
+	return it.val
+}
+
+func (it *iter) Release() {
+	it.db.lock.Lock()
+	defer it.db.lock.Unlock()
+
+	it.key = nil
+	it.val = nil
+	if it.db.closed {
+		return
+	}
+	it.release()
+	it.db.iterators.Remove(it)
+}
+
+// Invariant: Assumes the database's lock is held.
+func (it *iter) release() {
+	if it.iter != nil {
+		C.rocksdb_iter_destroy(it.iter)
+		it.iter = nil
+	}
+}
+
+// toCBytes returns a pointer to the contents of [b] that can be passed to
+// RocksDB for the duration of a call.
+func toCBytes(b []byte) *C.char {
+	if len(b) == 0 {
+		return nil
+	}
+	return (*C.char)(unsafe.Pointer(&b[0]))
+}
+
+// toGoBytes copies the [length] bytes at [b] into Go memory.
+func toGoBytes(b *C.char, length C.size_t) []byte {
+	if length == 0 {
+		return []byte{}
+	}
+	return C.GoBytes(unsafe.Pointer(b), C.int(length))
+}
+
+// toError converts an error reported by RocksDB into a Go error and frees it.
+func toError(cErr *C.char) error {
+	if cErr == nil {
+		return nil
+	}
+	defer C.rocksdb_free(unsafe.Pointer(cErr))
+	return errors.New(C.GoString(cErr))
+}
@@ -0,0 +1,22 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+//go:build !cgo || !rocksdballowed
+// +build !cgo !rocksdballowed
+
+package rocksdb
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+)
+
+// Supported is true if this binary was built with RocksDB support.
+const Supported = false
+
+// New returns an error, as this binary was built without RocksDB support.
+func New(string, []byte, logging.Logger, string, prometheus.Registerer) (database.Database, error) {
+	return nil, ErrUnsupported
+}
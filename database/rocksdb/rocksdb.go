@@ -0,0 +1,18 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package rocksdb implements the Database interface on top of RocksDB. RocksDB
+// is only supported by binaries built with cgo and the rocksdballowed build
+// tag, which link against the librocksdb system library.
+package rocksdb
+
+import "errors"
+
+// Name is the name of this database for database switches
+const Name = "rocksdb"
+
+var (
+	ErrInvalidConfig = errors.New("invalid config")
+	ErrCouldNotOpen  = errors.New("could not open")
+	ErrUnsupported   = errors.New("rocksdb support requires building with cgo and the rocksdballowed build tag")
+)
@@ -30,6 +30,7 @@ func NewCreator(
 	metrics prometheus.Registerer,
 	parentNamespace string,
 	compressionType compression.Type,
+	compressionThreshold int,
 	maxMessageTimeout time.Duration,
 ) (Creator, error) {
 	namespace := fmt.Sprintf("%s_codec", parentNamespace)
@@ -38,6 +39,7 @@ func NewCreator(
 		namespace,
 		metrics,
 		maxMessageTimeout,
+		compressionThreshold,
 	)
 	if err != nil {
 		return nil, err
@@ -243,16 +243,20 @@ func InboundChits(
 	requestID uint32,
 	preferredID ids.ID,
 	acceptedID ids.ID,
+	preferredHeight uint64,
+	acceptedHeight uint64,
 	nodeID ids.NodeID,
 ) InboundMessage {
 	return &inboundMessage{
 		nodeID: nodeID,
 		op:     ChitsOp,
 		message: &p2p.Chits{
-			ChainId:     chainID[:],
-			RequestId:   requestID,
-			PreferredId: preferredID[:],
-			AcceptedId:  acceptedID[:],
+			ChainId:         chainID[:],
+			RequestId:       requestID,
+			PreferredId:     preferredID[:],
+			AcceptedId:      acceptedID[:],
+			PreferredHeight: preferredHeight,
+			AcceptedHeight:  acceptedHeight,
 		},
 		expiration: mockable.MaxTime,
 	}
@@ -26,6 +26,7 @@ func Test_newMsgBuilder(t *testing.T) {
 		"test",
 		prometheus.NewRegistry(),
 		10*time.Second,
+		0,
 	)
 	require.NoError(err)
 	require.NotNil(mb)
@@ -324,6 +325,8 @@ func TestInboundMsgBuilder(t *testing.T) {
 				requestID,
 				containerIDs[0],
 				acceptedContainerIDs[0],
+				heights[1],
+				heights[0],
 				nodeID,
 			)
 
@@ -336,6 +339,8 @@ func TestInboundMsgBuilder(t *testing.T) {
 			require.Equal(requestID, innerMsg.RequestId)
 			require.Equal(containerIDs[0][:], innerMsg.PreferredId)
 			require.Equal(acceptedContainerIDs[0][:], innerMsg.AcceptedId)
+			require.Equal(heights[1], innerMsg.PreferredHeight)
+			require.Equal(heights[0], innerMsg.AcceptedHeight)
 		},
 	)
 
@@ -143,6 +143,10 @@ type msgBuilder struct {
 	zstdDecompressTimeMetrics map[Op]metric.Averager
 
 	maxMessageTimeout time.Duration
+
+	// Messages smaller than [compressionThreshold] bytes are sent
+	// uncompressed
+	compressionThreshold int
 }
 
 func newMsgBuilder(
@@ -150,6 +154,7 @@ func newMsgBuilder(
 	namespace string,
 	metrics prometheus.Registerer,
 	maxMessageTimeout time.Duration,
+	compressionThreshold int,
 ) (*msgBuilder, error) {
 	gzipCompressor, err := compression.NewGzipCompressor(constants.DefaultMaxMessageSize)
 	if err != nil {
@@ -171,7 +176,8 @@ func newMsgBuilder(
 		zstdCompressTimeMetrics:   make(map[Op]metric.Averager, len(ExternalOps)),
 		zstdDecompressTimeMetrics: make(map[Op]metric.Averager, len(ExternalOps)),
 
-		maxMessageTimeout: maxMessageTimeout,
+		maxMessageTimeout:    maxMessageTimeout,
+		compressionThreshold: compressionThreshold,
 	}
 
 	errs := wrappers.Errs{}
@@ -233,6 +239,11 @@ func (mb *msgBuilder) marshal(
 		compressedMsg           p2p.Message
 		opToCompressTimeMetrics map[Op]metric.Averager
 	)
+	if len(uncompressedMsgBytes) < mb.compressionThreshold {
+		// Compressing small messages costs more CPU time than the bandwidth
+		// it saves, and can even increase their size.
+		return uncompressedMsgBytes, 0, op, nil
+	}
 	switch compressionType {
 	case compression.TypeNone:
 		return uncompressedMsgBytes, 0, op, nil
@@ -30,6 +30,7 @@ func TestMessage(t *testing.T) {
 		"test",
 		prometheus.NewRegistry(),
 		5*time.Second,
+		0,
 	)
 	require.NoError(t, err)
 
@@ -857,6 +858,7 @@ func TestInboundMessageToString(t *testing.T) {
 		"test",
 		prometheus.NewRegistry(),
 		5*time.Second,
+		0,
 	)
 	require.NoError(err)
 
@@ -890,6 +892,7 @@ func TestEmptyInboundMessage(t *testing.T) {
 		"test",
 		prometheus.NewRegistry(),
 		5*time.Second,
+		0,
 	)
 	require.NoError(err)
 
@@ -911,6 +914,7 @@ func TestNilInboundMessage(t *testing.T) {
 		"test",
 		prometheus.NewRegistry(),
 		5*time.Second,
+		0,
 	)
 	require.NoError(err)
 
@@ -929,3 +933,50 @@ func TestNilInboundMessage(t *testing.T) {
 	pingMsg := parsedMsg.message.(*p2p.Ping)
 	require.NotNil(pingMsg)
 }
+
+func TestCompressionThreshold(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	threshold := 1024
+	mb, err := newMsgBuilder(
+		logging.NoLog{},
+		"test",
+		prometheus.NewRegistry(),
+		5*time.Second,
+		threshold,
+	)
+	require.NoError(err)
+
+	newAppGossip := func(size int) *p2p.Message {
+		return &p2p.Message{
+			Message: &p2p.Message_AppGossip{
+				AppGossip: &p2p.AppGossip{
+					ChainId:  ids.Empty[:],
+					AppBytes: make([]byte, size),
+				},
+			},
+		}
+	}
+
+	// Messages below the threshold are sent uncompressed
+	smallMsg := newAppGossip(threshold / 2)
+	outMsg, err := mb.createOutbound(smallMsg, compression.TypeZstd, false)
+	require.NoError(err)
+	require.Zero(outMsg.BytesSavedCompression())
+
+	expectedBytes, err := proto.Marshal(smallMsg)
+	require.NoError(err)
+	require.Equal(expectedBytes, outMsg.Bytes())
+
+	// Messages above the threshold are compressed
+	largeMsg := newAppGossip(2 * threshold)
+	outMsg, err = mb.createOutbound(largeMsg, compression.TypeZstd, false)
+	require.NoError(err)
+	require.Positive(outMsg.BytesSavedCompression())
+
+	inMsg, err := mb.parseInbound(outMsg.Bytes(), ids.EmptyNodeID, func() {})
+	require.NoError(err)
+	require.Equal(AppGossipOp, inMsg.Op())
+}
@@ -198,6 +198,14 @@ var (
 		GetStateSummaryFrontierOp: {},
 		GetAcceptedStateSummaryOp: {},
 	}
+	// PriorityOps are sent to a peer ahead of its other queued messages so
+	// that consensus polls aren't delayed behind bulk transfers such as
+	// Ancestors and AppGossip.
+	PriorityOps = set.Set[Op]{
+		PushQueryOp: {},
+		PullQueryOp: {},
+		ChitsOp:     {},
+	}
 
 	errUnknownMessageType = errors.New("unknown message type")
 )
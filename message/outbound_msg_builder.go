@@ -149,6 +149,8 @@ type OutboundMsgBuilder interface {
 		requestID uint32,
 		preferredID ids.ID,
 		acceptedID ids.ID,
+		preferredHeight uint64,
+		acceptedHeight uint64,
 	) (OutboundMessage, error)
 
 	AppRequest(
@@ -608,15 +610,19 @@ func (b *outMsgBuilder) Chits(
 	requestID uint32,
 	preferredID ids.ID,
 	acceptedID ids.ID,
+	preferredHeight uint64,
+	acceptedHeight uint64,
 ) (OutboundMessage, error) {
 	return b.builder.createOutbound(
 		&p2p.Message{
 			Message: &p2p.Message_Chits{
 				Chits: &p2p.Chits{
-					ChainId:     chainID[:],
-					RequestId:   requestID,
-					PreferredId: preferredID[:],
-					AcceptedId:  acceptedID[:],
+					ChainId:         chainID[:],
+					RequestId:       requestID,
+					PreferredId:     preferredID[:],
+					AcceptedId:      acceptedID[:],
+					PreferredHeight: preferredHeight,
+					AcceptedHeight:  acceptedHeight,
 				},
 			},
 		},
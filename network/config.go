@@ -130,6 +130,10 @@ type Config struct {
 	// Assumes all peers support this compression type.
 	CompressionType compression.Type `json:"compressionType"`
 
+	// Outbound messages smaller than [CompressionThreshold] bytes are sent
+	// uncompressed.
+	CompressionThreshold int `json:"compressionThreshold"`
+
 	// TLSKey is this node's TLS key that is used to sign IPs.
 	TLSKey crypto.Signer `json:"-"`
 
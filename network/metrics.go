@@ -30,6 +30,7 @@ type metrics struct {
 	inboundConnAllowed              prometheus.Counter
 	tlsConnRejected                 prometheus.Counter
 	numUselessPeerListBytes         prometheus.Counter
+	unallowedPeersSkipped           *prometheus.CounterVec
 	nodeUptimeWeightedAverage       prometheus.Gauge
 	nodeUptimeRewardingStake        prometheus.Gauge
 	nodeSubnetUptimeWeightedAverage *prometheus.GaugeVec
@@ -106,6 +107,14 @@ func newMetrics(namespace string, registerer prometheus.Registerer, initialSubne
 			Name:      "num_useless_peerlist_bytes",
 			Help:      "Amount of useless bytes (i.e. information about nodes we already knew/don't want to connect to) received in PeerList messages",
 		}),
+		unallowedPeersSkipped: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "unallowed_peers_skipped",
+				Help:      "Times a peer wasn't sent a message because it isn't allowed to connect to the message's subnet",
+			},
+			[]string{"subnetID"},
+		),
 		inboundConnRateLimited: prometheus.NewCounter(prometheus.CounterOpts{
 			Namespace: namespace,
 			Name:      "inbound_conn_throttler_rate_limited",
@@ -161,6 +170,7 @@ func newMetrics(namespace string, registerer prometheus.Registerer, initialSubne
 		registerer.Register(m.inboundConnAllowed),
 		registerer.Register(m.tlsConnRejected),
 		registerer.Register(m.numUselessPeerListBytes),
+		registerer.Register(m.unallowedPeersSkipped),
 		registerer.Register(m.inboundConnRateLimited),
 		registerer.Register(m.nodeUptimeWeightedAverage),
 		registerer.Register(m.nodeUptimeRewardingStake),
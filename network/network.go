@@ -33,7 +33,9 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow/networking/router"
 	"github.com/DioneProtocol/odysseygo/snow/networking/sender"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/staking"
 	"github.com/DioneProtocol/odysseygo/subnets"
+	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/ips"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
@@ -97,9 +99,18 @@ type Network interface {
 	// info about the peers in [nodeIDs] that have finished the handshake.
 	PeerInfo(nodeIDs []ids.NodeID) []peer.Info
 
+	// PeerCertificate returns the staking certificate of [nodeID] if it is
+	// currently connected.
+	PeerCertificate(nodeID ids.NodeID) (*staking.Certificate, bool)
+
 	// NodeUptime returns given node's [subnetID] UptimeResults in the view of
 	// this node's peer validators.
 	NodeUptime(subnetID ids.ID) (UptimeResult, error)
+
+	// TrackSubnet starts tracking [subnetID] while the network is running.
+	// The connections to the subnet's validators are restarted so that the
+	// subnet is included in the handshake with them.
+	TrackSubnet(subnetID ids.ID)
 }
 
 type UptimeResult struct {
@@ -262,7 +273,6 @@ func NewNetwork(
 		Network:              nil, // This is set below.
 		Router:               router,
 		VersionCompatibility: version.GetCompatibility(config.NetworkID),
-		MySubnets:            config.TrackedSubnets,
 		Beacons:              config.Beacons,
 		NetworkID:            config.NetworkID,
 		PingFrequency:        config.PingFrequency,
@@ -271,6 +281,7 @@ func NewNetwork(
 		ResourceTracker:      config.ResourceTracker,
 		UptimeCalculator:     config.UptimeCalculator,
 		IPSigner:             peer.NewIPSigner(config.MyIPPort, config.TLSKey),
+		MySubnets:            utils.NewAtomic(config.TrackedSubnets),
 	}
 
 	onCloseCtx, cancel := context.WithCancel(context.Background())
@@ -725,6 +736,12 @@ func (n *network) Peers(peerID ids.NodeID) ([]ips.ClaimedIPPort, error) {
 // Dispatch starts accepting connections from other nodes attempting to connect
 // to this node.
 func (n *network) Dispatch() error {
+	// The tracked subnets may be modified by the rest of the node once the
+	// network is running, so the network reads from its own copy.
+	n.peersLock.Lock()
+	n.peerConfig.MySubnets.Set(set.Of(n.config.TrackedSubnets.List()...))
+	n.peersLock.Unlock()
+
 	go n.runTimers() // Periodically perform operations
 	go n.inboundConnUpgradeThrottler.Dispatch()
 	errs := wrappers.Errs{}
@@ -865,6 +882,7 @@ func (n *network) getPeers(
 		isValidator := validators.Contains(n.config.Validators, subnetID, nodeID)
 		// check if the peer is allowed to connect to the subnet
 		if !allower.IsAllowed(nodeID, isValidator) {
+			n.metrics.unallowedPeersSkipped.WithLabelValues(subnetID.String()).Inc()
 			continue
 		}
 
@@ -909,6 +927,7 @@ func (n *network) samplePeers(
 			isValidator := subnetValidators.Contains(peerID)
 			// check if the peer is allowed to connect to the subnet
 			if !allower.IsAllowed(peerID, isValidator) {
+				n.metrics.unallowedPeersSkipped.WithLabelValues(subnetID.String()).Inc()
 				return false
 			}
 
@@ -1301,6 +1320,17 @@ func (n *network) PeerInfo(nodeIDs []ids.NodeID) []peer.Info {
 	return n.connectedPeers.Info(nodeIDs)
 }
 
+func (n *network) PeerCertificate(nodeID ids.NodeID) (*staking.Certificate, bool) {
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+
+	peer, ok := n.connectedPeers.GetByID(nodeID)
+	if !ok {
+		return nil, false
+	}
+	return peer.Cert(), true
+}
+
 func (n *network) StartClose() {
 	n.closeOnce.Do(func() {
 		n.peerConfig.Log.Info("shutting down the p2p networking")
@@ -1336,7 +1366,8 @@ func (n *network) StartClose() {
 }
 
 func (n *network) NodeUptime(subnetID ids.ID) (UptimeResult, error) {
-	if subnetID != constants.PrimaryNetworkID && !n.config.TrackedSubnets.Contains(subnetID) {
+	mySubnets := n.peerConfig.MySubnets.Get()
+	if subnetID != constants.PrimaryNetworkID && !mySubnets.Contains(subnetID) {
 		return UptimeResult{}, errNotTracked
 	}
 
@@ -1390,6 +1421,40 @@ func (n *network) NodeUptime(subnetID ids.ID) (UptimeResult, error) {
 	}, nil
 }
 
+func (n *network) TrackSubnet(subnetID ids.ID) {
+	n.peersLock.Lock()
+	defer n.peersLock.Unlock()
+
+	mySubnets := n.peerConfig.MySubnets.Get()
+	if subnetID == constants.PrimaryNetworkID || mySubnets.Contains(subnetID) {
+		return
+	}
+
+	// Peers may be reading the current set, so it is replaced rather than
+	// modified.
+	newSubnets := set.NewSet[ids.ID](mySubnets.Len() + 1)
+	newSubnets.Union(mySubnets)
+	newSubnets.Add(subnetID)
+	n.peerConfig.MySubnets.Set(newSubnets)
+
+	n.peerConfig.Log.Info("tracking subnet",
+		zap.Stringer("subnetID", subnetID),
+	)
+
+	// The subnets tracked by each side of a connection are only exchanged
+	// during the handshake, so the subnet's validators are reconnected to.
+	vdrs, ok := n.config.Validators.Get(subnetID)
+	if !ok {
+		return
+	}
+	for i := 0; i < n.connectedPeers.Len(); i++ {
+		peer, _ := n.connectedPeers.GetByIndex(i)
+		if vdrs.Contains(peer.ID()) {
+			peer.StartClose()
+		}
+	}
+}
+
 func (n *network) runTimers() {
 	gossipPeerlists := time.NewTicker(n.config.PeerListGossipFreq)
 	updateUptimes := time.NewTicker(n.config.UptimeMetricFreq)
@@ -1414,7 +1479,7 @@ func (n *network) runTimers() {
 			n.metrics.nodeUptimeWeightedAverage.Set(primaryUptime.WeightedAveragePercentage)
 			n.metrics.nodeUptimeRewardingStake.Set(primaryUptime.RewardingStakePercentage)
 
-			for subnetID := range n.config.TrackedSubnets {
+			for subnetID := range n.peerConfig.MySubnets.Get() {
 				result, err := n.NodeUptime(subnetID)
 				if err != nil {
 					n.peerConfig.Log.Debug("failed to get subnet uptime",
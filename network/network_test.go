@@ -189,6 +189,7 @@ func newMessageCreator(t *testing.T) message.Creator {
 		prometheus.NewRegistry(),
 		"",
 		constants.DefaultNetworkCompressionType,
+		constants.DefaultNetworkCompressionThreshold,
 		10*time.Second,
 	)
 	require.NoError(t, err)
@@ -13,6 +13,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow/networking/tracker"
 	"github.com/DioneProtocol/odysseygo/snow/uptime"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
@@ -33,7 +34,6 @@ type Config struct {
 	Network              Network
 	Router               router.InboundHandler
 	VersionCompatibility version.Compatibility
-	MySubnets            set.Set[ids.ID]
 	Beacons              validators.Set
 	NetworkID            uint32
 	PingFrequency        time.Duration
@@ -52,4 +52,8 @@ type Config struct {
 
 	// Signs my IP so I can send my signed IP address in the Version message
 	IPSigner *IPSigner
+
+	// Subnets this node is tracking. The set is replaced, rather than
+	// modified, when this node starts tracking another subnet.
+	MySubnets *utils.Atomic[set.Set[ids.ID]]
 }
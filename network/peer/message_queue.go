@@ -52,8 +52,10 @@ type MessageQueue interface {
 	Close()
 }
 
+// throttledMessageQueue sends the messages in [message.PriorityOps] ahead of
+// the other messages, preserving the order within each class.
 type throttledMessageQueue struct {
-	onFailed SendFailedCallback
+	metrics *Metrics
 	// [id] of the peer we're sending messages to
 	id                   ids.NodeID
 	log                  logging.Logger
@@ -67,23 +69,28 @@ type throttledMessageQueue struct {
 	// [cond.L] must be held while accessing [closed].
 	closed bool
 
+	// queue of the priority messages, which are popped before [queue]
+	// [cond.L] must be held while accessing [priorityQueue].
+	priorityQueue buffer.Deque[message.OutboundMessage]
+
 	// queue of the messages
 	// [cond.L] must be held while accessing [queue].
 	queue buffer.Deque[message.OutboundMessage]
 }
 
 func NewThrottledMessageQueue(
-	onFailed SendFailedCallback,
+	metrics *Metrics,
 	id ids.NodeID,
 	log logging.Logger,
 	outboundMsgThrottler throttling.OutboundMsgThrottler,
 ) MessageQueue {
 	return &throttledMessageQueue{
-		onFailed:             onFailed,
+		metrics:              metrics,
 		id:                   id,
 		log:                  log,
 		outboundMsgThrottler: outboundMsgThrottler,
 		cond:                 sync.NewCond(&sync.Mutex{}),
+		priorityQueue:        buffer.NewUnboundedDeque[message.OutboundMessage](initialQueueSize),
 		queue:                buffer.NewUnboundedDeque[message.OutboundMessage](initialQueueSize),
 	}
 }
@@ -96,7 +103,7 @@ func (q *throttledMessageQueue) Push(ctx context.Context, msg message.OutboundMe
 			zap.Stringer("nodeID", q.id),
 			zap.Error(err),
 		)
-		q.onFailed.SendFailed(msg)
+		q.metrics.SendFailed(msg)
 		return false
 	}
 
@@ -108,7 +115,7 @@ func (q *throttledMessageQueue) Push(ctx context.Context, msg message.OutboundMe
 			zap.Stringer("messageOp", msg.Op()),
 			zap.Stringer("nodeID", q.id),
 		)
-		q.onFailed.SendFailed(msg)
+		q.metrics.SendFailed(msg)
 		return false
 	}
 
@@ -127,11 +134,17 @@ func (q *throttledMessageQueue) Push(ctx context.Context, msg message.OutboundMe
 			zap.Stringer("nodeID", q.id),
 		)
 		q.outboundMsgThrottler.Release(msg, q.id)
-		q.onFailed.SendFailed(msg)
+		q.metrics.SendFailed(msg)
 		return false
 	}
 
-	q.queue.PushRight(msg)
+	if message.PriorityOps.Contains(msg.Op()) {
+		q.priorityQueue.PushRight(msg)
+		q.metrics.QueuedPriorityMsgs.Inc()
+	} else {
+		q.queue.PushRight(msg)
+		q.metrics.QueuedMsgs.Inc()
+	}
 	q.cond.Signal()
 	return true
 }
@@ -144,7 +157,7 @@ func (q *throttledMessageQueue) Pop() (message.OutboundMessage, bool) {
 		if q.closed {
 			return nil, false
 		}
-		if q.queue.Len() > 0 {
+		if q.len() > 0 {
 			// There is a message
 			break
 		}
@@ -159,7 +172,7 @@ func (q *throttledMessageQueue) PopNow() (message.OutboundMessage, bool) {
 	q.cond.L.Lock()
 	defer q.cond.L.Unlock()
 
-	if q.closed || q.queue.Len() == 0 {
+	if q.closed || q.len() == 0 {
 		// There isn't a message
 		return nil, false
 	}
@@ -167,8 +180,18 @@ func (q *throttledMessageQueue) PopNow() (message.OutboundMessage, bool) {
 	return q.pop(), true
 }
 
+func (q *throttledMessageQueue) len() int {
+	return q.priorityQueue.Len() + q.queue.Len()
+}
+
 func (q *throttledMessageQueue) pop() message.OutboundMessage {
-	msg, _ := q.queue.PopLeft()
+	msg, ok := q.priorityQueue.PopLeft()
+	if ok {
+		q.metrics.QueuedPriorityMsgs.Dec()
+	} else {
+		msg, _ = q.queue.PopLeft()
+		q.metrics.QueuedMsgs.Dec()
+	}
 
 	q.outboundMsgThrottler.Release(msg, q.id)
 	return msg
@@ -184,11 +207,11 @@ func (q *throttledMessageQueue) Close() {
 
 	q.closed = true
 
-	for q.queue.Len() > 0 {
-		msg, _ := q.queue.PopLeft()
-		q.outboundMsgThrottler.Release(msg, q.id)
-		q.onFailed.SendFailed(msg)
+	for q.len() > 0 {
+		msg := q.pop()
+		q.metrics.SendFailed(msg)
 	}
+	q.priorityQueue = nil
 	q.queue = nil
 
 	q.cond.Broadcast()
@@ -6,11 +6,16 @@ package peer
 import (
 	"context"
 	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/message"
+	"github.com/DioneProtocol/odysseygo/network/throttling"
 	"github.com/DioneProtocol/odysseygo/proto/pb/p2p"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 )
@@ -87,3 +92,52 @@ func TestMessageQueue(t *testing.T) {
 	_, ok = q.Pop()
 	require.False(ok)
 }
+
+func TestThrottledMessageQueuePriority(t *testing.T) {
+	require := require.New(t)
+
+	metrics, err := NewMetrics(logging.NoLog{}, "", prometheus.NewRegistry())
+	require.NoError(err)
+
+	q := NewThrottledMessageQueue(
+		metrics,
+		ids.GenerateTestNodeID(),
+		logging.NoLog{},
+		throttling.NewNoOutboundThrottler(),
+	)
+
+	mc := newMessageCreator(t)
+	chainID := ids.GenerateTestID()
+	gossip0, err := mc.AppGossip(chainID, []byte{0})
+	require.NoError(err)
+	gossip1, err := mc.AppGossip(chainID, []byte{1})
+	require.NoError(err)
+	query0, err := mc.PullQuery(chainID, 0, time.Second, ids.GenerateTestID(), p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	require.NoError(err)
+	query1, err := mc.PullQuery(chainID, 1, time.Second, ids.GenerateTestID(), p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	require.NoError(err)
+
+	for _, msg := range []message.OutboundMessage{gossip0, query0, gossip1, query1} {
+		require.True(q.Push(context.Background(), msg))
+	}
+	require.Equal(float64(2), testutil.ToFloat64(metrics.QueuedMsgs))
+	require.Equal(float64(2), testutil.ToFloat64(metrics.QueuedPriorityMsgs))
+
+	// Priority messages are sent first, and the order within each class is
+	// preserved
+	for _, expectedMsg := range []message.OutboundMessage{query0, query1, gossip0} {
+		msg, ok := q.PopNow()
+		require.True(ok)
+		require.Equal(expectedMsg, msg)
+	}
+	require.Equal(float64(1), testutil.ToFloat64(metrics.QueuedMsgs))
+	require.Zero(testutil.ToFloat64(metrics.QueuedPriorityMsgs))
+
+	// Closing the queue drops the remaining messages
+	q.Close()
+	require.Zero(testutil.ToFloat64(metrics.QueuedMsgs))
+	require.Equal(float64(1), testutil.ToFloat64(metrics.MessageMetrics[message.AppGossipOp].NumFailed))
+
+	_, ok := q.PopNow()
+	require.False(ok)
+}
@@ -84,6 +84,10 @@ type Metrics struct {
 	ClockSkew      metric.Averager
 	FailedToParse  prometheus.Counter
 	MessageMetrics map[message.Op]*MessageMetrics
+
+	// Number of messages waiting in the outbound queues of all the peers
+	QueuedMsgs         prometheus.Gauge
+	QueuedPriorityMsgs prometheus.Gauge
 }
 
 func NewMetrics(
@@ -99,11 +103,23 @@ func NewMetrics(
 			Help:      "Number of messages that could not be parsed or were invalidly formed",
 		}),
 		MessageMetrics: make(map[message.Op]*MessageMetrics, len(message.ExternalOps)),
+		QueuedMsgs: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "msgs_queued",
+			Help:      "Number of non-priority messages waiting to be sent to peers",
+		}),
+		QueuedPriorityMsgs: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "priority_msgs_queued",
+			Help:      "Number of priority messages waiting to be sent to peers",
+		}),
 	}
 
 	errs := wrappers.Errs{}
 	errs.Add(
 		registerer.Register(m.FailedToParse),
+		registerer.Register(m.QueuedMsgs),
+		registerer.Register(m.QueuedPriorityMsgs),
 	)
 	for _, op := range message.ExternalOps {
 		m.MessageMetrics[op] = NewMessageMetrics(op, namespace, registerer, &errs)
@@ -504,7 +504,7 @@ func (p *peer) writeMessages() {
 		p.VersionCompatibility.Version().String(),
 		mySignedIP.Timestamp,
 		mySignedIP.Signature,
-		p.MySubnets.List(),
+		p.MySubnets.Get().List(),
 	)
 	if err != nil {
 		p.Log.Error("failed to create message",
@@ -784,6 +784,7 @@ func (p *peer) observeUptimes(primaryUptime uint32, subnetUptimes []*p2p.SubnetU
 	}
 	p.observeUptime(constants.PrimaryNetworkID, primaryUptime)
 
+	mySubnets := p.MySubnets.Get()
 	for _, subnetUptime := range subnetUptimes {
 		subnetID, err := ids.ToID(subnetUptime.SubnetId)
 		if err != nil {
@@ -795,7 +796,7 @@ func (p *peer) observeUptimes(primaryUptime uint32, subnetUptimes []*p2p.SubnetU
 			return
 		}
 
-		if !p.MySubnets.Contains(subnetID) {
+		if !mySubnets.Contains(subnetID) {
 			p.Log.Debug("dropping message with unexpected subnetID",
 				zap.Stringer("nodeID", p.id),
 				zap.Stringer("subnetID", subnetID),
@@ -918,6 +919,7 @@ func (p *peer) handleVersion(msg *p2p.Version) {
 	}
 
 	// handle subnet IDs
+	mySubnets := p.MySubnets.Get()
 	for _, subnetIDBytes := range msg.TrackedSubnets {
 		subnetID, err := ids.ToID(subnetIDBytes)
 		if err != nil {
@@ -929,7 +931,7 @@ func (p *peer) handleVersion(msg *p2p.Version) {
 			return
 		}
 		// add only if we also track this subnet
-		if p.MySubnets.Contains(subnetID) {
+		if mySubnets.Contains(subnetID) {
 			p.trackedSubnets.Add(subnetID)
 		}
 	}
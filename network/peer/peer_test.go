@@ -23,6 +23,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow/uptime"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
 	"github.com/DioneProtocol/odysseygo/staking"
+	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/ips"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
@@ -53,6 +54,7 @@ func newMessageCreator(t *testing.T) message.Creator {
 		prometheus.NewRegistry(),
 		"",
 		constants.DefaultNetworkCompressionType,
+		constants.DefaultNetworkCompressionThreshold,
 		10*time.Second,
 	)
 	require.NoError(t, err)
@@ -100,7 +102,7 @@ func makeRawTestPeers(t *testing.T, trackedSubnets set.Set[ids.ID]) (*rawTestPee
 		Log:                  logging.NoLog{},
 		InboundMsgThrottler:  throttling.NewNoInboundThrottler(),
 		VersionCompatibility: version.GetCompatibility(constants.LocalID),
-		MySubnets:            trackedSubnets,
+		MySubnets:            utils.NewAtomic(trackedSubnets),
 		UptimeCalculator:     uptime.NoOpCalculator,
 		Beacons:              validators.NewSet(),
 		NetworkID:            constants.LocalID,
@@ -19,6 +19,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow/uptime"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
 	"github.com/DioneProtocol/odysseygo/staking"
+	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/ips"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
@@ -77,6 +78,7 @@ func StartTestPeer(
 		prometheus.NewRegistry(),
 		"",
 		constants.DefaultNetworkCompressionType,
+		constants.DefaultNetworkCompressionThreshold,
 		10*time.Second,
 	)
 	if err != nil {
@@ -114,7 +116,7 @@ func StartTestPeer(
 			Network:              TestNetwork,
 			Router:               router,
 			VersionCompatibility: version.GetCompatibility(networkID),
-			MySubnets:            set.Set[ids.ID]{},
+			MySubnets:            utils.NewAtomic(set.Set[ids.ID]{}),
 			Beacons:              validators.NewSet(),
 			NetworkID:            networkID,
 			PingFrequency:        constants.DefaultPingFrequency,
@@ -83,6 +83,7 @@ func NewTestNetwork(
 		metrics,
 		"",
 		constants.DefaultNetworkCompressionType,
+		constants.DefaultNetworkCompressionThreshold,
 		constants.DefaultNetworkMaximumInboundTimeout,
 	)
 	if err != nil {
@@ -165,6 +166,7 @@ func NewTestNetwork(
 
 		MaxClockDifference:           constants.DefaultNetworkMaxClockDifference,
 		CompressionType:              constants.DefaultNetworkCompressionType,
+		CompressionThreshold:         constants.DefaultNetworkCompressionThreshold,
 		PingFrequency:                constants.DefaultPingFrequency,
 		AllowPrivateIPs:              !constants.ProductionNetworkIDs.Contains(networkID),
 		UptimeMetricFreq:             constants.DefaultUptimeMetricFreq,
@@ -36,6 +36,10 @@ type IPCConfig struct {
 type APIAuthConfig struct {
 	APIRequireAuthToken bool   `json:"apiRequireAuthToken"`
 	APIAuthPassword     string `json:"-"`
+
+	// APIStakingAuthMethods are the API methods that must be called with a
+	// request signed by the node's staking key.
+	APIStakingAuthMethods []string `json:"apiStakingAuthMethods"`
 }
 
 type APIIndexerConfig struct {
@@ -71,6 +75,9 @@ type APIConfig struct {
 	KeystoreAPIEnabled bool `json:"keystoreAPIEnabled"`
 	MetricsAPIEnabled  bool `json:"metricsAPIEnabled"`
 	HealthAPIEnabled   bool `json:"healthAPIEnabled"`
+
+	// If non-empty, keystore events are sent to this URL
+	KeystoreEventsWebhookURL string `json:"keystoreEventsWebhookURL"`
 }
 
 type IPConfig struct {
@@ -99,6 +106,12 @@ type StakingConfig struct {
 	StakingKeyPath                string          `json:"stakingKeyPath"`
 	StakingCertPath               string          `json:"stakingCertPath"`
 	StakingSignerPath             string          `json:"stakingSignerPath"`
+	// StakingAllowNodeIDChange allows the node to start with a node ID other
+	// than the one recorded in its database.
+	StakingAllowNodeIDChange bool `json:"stakingAllowNodeIDChange"`
+	// StakingEphemeralCertEnabled is true if the node ID was generated for this
+	// run only, in which case it isn't recorded in the database.
+	StakingEphemeralCertEnabled bool `json:"stakingEphemeralCertEnabled"`
 }
 
 type StateSyncConfig struct {
@@ -127,6 +140,10 @@ type BootstrapConfig struct {
 	// ancestors while responding to a GetAncestors message
 	BootstrapMaxTimeGetAncestors time.Duration `json:"bootstrapMaxTimeGetAncestors"`
 
+	// Max age of a beacon's accepted frontier for it to be reused when
+	// bootstrapping is retried
+	BootstrapFrontierCacheTTL time.Duration `json:"bootstrapFrontierCacheTTL"`
+
 	Bootstrappers []genesis.Bootstrapper `json:"bootstrappers"`
 }
 
@@ -189,8 +206,14 @@ type Config struct {
 	// ConsensusAppConcurrency defines the maximum number of goroutines to
 	// handle App messages per chain.
 	ConsensusAppConcurrency int `json:"consensusAppConcurrency"`
+	// ConsensusChitsHeightsEnabled includes block heights in the chits sent
+	// by this node.
+	ConsensusChitsHeightsEnabled bool `json:"consensusChitsHeightsEnabled"`
 
 	TrackedSubnets set.Set[ids.ID] `json:"trackedSubnets"`
+	// TrackValidatedSubnets adds the subnets this node validates to
+	// TrackedSubnets when the O-chain is initialized.
+	TrackValidatedSubnets bool `json:"trackValidatedSubnets"`
 
 	SubnetConfigs map[ids.ID]subnets.Config `json:"subnetConfigs"`
 
@@ -235,6 +258,10 @@ type Config struct {
 	// write arbitrary data.
 	ChainDataDir string `json:"chainDataDir"`
 
+	// ChainSnapshotsEnabled allows the state of chains to be snapshotted and
+	// rolled back through the admin API.
+	ChainSnapshotsEnabled bool `json:"chainSnapshotsEnabled"`
+
 	// Path to write process context to (including PID, API URI, and
 	// staking address).
 	ProcessContextFilePath string `json:"processContextFilePath"`
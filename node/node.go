@@ -36,9 +36,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/chains"
 	"github.com/DioneProtocol/odysseygo/chains/atomic"
 	"github.com/DioneProtocol/odysseygo/database"
-	"github.com/DioneProtocol/odysseygo/database/leveldb"
 	"github.com/DioneProtocol/odysseygo/database/manager"
-	"github.com/DioneProtocol/odysseygo/database/memdb"
 	"github.com/DioneProtocol/odysseygo/database/prefixdb"
 	"github.com/DioneProtocol/odysseygo/genesis"
 	"github.com/DioneProtocol/odysseygo/ids"
@@ -49,6 +47,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/network/dialer"
 	"github.com/DioneProtocol/odysseygo/network/peer"
 	"github.com/DioneProtocol/odysseygo/network/throttling"
+	"github.com/DioneProtocol/odysseygo/pubsub"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
 	"github.com/DioneProtocol/odysseygo/snow/networking/benchlist"
@@ -77,6 +76,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms"
 	"github.com/DioneProtocol/odysseygo/vms/alpha"
 	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
+	"github.com/DioneProtocol/odysseygo/vms/components/txdecoder"
 	"github.com/DioneProtocol/odysseygo/vms/nftfx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
@@ -92,10 +92,12 @@ import (
 
 var (
 	genesisHashKey  = []byte("genesisID")
+	nodeIDKey       = []byte("nodeID")
 	indexerDBPrefix = []byte{0x00}
 
 	errInvalidTLSKey = errors.New("invalid TLS key")
 	errShuttingDown  = errors.New("server shutting down")
+	errNodeIDChanged = errors.New("staking identity changed since the database was last used")
 )
 
 // Node is an instance of an Odyssey node.
@@ -120,6 +122,8 @@ type Node struct {
 
 	// Handles calls to Keystore API
 	keystore keystore.Keystore
+	// Sends keystore events to the configured webhook, if any
+	keystoreWebhook *keystore.Webhook
 
 	// Manages shared memory
 	sharedMemory *atomic.Memory
@@ -136,6 +140,9 @@ type Node struct {
 	// Manages creation of blockchains and routing messages to them
 	chainManager chains.Manager
 
+	// Decodes txs of the chains that are created by the chain manager
+	txDecoders txdecoder.Registry
+
 	// Manages validator benching
 	benchlistManager benchlist.Manager
 
@@ -167,6 +174,9 @@ type Node struct {
 	// current validators of the network
 	vdrs validators.Manager
 
+	// publishes changes to the validator sets in [vdrs]
+	validatorEvents *pubsub.Server
+
 	apiURI string
 
 	// Handles HTTP API calls
@@ -500,23 +510,18 @@ func (n *Node) Dispatch() error {
 
 func (n *Node) initDatabase() error {
 	// start the db manager
-	var (
-		dbManager manager.Manager
-		err       error
-	)
-	switch n.Config.DatabaseConfig.Name {
-	case leveldb.Name:
-		dbManager, err = manager.NewLevelDB(n.Config.DatabaseConfig.Path, n.Config.DatabaseConfig.Config, n.Log, version.CurrentDatabase, "db_internal", n.MetricsRegisterer)
-	case memdb.Name:
-		dbManager = manager.NewMemDB(version.CurrentDatabase)
-	default:
-		err = fmt.Errorf(
-			"db-type was %q but should have been one of {%s, %s}",
-			n.Config.DatabaseConfig.Name,
-			leveldb.Name,
-			memdb.Name,
-		)
+	backend, err := manager.GetBackend(n.Config.DatabaseConfig.Name)
+	if err != nil {
+		return err
 	}
+	dbManager, err := backend.NewManager(manager.BackendConfig{
+		Path:           n.Config.DatabaseConfig.Path,
+		Config:         n.Config.DatabaseConfig.Config,
+		Log:            n.Log,
+		CurrentVersion: version.CurrentDatabase,
+		Namespace:      "db_internal",
+		Registerer:     n.MetricsRegisterer,
+	})
 	if err != nil {
 		return err
 	}
@@ -557,7 +562,48 @@ func (n *Node) initDatabase() error {
 	if genesisHash != expectedGenesisHash {
 		return fmt.Errorf("db contains invalid genesis hash. DB Genesis: %s Generated Genesis: %s", genesisHash, expectedGenesisHash)
 	}
-	return nil
+
+	return n.verifyNodeID()
+}
+
+// verifyNodeID refuses to start the node if its node ID differs from the one
+// recorded in the database, as this is usually the result of a mistake while
+// moving the node to a new machine. If the change is explicitly allowed, a
+// warning is logged and the new node ID is recorded. Ephemeral node IDs are
+// never recorded.
+func (n *Node) verifyNodeID() error {
+	if n.Config.StakingEphemeralCertEnabled {
+		return nil
+	}
+
+	rawNodeID, err := n.DB.Get(nodeIDKey)
+	if err == database.ErrNotFound {
+		return n.DB.Put(nodeIDKey, n.ID.Bytes())
+	}
+	if err != nil {
+		return err
+	}
+
+	previousNodeID, err := ids.ToNodeID(rawNodeID)
+	if err != nil {
+		return err
+	}
+	if previousNodeID == n.ID {
+		return nil
+	}
+	if !n.Config.StakingAllowNodeIDChange {
+		return fmt.Errorf("%w: database was last used by %s but this node is %s",
+			errNodeIDChanged,
+			previousNodeID,
+			n.ID,
+		)
+	}
+
+	n.Log.Warn("staking identity changed since the database was last used",
+		zap.Stringer("previousNodeID", previousNodeID),
+		zap.Stringer("nodeID", n.ID),
+	)
+	return n.DB.Put(nodeIDKey, n.ID.Bytes())
 }
 
 // Set the node IDs of the peers this node should first connect to
@@ -683,28 +729,23 @@ func (n *Node) initAPIServer() error {
 	}
 	n.apiURI = fmt.Sprintf("%s://%s", protocol, listener.Addr())
 
-	if !n.Config.APIRequireAuthToken {
-		var err error
-		n.APIServer, err = server.New(
-			n.Log,
-			n.LogFactory,
-			listener,
-			n.Config.HTTPAllowedOrigins,
-			n.Config.ShutdownTimeout,
-			n.ID,
-			n.Config.TraceConfig.Enabled,
-			n.tracer,
-			"api",
-			n.MetricsRegisterer,
-			n.Config.HTTPConfig.HTTPConfig,
-			n.Config.HTTPAllowedHosts,
-		)
-		return err
+	var (
+		wrappers []server.Wrapper
+		a        auth.Auth
+	)
+	if n.Config.APIRequireAuthToken {
+		a, err = auth.New(n.Log, "auth", n.Config.APIAuthPassword)
+		if err != nil {
+			return err
+		}
+		wrappers = append(wrappers, a)
 	}
-
-	a, err := auth.New(n.Log, "auth", n.Config.APIAuthPassword)
-	if err != nil {
-		return err
+	if len(n.Config.APIStakingAuthMethods) > 0 {
+		n.Log.Info("API staking key authorization is enabled",
+			zap.Strings("methods", n.Config.APIStakingAuthMethods),
+		)
+		stakingCert := staking.CertificateFromX509(n.Config.StakingTLSCert.Leaf)
+		wrappers = append(wrappers, auth.NewStakingAuth(stakingCert, n.Config.APIStakingAuthMethods))
 	}
 
 	n.APIServer, err = server.New(
@@ -720,9 +761,9 @@ func (n *Node) initAPIServer() error {
 		n.MetricsRegisterer,
 		n.Config.HTTPConfig.HTTPConfig,
 		n.Config.HTTPAllowedHosts,
-		a,
+		wrappers...,
 	)
-	if err != nil {
+	if err != nil || !n.Config.APIRequireAuthToken {
 		return err
 	}
 
@@ -808,6 +849,7 @@ func (n *Node) initChainManager(dioneAssetID ids.ID) error {
 		return fmt.Errorf("couldn't initialize chain router: %w", err)
 	}
 
+	n.txDecoders = txdecoder.NewRegistry()
 	n.chainManager = chains.New(&chains.ManagerConfig{
 		SybilProtectionEnabled:                  n.Config.SybilProtectionEnabled,
 		StakingTLSCert:                          n.Config.StakingTLSCert,
@@ -845,9 +887,11 @@ func (n *Node) initChainManager(dioneAssetID ids.ID) error {
 		ChainConfigs:                            n.Config.ChainConfigs,
 		AcceptedFrontierGossipFrequency:         n.Config.AcceptedFrontierGossipFrequency,
 		ConsensusAppConcurrency:                 n.Config.ConsensusAppConcurrency,
+		ConsensusChitsHeightsEnabled:            n.Config.ConsensusChitsHeightsEnabled,
 		BootstrapMaxTimeGetAncestors:            n.Config.BootstrapMaxTimeGetAncestors,
 		BootstrapAncestorsMaxContainersSent:     n.Config.BootstrapAncestorsMaxContainersSent,
 		BootstrapAncestorsMaxContainersReceived: n.Config.BootstrapAncestorsMaxContainersReceived,
+		BootstrapFrontierCacheTTL:               n.Config.BootstrapFrontierCacheTTL,
 		ApricotPhase4Time:                       version.GetApricotPhase4Time(n.Config.NetworkID),
 		ApricotPhase4MinOChainHeight:            version.GetApricotPhase4MinOChainHeight(n.Config.NetworkID),
 		ResourceTracker:                         n.resourceTracker,
@@ -855,6 +899,8 @@ func (n *Node) initChainManager(dioneAssetID ids.ID) error {
 		TracingEnabled:                          n.Config.TraceConfig.Enabled,
 		Tracer:                                  n.tracer,
 		ChainDataDir:                            n.Config.ChainDataDir,
+		ChainSnapshotsEnabled:                   n.Config.ChainSnapshotsEnabled,
+		TxDecoders:                              n.txDecoders,
 	})
 
 	// Notify the API server when new chains are created
@@ -890,11 +936,13 @@ func (n *Node) initVMs() error {
 		vmRegisterer.Register(context.TODO(), constants.OmegaVMID, &omegavm.Factory{
 			Config: omegaconfig.Config{
 				Chains:                        n.chainManager,
+				VMManager:                     n.VMManager,
 				Validators:                    vdrs,
 				UptimeLockedCalculator:        n.uptimeCalculator,
 				SybilProtectionEnabled:        n.Config.SybilProtectionEnabled,
 				PartialSyncPrimaryNetwork:     n.Config.PartialSyncPrimaryNetwork,
 				TrackedSubnets:                n.Config.TrackedSubnets,
+				TrackValidatedSubnets:         n.Config.TrackValidatedSubnets,
 				TxFee:                         n.Config.TxFee,
 				CreateAssetTxFee:              n.Config.CreateAssetTxFee,
 				CreateSubnetTxFee:             n.Config.CreateSubnetTxFee,
@@ -919,6 +967,7 @@ func (n *Node) initVMs() error {
 				ApricotPhase5Time:             version.GetApricotPhase5Time(n.Config.NetworkID),
 				BanffTime:                     version.GetBanffTime(n.Config.NetworkID),
 				CortinaTime:                   version.GetCortinaTime(n.Config.NetworkID),
+				DurangoTime:                   version.GetDurangoTime(n.Config.NetworkID),
 				UseCurrentHeight:              n.Config.UseCurrentHeight,
 			},
 		}),
@@ -985,6 +1034,12 @@ func (n *Node) initKeystoreAPI() error {
 	n.Log.Info("initializing keystore")
 	keystoreDB := n.DBManager.NewPrefixDBManager([]byte("keystore"))
 	n.keystore = keystore.New(n.Log, keystoreDB)
+	if n.Config.KeystoreEventsWebhookURL != "" {
+		n.keystoreWebhook = keystore.NewWebhook(n.Log, n.Config.KeystoreEventsWebhookURL)
+		if err := n.keystore.RegisterEventHandler(n.keystoreWebhook); err != nil {
+			return err
+		}
+	}
 	keystoreHandler, err := n.keystore.CreateHandler()
 	if err != nil {
 		return err
@@ -1041,6 +1096,22 @@ func (n *Node) initMetricsAPI() error {
 	)
 }
 
+// initValidatorEventsAPI exposes the changes to the validator sets of the
+// tracked subnets over a websocket.
+// Assumes n.APIServer and n.validatorEvents are already set
+func (n *Node) initValidatorEventsAPI() error {
+	n.Log.Info("initializing validator events API")
+	return n.APIServer.AddRoute(
+		&common.HTTPHandler{
+			LockOptions: common.NoLock,
+			Handler:     n.validatorEvents,
+		},
+		&sync.RWMutex{},
+		"validators",
+		"/events",
+	)
+}
+
 // initAdminAPI initializes the Admin API service
 // Assumes n.log, n.chainManager, and n.ValidatorAPI already initialized
 func (n *Node) initAdminAPI() error {
@@ -1051,14 +1122,17 @@ func (n *Node) initAdminAPI() error {
 	n.Log.Info("initializing admin API")
 	service, err := admin.NewService(
 		admin.Config{
-			Log:          n.Log,
-			ChainManager: n.chainManager,
-			HTTPServer:   n.APIServer,
-			ProfileDir:   n.Config.ProfilerConfig.Dir,
-			LogFactory:   n.LogFactory,
-			NodeConfig:   n.Config,
-			VMManager:    n.VMManager,
-			VMRegistry:   n.VMRegistry,
+			Log:            n.Log,
+			ChainManager:   n.chainManager,
+			DB:             n.DB,
+			HTTPServer:     n.APIServer,
+			ProfileDir:     n.Config.ProfilerConfig.Dir,
+			LogFactory:     n.LogFactory,
+			NodeConfig:     n.Config,
+			VMManager:      n.VMManager,
+			VMRegistry:     n.VMRegistry,
+			Benchlist:      n.benchlistManager,
+			StakingTLSCert: &n.Config.StakingTLSCert,
 		},
 	)
 	if err != nil {
@@ -1116,6 +1190,7 @@ func (n *Node) initInfoAPI() error {
 			AddSubnetValidatorFee:         n.Config.AddSubnetValidatorFee,
 			AddSubnetDelegatorFee:         n.Config.AddSubnetDelegatorFee,
 			VMManager:                     n.VMManager,
+			TxDecoders:                    n.txDecoders,
 		},
 		n.Log,
 		n.chainManager,
@@ -1304,7 +1379,8 @@ func (n *Node) initAPIAliases(genesisBytes []byte) error {
 
 // Initializes [n.vdrs] and returns the Primary Network validator set.
 func (n *Node) initVdrs() validators.Set {
-	n.vdrs = validators.NewManager()
+	n.validatorEvents = pubsub.New(n.Log)
+	n.vdrs = newValidatorEventsManager(validators.NewManager(), n.validatorEvents)
 	vdrSet := validators.NewSet()
 	_ = n.vdrs.Add(constants.PrimaryNetworkID, vdrSet)
 	return vdrSet
@@ -1440,6 +1516,7 @@ func (n *Node) Initialize(
 		n.MetricsRegisterer,
 		n.networkNamespace,
 		n.Config.NetworkConfig.CompressionType,
+		n.Config.NetworkConfig.CompressionThreshold,
 		n.Config.NetworkConfig.MaximumInboundMessageTimeout,
 	)
 	if err != nil {
@@ -1447,6 +1524,9 @@ func (n *Node) Initialize(
 	}
 
 	primaryNetVdrs := n.initVdrs()
+	if err := n.initValidatorEventsAPI(); err != nil {
+		return fmt.Errorf("couldn't initialize validator events API: %w", err)
+	}
 	if err := n.initResourceManager(n.MetricsRegisterer); err != nil {
 		return fmt.Errorf("problem initializing resource manager: %w", err)
 	}
@@ -1551,6 +1631,9 @@ func (n *Node) shutdown() {
 	if n.chainManager != nil {
 		n.chainManager.Shutdown()
 	}
+	if n.keystoreWebhook != nil {
+		n.keystoreWebhook.Shutdown()
+	}
 	if n.profiler != nil {
 		n.profiler.Shutdown()
 	}
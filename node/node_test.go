@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+)
+
+func TestVerifyNodeID(t *testing.T) {
+	previousNodeID := ids.GenerateTestNodeID()
+	newNodeID := ids.GenerateTestNodeID()
+	tests := []struct {
+		name              string
+		storedNodeID      *ids.NodeID
+		nodeID            ids.NodeID
+		allowNodeIDChange bool
+		ephemeralCert     bool
+		expectedErr       error
+		expectedStoredID  *ids.NodeID
+	}{
+		{
+			name:             "first start",
+			nodeID:           previousNodeID,
+			expectedStoredID: &previousNodeID,
+		},
+		{
+			name:             "same node ID",
+			storedNodeID:     &previousNodeID,
+			nodeID:           previousNodeID,
+			expectedStoredID: &previousNodeID,
+		},
+		{
+			name:             "changed node ID",
+			storedNodeID:     &previousNodeID,
+			nodeID:           newNodeID,
+			expectedErr:      errNodeIDChanged,
+			expectedStoredID: &previousNodeID,
+		},
+		{
+			name:              "allowed node ID change",
+			storedNodeID:      &previousNodeID,
+			nodeID:            newNodeID,
+			allowNodeIDChange: true,
+			expectedStoredID:  &newNodeID,
+		},
+		{
+			name:              "ephemeral node ID",
+			storedNodeID:      &previousNodeID,
+			nodeID:            newNodeID,
+			allowNodeIDChange: true,
+			ephemeralCert:     true,
+			expectedStoredID:  &previousNodeID,
+		},
+		{
+			name:              "ephemeral node ID on first start",
+			nodeID:            newNodeID,
+			allowNodeIDChange: true,
+			ephemeralCert:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			db := memdb.New()
+			if test.storedNodeID != nil {
+				require.NoError(db.Put(nodeIDKey, test.storedNodeID.Bytes()))
+			}
+
+			n := &Node{
+				Log: logging.NoLog{},
+				ID:  test.nodeID,
+				DB:  db,
+				Config: &Config{
+					StakingConfig: StakingConfig{
+						StakingAllowNodeIDChange:    test.allowNodeIDChange,
+						StakingEphemeralCertEnabled: test.ephemeralCert,
+					},
+				},
+			}
+
+			err := n.verifyNodeID()
+			require.ErrorIs(err, test.expectedErr)
+
+			rawNodeID, err := db.Get(nodeIDKey)
+			if test.expectedStoredID == nil {
+				require.ErrorIs(err, database.ErrNotFound)
+				return
+			}
+			require.NoError(err)
+			storedNodeID, err := ids.ToNodeID(rawNodeID)
+			require.NoError(err)
+			require.Equal(*test.expectedStoredID, storedNodeID)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package node
+
+import (
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/pubsub"
+	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+)
+
+const (
+	// ValidatorAdded is published when a node begins validating a subnet.
+	ValidatorAdded = "validatorAdded"
+	// ValidatorRemoved is published when a node stops validating a subnet.
+	ValidatorRemoved = "validatorRemoved"
+	// ValidatorWeightChanged is published when the weight of a current
+	// validator changes, e.g. when a delegator starts or stops delegating.
+	ValidatorWeightChanged = "validatorWeightChanged"
+)
+
+var (
+	_ validators.Manager             = (*validatorEventsManager)(nil)
+	_ validators.SetCallbackListener = (*validatorEventsListener)(nil)
+	_ pubsub.Filterer                = (*validatorEventFilterer)(nil)
+)
+
+// ValidatorEvent is published to the subscribers of a validator's node ID or
+// subnet ID whenever the validator set of a tracked subnet changes.
+type ValidatorEvent struct {
+	Event    string     `json:"event"`
+	SubnetID ids.ID     `json:"subnetID"`
+	NodeID   ids.NodeID `json:"nodeID"`
+	// TxID is only populated for [ValidatorAdded] events.
+	TxID *ids.ID `json:"txID,omitempty"`
+	// Weight is the weight of the validator after the change. For
+	// [ValidatorRemoved] events it is the weight that was removed.
+	Weight json.Uint64 `json:"weight"`
+	// PreviousWeight is only populated for [ValidatorWeightChanged] events.
+	PreviousWeight *json.Uint64 `json:"previousWeight,omitempty"`
+}
+
+type publisher interface {
+	Publish(pubsub.Filterer)
+}
+
+// validatorEventsManager publishes the changes of every validator set that is
+// added to it.
+type validatorEventsManager struct {
+	validators.Manager
+	events publisher
+}
+
+func newValidatorEventsManager(manager validators.Manager, events publisher) validators.Manager {
+	return &validatorEventsManager{
+		Manager: manager,
+		events:  events,
+	}
+}
+
+func (m *validatorEventsManager) Add(subnetID ids.ID, set validators.Set) bool {
+	if !m.Manager.Add(subnetID, set) {
+		return false
+	}
+	set.RegisterCallbackListener(&validatorEventsListener{
+		subnetID: subnetID,
+		events:   m.events,
+	})
+	return true
+}
+
+type validatorEventsListener struct {
+	subnetID ids.ID
+	events   publisher
+}
+
+func (l *validatorEventsListener) OnValidatorAdded(nodeID ids.NodeID, _ *bls.PublicKey, txID ids.ID, weight uint64) {
+	l.publish(ValidatorEvent{
+		Event:    ValidatorAdded,
+		SubnetID: l.subnetID,
+		NodeID:   nodeID,
+		TxID:     &txID,
+		Weight:   json.Uint64(weight),
+	})
+}
+
+func (l *validatorEventsListener) OnValidatorRemoved(nodeID ids.NodeID, weight uint64) {
+	l.publish(ValidatorEvent{
+		Event:    ValidatorRemoved,
+		SubnetID: l.subnetID,
+		NodeID:   nodeID,
+		Weight:   json.Uint64(weight),
+	})
+}
+
+func (l *validatorEventsListener) OnValidatorWeightChanged(nodeID ids.NodeID, oldWeight, newWeight uint64) {
+	previousWeight := json.Uint64(oldWeight)
+	l.publish(ValidatorEvent{
+		Event:          ValidatorWeightChanged,
+		SubnetID:       l.subnetID,
+		NodeID:         nodeID,
+		Weight:         json.Uint64(newWeight),
+		PreviousWeight: &previousWeight,
+	})
+}
+
+func (l *validatorEventsListener) publish(event ValidatorEvent) {
+	l.events.Publish(&validatorEventFilterer{
+		event: event,
+	})
+}
+
+type validatorEventFilterer struct {
+	event ValidatorEvent
+}
+
+func (f *validatorEventFilterer) Filter(filters []pubsub.Filter) ([]bool, interface{}) {
+	subnetID := f.event.SubnetID
+	nodeID := f.event.NodeID.Bytes()
+
+	resp := make([]bool, len(filters))
+	for i, filter := range filters {
+		resp[i] = filter.Check(subnetID[:]) || filter.Check(nodeID)
+	}
+	return resp, f.event
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/pubsub"
+	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+)
+
+type testPublisher struct {
+	published []pubsub.Filterer
+}
+
+func (p *testPublisher) Publish(f pubsub.Filterer) {
+	p.published = append(p.published, f)
+}
+
+func TestValidatorEventsManager(t *testing.T) {
+	require := require.New(t)
+
+	events := &testPublisher{}
+	vdrs := newValidatorEventsManager(validators.NewManager(), events)
+
+	subnetID := ids.GenerateTestID()
+	nodeID := ids.GenerateTestNodeID()
+	txID := ids.GenerateTestID()
+
+	set := validators.NewSet()
+	require.True(vdrs.Add(subnetID, set))
+	require.False(vdrs.Add(subnetID, validators.NewSet()))
+
+	require.NoError(set.Add(nodeID, nil, txID, 2))
+	require.NoError(set.AddWeight(nodeID, 3))
+	require.NoError(set.RemoveWeight(nodeID, 5))
+
+	previousWeight := json.Uint64(2)
+	expectedEvents := []ValidatorEvent{
+		{
+			Event:    ValidatorAdded,
+			SubnetID: subnetID,
+			NodeID:   nodeID,
+			TxID:     &txID,
+			Weight:   2,
+		},
+		{
+			Event:          ValidatorWeightChanged,
+			SubnetID:       subnetID,
+			NodeID:         nodeID,
+			Weight:         5,
+			PreviousWeight: &previousWeight,
+		},
+		{
+			Event:    ValidatorRemoved,
+			SubnetID: subnetID,
+			NodeID:   nodeID,
+			Weight:   5,
+		},
+	}
+	require.Len(events.published, len(expectedEvents))
+	for i, expectedEvent := range expectedEvents {
+		_, event := events.published[i].Filter(nil)
+		require.Equal(expectedEvent, event)
+	}
+}
+
+func TestValidatorEventFilterer(t *testing.T) {
+	require := require.New(t)
+
+	subnetID := ids.GenerateTestID()
+	nodeID := ids.GenerateTestNodeID()
+	filterer := &validatorEventFilterer{
+		event: ValidatorEvent{
+			Event:    ValidatorRemoved,
+			SubnetID: subnetID,
+			NodeID:   nodeID,
+		},
+	}
+
+	subnetFilter := pubsub.NewFilterParam()
+	require.NoError(subnetFilter.Add(subnetID[:]))
+
+	nodeFilter := pubsub.NewFilterParam()
+	require.NoError(nodeFilter.Add(nodeID.Bytes()))
+
+	otherFilter := pubsub.NewFilterParam()
+	otherSubnetID := ids.GenerateTestID()
+	require.NoError(otherFilter.Add(otherSubnetID[:]))
+
+	notify, event := filterer.Filter([]pubsub.Filter{subnetFilter, nodeFilter, otherFilter})
+	require.Equal([]bool{true, true, false}, notify)
+	require.Equal(filterer.event, event)
+}
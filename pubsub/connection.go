@@ -175,6 +175,12 @@ func (c *connection) readMessage() error {
 		c.handleNewSet(cmd.NewSet)
 	case cmd.AddAddresses != nil:
 		err = c.handleAddAddresses(cmd.AddAddresses)
+	case cmd.AddNodeIDs != nil:
+		err = c.handleAddNodeIDs(cmd.AddNodeIDs)
+	case cmd.AddTxIDs != nil:
+		err = c.handleAddTxIDs(cmd.AddTxIDs)
+	case cmd.AddSubnetIDs != nil:
+		err = c.handleAddSubnetIDs(cmd.AddSubnetIDs)
 	default:
 		err = ErrInvalidCommand
 	}
@@ -213,3 +219,39 @@ func (c *connection) handleAddAddresses(cmd *AddAddresses) error {
 	c.s.subscribedConnections.Add(c)
 	return nil
 }
+
+func (c *connection) handleAddNodeIDs(cmd *AddNodeIDs) error {
+	nodeIDs := make([][]byte, len(cmd.NodeIDs))
+	for i, nodeID := range cmd.NodeIDs {
+		nodeIDs[i] = nodeID.Bytes()
+	}
+	if err := c.fp.Add(nodeIDs...); err != nil {
+		return fmt.Errorf("node ID append failed %w", err)
+	}
+	c.s.subscribedConnections.Add(c)
+	return nil
+}
+
+func (c *connection) handleAddTxIDs(cmd *AddTxIDs) error {
+	txIDs := make([][]byte, len(cmd.TxIDs))
+	for i, txID := range cmd.TxIDs {
+		txIDs[i] = txID[:]
+	}
+	if err := c.fp.Add(txIDs...); err != nil {
+		return fmt.Errorf("tx ID append failed %w", err)
+	}
+	c.s.subscribedConnections.Add(c)
+	return nil
+}
+
+func (c *connection) handleAddSubnetIDs(cmd *AddSubnetIDs) error {
+	subnetIDs := make([][]byte, len(cmd.SubnetIDs))
+	for i, subnetID := range cmd.SubnetIDs {
+		subnetIDs[i] = subnetID[:]
+	}
+	if err := c.fp.Add(subnetIDs...); err != nil {
+		return fmt.Errorf("subnet ID append failed %w", err)
+	}
+	c.s.subscribedConnections.Add(c)
+	return nil
+}
@@ -5,6 +5,7 @@ package pubsub
 
 import (
 	"github.com/DioneProtocol/odysseygo/api"
+	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
 	"github.com/DioneProtocol/odysseygo/utils/json"
 )
@@ -34,6 +35,27 @@ type AddAddresses struct {
 	addressIds [][]byte
 }
 
+// AddNodeIDs command to add node IDs
+//
+// Deprecated: The pubsub server is deprecated.
+type AddNodeIDs struct {
+	NodeIDs []ids.NodeID `json:"nodeIDs"`
+}
+
+// AddTxIDs command to add tx IDs
+//
+// Deprecated: The pubsub server is deprecated.
+type AddTxIDs struct {
+	TxIDs []ids.ID `json:"txIDs"`
+}
+
+// AddSubnetIDs command to add subnet IDs
+//
+// Deprecated: The pubsub server is deprecated.
+type AddSubnetIDs struct {
+	SubnetIDs []ids.ID `json:"subnetIDs"`
+}
+
 // Command execution command
 //
 // Deprecated: The pubsub server is deprecated.
@@ -41,6 +63,9 @@ type Command struct {
 	NewBloom     *NewBloom     `json:"newBloom,omitempty"`
 	NewSet       *NewSet       `json:"newSet,omitempty"`
 	AddAddresses *AddAddresses `json:"addAddresses,omitempty"`
+	AddNodeIDs   *AddNodeIDs   `json:"addNodeIDs,omitempty"`
+	AddTxIDs     *AddTxIDs     `json:"addTxIDs,omitempty"`
+	AddSubnetIDs *AddSubnetIDs `json:"addSubnetIDs,omitempty"`
 }
 
 func (c *Command) String() string {
@@ -51,6 +76,12 @@ func (c *Command) String() string {
 		return "newSet"
 	case c.AddAddresses != nil:
 		return "addAddresses"
+	case c.AddNodeIDs != nil:
+		return "addNodeIDs"
+	case c.AddTxIDs != nil:
+		return "addTxIDs"
+	case c.AddSubnetIDs != nil:
+		return "addSubnetIDs"
 	default:
 		return "unknown"
 	}
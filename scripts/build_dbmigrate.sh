@@ -0,0 +1,21 @@
+#!/usr/bin/env bash
+
+set -euo pipefail
+
+# Odysseygo root folder
+ODYSSEY_PATH=$( cd "$( dirname "${BASH_SOURCE[0]}" )"; cd .. && pwd )
+# Load the constants
+source "$ODYSSEY_PATH"/scripts/constants.sh
+
+# Set ROCKSDBALLOWED to build with RocksDB support, which requires the
+# librocksdb system library.
+build_args=""
+if [[ -n "${ROCKSDBALLOWED:-}" ]]; then
+  build_args="-tags rocksdballowed"
+fi
+
+echo "Building dbmigrate..."
+go build $build_args -ldflags\
+   "-X github.com/DioneProtocol/odysseygo/version.GitCommit=$git_commit $static_ld_flags"\
+   -o "$ODYSSEY_PATH/build/dbmigrate"\
+   "$ODYSSEY_PATH/database/manager/cmd/"*.go
@@ -56,5 +56,10 @@ ODYSSEY_PATH=$( cd "$( dirname "${BASH_SOURCE[0]}" )"; cd .. && pwd )
 source "$ODYSSEY_PATH"/scripts/constants.sh
 
 build_args="$race"
+# Set ROCKSDBALLOWED to build with RocksDB support, which requires the
+# librocksdb system library.
+if [[ -n "${ROCKSDBALLOWED:-}" ]]; then
+  build_args="$build_args -tags rocksdballowed"
+fi
 echo "Building OdysseyGo..."
 go build $build_args -ldflags "-X github.com/DioneProtocol/odysseygo/version.GitCommit=$git_commit $static_ld_flags" -o "$odysseygo_path" "$ODYSSEY_PATH/main/"*.go
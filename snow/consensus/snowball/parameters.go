@@ -44,6 +44,7 @@ var (
 		OptimalProcessing:     10,
 		MaxOutstandingItems:   256,
 		MaxItemProcessingTime: 30 * time.Second,
+		MaxProcessingDepth:    128,
 	}
 
 	ErrParametersInvalid = errors.New("parameters invalid")
@@ -64,6 +65,16 @@ type Parameters struct {
 	// Reports unhealthy if there is an item processing for longer than this
 	// duration.
 	MaxItemProcessingTime time.Duration `json:"maxItemProcessingTime" yaml:"maxItemProcessingTime"`
+
+	// Reports unhealthy, and stops issuing locally built blocks, if a
+	// processing block is more than this number of blocks past the last
+	// accepted block.
+	MaxProcessingDepth int `json:"maxProcessingDepth" yaml:"maxProcessingDepth"`
+
+	// If non-zero, the snowman engine may sample as few as this number of
+	// validators per poll, with Alpha scaled down proportionally, while many
+	// of its queries are failing.
+	MinK int `json:"minK" yaml:"minK"`
 }
 
 // Verify returns nil if the parameters describe a valid initialization.
@@ -89,6 +100,12 @@ func (p Parameters) Verify() error {
 		return fmt.Errorf("%w: maxOutstandingItems = %d: fails the condition that: 0 < maxOutstandingItems", ErrParametersInvalid, p.MaxOutstandingItems)
 	case p.MaxItemProcessingTime <= 0:
 		return fmt.Errorf("%w: maxItemProcessingTime = %d: fails the condition that: 0 < maxItemProcessingTime", ErrParametersInvalid, p.MaxItemProcessingTime)
+	case p.MaxProcessingDepth <= 0:
+		return fmt.Errorf("%w: maxProcessingDepth = %d: fails the condition that: 0 < maxProcessingDepth", ErrParametersInvalid, p.MaxProcessingDepth)
+	case p.MinK < 0:
+		return fmt.Errorf("%w: minK = %d: fails the condition that: 0 <= minK", ErrParametersInvalid, p.MinK)
+	case p.MinK > p.K:
+		return fmt.Errorf("%w: k = %d, minK = %d: fails the condition that: minK <= k", ErrParametersInvalid, p.K, p.MinK)
 	default:
 		return nil
 	}
@@ -20,6 +20,7 @@ func TestParametersVerify(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	require.NoError(t, p.Verify())
@@ -35,6 +36,7 @@ func TestParametersAnotherVerify(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	require.NoError(t, p.Verify())
@@ -50,6 +52,7 @@ func TestParametersYetAnotherVerify(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	require.NoError(t, p.Verify())
@@ -65,6 +68,7 @@ func TestParametersInvalidK(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	err := p.Verify()
@@ -81,6 +85,7 @@ func TestParametersInvalidAlpha(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	err := p.Verify()
@@ -97,6 +102,7 @@ func TestParametersInvalidBetaVirtuous(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	err := p.Verify()
@@ -113,6 +119,7 @@ func TestParametersInvalidBetaRogue(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	err := p.Verify()
@@ -129,6 +136,7 @@ func TestParametersAnotherInvalidBetaRogue(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	err := p.Verify()
@@ -146,6 +154,7 @@ func TestParametersInvalidConcurrentRepolls(t *testing.T) {
 			OptimalProcessing:     1,
 			MaxOutstandingItems:   1,
 			MaxItemProcessingTime: 1,
+			MaxProcessingDepth:    1,
 		},
 		{
 			K:                     1,
@@ -156,6 +165,7 @@ func TestParametersInvalidConcurrentRepolls(t *testing.T) {
 			OptimalProcessing:     1,
 			MaxOutstandingItems:   1,
 			MaxItemProcessingTime: 1,
+			MaxProcessingDepth:    1,
 		},
 	}
 	for _, p := range tests {
@@ -177,6 +187,7 @@ func TestParametersInvalidOptimalProcessing(t *testing.T) {
 		OptimalProcessing:     0,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	err := p.Verify()
@@ -193,6 +204,7 @@ func TestParametersInvalidMaxOutstandingItems(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   0,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    0,
 	}
 
 	err := p.Verify()
@@ -209,6 +221,42 @@ func TestParametersInvalidMaxItemProcessingTime(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 0,
+		MaxProcessingDepth:    1,
+	}
+
+	err := p.Verify()
+	require.ErrorIs(t, err, ErrParametersInvalid)
+}
+
+func TestParametersInvalidMaxProcessingDepth(t *testing.T) {
+	p := Parameters{
+		K:                     1,
+		Alpha:                 1,
+		BetaVirtuous:          1,
+		BetaRogue:             1,
+		ConcurrentRepolls:     1,
+		OptimalProcessing:     1,
+		MaxOutstandingItems:   1,
+		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    0,
+	}
+
+	err := p.Verify()
+	require.ErrorIs(t, err, ErrParametersInvalid)
+}
+
+func TestParametersInvalidMinK(t *testing.T) {
+	p := Parameters{
+		K:                     1,
+		Alpha:                 1,
+		BetaVirtuous:          1,
+		BetaRogue:             1,
+		ConcurrentRepolls:     1,
+		OptimalProcessing:     1,
+		MaxOutstandingItems:   1,
+		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
+		MinK:                  2,
 	}
 
 	err := p.Verify()
@@ -31,6 +31,10 @@ type Consensus interface {
 	// Returns the number of blocks processing
 	NumProcessing() int
 
+	// ProcessingDepth returns the number of blocks between the last accepted
+	// block and the highest processing block.
+	ProcessingDepth() uint64
+
 	// Adds a new decision. Assumes the dependency has already been added.
 	// Returns if a critical error has occurred.
 	Add(context.Context, Block) error
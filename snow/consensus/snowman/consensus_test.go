@@ -39,6 +39,7 @@ var (
 	testFuncs = []testFunc{
 		InitializeTest,
 		NumProcessingTest,
+		ProcessingDepthTest,
 		AddToTailTest,
 		AddToNonTailTest,
 		AddToUnknownTest,
@@ -101,6 +102,7 @@ func InitializeTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
@@ -125,6 +127,7 @@ func NumProcessingTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -150,6 +153,63 @@ func NumProcessingTest(t *testing.T, factory Factory) {
 	require.Zero(sm.NumProcessing())
 }
 
+// Make sure that the processing depth is tracked and reported in the health
+// check
+func ProcessingDepthTest(t *testing.T, factory Factory) {
+	require := require.New(t)
+
+	sm := factory.New()
+
+	ctx := snow.DefaultConsensusContextTest()
+	params := snowball.Parameters{
+		K:                     1,
+		Alpha:                 1,
+		BetaVirtuous:          1,
+		BetaRogue:             1,
+		ConcurrentRepolls:     1,
+		OptimalProcessing:     1,
+		MaxOutstandingItems:   2,
+		MaxItemProcessingTime: time.Hour,
+		MaxProcessingDepth:    1,
+	}
+	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
+
+	block0 := &TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.Empty.Prefix(1),
+			StatusV: choices.Processing,
+		},
+		ParentV: Genesis.IDV,
+		HeightV: Genesis.HeightV + 1,
+	}
+	block1 := &TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.Empty.Prefix(2),
+			StatusV: choices.Processing,
+		},
+		ParentV: block0.IDV,
+		HeightV: block0.HeightV + 1,
+	}
+
+	require.Zero(sm.ProcessingDepth())
+
+	require.NoError(sm.Add(context.Background(), block0))
+	require.Equal(uint64(1), sm.ProcessingDepth())
+	_, err := sm.HealthCheck(context.Background())
+	require.NoError(err)
+
+	require.NoError(sm.Add(context.Background(), block1))
+	require.Equal(uint64(2), sm.ProcessingDepth())
+	_, err = sm.HealthCheck(context.Background())
+	require.ErrorIs(err, errUnhealthy)
+
+	votes := bag.Of(block0.ID())
+	require.NoError(sm.RecordPoll(context.Background(), votes))
+	require.Equal(uint64(1), sm.ProcessingDepth())
+	_, err = sm.HealthCheck(context.Background())
+	require.NoError(err)
+}
+
 // Make sure that adding a block to the tail updates the preference
 func AddToTailTest(t *testing.T, factory Factory) {
 	require := require.New(t)
@@ -166,6 +226,7 @@ func AddToTailTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -200,6 +261,7 @@ func AddToNonTailTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -247,6 +309,7 @@ func AddToUnknownTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -286,6 +349,7 @@ func StatusOrProcessingPreviouslyAcceptedTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -310,6 +374,7 @@ func StatusOrProcessingPreviouslyRejectedTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -343,6 +408,7 @@ func StatusOrProcessingUnissuedTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -376,6 +442,7 @@ func StatusOrProcessingIssuedTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -410,6 +477,7 @@ func RecordPollAcceptSingleBlockTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -451,6 +519,7 @@ func RecordPollAcceptAndRejectTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -506,6 +575,7 @@ func RecordPollSplitVoteNoChangeTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -565,6 +635,7 @@ func RecordPollWhenFinalizedTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -589,6 +660,7 @@ func RecordPollRejectTransitivelyTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -658,6 +730,7 @@ func RecordPollTransitivelyResetConfidenceTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -749,6 +822,7 @@ func RecordPollInvalidVoteTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -789,6 +863,7 @@ func RecordPollTransitiveVotingTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -898,6 +973,7 @@ func RecordPollDivergedVotingTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -1000,6 +1076,7 @@ func RecordPollDivergedVotingWithNoConflictingBitTest(t *testing.T, factory Fact
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -1103,6 +1180,7 @@ func RecordPollChangePreferredChainTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -1186,6 +1264,7 @@ func MetricsProcessingErrorTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	numProcessing := prometheus.NewGauge(
@@ -1214,6 +1293,7 @@ func MetricsAcceptedErrorTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	numAccepted := prometheus.NewGauge(
@@ -1242,6 +1322,7 @@ func MetricsRejectedErrorTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	numRejected := prometheus.NewGauge(
@@ -1270,6 +1351,7 @@ func ErrorOnInitialRejectionTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
@@ -1308,6 +1390,7 @@ func ErrorOnAcceptTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
@@ -1344,6 +1427,7 @@ func ErrorOnRejectSiblingTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
@@ -1389,6 +1473,7 @@ func ErrorOnTransitiveRejectionTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
@@ -1442,6 +1527,7 @@ func RandomizedConsistencyTest(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	seed := int64(0)
 
@@ -1475,6 +1561,7 @@ func ErrorOnAddDecidedBlock(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
@@ -1504,6 +1591,7 @@ func ErrorOnAddDuplicateBlockID(t *testing.T, factory Factory) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
 
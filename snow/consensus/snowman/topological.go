@@ -25,6 +25,7 @@ import (
 
 var (
 	errDuplicateAdd = errors.New("duplicate block add")
+	errUnhealthy    = errors.New("snowman consensus is not healthy")
 
 	_ Factory   = (*TopologicalFactory)(nil)
 	_ Consensus = (*Topological)(nil)
@@ -62,6 +63,13 @@ type Topological struct {
 	// height is the height of the last accepted block
 	height uint64
 
+	// lastAcceptedTime is the timestamp of the last accepted block
+	lastAcceptedTime time.Time
+
+	// consecutiveFailedPolls is the number of polls that failed since the
+	// last successful poll
+	consecutiveFailedPolls uint64
+
 	// blocks stores the last accepted block and all the pending blocks
 	blocks map[ids.ID]*snowmanBlock // blockID -> snowmanBlock
 
@@ -136,6 +144,7 @@ func (ts *Topological) Initialize(ctx *snow.ConsensusContext, params snowball.Pa
 	ts.params = params
 	ts.head = rootID
 	ts.height = rootHeight
+	ts.lastAcceptedTime = rootTime
 	ts.blocks = map[ids.ID]*snowmanBlock{
 		rootID: {params: ts.params},
 	}
@@ -152,6 +161,20 @@ func (ts *Topological) NumProcessing() int {
 	return len(ts.blocks) - 1
 }
 
+func (ts *Topological) ProcessingDepth() uint64 {
+	maxHeight := ts.height
+	for blkID, block := range ts.blocks {
+		// The last accepted block is in the blocks map, but isn't processing.
+		if blkID == ts.head {
+			continue
+		}
+		if height := block.blk.Height(); height > maxHeight {
+			maxHeight = height
+		}
+	}
+	return maxHeight - ts.height
+}
+
 func (ts *Topological) Add(ctx context.Context, blk Block) error {
 	blkID := blk.ID()
 
@@ -328,6 +351,18 @@ func (ts *Topological) HealthCheck(context.Context) (interface{}, error) {
 	healthy = healthy && isProcessingTime
 	details["longestRunningBlock"] = timeReqRunning.String()
 
+	// check for processing blocks that are too far past the last accepted block
+	processingDepth := ts.ProcessingDepth()
+	isProcessingDepth := processingDepth <= uint64(ts.params.MaxProcessingDepth)
+	healthy = healthy && isProcessingDepth
+	details["processingDepth"] = processingDepth
+
+	// report the progress of the chain, which doesn't impact the health as
+	// blocks are only built when there is activity
+	details["lastAcceptedHeight"] = ts.height
+	details["lastAcceptedBlockAge"] = time.Since(ts.lastAcceptedTime).String()
+	details["consecutiveFailedPolls"] = ts.consecutiveFailedPolls
+
 	if !healthy {
 		var errorReasons []string
 		if !isOutstandingBlks {
@@ -336,7 +371,10 @@ func (ts *Topological) HealthCheck(context.Context) (interface{}, error) {
 		if !isProcessingTime {
 			errorReasons = append(errorReasons, fmt.Sprintf("block processing time %s > %s", timeReqRunning, ts.params.MaxItemProcessingTime))
 		}
-		return details, fmt.Errorf("snowman consensus is not healthy reason: %s", strings.Join(errorReasons, ", "))
+		if !isProcessingDepth {
+			errorReasons = append(errorReasons, fmt.Sprintf("block processing depth %d > %d", processingDepth, ts.params.MaxProcessingDepth))
+		}
+		return details, fmt.Errorf("%w reason: %s", errUnhealthy, strings.Join(errorReasons, ", "))
 	}
 	return details, nil
 }
@@ -466,6 +504,7 @@ func (ts *Topological) vote(ctx context.Context, voteStack []votes) (ids.ID, err
 				zap.Int("numProcessing", numProcessing),
 			)
 			ts.Polls.Failed()
+			ts.consecutiveFailedPolls++
 		}
 		return ts.tail, nil
 	}
@@ -571,8 +610,10 @@ func (ts *Topological) vote(ctx context.Context, voteStack []votes) (ids.ID, err
 
 	if pollSuccessful {
 		ts.Polls.Successful()
+		ts.consecutiveFailedPolls = 0
 	} else {
 		ts.Polls.Failed()
+		ts.consecutiveFailedPolls++
 	}
 	return newPreferred, nil
 }
@@ -607,6 +648,7 @@ func (ts *Topological) acceptPreferredChild(ctx context.Context, n *snowmanBlock
 	// Because this is the newest accepted block, this is the new head.
 	ts.head = pref
 	ts.height = child.Height()
+	ts.lastAcceptedTime = child.Timestamp()
 	// Remove the decided block from the set of processing IDs, as its status
 	// now implies its preferredness.
 	ts.preferredIDs.Remove(pref)
@@ -3,8 +3,71 @@
 
 package snowman
 
-import "testing"
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/snow/choices"
+	"github.com/DioneProtocol/odysseygo/snow/consensus/snowball"
+	"github.com/DioneProtocol/odysseygo/utils/bag"
+)
 
 func TestTopological(t *testing.T) {
 	runConsensusTests(t, TopologicalFactory{})
 }
+
+// Make sure that the progress of the chain is reported in the health check
+func TestTopologicalHealthCheckProgress(t *testing.T) {
+	require := require.New(t)
+
+	sm := &Topological{}
+
+	ctx := snow.DefaultConsensusContextTest()
+	params := snowball.Parameters{
+		K:                     1,
+		Alpha:                 1,
+		BetaVirtuous:          1,
+		BetaRogue:             1,
+		ConcurrentRepolls:     1,
+		OptimalProcessing:     1,
+		MaxOutstandingItems:   1,
+		MaxItemProcessingTime: time.Hour,
+		MaxProcessingDepth:    1,
+	}
+	require.NoError(sm.Initialize(ctx, params, GenesisID, GenesisHeight, GenesisTimestamp))
+
+	block := &TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.Empty.Prefix(1),
+			StatusV: choices.Processing,
+		},
+		ParentV:    Genesis.IDV,
+		HeightV:    Genesis.HeightV + 1,
+		TimestampV: GenesisTimestamp.Add(time.Second),
+	}
+	require.NoError(sm.Add(context.Background(), block))
+
+	// A poll without any votes doesn't make progress
+	require.NoError(sm.RecordPoll(context.Background(), bag.Bag[ids.ID]{}))
+
+	detailsIntf, err := sm.HealthCheck(context.Background())
+	require.NoError(err)
+	details := detailsIntf.(map[string]interface{})
+	require.Equal(GenesisHeight, details["lastAcceptedHeight"])
+	require.Equal(uint64(1), details["consecutiveFailedPolls"])
+
+	require.NoError(sm.RecordPoll(context.Background(), bag.Of(block.ID())))
+	require.Equal(choices.Accepted, block.Status())
+
+	detailsIntf, err = sm.HealthCheck(context.Background())
+	require.NoError(err)
+	details = detailsIntf.(map[string]interface{})
+	require.Equal(block.Height(), details["lastAcceptedHeight"])
+	require.Equal(uint64(0), details["consecutiveFailedPolls"])
+	require.Contains(details, "lastAcceptedBlockAge")
+}
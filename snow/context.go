@@ -97,6 +97,9 @@ type ConsensusContext struct {
 
 	// True iff this chain is currently state-syncing
 	StateSyncing utils.Atomic[bool]
+
+	// Progress of the most recent bootstrapping of this chain.
+	BootstrapProgress utils.Atomic[BootstrapProgress]
 }
 
 func DefaultContextTest() *Context {
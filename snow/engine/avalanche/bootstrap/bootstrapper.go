@@ -58,7 +58,11 @@ func New(
 	}
 
 	config.Config.Bootstrapable = b
-	b.Bootstrapper = common.NewCommonBootstrapper(config.Config)
+	var err error
+	b.Bootstrapper, err = common.NewCommonBootstrapper(config.Config, "bs", config.Ctx.OdysseyRegisterer)
+	if err != nil {
+		return nil, err
+	}
 	return b, nil
 }
 
@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/utils/sampler"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/utils/wrappers"
+)
+
+const (
+	// Each consecutive failure of a beacon halves its sampling weight, up to
+	// [maxBeaconFailurePenalty] halvings.
+	maxBeaconFailurePenalty = 16
+
+	// A beacon whose average response latency is [beaconLatencyScale] has its
+	// sampling weight halved.
+	beaconLatencyScale = time.Second
+
+	beaconLatencyHalflife = time.Minute
+
+	beaconLabel = "beacon"
+)
+
+type beaconStats struct {
+	// Number of accepted frontier requests the beacon failed to respond to
+	// since its last response
+	consecutiveFailures int
+	// Time the outstanding accepted frontier request was sent to the beacon
+	sent    time.Time
+	latency math.Averager
+}
+
+// beaconTracker tracks the accepted frontier responses of the bootstrap
+// beacons. Beacons are sampled weighted by their stake and by how reliably
+// and quickly they responded to previous requests. Beacons that failed to
+// respond are heavily de-prioritized, so that retried bootstrap attempts
+// rotate to other beacons rather than stalling on the same unresponsive ones.
+type beaconTracker struct {
+	beacons validators.Set
+	stats   map[ids.NodeID]*beaconStats
+
+	successes *prometheus.CounterVec
+	failures  *prometheus.CounterVec
+	latency   *prometheus.GaugeVec
+}
+
+func newBeaconTracker(
+	beacons validators.Set,
+	namespace string,
+	registerer prometheus.Registerer,
+) (*beaconTracker, error) {
+	t := &beaconTracker{
+		beacons: beacons,
+		stats:   make(map[ids.NodeID]*beaconStats),
+		successes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "beacon_frontier_successes",
+			Help:      "Number of accepted frontier requests the beacon responded to",
+		}, []string{beaconLabel}),
+		failures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "beacon_frontier_failures",
+			Help:      "Number of accepted frontier requests the beacon failed to respond to",
+		}, []string{beaconLabel}),
+		latency: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "beacon_frontier_latency",
+			Help:      "Average time (in ns) the beacon took to respond to accepted frontier requests",
+		}, []string{beaconLabel}),
+	}
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		registerer.Register(t.successes),
+		registerer.Register(t.failures),
+		registerer.Register(t.latency),
+	)
+	return t, errs.Err
+}
+
+// Sample returns [size] beacons, potentially with duplicates.
+func (t *beaconTracker) Sample(size int) ([]ids.NodeID, error) {
+	if size == 0 {
+		return nil, nil
+	}
+
+	beaconIDs := maps.Keys(t.beacons.Map())
+	utils.Sort(beaconIDs)
+
+	var (
+		weights     = make([]uint64, len(beaconIDs))
+		totalWeight uint64
+	)
+	for i, nodeID := range beaconIDs {
+		weights[i] = t.weight(nodeID)
+		totalWeight += weights[i]
+	}
+
+	// Reducing the weights of the beacons may make it impossible to sample
+	// [size] beacons, in which case the beacons are sampled by stake only.
+	if totalWeight < uint64(size) {
+		return t.beacons.Sample(size)
+	}
+
+	s := sampler.NewWeightedWithoutReplacement()
+	if err := s.Initialize(weights); err != nil {
+		return nil, err
+	}
+	indices, err := s.Sample(size)
+	if err != nil {
+		return nil, err
+	}
+
+	sampled := make([]ids.NodeID, size)
+	for i, index := range indices {
+		sampled[i] = beaconIDs[index]
+	}
+	return sampled, nil
+}
+
+// weight returns the sampling weight of the beacon [nodeID]. The weight is
+// always at least 1.
+func (t *beaconTracker) weight(nodeID ids.NodeID) uint64 {
+	weight := float64(t.beacons.GetWeight(nodeID))
+	if stats, ok := t.stats[nodeID]; ok {
+		weight /= float64(uint64(1) << math.Min(stats.consecutiveFailures, maxBeaconFailurePenalty))
+		if stats.latency != nil {
+			weight *= float64(beaconLatencyScale) / (float64(beaconLatencyScale) + stats.latency.Read())
+		}
+	}
+	if weight < 1 {
+		return 1
+	}
+	return uint64(weight)
+}
+
+// Sent marks that an accepted frontier request was sent to [nodeIDs].
+func (t *beaconTracker) Sent(nodeIDs set.Set[ids.NodeID]) {
+	now := time.Now()
+	for nodeID := range nodeIDs {
+		t.getStats(nodeID).sent = now
+	}
+}
+
+// Succeeded marks that [nodeID] responded to its accepted frontier request.
+func (t *beaconTracker) Succeeded(nodeID ids.NodeID) {
+	now := time.Now()
+	stats := t.getStats(nodeID)
+	stats.consecutiveFailures = 0
+	if stats.latency == nil {
+		stats.latency = math.NewUninitializedAverager(beaconLatencyHalflife)
+	}
+	stats.latency.Observe(float64(now.Sub(stats.sent)), now)
+
+	label := nodeID.String()
+	t.successes.WithLabelValues(label).Inc()
+	t.latency.WithLabelValues(label).Set(stats.latency.Read())
+}
+
+// Failed marks that [nodeID] failed to respond to its accepted frontier
+// request.
+func (t *beaconTracker) Failed(nodeID ids.NodeID) {
+	t.getStats(nodeID).consecutiveFailures++
+	t.failures.WithLabelValues(nodeID.String()).Inc()
+}
+
+func (t *beaconTracker) getStats(nodeID ids.NodeID) *beaconStats {
+	stats, ok := t.stats[nodeID]
+	if !ok {
+		stats = &beaconStats{}
+		t.stats[nodeID] = stats
+	}
+	return stats
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+)
+
+func TestBeaconTrackerWeight(t *testing.T) {
+	require := require.New(t)
+
+	beacons := validators.NewSet()
+	nodeID := ids.GenerateTestNodeID()
+	require.NoError(beacons.Add(nodeID, nil, ids.Empty, 1024))
+
+	tracker, err := newBeaconTracker(beacons, "", prometheus.NewRegistry())
+	require.NoError(err)
+	require.Equal(uint64(1024), tracker.weight(nodeID))
+
+	tracker.Sent(set.Of(nodeID))
+	tracker.Failed(nodeID)
+	require.Equal(uint64(512), tracker.weight(nodeID))
+
+	tracker.Sent(set.Of(nodeID))
+	tracker.Failed(nodeID)
+	require.Equal(uint64(256), tracker.weight(nodeID))
+
+	// A response resets the failure penalty. The response latency is
+	// negligible compared to [beaconLatencyScale].
+	tracker.Sent(set.Of(nodeID))
+	tracker.Succeeded(nodeID)
+	require.InDelta(1024, tracker.weight(nodeID), 1)
+
+	// The weight never drops below 1
+	for i := 0; i < 2*maxBeaconFailurePenalty; i++ {
+		tracker.Failed(nodeID)
+	}
+	require.Equal(uint64(1), tracker.weight(nodeID))
+}
+
+func TestBeaconTrackerSampleRotatesFailedBeacons(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		beacons = validators.NewSet()
+		failed  = ids.GenerateTestNodeID()
+		healthy = ids.GenerateTestNodeID()
+	)
+	require.NoError(beacons.Add(failed, nil, ids.Empty, 1<<20))
+	require.NoError(beacons.Add(healthy, nil, ids.Empty, 1<<20))
+
+	tracker, err := newBeaconTracker(beacons, "", prometheus.NewRegistry())
+	require.NoError(err)
+
+	for i := 0; i < maxBeaconFailurePenalty; i++ {
+		tracker.Failed(failed)
+	}
+
+	// The failed beacon has a 1 in 2^16 chance of being sampled
+	sampled, err := tracker.Sample(1)
+	require.NoError(err)
+	require.Equal([]ids.NodeID{healthy}, sampled)
+}
+
+func TestBeaconTrackerSampleFallsBackToStake(t *testing.T) {
+	require := require.New(t)
+
+	beacons := validators.NewSet()
+	nodeID := ids.GenerateTestNodeID()
+	require.NoError(beacons.Add(nodeID, nil, ids.Empty, 2))
+
+	tracker, err := newBeaconTracker(beacons, "", prometheus.NewRegistry())
+	require.NoError(err)
+	tracker.Failed(nodeID)
+
+	// The reduced weight is too low to sample 2 beacons
+	sampled, err := tracker.Sample(2)
+	require.NoError(err)
+	require.Equal([]ids.NodeID{nodeID, nodeID}, sampled)
+}
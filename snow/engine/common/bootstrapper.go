@@ -8,6 +8,8 @@ import (
 
 	stdmath "math"
 
+	"github.com/prometheus/client_golang/prometheus"
+
 	"go.uber.org/zap"
 
 	"github.com/DioneProtocol/odysseygo/ids"
@@ -45,6 +47,11 @@ type bootstrapper struct {
 	Config
 	Halter
 
+	// Samples the beacons to request the accepted frontier from
+	beaconTracker *beaconTracker
+	// Recently reported accepted frontiers, which are reused when bootstrapping
+	// is retried
+	frontierCache *frontierCache
 	// Holds the beacons that were sampled for the accepted frontier
 	sampledBeacons validators.Set
 	// IDs of validators we should request an accepted frontier from
@@ -74,10 +81,21 @@ type bootstrapper struct {
 	bootstrapAttempts int
 }
 
-func NewCommonBootstrapper(config Config) Bootstrapper {
-	return &bootstrapper{
-		Config: config,
+func NewCommonBootstrapper(
+	config Config,
+	namespace string,
+	registerer prometheus.Registerer,
+) (Bootstrapper, error) {
+	beaconTracker, err := newBeaconTracker(config.Beacons, namespace, registerer)
+	if err != nil {
+		return nil, err
 	}
+	frontierCache, err := newFrontierCache(config.FrontierCacheTTL, namespace, registerer)
+	return &bootstrapper{
+		Config:        config,
+		beaconTracker: beaconTracker,
+		frontierCache: frontierCache,
+	}, err
 }
 
 func (b *bootstrapper) AcceptedFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, containerID ids.ID) error {
@@ -98,6 +116,9 @@ func (b *bootstrapper) AcceptedFrontier(ctx context.Context, nodeID ids.NodeID,
 		return nil
 	}
 
+	b.beaconTracker.Succeeded(nodeID)
+	b.frontierCache.Put(nodeID, containerID)
+
 	// Union the reported accepted frontier from [nodeID] with the accepted
 	// frontier we got from others
 	b.acceptedFrontierSet.Add(containerID)
@@ -124,6 +145,7 @@ func (b *bootstrapper) GetAcceptedFrontierFailed(ctx context.Context, nodeID ids
 
 	// If we can't get a response from [nodeID], act as though they said their
 	// accepted frontier is empty and we add the validator to the failed list
+	b.beaconTracker.Failed(nodeID)
 	b.failedAcceptedFrontier.Add(nodeID)
 	return b.markAcceptedFrontierReceived(ctx, nodeID)
 }
@@ -131,7 +153,10 @@ func (b *bootstrapper) GetAcceptedFrontierFailed(ctx context.Context, nodeID ids
 func (b *bootstrapper) markAcceptedFrontierReceived(ctx context.Context, nodeID ids.NodeID) error {
 	// Mark that we received a response from [nodeID]
 	b.pendingReceiveAcceptedFrontier.Remove(nodeID)
+	return b.processAcceptedFrontiers(ctx)
+}
 
+func (b *bootstrapper) processAcceptedFrontiers(ctx context.Context) error {
 	b.sendGetAcceptedFrontiers(ctx)
 
 	// still waiting on requests
@@ -282,7 +307,7 @@ func (b *bootstrapper) GetAcceptedFailed(ctx context.Context, nodeID ids.NodeID,
 }
 
 func (b *bootstrapper) Startup(ctx context.Context) error {
-	beaconIDs, err := b.Beacons.Sample(b.Config.SampleK)
+	beaconIDs, err := b.beaconTracker.Sample(b.Config.SampleK)
 	if err != nil {
 		return err
 	}
@@ -324,8 +349,7 @@ func (b *bootstrapper) Startup(ctx context.Context) error {
 	}
 
 	b.Config.SharedCfg.RequestID++
-	b.sendGetAcceptedFrontiers(ctx)
-	return nil
+	return b.processAcceptedFrontiers(ctx)
 }
 
 func (b *bootstrapper) Restart(ctx context.Context, reset bool) error {
@@ -336,6 +360,9 @@ func (b *bootstrapper) Restart(ctx context.Context, reset bool) error {
 
 		b.Config.SharedCfg.Restarted = true
 		b.bootstrapAttempts = 0
+		// The frontiers are being re-checked because they may have moved, so
+		// they must be requested again.
+		b.frontierCache.Clear()
 	}
 
 	if b.bootstrapAttempts > 0 && b.bootstrapAttempts%b.RetryBootstrapWarnFrequency == 0 {
@@ -348,11 +375,16 @@ func (b *bootstrapper) Restart(ctx context.Context, reset bool) error {
 }
 
 // Ask up to [MaxOutstandingBroadcastRequests] bootstrap validators to send
-// their accepted frontier with the current accepted frontier
+// their accepted frontier with the current accepted frontier. Validators whose
+// accepted frontier is cached aren't asked again.
 func (b *bootstrapper) sendGetAcceptedFrontiers(ctx context.Context) {
 	vdrs := set.NewSet[ids.NodeID](1)
 	for b.pendingSendAcceptedFrontier.Len() > 0 && b.pendingReceiveAcceptedFrontier.Len() < MaxOutstandingBroadcastRequests {
 		vdr, _ := b.pendingSendAcceptedFrontier.Pop()
+		if containerID, ok := b.frontierCache.Get(vdr); ok {
+			b.acceptedFrontierSet.Add(containerID)
+			continue
+		}
 		// Add the validator to the set to send the messages to
 		vdrs.Add(vdr)
 		// Add the validator to send pending receipt set
@@ -360,6 +392,7 @@ func (b *bootstrapper) sendGetAcceptedFrontiers(ctx context.Context) {
 	}
 
 	if vdrs.Len() > 0 {
+		b.beaconTracker.Sent(vdrs)
 		b.Sender.SendGetAcceptedFrontier(ctx, vdrs, b.Config.SharedCfg.RequestID)
 	}
 }
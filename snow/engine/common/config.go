@@ -42,6 +42,10 @@ type Config struct {
 	// containers in an ancestors message it receives.
 	AncestorsMaxContainersReceived int
 
+	// Max age of a beacon's accepted frontier for it to be reused when
+	// bootstrapping is retried. If 0, accepted frontiers are always requested.
+	FrontierCacheTTL time.Duration
+
 	SharedCfg *SharedConfig
 }
 
@@ -31,6 +31,14 @@ type Engine interface {
 	GetVM() VM
 }
 
+// OutstandingRequestsReporter is implemented by engines that are able to
+// report the container requests that they are waiting on.
+type OutstandingRequestsReporter interface {
+	// OutstandingRequests returns the requests that haven't been responded
+	// to yet. Must be called with the context lock held.
+	OutstandingRequests() []OutstandingRequest
+}
+
 type Handler interface {
 	AllGetsServer
 	StateSummaryFrontierHandler
@@ -386,12 +394,17 @@ type ChitsHandler interface {
 	// This function can be called by any validator. It is not safe to assume
 	// this message is in response to a PullQuery or a PushQuery message.
 	// However, the validatorID is assumed to be authenticated.
+	//
+	// The heights of the preferred and accepted blocks are reported by the
+	// validator and are 0 if the validator didn't include them.
 	Chits(
 		ctx context.Context,
 		validatorID ids.NodeID,
 		requestID uint32,
 		preferredID ids.ID,
 		acceptedID ids.ID,
+		preferredHeight uint64,
+		acceptedHeight uint64,
 	) error
 
 	// Notify this engine that a query it issued has failed.
@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+)
+
+type cachedFrontier struct {
+	containerID ids.ID
+	received    time.Time
+}
+
+// frontierCache caches the accepted frontier most recently reported by each
+// beacon. When bootstrapping is retried, a beacon's cached frontier is used
+// rather than requesting it again if it was reported less than [ttl] ago.
+type frontierCache struct {
+	clock mockable.Clock
+	ttl   time.Duration
+
+	frontiers map[ids.NodeID]cachedFrontier
+
+	hits prometheus.Counter
+}
+
+func newFrontierCache(
+	ttl time.Duration,
+	namespace string,
+	registerer prometheus.Registerer,
+) (*frontierCache, error) {
+	c := &frontierCache{
+		ttl:       ttl,
+		frontiers: make(map[ids.NodeID]cachedFrontier),
+		hits: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "frontier_cache_hits",
+			Help:      "Number of accepted frontier requests that were served from the cache",
+		}),
+	}
+	return c, registerer.Register(c.hits)
+}
+
+// Put caches [containerID] as the accepted frontier of [nodeID].
+func (c *frontierCache) Put(nodeID ids.NodeID, containerID ids.ID) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.frontiers[nodeID] = cachedFrontier{
+		containerID: containerID,
+		received:    c.clock.Time(),
+	}
+}
+
+// Get returns the cached accepted frontier of [nodeID], if it is still fresh.
+func (c *frontierCache) Get(nodeID ids.NodeID) (ids.ID, bool) {
+	frontier, ok := c.frontiers[nodeID]
+	if !ok {
+		return ids.Empty, false
+	}
+	if c.clock.Time().Sub(frontier.received) >= c.ttl {
+		delete(c.frontiers, nodeID)
+		return ids.Empty, false
+	}
+	c.hits.Inc()
+	return frontier.containerID, true
+}
+
+// Clear removes every cached accepted frontier.
+func (c *frontierCache) Clear() {
+	maps.Clear(c.frontiers)
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+)
+
+func TestFrontierCache(t *testing.T) {
+	require := require.New(t)
+
+	c, err := newFrontierCache(time.Minute, "", prometheus.NewRegistry())
+	require.NoError(err)
+	now := time.Now()
+	c.clock.Set(now)
+
+	nodeID := ids.GenerateTestNodeID()
+	_, ok := c.Get(nodeID)
+	require.False(ok)
+
+	containerID := ids.GenerateTestID()
+	c.Put(nodeID, containerID)
+	cachedID, ok := c.Get(nodeID)
+	require.True(ok)
+	require.Equal(containerID, cachedID)
+
+	// The cached frontier expires after the TTL
+	c.clock.Set(now.Add(time.Minute))
+	_, ok = c.Get(nodeID)
+	require.False(ok)
+	require.Empty(c.frontiers)
+
+	c.Put(nodeID, containerID)
+	c.Clear()
+	_, ok = c.Get(nodeID)
+	require.False(ok)
+}
+
+func TestFrontierCacheDisabled(t *testing.T) {
+	require := require.New(t)
+
+	c, err := newFrontierCache(0, "", prometheus.NewRegistry())
+	require.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	c.Put(nodeID, ids.GenerateTestID())
+	_, ok := c.Get(nodeID)
+	require.False(ok)
+}
@@ -125,6 +125,12 @@ func (j *Jobs) ExecuteAll(
 	startTime := time.Now()
 	lastProgressUpdate := startTime
 
+	progress := chainCtx.BootstrapProgress.Get()
+	progress.Executed = 0
+	progress.ToExecute = numToExecute
+	progress.ETA = 0
+	chainCtx.BootstrapProgress.Set(progress)
+
 	// Disable and clear state caches to prevent us from attempting to execute
 	// a vertex that was previously parsed, but not saved to the VM. Some VMs
 	// may only persist containers when they are accepted. This is a stop-gap
@@ -191,6 +197,7 @@ func (j *Jobs) ExecuteAll(
 		}
 
 		numExecuted++
+		progress.Executed = uint64(numExecuted)
 		if time.Since(lastProgressUpdate) > progressUpdateFrequency { // Periodically print progress
 			eta := timer.EstimateETA(
 				startTime,
@@ -198,6 +205,7 @@ func (j *Jobs) ExecuteAll(
 				numToExecute,
 			)
 			j.etaMetric.Set(float64(eta))
+			progress.ETA = eta
 
 			if !restarted {
 				chainCtx.Log.Info("executing operations",
@@ -215,6 +223,7 @@ func (j *Jobs) ExecuteAll(
 
 			lastProgressUpdate = time.Now()
 		}
+		chainCtx.BootstrapProgress.Set(progress)
 	}
 
 	// Now that executing has finished, zero out the ETA.
@@ -116,10 +116,15 @@ func TestPushAndExecute(t *testing.T) {
 		return job, nil
 	}
 
-	count, err := jobs.ExecuteAll(context.Background(), snow.DefaultConsensusContextTest(), &common.Halter{}, false)
+	ctx := snow.DefaultConsensusContextTest()
+	count, err := jobs.ExecuteAll(context.Background(), ctx, &common.Halter{}, false)
 	require.NoError(err)
 	require.Equal(1, count)
 
+	progress := ctx.BootstrapProgress.Get()
+	require.Equal(uint64(1), progress.Executed)
+	require.Equal(uint64(1), progress.ToExecute)
+
 	has, err = jobs.Has(jobID)
 	require.NoError(err)
 	require.False(has)
@@ -6,6 +6,7 @@ package common
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/DioneProtocol/odysseygo/ids"
 )
@@ -15,8 +16,18 @@ const (
 )
 
 type req struct {
-	vdr ids.NodeID
-	id  uint32
+	vdr    ids.NodeID
+	id     uint32
+	issued time.Time
+}
+
+// OutstandingRequest is a container request that hasn't been responded to.
+type OutstandingRequest struct {
+	NodeID      ids.NodeID
+	RequestID   uint32
+	ContainerID ids.ID
+	// Time the request was added
+	Issued time.Time
 }
 
 // Requests tracks pending container messages from a peer.
@@ -42,8 +53,9 @@ func (r *Requests) Add(vdr ids.NodeID, requestID uint32, containerID ids.ID) {
 		r.idToReq = make(map[ids.ID]req, minRequestsSize)
 	}
 	r.idToReq[containerID] = req{
-		vdr: vdr,
-		id:  requestID,
+		vdr:    vdr,
+		id:     requestID,
+		issued: time.Now(),
 	}
 }
 
@@ -97,6 +109,20 @@ func (r *Requests) Contains(containerID ids.ID) bool {
 	return ok
 }
 
+// Outstanding returns all of the outstanding requests.
+func (r *Requests) Outstanding() []OutstandingRequest {
+	reqs := make([]OutstandingRequest, 0, len(r.idToReq))
+	for containerID, req := range r.idToReq {
+		reqs = append(reqs, OutstandingRequest{
+			NodeID:      req.vdr,
+			RequestID:   req.id,
+			ContainerID: containerID,
+			Issued:      req.issued,
+		})
+	}
+	return reqs
+}
+
 func (r Requests) String() string {
 	sb := strings.Builder{}
 	sb.WriteString(fmt.Sprintf("Requests: (Num Validators = %d)", len(r.reqsToID)))
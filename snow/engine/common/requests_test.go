@@ -67,3 +67,24 @@ func TestRequests(t *testing.T) {
 	require.False(req.RemoveAny(ids.Empty))
 	require.Zero(req.Len())
 }
+
+func TestRequestsOutstanding(t *testing.T) {
+	require := require.New(t)
+
+	req := Requests{}
+	require.Empty(req.Outstanding())
+
+	nodeID := ids.GenerateTestNodeID()
+	containerID := ids.GenerateTestID()
+	req.Add(nodeID, 1, containerID)
+
+	outstanding := req.Outstanding()
+	require.Len(outstanding, 1)
+	require.Equal(nodeID, outstanding[0].NodeID)
+	require.Equal(uint32(1), outstanding[0].RequestID)
+	require.Equal(containerID, outstanding[0].ContainerID)
+	require.False(outstanding[0].Issued.IsZero())
+
+	require.True(req.RemoveAny(containerID))
+	require.Empty(req.Outstanding())
+}
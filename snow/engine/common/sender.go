@@ -5,6 +5,7 @@ package common
 
 import (
 	"context"
+	"time"
 
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
@@ -137,8 +138,9 @@ type QuerySender interface {
 	// existence of the specified container.
 	SendPullQuery(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, containerID ids.ID)
 
-	// Send chits to the specified node
-	SendChits(ctx context.Context, nodeID ids.NodeID, requestID uint32, preferredID ids.ID, acceptedID ids.ID)
+	// Send chits to the specified node. The heights of the preferred and
+	// accepted blocks are optional and left as 0 if they aren't included.
+	SendChits(ctx context.Context, nodeID ids.NodeID, requestID uint32, preferredID ids.ID, acceptedID ids.ID, preferredHeight uint64, acceptedHeight uint64)
 }
 
 // Gossiper defines how a consensus engine gossips a container on the accepted
@@ -169,6 +171,16 @@ type NetworkAppSender interface {
 	SendAppGossipSpecific(ctx context.Context, nodeIDs set.Set[ids.NodeID], appGossipBytes []byte) error
 }
 
+// AppRequestDeadlineSender is optionally implemented by an AppSender to allow
+// a VM to choose how long its application-level requests may be outstanding.
+type AppRequestDeadlineSender interface {
+	// SendAppRequestWithDeadline is the same as SendAppRequest except that an
+	// AppRequestFailed is delivered if a node doesn't respond within
+	// [deadline] rather than within the current network timeout. [deadline]
+	// must be positive and is capped to the maximum network timeout.
+	SendAppRequestWithDeadline(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, deadline time.Duration, appRequestBytes []byte) error
+}
+
 // CrossChainAppSender sends local VM-level messages to another VM.
 type CrossChainAppSender interface {
 	// SendCrossChainAppRequest sends an application-level request to a
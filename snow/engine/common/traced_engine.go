@@ -17,7 +17,10 @@ import (
 	"github.com/DioneProtocol/odysseygo/version"
 )
 
-var _ Engine = (*tracedEngine)(nil)
+var (
+	_ Engine                      = (*tracedEngine)(nil)
+	_ OutstandingRequestsReporter = (*tracedEngine)(nil)
+)
 
 type tracedEngine struct {
 	engine Engine
@@ -243,16 +246,18 @@ func (e *tracedEngine) PushQuery(ctx context.Context, nodeID ids.NodeID, request
 	return e.engine.PushQuery(ctx, nodeID, requestID, container)
 }
 
-func (e *tracedEngine) Chits(ctx context.Context, nodeID ids.NodeID, requestID uint32, preferredID ids.ID, acceptedID ids.ID) error {
+func (e *tracedEngine) Chits(ctx context.Context, nodeID ids.NodeID, requestID uint32, preferredID ids.ID, acceptedID ids.ID, preferredHeight uint64, acceptedHeight uint64) error {
 	ctx, span := e.tracer.Start(ctx, "tracedEngine.Chits", oteltrace.WithAttributes(
 		attribute.Stringer("nodeID", nodeID),
 		attribute.Int64("requestID", int64(requestID)),
 		attribute.Stringer("preferredID", preferredID),
 		attribute.Stringer("acceptedID", acceptedID),
+		attribute.Int64("preferredHeight", int64(preferredHeight)),
+		attribute.Int64("acceptedHeight", int64(acceptedHeight)),
 	))
 	defer span.End()
 
-	return e.engine.Chits(ctx, nodeID, requestID, preferredID, acceptedID)
+	return e.engine.Chits(ctx, nodeID, requestID, preferredID, acceptedID, preferredHeight, acceptedHeight)
 }
 
 func (e *tracedEngine) QueryFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
@@ -418,3 +423,11 @@ func (e *tracedEngine) HealthCheck(ctx context.Context) (interface{}, error) {
 func (e *tracedEngine) GetVM() VM {
 	return e.engine.GetVM()
 }
+
+func (e *tracedEngine) OutstandingRequests() []OutstandingRequest {
+	reporter, ok := e.engine.(OutstandingRequestsReporter)
+	if !ok {
+		return nil
+	}
+	return reporter.OutstandingRequests()
+}
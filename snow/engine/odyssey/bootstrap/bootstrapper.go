@@ -58,7 +58,11 @@ func New(
 	}
 
 	config.Config.Bootstrapable = b
-	b.Bootstrapper = common.NewCommonBootstrapper(config.Config)
+	var err error
+	b.Bootstrapper, err = common.NewCommonBootstrapper(config.Config, "bs", config.Ctx.OdysseyRegisterer)
+	if err != nil {
+		return nil, err
+	}
 	return b, nil
 }
 
@@ -484,6 +488,9 @@ func (b *bootstrapper) process(ctx context.Context, vtxs ...odyssey.Vertex) erro
 			b.numFetchedVts.Inc()
 
 			verticesFetchedSoFar := b.VtxBlocked.Jobs.PendingJobs()
+			progress := b.Ctx.BootstrapProgress.Get()
+			progress.Fetched = verticesFetchedSoFar
+			b.Ctx.BootstrapProgress.Set(progress)
 			if verticesFetchedSoFar%common.StatusUpdateFrequency == 0 { // Periodically print progress
 				if !b.Config.SharedCfg.Restarted {
 					b.Ctx.Log.Info("fetched vertices",
@@ -0,0 +1,41 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package block
+
+import (
+	"context"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+)
+
+// BlockProposer describes the proposervm block that wrapped an accepted block
+// of an underlying vm.
+type BlockProposer struct {
+	// NodeID is the node that proposed the wrapping block. It is empty if the
+	// wrapping block wasn't signed, which is the case for blocks built outside
+	// of the proposer windows and for options.
+	NodeID ids.NodeID
+	// Timestamp is the timestamp of the wrapping block.
+	Timestamp time.Time
+}
+
+// BlockProposerGetter is optionally provided by the proposervm to an
+// underlying vm to resolve the proposervm blocks that wrapped its blocks.
+type BlockProposerGetter interface {
+	// GetBlockProposer returns the proposer of the wrapping block of the
+	// accepted block [blkID] at [height].
+	//
+	// Returns database.ErrNotFound if [blkID] wasn't wrapped by a post-fork
+	// block or if the wrapping block was pruned.
+	GetBlockProposer(ctx context.Context, blkID ids.ID, height uint64) (*BlockProposer, error)
+}
+
+// BlockProposerAwareChainVM defines the interface a ChainVM can optionally
+// implement to resolve the proposers of its blocks.
+type BlockProposerAwareChainVM interface {
+	// SetBlockProposerGetter is called by the proposervm before the underlying
+	// vm is initialized.
+	SetBlockProposerGetter(BlockProposerGetter)
+}
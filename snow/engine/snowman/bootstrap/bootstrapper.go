@@ -106,8 +106,10 @@ func New(config Config, onFinished func(ctx context.Context, lastReqID uint32) e
 	}
 
 	config.Bootstrapable = b
-	b.Bootstrapper = common.NewCommonBootstrapper(config.Config)
-
+	b.Bootstrapper, err = common.NewCommonBootstrapper(config.Config, "bs", config.Ctx.Registerer)
+	if err != nil {
+		return nil, err
+	}
 	return b, nil
 }
 
@@ -480,16 +482,21 @@ func (b *bootstrapper) process(ctx context.Context, blk snowman.Block, processin
 		// We added a new block to the queue, so track that it was fetched
 		b.numFetched.Inc()
 
-		// Periodically log progress
 		blocksFetchedSoFar := b.Blocked.Jobs.PendingJobs()
+		totalBlocksToFetch := b.tipHeight - b.startingHeight
+		progress := b.Ctx.BootstrapProgress.Get()
+		progress.Fetched = blocksFetchedSoFar
+		progress.ToFetch = totalBlocksToFetch
+
+		// Periodically log progress
 		if blocksFetchedSoFar%common.StatusUpdateFrequency == 0 {
-			totalBlocksToFetch := b.tipHeight - b.startingHeight
 			eta := timer.EstimateETA(
 				b.startTime,
 				blocksFetchedSoFar-b.initiallyFetched, // Number of blocks we have fetched during this run
 				totalBlocksToFetch-b.initiallyFetched, // Number of blocks we expect to fetch during this run
 			)
 			b.fetchETA.Set(float64(eta))
+			progress.ETA = eta
 
 			if !b.Config.SharedCfg.Restarted {
 				b.Ctx.Log.Info("fetching blocks",
@@ -505,6 +512,7 @@ func (b *bootstrapper) process(ctx context.Context, blk snowman.Block, processin
 				)
 			}
 		}
+		b.Ctx.BootstrapProgress.Set(progress)
 
 		// Attempt to traverse to the next block
 		parentID := blk.Parent()
@@ -4,6 +4,7 @@
 package snowman
 
 import (
+	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowball"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
@@ -23,4 +24,13 @@ type Config struct {
 	Params      snowball.Parameters
 	Consensus   snowman.Consensus
 	PartialSync bool
+
+	// IncludeChitsHeights includes the heights of the preferred and last
+	// accepted blocks in the chits sent by this engine, which allows peers to
+	// detect that they have fallen far behind.
+	IncludeChitsHeights bool
+
+	// Journal persists the blocks that are processing in consensus, so that
+	// they can be restored after the node restarts.
+	Journal database.Database
 }
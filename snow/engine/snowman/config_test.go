@@ -4,6 +4,7 @@
 package snowman
 
 import (
+	"github.com/DioneProtocol/odysseygo/database/memdb"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowball"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
@@ -27,7 +28,9 @@ func DefaultConfigs() Config {
 			OptimalProcessing:     100,
 			MaxOutstandingItems:   1,
 			MaxItemProcessingTime: 1,
+			MaxProcessingDepth:    100,
 		},
 		Consensus: &snowman.Topological{},
+		Journal:   memdb.New(),
 	}
 }
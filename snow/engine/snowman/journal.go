@@ -0,0 +1,50 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snowman
+
+import (
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+)
+
+const journalClearBatchSize = units.MiB
+
+// journal persists the bytes of the blocks that are processing in consensus,
+// keyed by their ID. If the node crashes, the journaled blocks can be
+// re-issued into consensus without having to be verified against gossip or
+// fetched from peers again.
+type journal struct {
+	db database.Database
+}
+
+// Add records that [blk] is processing.
+func (j *journal) Add(blk snowman.Block) error {
+	blkID := blk.ID()
+	return j.db.Put(blkID[:], blk.Bytes())
+}
+
+// Remove records that the block [blkID] is no longer processing.
+func (j *journal) Remove(blkID ids.ID) error {
+	return j.db.Delete(blkID[:])
+}
+
+// Blocks returns the bytes of every journaled block.
+func (j *journal) Blocks() ([][]byte, error) {
+	it := j.db.NewIterator()
+	defer it.Release()
+
+	var blks [][]byte
+	for it.Next() {
+		// The iterator's value is only valid until the next call to Next.
+		blks = append(blks, append([]byte(nil), it.Value()...))
+	}
+	return blks, it.Error()
+}
+
+// Clear removes every journaled block.
+func (j *journal) Clear() error {
+	return database.Clear(j.db, journalClearBatchSize)
+}
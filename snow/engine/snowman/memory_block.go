@@ -17,18 +17,25 @@ type memoryBlock struct {
 
 	tree    AncestorTree
 	metrics *metrics
+	journal *journal
 }
 
 // Accept accepts the underlying block & removes sibling subtrees
 func (mb *memoryBlock) Accept(ctx context.Context) error {
 	mb.tree.RemoveSubtree(mb.Parent())
 	mb.metrics.numNonVerifieds.Set(float64(mb.tree.Len()))
-	return mb.Block.Accept(ctx)
+	if err := mb.Block.Accept(ctx); err != nil {
+		return err
+	}
+	return mb.journal.Remove(mb.ID())
 }
 
 // Reject rejects the underlying block & removes child subtrees
 func (mb *memoryBlock) Reject(ctx context.Context) error {
 	mb.tree.RemoveSubtree(mb.ID())
 	mb.metrics.numNonVerifieds.Set(float64(mb.tree.Len()))
-	return mb.Block.Reject(ctx)
+	if err := mb.Block.Reject(ctx); err != nil {
+		return err
+	}
+	return mb.journal.Remove(mb.ID())
 }
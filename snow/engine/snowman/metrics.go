@@ -13,6 +13,7 @@ import (
 type metrics struct {
 	bootstrapFinished, numRequests, numBlocked, numBlockers, numNonVerifieds prometheus.Gauge
 	numBuilt, numBuildsFailed, numUselessPutBytes, numUselessPushQueryBytes  prometheus.Counter
+	numJournalRestored, numBuildsThrottled, numInvalidBlksDropped            prometheus.Counter
 	getAncestorsBlks                                                         metric.Averager
 }
 
@@ -49,6 +50,11 @@ func (m *metrics) Initialize(namespace string, reg prometheus.Registerer) error
 		Name:      "blk_builds_failed",
 		Help:      "Number of BuildBlock calls that have failed",
 	})
+	m.numBuildsThrottled = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "blk_builds_throttled",
+		Help:      "Number of times block building was skipped because the processing blocks were too deep",
+	})
 	m.numUselessPutBytes = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: namespace,
 		Name:      "num_useless_put_bytes",
@@ -59,6 +65,16 @@ func (m *metrics) Initialize(namespace string, reg prometheus.Registerer) error
 		Name:      "num_useless_push_query_bytes",
 		Help:      "Amount of useless bytes received in PushQuery messages",
 	})
+	m.numJournalRestored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "journal_restored_blks",
+		Help:      "Number of processing blocks restored from the journal on startup",
+	})
+	m.numInvalidBlksDropped = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "invalid_blks_dropped",
+		Help:      "Number of received blocks dropped because they previously failed verification",
+	})
 	m.getAncestorsBlks = metric.NewAveragerWithErrs(
 		namespace,
 		"get_ancestors_blks",
@@ -80,8 +96,11 @@ func (m *metrics) Initialize(namespace string, reg prometheus.Registerer) error
 		reg.Register(m.numNonVerifieds),
 		reg.Register(m.numBuilt),
 		reg.Register(m.numBuildsFailed),
+		reg.Register(m.numBuildsThrottled),
 		reg.Register(m.numUselessPutBytes),
 		reg.Register(m.numUselessPushQueryBytes),
+		reg.Register(m.numJournalRestored),
+		reg.Register(m.numInvalidBlksDropped),
 	)
 	return errs.Err
 }
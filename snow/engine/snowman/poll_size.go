@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snowman
+
+import (
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/bag"
+)
+
+// Halflife of the rate of failed queries that the poll size is adapted to.
+const queryFailureHalflife = time.Minute
+
+// observeQuery records whether a query sent to a validator failed.
+func (t *Transitive) observeQuery(failed bool) {
+	var value float64
+	if failed {
+		value = 1
+	}
+	t.queryFailureRate.Observe(value, t.clock.Time())
+}
+
+// pollSize returns the number of validators to sample for the next poll and
+// the weight of each of their votes.
+//
+// If MinK is set and so many queries are failing that a poll of K validators
+// is unlikely to receive Alpha votes, fewer validators are sampled. Each of
+// their votes is weighted so that a poll still needs roughly the same fraction
+// of votes to be successful, without changing the parameters consensus uses.
+func (t *Transitive) pollSize() (int, int) {
+	k := t.Params.K
+	if t.Params.MinK == 0 {
+		return k, 1
+	}
+
+	expectedVotes := (1 - t.queryFailureRate.Read()) * float64(k)
+	if expectedVotes >= float64(t.Params.Alpha) {
+		return k, 1
+	}
+
+	targetK := int(expectedVotes)
+	if targetK < t.Params.MinK {
+		targetK = t.Params.MinK
+	}
+	for weight := ceilDiv(k, targetK); weight > 1; weight-- {
+		sampleSize := ceilDiv(k, weight)
+		alpha := ceilDiv(t.Params.Alpha, weight)
+		if sampleSize >= t.Params.MinK && alpha > sampleSize/2 {
+			return sampleSize, weight
+		}
+	}
+	return k, 1
+}
+
+// sampleValidators returns the validators to query in the next poll, weighted
+// by how many votes each of their responses counts for.
+func (t *Transitive) sampleValidators() (bag.Bag[ids.NodeID], error) {
+	sampleSize, weight := t.pollSize()
+	vdrIDs, err := t.Validators.Sample(sampleSize)
+	if err != nil {
+		return bag.Bag[ids.NodeID]{}, err
+	}
+
+	var vdrBag bag.Bag[ids.NodeID]
+	for _, vdrID := range vdrIDs {
+		vdrBag.AddCount(vdrID, weight)
+	}
+	return vdrBag, nil
+}
+
+// pollHealth reports the parameters of the next poll.
+func (t *Transitive) pollHealth() map[string]interface{} {
+	sampleSize, weight := t.pollSize()
+	return map[string]interface{}{
+		"k":                sampleSize,
+		"alpha":            ceilDiv(t.Params.Alpha, weight),
+		"queryFailureRate": t.queryFailureRate.Read(),
+	}
+}
+
+func ceilDiv(a, b int) int {
+	return (a + b - 1) / b
+}
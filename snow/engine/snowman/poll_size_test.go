@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snowman
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/snow/consensus/snowball"
+	"github.com/DioneProtocol/odysseygo/utils/math"
+)
+
+func TestPollSize(t *testing.T) {
+	tests := []struct {
+		name               string
+		minK               int
+		numFailures        int
+		expectedSampleSize int
+		expectedWeight     int
+		expectedAlpha      int
+	}{
+		{
+			name:               "disabled",
+			minK:               0,
+			numFailures:        99,
+			expectedSampleSize: 20,
+			expectedWeight:     1,
+			expectedAlpha:      15,
+		},
+		{
+			name:               "no failures",
+			minK:               5,
+			numFailures:        0,
+			expectedSampleSize: 20,
+			expectedWeight:     1,
+			expectedAlpha:      15,
+		},
+		{
+			name:               "half of the queries failing",
+			minK:               5,
+			numFailures:        1,
+			expectedSampleSize: 10,
+			expectedWeight:     2,
+			expectedAlpha:      8,
+		},
+		{
+			name:               "all queries failing",
+			minK:               5,
+			numFailures:        99,
+			expectedSampleSize: 5,
+			expectedWeight:     4,
+			expectedAlpha:      4,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			te := &Transitive{
+				Config: Config{
+					Params: snowball.Parameters{
+						K:     20,
+						Alpha: 15,
+						MinK:  test.minK,
+					},
+				},
+			}
+			now := time.Now()
+			te.clock.Set(now)
+			te.queryFailureRate = math.NewAverager(0, queryFailureHalflife, now)
+			for i := 0; i < test.numFailures; i++ {
+				te.observeQuery(true)
+			}
+
+			sampleSize, weight := te.pollSize()
+			require.Equal(test.expectedSampleSize, sampleSize)
+			require.Equal(test.expectedWeight, weight)
+
+			health := te.pollHealth()
+			require.Equal(test.expectedSampleSize, health["k"])
+			require.Equal(test.expectedAlpha, health["alpha"])
+		})
+	}
+}
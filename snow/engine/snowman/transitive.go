@@ -9,6 +9,8 @@ import (
 
 	"go.uber.org/zap"
 
+	"golang.org/x/exp/slices"
+
 	"github.com/DioneProtocol/odysseygo/cache"
 	"github.com/DioneProtocol/odysseygo/cache/metercacher"
 	"github.com/DioneProtocol/odysseygo/ids"
@@ -21,17 +23,38 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow/engine/common/tracker"
 	"github.com/DioneProtocol/odysseygo/snow/event"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
-	"github.com/DioneProtocol/odysseygo/utils/bag"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/math"
 	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/utils/units"
 	"github.com/DioneProtocol/odysseygo/utils/wrappers"
 )
 
-const nonVerifiedCacheSize = 64 * units.MiB
+const (
+	nonVerifiedCacheSize = 64 * units.MiB
+
+	// If a peer reports a last accepted block more than [farBehindThreshold]
+	// blocks past our last accepted block, the missing blocks are fetched in
+	// bulk rather than one block at a time.
+	farBehindThreshold = 128
 
-var _ Engine = (*Transitive)(nil)
+	// If a block more than [missingAncestorsThreshold] blocks past our last
+	// accepted block has a missing parent, its ancestry is fetched in bulk
+	// rather than one block per round-trip.
+	missingAncestorsThreshold = 4
+
+	// Number of blocks that failed verification to remember so that repeated
+	// deliveries of the same block can be dropped without parsing it.
+	invalidBlkCacheSize = 1024
+)
+
+var (
+	_ Engine                             = (*Transitive)(nil)
+	_ common.OutstandingRequestsReporter = (*Transitive)(nil)
+)
 
 func New(config Config) (Engine, error) {
 	return newTransitive(config)
@@ -52,7 +75,6 @@ type Transitive struct {
 	common.AcceptedStateSummaryHandler
 	common.AcceptedFrontierHandler
 	common.AcceptedHandler
-	common.AncestorsHandler
 	common.AppHandler
 	validators.Connector
 
@@ -77,6 +99,10 @@ type Transitive struct {
 	// occurs.
 	nonVerifiedCache cache.Cacher[ids.ID, snowman.Block]
 
+	// Hash of block bytes --> Verification error.
+	// A block is put into this cache if it failed verification.
+	invalidBlks cache.Cacher[ids.ID, error]
+
 	// acceptedFrontiers of the other validators of this chain
 	acceptedFrontiers tracker.Accepted
 
@@ -88,6 +114,14 @@ type Transitive struct {
 	// processing blocks has gone below the optimal number.
 	pendingBuildBlocks int
 
+	// blocks that are processing in consensus, persisted to be restored after
+	// a restart
+	journal *journal
+
+	// rate of queries that failed, used to adapt the poll size
+	clock            mockable.Clock
+	queryFailureRate math.Averager
+
 	// errs tracks if an error has occurred in a callback
 	errs wrappers.Errs
 }
@@ -117,13 +151,14 @@ func newTransitive(config Config) (*Transitive, error) {
 		AcceptedStateSummaryHandler: common.NewNoOpAcceptedStateSummaryHandler(config.Ctx.Log),
 		AcceptedFrontierHandler:     common.NewNoOpAcceptedFrontierHandler(config.Ctx.Log),
 		AcceptedHandler:             common.NewNoOpAcceptedHandler(config.Ctx.Log),
-		AncestorsHandler:            common.NewNoOpAncestorsHandler(config.Ctx.Log),
 		AppHandler:                  config.VM,
 		Connector:                   config.VM,
 		pending:                     make(map[ids.ID]snowman.Block),
 		nonVerifieds:                NewAncestorTree(),
 		nonVerifiedCache:            nonVerifiedCache,
+		invalidBlks:                 &cache.LRU[ids.ID, error]{Size: invalidBlkCacheSize},
 		acceptedFrontiers:           acceptedFrontiers,
+		journal:                     &journal{db: config.Journal},
 		polls: poll.NewSet(factory,
 			config.Ctx.Log,
 			"",
@@ -131,6 +166,7 @@ func newTransitive(config Config) (*Transitive, error) {
 		),
 	}
 
+	t.queryFailureRate = math.NewAverager(0, queryFailureHalflife, t.clock.Time())
 	return t, t.metrics.Initialize("", config.Ctx.Registerer)
 }
 
@@ -222,6 +258,10 @@ func (t *Transitive) PullQuery(ctx context.Context, nodeID ids.NodeID, requestID
 func (t *Transitive) PushQuery(ctx context.Context, nodeID ids.NodeID, requestID uint32, blkBytes []byte) error {
 	t.sendChits(ctx, nodeID, requestID)
 
+	if t.isKnownInvalid(nodeID, requestID, blkBytes) {
+		return nil
+	}
+
 	blk, err := t.VM.ParseBlock(ctx, blkBytes)
 	// If parsing fails, we just drop the request, as we didn't ask for it
 	if err != nil {
@@ -258,14 +298,98 @@ func (t *Transitive) PushQuery(ctx context.Context, nodeID ids.NodeID, requestID
 	return t.buildBlocks(ctx)
 }
 
-func (t *Transitive) Chits(ctx context.Context, nodeID ids.NodeID, requestID uint32, blkID ids.ID, acceptedID ids.ID) error {
+func (t *Transitive) Ancestors(ctx context.Context, nodeID ids.NodeID, requestID uint32, blks [][]byte) error {
+	blkID, ok := t.blkReqs.Get(nodeID, requestID)
+	if !ok {
+		t.Ctx.Log.Debug("unexpected Ancestors",
+			zap.Stringer("nodeID", nodeID),
+			zap.Uint32("requestID", requestID),
+		)
+		return nil
+	}
+
+	// Only the chain of blocks ending with the requested block is useful.
+	chain := make([]snowman.Block, 0, len(blks))
+	for _, blkBytes := range blks {
+		blk, err := t.VM.ParseBlock(ctx, blkBytes)
+		if err != nil {
+			t.Ctx.Log.Debug("failed to parse block",
+				zap.Stringer("nodeID", nodeID),
+				zap.Uint32("requestID", requestID),
+				zap.Error(err),
+			)
+			break
+		}
+
+		expectedBlkID := blkID
+		if len(chain) > 0 {
+			expectedBlkID = chain[len(chain)-1].Parent()
+		}
+		if actualBlkID := blk.ID(); actualBlkID != expectedBlkID {
+			t.Ctx.Log.Debug("incorrect block returned in Ancestors",
+				zap.Stringer("nodeID", nodeID),
+				zap.Uint32("requestID", requestID),
+				zap.Stringer("blkID", actualBlkID),
+				zap.Stringer("expectedBlkID", expectedBlkID),
+			)
+			break
+		}
+		chain = append(chain, blk)
+	}
+	if len(chain) == 0 {
+		return t.GetAncestorsFailed(ctx, nodeID, requestID)
+	}
+
+	// Issue the oldest block first so that the parent of every later block is
+	// already pending when it is issued. If the oldest block's parent is
+	// missing, it will be requested from [nodeID].
+	for i := len(chain) - 1; i >= 0; i-- {
+		if _, err := t.issueFrom(ctx, nodeID, chain[i]); err != nil {
+			return err
+		}
+	}
+	return t.buildBlocks(ctx)
+}
+
+func (t *Transitive) GetAncestorsFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
+	blkID, ok := t.blkReqs.Remove(nodeID, requestID)
+	if !ok {
+		t.Ctx.Log.Debug("unexpected GetAncestorsFailed",
+			zap.Stringer("nodeID", nodeID),
+			zap.Uint32("requestID", requestID),
+		)
+		return nil
+	}
+
+	// Because the request was dropped, we no longer expect blkID to be issued.
+	t.blocked.Abandon(ctx, blkID)
+	t.metrics.numRequests.Set(float64(t.blkReqs.Len()))
+	t.metrics.numBlockers.Set(float64(t.blocked.Len()))
+	return t.buildBlocks(ctx)
+}
+
+func (t *Transitive) Chits(ctx context.Context, nodeID ids.NodeID, requestID uint32, blkID ids.ID, acceptedID ids.ID, blkHeight uint64, acceptedHeight uint64) error {
+	t.observeQuery(false)
+	return t.chits(ctx, nodeID, requestID, blkID, acceptedID, blkHeight, acceptedHeight)
+}
+
+func (t *Transitive) chits(ctx context.Context, nodeID ids.NodeID, requestID uint32, blkID ids.ID, acceptedID ids.ID, blkHeight uint64, acceptedHeight uint64) error {
 	t.acceptedFrontiers.SetLastAccepted(nodeID, acceptedID)
 
 	t.Ctx.Log.Verbo("called Chits for the block",
 		zap.Stringer("blkID", blkID),
+		zap.Uint64("blkHeight", blkHeight),
+		zap.Uint64("acceptedHeight", acceptedHeight),
 		zap.Stringer("nodeID", nodeID),
 		zap.Uint32("requestID", requestID))
 
+	// If [nodeID] has accepted far more blocks than we have, fetch the
+	// ancestry of its preference in bulk rather than waiting for our polls to
+	// fetch it one block at a time.
+	if t.isFarBehind(ctx, acceptedHeight) {
+		t.sendAncestorsRequest(ctx, nodeID, blkID)
+	}
+
 	added, err := t.issueFromByID(ctx, nodeID, blkID)
 	if err != nil {
 		return err
@@ -290,9 +414,11 @@ func (t *Transitive) Chits(ctx context.Context, nodeID ids.NodeID, requestID uin
 }
 
 func (t *Transitive) QueryFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
+	t.observeQuery(true)
+
 	lastAccepted, ok := t.acceptedFrontiers.LastAccepted(nodeID)
 	if ok {
-		return t.Chits(ctx, nodeID, requestID, lastAccepted, lastAccepted)
+		return t.chits(ctx, nodeID, requestID, lastAccepted, lastAccepted, 0, 0)
 	}
 
 	t.blocked.Register(
@@ -419,6 +545,57 @@ func (t *Transitive) Start(ctx context.Context, startReqID uint32) error {
 		return fmt.Errorf("failed to notify VM that consensus is starting: %w",
 			err)
 	}
+	return t.restoreJournal(ctx)
+}
+
+// restoreJournal re-issues the blocks that were processing when the node last
+// shut down. Blocks that are no longer valid, or whose ancestry is no longer
+// known, are dropped.
+func (t *Transitive) restoreJournal(ctx context.Context) error {
+	blksBytes, err := t.journal.Blocks()
+	if err != nil {
+		return err
+	}
+	if len(blksBytes) == 0 {
+		return nil
+	}
+
+	// Restored blocks that are added to consensus are journaled again.
+	if err := t.journal.Clear(); err != nil {
+		return err
+	}
+
+	blks := make([]snowman.Block, 0, len(blksBytes))
+	for _, blkBytes := range blksBytes {
+		blk, err := t.VM.ParseBlock(ctx, blkBytes)
+		if err != nil {
+			t.Ctx.Log.Debug("dropping journaled block",
+				zap.String("reason", "failed to parse block"),
+				zap.Error(err),
+			)
+			continue
+		}
+		blks = append(blks, blk)
+	}
+
+	// Issuing the blocks in order of height guarantees that the parent of a
+	// journaled block is issued before the block itself.
+	slices.SortFunc(blks, func(i, j snowman.Block) bool {
+		return i.Height() < j.Height()
+	})
+	numProcessing := t.Consensus.NumProcessing()
+	for _, blk := range blks {
+		if _, err := t.issueWithAncestors(ctx, blk); err != nil {
+			return err
+		}
+	}
+
+	numRestored := t.Consensus.NumProcessing() - numProcessing
+	t.metrics.numJournalRestored.Add(float64(numRestored))
+	t.Ctx.Log.Info("restored processing blocks",
+		zap.Int("numJournaled", len(blksBytes)),
+		zap.Int("numRestored", numRestored),
+	)
 	return nil
 }
 
@@ -428,6 +605,7 @@ func (t *Transitive) HealthCheck(ctx context.Context) (interface{}, error) {
 	intf := map[string]interface{}{
 		"consensus": consensusIntf,
 		"vm":        vmIntf,
+		"poll":      t.pollHealth(),
 	}
 	if consensusErr == nil {
 		return intf, vmErr
@@ -442,6 +620,10 @@ func (t *Transitive) GetVM() common.VM {
 	return t.VM
 }
 
+func (t *Transitive) OutstandingRequests() []common.OutstandingRequest {
+	return t.blkReqs.Outstanding()
+}
+
 func (t *Transitive) GetBlock(ctx context.Context, blkID ids.ID) (snowman.Block, error) {
 	if blk, ok := t.pending[blkID]; ok {
 		return blk, nil
@@ -455,22 +637,73 @@ func (t *Transitive) GetBlock(ctx context.Context, blkID ids.ID) (snowman.Block,
 
 func (t *Transitive) sendChits(ctx context.Context, nodeID ids.NodeID, requestID uint32) {
 	lastAccepted := t.Consensus.LastAccepted()
+	preference := t.Consensus.Preference()
 	// If we aren't fully verifying blocks, only vote for blocks that are widely
 	// preferred by the validator set.
 	if t.Ctx.StateSyncing.Get() || t.Config.PartialSync {
-		t.Sender.SendChits(ctx, nodeID, requestID, lastAccepted, lastAccepted)
-	} else {
-		t.Sender.SendChits(ctx, nodeID, requestID, t.Consensus.Preference(), lastAccepted)
+		preference = lastAccepted
+	}
+
+	var preferenceHeight, lastAcceptedHeight uint64
+	if t.Config.IncludeChitsHeights {
+		preferenceHeight = t.getHeight(ctx, preference)
+		lastAcceptedHeight = t.getHeight(ctx, lastAccepted)
+	}
+	t.Sender.SendChits(ctx, nodeID, requestID, preference, lastAccepted, preferenceHeight, lastAcceptedHeight)
+}
+
+// getHeight returns the height of [blkID], or 0 if the block isn't available.
+func (t *Transitive) getHeight(ctx context.Context, blkID ids.ID) uint64 {
+	blk, err := t.GetBlock(ctx, blkID)
+	if err != nil {
+		t.Ctx.Log.Debug("failed to get block height",
+			zap.Stringer("blkID", blkID),
+			zap.Error(err),
+		)
+		return 0
+	}
+	return blk.Height()
+}
+
+// isFarBehind returns true if [acceptedHeight] is more than
+// [farBehindThreshold] blocks past our last accepted block.
+func (t *Transitive) isFarBehind(ctx context.Context, acceptedHeight uint64) bool {
+	// A height of 0 means that the peer didn't report its height.
+	if acceptedHeight <= farBehindThreshold {
+		return false
+	}
+	lastAcceptedHeight := t.getHeight(ctx, t.Consensus.LastAccepted())
+	return acceptedHeight-farBehindThreshold > lastAcceptedHeight
+}
+
+// isMissingManyAncestors returns true if a block at [height] is more than
+// [missingAncestorsThreshold] blocks past our last accepted block, meaning
+// that several of its ancestors may be unknown.
+func (t *Transitive) isMissingManyAncestors(ctx context.Context, height uint64) bool {
+	if height <= missingAncestorsThreshold {
+		return false
 	}
+	lastAcceptedHeight := t.getHeight(ctx, t.Consensus.LastAccepted())
+	return height-missingAncestorsThreshold > lastAcceptedHeight
 }
 
-// Build blocks if they have been requested and the number of processing blocks
-// is less than optimal.
+// Build blocks if they have been requested, the number of processing blocks is
+// less than optimal, and the processing blocks don't extend too far past the
+// last accepted block.
 func (t *Transitive) buildBlocks(ctx context.Context) error {
 	if err := t.errs.Err; err != nil {
 		return err
 	}
 	for t.pendingBuildBlocks > 0 && t.Consensus.NumProcessing() < t.Params.OptimalProcessing {
+		if t.Consensus.ProcessingDepth() >= uint64(t.Params.MaxProcessingDepth) {
+			t.Ctx.Log.Debug("skipping block building",
+				zap.String("reason", "processing depth too large"),
+				zap.Int("maxProcessingDepth", t.Params.MaxProcessingDepth),
+			)
+			t.numBuildsThrottled.Inc()
+			return nil
+		}
+
 		t.pendingBuildBlocks--
 
 		blk, err := t.VM.BuildBlock(ctx)
@@ -552,13 +785,18 @@ func (t *Transitive) issueFrom(ctx context.Context, nodeID ids.NodeID, blk snowm
 			return false, err
 		}
 
+		height := blk.Height()
 		blkID = blk.Parent()
 		var err error
 		blk, err = t.GetBlock(ctx, blkID)
 
 		// If we don't have this ancestor, request it from [vdr]
 		if err != nil || !blk.Status().Fetched() {
-			t.sendRequest(ctx, nodeID, blkID)
+			if t.isMissingManyAncestors(ctx, height) {
+				t.sendAncestorsRequest(ctx, nodeID, blkID)
+			} else {
+				t.sendRequest(ctx, nodeID, blkID)
+			}
 			return false, nil
 		}
 	}
@@ -685,13 +923,37 @@ func (t *Transitive) sendRequest(ctx context.Context, nodeID ids.NodeID, blkID i
 	t.metrics.numRequests.Set(float64(t.blkReqs.Len()))
 }
 
+// Request that [vdr] send us block [blkID] along with its ancestors
+func (t *Transitive) sendAncestorsRequest(ctx context.Context, nodeID ids.NodeID, blkID ids.ID) {
+	// There is already an outstanding request for this block
+	if t.blkReqs.Contains(blkID) {
+		return
+	}
+	// We already have this block
+	if _, err := t.GetBlock(ctx, blkID); err == nil {
+		return
+	}
+
+	t.RequestID++
+	t.blkReqs.Add(nodeID, t.RequestID, blkID)
+	t.Ctx.Log.Verbo("sending GetAncestors request",
+		zap.Stringer("nodeID", nodeID),
+		zap.Uint32("requestID", t.RequestID),
+		zap.Stringer("blkID", blkID),
+	)
+	t.Sender.SendGetAncestors(ctx, nodeID, t.RequestID, blkID)
+
+	// Tracks performance statistics
+	t.metrics.numRequests.Set(float64(t.blkReqs.Len()))
+}
+
 // send a pull query for this block ID
 func (t *Transitive) pullQuery(ctx context.Context, blkID ids.ID) {
 	t.Ctx.Log.Verbo("sampling from validators",
 		zap.Stringer("validators", t.Validators),
 	)
 	// The validators we will query
-	vdrIDs, err := t.Validators.Sample(t.Params.K)
+	vdrBag, err := t.sampleValidators()
 	if err != nil {
 		t.Ctx.Log.Error("dropped query for block",
 			zap.String("reason", "insufficient number of validators"),
@@ -700,8 +962,6 @@ func (t *Transitive) pullQuery(ctx context.Context, blkID ids.ID) {
 		return
 	}
 
-	vdrBag := bag.Of(vdrIDs...)
-
 	t.RequestID++
 	if t.polls.Add(t.RequestID, vdrBag) {
 		vdrList := vdrBag.List()
@@ -720,7 +980,7 @@ func (t *Transitive) sendQuery(ctx context.Context, blk snowman.Block, push bool
 	)
 
 	blkID := blk.ID()
-	vdrIDs, err := t.Validators.Sample(t.Params.K)
+	vdrBag, err := t.sampleValidators()
 	if err != nil {
 		t.Ctx.Log.Error("dropped query for block",
 			zap.String("reason", "insufficient number of validators"),
@@ -729,8 +989,6 @@ func (t *Transitive) sendQuery(ctx context.Context, blk snowman.Block, push bool
 		return
 	}
 
-	vdrBag := bag.Of(vdrIDs...)
-
 	t.RequestID++
 	if t.polls.Add(t.RequestID, vdrBag) {
 		vdrs := vdrBag.List()
@@ -849,6 +1107,25 @@ func (t *Transitive) deliver(ctx context.Context, blk snowman.Block, push bool)
 	return t.errs.Err
 }
 
+// isKnownInvalid returns true if [blkBytes] recently failed verification, in
+// which case gossip of the block is dropped without being parsed. Blocks that
+// we requested are always parsed, as verification may pass once the block's
+// ancestry has been fetched.
+func (t *Transitive) isKnownInvalid(nodeID ids.NodeID, requestID uint32, blkBytes []byte) bool {
+	verifyErr, ok := t.invalidBlks.Get(hashing.ComputeHash256Array(blkBytes))
+	if !ok {
+		return false
+	}
+
+	t.Ctx.Log.Debug("dropping block that previously failed verification",
+		zap.Stringer("nodeID", nodeID),
+		zap.Uint32("requestID", requestID),
+		zap.NamedError("verifyErr", verifyErr),
+	)
+	t.metrics.numInvalidBlksDropped.Inc()
+	return true
+}
+
 // Returns true if the block whose ID is [blkID] is waiting to be issued to consensus
 func (t *Transitive) pendingContains(blkID ids.ID) bool {
 	_, ok := t.pending[blkID]
@@ -887,6 +1164,7 @@ func (t *Transitive) addUnverifiedBlockToConsensus(ctx context.Context, blk snow
 
 		// if verify fails, then all descendants are also invalid
 		t.addToNonVerifieds(blk)
+		t.invalidBlks.Put(hashing.ComputeHash256Array(blk.Bytes()), err)
 		return false, nil
 	}
 
@@ -894,6 +1172,9 @@ func (t *Transitive) addUnverifiedBlockToConsensus(ctx context.Context, blk snow
 	t.nonVerifieds.Remove(blkID)
 	t.nonVerifiedCache.Evict(blkID)
 	t.metrics.numNonVerifieds.Set(float64(t.nonVerifieds.Len()))
+	if err := t.journal.Add(blk); err != nil {
+		return false, err
+	}
 	t.Ctx.Log.Verbo("adding block to consensus",
 		zap.Stringer("blkID", blkID),
 	)
@@ -901,5 +1182,6 @@ func (t *Transitive) addUnverifiedBlockToConsensus(ctx context.Context, blk snow
 		Block:   blk,
 		metrics: &t.metrics,
 		tree:    t.nonVerifieds,
+		journal: t.journal,
 	})
 }
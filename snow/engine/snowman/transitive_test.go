@@ -9,6 +9,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
 	"github.com/stretchr/testify/require"
 
 	"github.com/DioneProtocol/odysseygo/ids"
@@ -160,6 +162,12 @@ func TestEngineAdd(t *testing.T) {
 	require.True(*asked)
 	require.Len(te.blocked, 1)
 
+	outstanding := te.OutstandingRequests()
+	require.Len(outstanding, 1)
+	require.Equal(vdr, outstanding[0].NodeID)
+	require.Equal(*reqID, outstanding[0].RequestID)
+	require.Equal(parent.ID(), outstanding[0].ContainerID)
+
 	vm.ParseBlockF = func(context.Context, []byte) (snowman.Block, error) {
 		return nil, errUnknownBytes
 	}
@@ -169,6 +177,7 @@ func TestEngineAdd(t *testing.T) {
 	vm.ParseBlockF = nil
 
 	require.Empty(te.blocked)
+	require.Empty(te.OutstandingRequests())
 }
 
 func TestEngineQuery(t *testing.T) {
@@ -187,7 +196,7 @@ func TestEngineQuery(t *testing.T) {
 	}
 
 	chitted := new(bool)
-	sender.SendChitsF = func(_ context.Context, inVdr ids.NodeID, requestID uint32, vote ids.ID, accepted ids.ID) {
+	sender.SendChitsF = func(_ context.Context, inVdr ids.NodeID, requestID uint32, vote ids.ID, accepted ids.ID, _ uint64, _ uint64) {
 		require.False(*chitted)
 		*chitted = true
 		require.Equal(uint32(15), requestID)
@@ -272,7 +281,7 @@ func TestEngineQuery(t *testing.T) {
 		require.Equal(vdr, inVdr)
 		require.Equal(blk1.ID(), blkID)
 	}
-	require.NoError(te.Chits(context.Background(), vdr, *queryRequestID, blk1.ID(), blk1.ID()))
+	require.NoError(te.Chits(context.Background(), vdr, *queryRequestID, blk1.ID(), blk1.ID(), 0, 0))
 
 	*queried = false
 	*queryRequestID = 0
@@ -327,6 +336,7 @@ func TestEngineMultipleQuery(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	vals := validators.NewSet()
@@ -438,8 +448,8 @@ func TestEngineMultipleQuery(t *testing.T) {
 		require.Equal(vdr0, inVdr)
 		require.Equal(blk1.ID(), blkID)
 	}
-	require.NoError(te.Chits(context.Background(), vdr0, *queryRequestID, blk1.ID(), blk1.ID()))
-	require.NoError(te.Chits(context.Background(), vdr1, *queryRequestID, blk1.ID(), blk1.ID()))
+	require.NoError(te.Chits(context.Background(), vdr0, *queryRequestID, blk1.ID(), blk1.ID(), 0, 0))
+	require.NoError(te.Chits(context.Background(), vdr1, *queryRequestID, blk1.ID(), blk1.ID(), 0, 0))
 
 	vm.ParseBlockF = func(context.Context, []byte) (snowman.Block, error) {
 		vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
@@ -470,7 +480,7 @@ func TestEngineMultipleQuery(t *testing.T) {
 	require.NoError(te.Put(context.Background(), vdr0, *getRequestID, blk1.Bytes()))
 
 	// Should be dropped because the query was already filled
-	require.NoError(te.Chits(context.Background(), vdr2, *queryRequestID, blk0.ID(), blk0.ID()))
+	require.NoError(te.Chits(context.Background(), vdr2, *queryRequestID, blk0.ID(), blk0.ID(), 0, 0))
 
 	require.Equal(choices.Accepted, blk1.Status())
 	require.Empty(te.blocked)
@@ -617,7 +627,7 @@ func TestEnginePushQuery(t *testing.T) {
 	}
 
 	chitted := new(bool)
-	sender.SendChitsF = func(_ context.Context, inVdr ids.NodeID, requestID uint32, vote ids.ID, accepted ids.ID) {
+	sender.SendChitsF = func(_ context.Context, inVdr ids.NodeID, requestID uint32, vote ids.ID, accepted ids.ID, _ uint64, _ uint64) {
 		require.False(*chitted)
 		*chitted = true
 		require.Equal(vdr, inVdr)
@@ -722,6 +732,7 @@ func TestVoteCanceling(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	vals := validators.NewSet()
@@ -966,7 +977,7 @@ func TestEngineAbandonChit(t *testing.T) {
 	}
 
 	// Register a voter dependency on an unknown block.
-	require.NoError(te.Chits(context.Background(), vdr, reqID, fakeBlkID, fakeBlkID))
+	require.NoError(te.Chits(context.Background(), vdr, reqID, fakeBlkID, fakeBlkID, 0, 0))
 	require.Len(te.blocked, 1)
 
 	sender.CantSendPullQuery = false
@@ -1021,7 +1032,7 @@ func TestEngineAbandonChitWithUnexpectedPutBlock(t *testing.T) {
 	}
 
 	// Register a voter dependency on an unknown block.
-	require.NoError(te.Chits(context.Background(), vdr, reqID, fakeBlkID, fakeBlkID))
+	require.NoError(te.Chits(context.Background(), vdr, reqID, fakeBlkID, fakeBlkID, 0, 0))
 	require.Len(te.blocked, 1)
 
 	sender.CantSendPullQuery = false
@@ -1169,7 +1180,7 @@ func TestEngineBlockingChitResponse(t *testing.T) {
 	sender.SendPushQueryF = nil
 	sender.CantSendPushQuery = false
 
-	require.NoError(te.Chits(context.Background(), vdr, *queryRequestID, blockingBlk.ID(), blockingBlk.ID()))
+	require.NoError(te.Chits(context.Background(), vdr, *queryRequestID, blockingBlk.ID(), blockingBlk.ID(), 0, 0))
 
 	require.Len(te.blocked, 2)
 	sender.CantSendPullQuery = false
@@ -1274,7 +1285,7 @@ func TestEngineUndeclaredDependencyDeadlock(t *testing.T) {
 	require.NoError(te.issue(context.Background(), validBlk, false))
 	sender.SendPushQueryF = nil
 	require.NoError(te.issue(context.Background(), invalidBlk, false))
-	require.NoError(te.Chits(context.Background(), vdr, *reqID, invalidBlkID, invalidBlkID))
+	require.NoError(te.Chits(context.Background(), vdr, *reqID, invalidBlkID, invalidBlkID, 0, 0))
 
 	require.Equal(choices.Accepted, validBlk.Status())
 }
@@ -1590,6 +1601,7 @@ func TestEngineDoubleChit(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	vals := validators.NewSet()
@@ -1670,13 +1682,13 @@ func TestEngineDoubleChit(t *testing.T) {
 
 	require.Equal(choices.Processing, blk.Status())
 
-	require.NoError(te.Chits(context.Background(), vdr0, *queryRequestID, blk.ID(), blk.ID()))
+	require.NoError(te.Chits(context.Background(), vdr0, *queryRequestID, blk.ID(), blk.ID(), 0, 0))
 	require.Equal(choices.Processing, blk.Status())
 
-	require.NoError(te.Chits(context.Background(), vdr0, *queryRequestID, blk.ID(), blk.ID()))
+	require.NoError(te.Chits(context.Background(), vdr0, *queryRequestID, blk.ID(), blk.ID(), 0, 0))
 	require.Equal(choices.Processing, blk.Status())
 
-	require.NoError(te.Chits(context.Background(), vdr1, *queryRequestID, blk.ID(), blk.ID()))
+	require.NoError(te.Chits(context.Background(), vdr1, *queryRequestID, blk.ID(), blk.ID(), 0, 0))
 	require.Equal(choices.Accepted, blk.Status())
 }
 
@@ -1796,7 +1808,7 @@ func TestEngineBuildBlockLimit(t *testing.T) {
 		}
 	}
 
-	require.NoError(te.Chits(context.Background(), vdr, reqID, blk0.ID(), blk0.ID()))
+	require.NoError(te.Chits(context.Background(), vdr, reqID, blk0.ID(), blk0.ID(), 0, 0))
 
 	require.True(queried)
 }
@@ -1872,7 +1884,7 @@ func TestEngineReceiveNewRejectedBlock(t *testing.T) {
 
 	require.True(asked)
 
-	require.NoError(te.Chits(context.Background(), vdr, reqID, acceptedBlk.ID(), acceptedBlk.ID()))
+	require.NoError(te.Chits(context.Background(), vdr, reqID, acceptedBlk.ID(), acceptedBlk.ID(), 0, 0))
 
 	sender.SendPullQueryF = nil
 	asked = false
@@ -1975,7 +1987,7 @@ func TestEngineRejectionAmplification(t *testing.T) {
 		}
 	}
 
-	require.NoError(te.Chits(context.Background(), vdr, reqID, acceptedBlk.ID(), acceptedBlk.ID()))
+	require.NoError(te.Chits(context.Background(), vdr, reqID, acceptedBlk.ID(), acceptedBlk.ID(), 0, 0))
 
 	require.True(te.Consensus.Finalized())
 
@@ -2078,7 +2090,7 @@ func TestEngineTransitiveRejectionAmplificationDueToRejectedParent(t *testing.T)
 
 	require.True(queried)
 
-	require.NoError(te.Chits(context.Background(), vdr, reqID, acceptedBlk.ID(), acceptedBlk.ID()))
+	require.NoError(te.Chits(context.Background(), vdr, reqID, acceptedBlk.ID(), acceptedBlk.ID(), 0, 0))
 
 	require.True(te.Consensus.Finalized())
 
@@ -2174,7 +2186,7 @@ func TestEngineTransitiveRejectionAmplificationDueToInvalidParent(t *testing.T)
 		}
 	}
 
-	require.NoError(te.Chits(context.Background(), vdr, reqID, acceptedBlk.ID(), acceptedBlk.ID()))
+	require.NoError(te.Chits(context.Background(), vdr, reqID, acceptedBlk.ID(), acceptedBlk.ID(), 0, 0))
 
 	require.NoError(te.Put(context.Background(), vdr, 0, pendingBlk.Bytes()))
 	require.True(te.Consensus.Finalized())
@@ -2367,7 +2379,7 @@ func TestEngineBubbleVotesThroughInvalidBlock(t *testing.T) {
 
 	// Now we are expecting a Chits message, and we receive it for [blk2]
 	// instead of [blk1]. This will cause the node to again request [blk2].
-	require.NoError(te.Chits(context.Background(), vdr, *queryRequestID, blk2.ID(), blk2.ID()))
+	require.NoError(te.Chits(context.Background(), vdr, *queryRequestID, blk2.ID(), blk2.ID(), 0, 0))
 
 	// The votes should be bubbled through [blk2] despite the fact that it is
 	// failing verification.
@@ -2405,7 +2417,7 @@ func TestEngineBubbleVotesThroughInvalidBlock(t *testing.T) {
 	require.True(*queried)
 
 	// After a single vote for [blk2], it should be marked as accepted.
-	require.NoError(te.Chits(context.Background(), vdr, *queryRequestID, blk2.ID(), blk2.ID()))
+	require.NoError(te.Chits(context.Background(), vdr, *queryRequestID, blk2.ID(), blk2.ID(), 0, 0))
 	require.Equal(choices.Accepted, blk2.Status())
 }
 
@@ -2546,7 +2558,7 @@ func TestEngineBubbleVotesThroughInvalidChain(t *testing.T) {
 
 	// Now we are expecting a Chits message and we receive it for [blk3].
 	// This will cause the node to again request [blk3].
-	require.NoError(te.Chits(context.Background(), vdr, *queryRequestID, blk3.ID(), blk3.ID()))
+	require.NoError(te.Chits(context.Background(), vdr, *queryRequestID, blk3.ID(), blk3.ID(), 0, 0))
 
 	// Drop the re-request for [blk3] to cause the poll to terminate. The votes
 	// should be bubbled through [blk3] despite the fact that it hasn't been
@@ -2676,8 +2688,8 @@ func TestEngineBuildBlockWithCachedNonVerifiedParent(t *testing.T) {
 	require.NoError(te.Put(context.Background(), vdr, 0, parentBlkA.BytesV))
 
 	// Give 2 chits for [parentBlkA]/[parentBlkB]
-	require.NoError(te.Chits(context.Background(), vdr, *queryRequestAID, parentBlkB.IDV, parentBlkB.IDV))
-	require.NoError(te.Chits(context.Background(), vdr, *queryRequestGPID, parentBlkB.IDV, parentBlkB.IDV))
+	require.NoError(te.Chits(context.Background(), vdr, *queryRequestAID, parentBlkB.IDV, parentBlkB.IDV, 0, 0))
+	require.NoError(te.Chits(context.Background(), vdr, *queryRequestGPID, parentBlkB.IDV, parentBlkB.IDV, 0, 0))
 
 	// Assert that the blocks' statuses are correct.
 	// The evicted [parentBlkA] shouldn't be changed.
@@ -2711,6 +2723,7 @@ func TestEngineApplyAcceptedFrontierInQueryFailed(t *testing.T) {
 		OptimalProcessing:     1,
 		MaxOutstandingItems:   1,
 		MaxItemProcessingTime: 1,
+		MaxProcessingDepth:    1,
 	}
 
 	vals := validators.NewSet()
@@ -2789,7 +2802,7 @@ func TestEngineApplyAcceptedFrontierInQueryFailed(t *testing.T) {
 		*queryRequestID = requestID
 	}
 
-	require.NoError(te.Chits(context.Background(), vdr, *queryRequestID, blk.ID(), blk.ID()))
+	require.NoError(te.Chits(context.Background(), vdr, *queryRequestID, blk.ID(), blk.ID(), 0, 0))
 
 	require.Equal(choices.Processing, blk.Status())
 
@@ -2797,3 +2810,381 @@ func TestEngineApplyAcceptedFrontierInQueryFailed(t *testing.T) {
 
 	require.Equal(choices.Accepted, blk.Status())
 }
+
+func TestEngineRestoreJournal(t *testing.T) {
+	require := require.New(t)
+
+	_, _, sender, vm, te, gBlk := setupDefaultConfig(t)
+	sender.SendPushQueryF = func(context.Context, set.Set[ids.NodeID], uint32, []byte) {}
+	sender.SendPullQueryF = func(context.Context, set.Set[ids.NodeID], uint32, ids.ID) {}
+
+	blk1 := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.GenerateTestID(),
+			StatusV: choices.Processing,
+		},
+		ParentV: gBlk.ID(),
+		HeightV: 1,
+		BytesV:  []byte{1},
+	}
+	blk2 := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.GenerateTestID(),
+			StatusV: choices.Processing,
+		},
+		ParentV: blk1.ID(),
+		HeightV: 2,
+		BytesV:  []byte{2},
+	}
+	// The parent of [orphan] isn't known, so it can't be restored
+	orphan := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.GenerateTestID(),
+			StatusV: choices.Processing,
+		},
+		ParentV: ids.GenerateTestID(),
+		HeightV: 5,
+		BytesV:  []byte{5},
+	}
+	blks := []*snowman.TestBlock{blk2, orphan, blk1}
+	for _, blk := range blks {
+		require.NoError(te.journal.Add(blk))
+	}
+
+	vm.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		for _, blk := range blks {
+			if bytes.Equal(b, blk.Bytes()) {
+				return blk, nil
+			}
+		}
+		return nil, errUnknownBytes
+	}
+	vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		switch blkID {
+		case gBlk.ID():
+			return gBlk, nil
+		case blk1.ID():
+			return blk1, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+
+	require.NoError(te.restoreJournal(context.Background()))
+	require.True(te.Consensus.Processing(blk1.ID()))
+	require.True(te.Consensus.Processing(blk2.ID()))
+	require.False(te.Consensus.Processing(orphan.ID()))
+	require.Empty(te.pending)
+
+	// Only the restored blocks remain journaled
+	journaled, err := te.journal.Blocks()
+	require.NoError(err)
+	require.ElementsMatch([][]byte{blk1.Bytes(), blk2.Bytes()}, journaled)
+}
+
+func TestEngineBuildBlockProcessingDepthLimit(t *testing.T) {
+	require := require.New(t)
+
+	commonCfg := common.DefaultConfigTest()
+	engCfg := DefaultConfigs()
+	engCfg.Params.MaxProcessingDepth = 1
+	vdr, _, sender, vm, te, gBlk := setup(t, commonCfg, engCfg)
+
+	blk0 := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.GenerateTestID(),
+			StatusV: choices.Processing,
+		},
+		ParentV: gBlk.ID(),
+		HeightV: 1,
+		BytesV:  []byte{1},
+	}
+	blk1 := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.GenerateTestID(),
+			StatusV: choices.Processing,
+		},
+		ParentV: blk0.ID(),
+		HeightV: 2,
+		BytesV:  []byte{2},
+	}
+	blks := []snowman.Block{blk0, blk1}
+
+	var reqID uint32
+	sender.SendPushQueryF = func(_ context.Context, _ set.Set[ids.NodeID], rID uint32, _ []byte) {
+		reqID = rID
+	}
+	vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		switch blkID {
+		case gBlk.ID():
+			return gBlk, nil
+		case blk0.ID():
+			return blk0, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+
+	blkToReturn := 0
+	vm.BuildBlockF = func(context.Context) (snowman.Block, error) {
+		require.Less(blkToReturn, len(blks))
+		blk := blks[blkToReturn]
+		blkToReturn++
+		return blk, nil
+	}
+
+	require.NoError(te.Notify(context.Background(), common.PendingTxs))
+	require.Equal(1, blkToReturn)
+
+	// [blk0] is at the maximum processing depth, so no block is built
+	require.NoError(te.Notify(context.Background(), common.PendingTxs))
+	require.Equal(1, blkToReturn)
+
+	// Accepting [blk0] allows the pending block to be built
+	require.NoError(te.Chits(context.Background(), vdr, reqID, blk0.ID(), blk0.ID(), 0, 0))
+	require.Equal(choices.Accepted, blk0.Status())
+	require.Equal(2, blkToReturn)
+}
+
+func TestEngineChitsIncludeHeights(t *testing.T) {
+	require := require.New(t)
+
+	commonCfg := common.DefaultConfigTest()
+	engCfg := DefaultConfigs()
+	engCfg.IncludeChitsHeights = true
+	vdr, _, sender, vm, te, gBlk := setup(t, commonCfg, engCfg)
+	sender.Default(false)
+
+	blk := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.GenerateTestID(),
+			StatusV: choices.Processing,
+		},
+		ParentV: gBlk.ID(),
+		HeightV: 1,
+		BytesV:  []byte{1},
+	}
+
+	vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		switch blkID {
+		case gBlk.ID():
+			return gBlk, nil
+		case blk.ID():
+			return blk, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+	vm.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		require.Equal(blk.Bytes(), b)
+		return blk, nil
+	}
+	require.NoError(te.Put(context.Background(), vdr, 0, blk.Bytes()))
+	require.Equal(blk.ID(), te.Consensus.Preference())
+
+	chitted := false
+	sender.SendChitsF = func(_ context.Context, _ ids.NodeID, requestID uint32, preferredID ids.ID, acceptedID ids.ID, preferredHeight uint64, acceptedHeight uint64) {
+		chitted = true
+		require.Equal(uint32(15), requestID)
+		require.Equal(blk.ID(), preferredID)
+		require.Equal(gBlk.ID(), acceptedID)
+		require.Equal(blk.Height(), preferredHeight)
+		require.Equal(gBlk.Height(), acceptedHeight)
+	}
+	require.NoError(te.PullQuery(context.Background(), vdr, 15, blk.ID()))
+	require.True(chitted)
+}
+
+func TestEngineFetchAncestorsWhenFarBehind(t *testing.T) {
+	require := require.New(t)
+
+	vdr, _, sender, vm, te, gBlk := setupDefaultConfig(t)
+	sender.Default(false)
+
+	blks := make([]*snowman.TestBlock, 3)
+	parent := gBlk
+	for i := range blks {
+		blks[i] = &snowman.TestBlock{
+			TestDecidable: choices.TestDecidable{
+				IDV:     ids.GenerateTestID(),
+				StatusV: choices.Processing,
+			},
+			ParentV: parent.ID(),
+			HeightV: parent.Height() + 1,
+			BytesV:  []byte{byte(i)},
+		}
+		parent = blks[i]
+	}
+	tip := blks[len(blks)-1]
+
+	parsed := map[ids.ID]snowman.Block{
+		gBlk.ID(): gBlk,
+	}
+	vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		if blk, ok := parsed[blkID]; ok {
+			return blk, nil
+		}
+		return nil, errUnknownBlock
+	}
+	vm.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		for _, blk := range blks {
+			if bytes.Equal(blk.Bytes(), b) {
+				parsed[blk.ID()] = blk
+				return blk, nil
+			}
+		}
+		return nil, errUnknownBytes
+	}
+
+	// A peer that isn't far ahead of us has its preference fetched directly
+	sender.SendGetAncestorsF = func(context.Context, ids.NodeID, uint32, ids.ID) {
+		require.FailNow("unexpectedly called SendGetAncestors")
+	}
+	var (
+		getRequested bool
+		reqID        uint32
+	)
+	sender.SendGetF = func(_ context.Context, _ ids.NodeID, requestID uint32, blkID ids.ID) {
+		getRequested = true
+		reqID = requestID
+		require.Equal(tip.ID(), blkID)
+	}
+	require.NoError(te.Chits(context.Background(), vdr, 0, tip.ID(), tip.ID(), farBehindThreshold, farBehindThreshold))
+	require.True(getRequested)
+	require.NoError(te.GetFailed(context.Background(), vdr, reqID))
+
+	// A peer that is far ahead of us has the ancestry of its preference
+	// fetched in bulk
+	sender.SendGetF = func(context.Context, ids.NodeID, uint32, ids.ID) {
+		require.FailNow("unexpectedly called SendGet")
+	}
+	var ancestorsRequested bool
+	sender.SendGetAncestorsF = func(_ context.Context, inVdr ids.NodeID, requestID uint32, blkID ids.ID) {
+		ancestorsRequested = true
+		reqID = requestID
+		require.Equal(vdr, inVdr)
+		require.Equal(tip.ID(), blkID)
+	}
+	require.NoError(te.Chits(context.Background(), vdr, 0, tip.ID(), tip.ID(), farBehindThreshold+1, farBehindThreshold+1))
+	require.True(ancestorsRequested)
+
+	require.NoError(te.Ancestors(context.Background(), vdr, reqID, [][]byte{
+		blks[2].Bytes(),
+		blks[1].Bytes(),
+		blks[0].Bytes(),
+	}))
+	for _, blk := range blks {
+		require.True(te.Consensus.Processing(blk.ID()))
+	}
+	require.Empty(te.OutstandingRequests())
+}
+
+func TestEngineFetchMissingAncestorsInBulk(t *testing.T) {
+	require := require.New(t)
+
+	vdr, _, sender, vm, te, gBlk := setupDefaultConfig(t)
+	sender.Default(false)
+
+	blks := make([]*snowman.TestBlock, missingAncestorsThreshold+2)
+	parent := gBlk
+	for i := range blks {
+		blks[i] = &snowman.TestBlock{
+			TestDecidable: choices.TestDecidable{
+				IDV:     ids.GenerateTestID(),
+				StatusV: choices.Processing,
+			},
+			ParentV: parent.ID(),
+			HeightV: parent.Height() + 1,
+			BytesV:  []byte{byte(i)},
+		}
+		parent = blks[i]
+	}
+	tip := blks[len(blks)-1]
+
+	parsed := map[ids.ID]snowman.Block{
+		gBlk.ID(): gBlk,
+	}
+	vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		if blk, ok := parsed[blkID]; ok {
+			return blk, nil
+		}
+		return nil, errUnknownBlock
+	}
+	vm.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		for _, blk := range blks {
+			if bytes.Equal(blk.Bytes(), b) {
+				parsed[blk.ID()] = blk
+				return blk, nil
+			}
+		}
+		return nil, errUnknownBytes
+	}
+
+	// The tip's parent is far enough past our last accepted block that its
+	// ancestry is fetched in bulk
+	sender.SendGetF = func(context.Context, ids.NodeID, uint32, ids.ID) {
+		require.FailNow("unexpectedly called SendGet")
+	}
+	var (
+		ancestorsRequested bool
+		reqID              uint32
+	)
+	sender.SendGetAncestorsF = func(_ context.Context, inVdr ids.NodeID, requestID uint32, blkID ids.ID) {
+		ancestorsRequested = true
+		reqID = requestID
+		require.Equal(vdr, inVdr)
+		require.Equal(tip.Parent(), blkID)
+	}
+	require.NoError(te.PushQuery(context.Background(), vdr, 0, tip.Bytes()))
+	require.True(ancestorsRequested)
+
+	ancestors := make([][]byte, 0, len(blks)-1)
+	for i := len(blks) - 2; i >= 0; i-- {
+		ancestors = append(ancestors, blks[i].Bytes())
+	}
+	require.NoError(te.Ancestors(context.Background(), vdr, reqID, ancestors))
+	for _, blk := range blks {
+		require.True(te.Consensus.Processing(blk.ID()))
+	}
+	require.Empty(te.OutstandingRequests())
+}
+
+func TestEngineDropGossipedInvalidBlock(t *testing.T) {
+	require := require.New(t)
+
+	vdr, _, sender, vm, te, gBlk := setupDefaultConfig(t)
+	sender.Default(false)
+
+	blk := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.GenerateTestID(),
+			StatusV: choices.Processing,
+		},
+		ParentV: gBlk.ID(),
+		HeightV: gBlk.Height() + 1,
+		VerifyV: errTest, // Reports as invalid
+		BytesV:  []byte{1},
+	}
+
+	numParsed := 0
+	vm.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		require.Equal(blk.Bytes(), b)
+		numParsed++
+		return blk, nil
+	}
+	vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		if blkID == gBlk.ID() {
+			return gBlk, nil
+		}
+		return nil, errUnknownBlock
+	}
+
+	require.NoError(te.PushQuery(context.Background(), vdr, 0, blk.Bytes()))
+	require.Equal(1, numParsed)
+	require.False(te.Consensus.Processing(blk.ID()))
+
+	// Repeated gossip of the invalid block isn't parsed again
+	require.NoError(te.PushQuery(context.Background(), vdr, 1, blk.Bytes()))
+	require.Equal(1, numParsed)
+	require.Equal(float64(1), testutil.ToFloat64(te.numInvalidBlksDropped))
+}
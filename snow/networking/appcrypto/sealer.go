@@ -0,0 +1,244 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package appcrypto
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/staking"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
+	"github.com/DioneProtocol/odysseygo/utils/wrappers"
+)
+
+const (
+	keyLen   = 32
+	nonceLen = 12
+)
+
+var (
+	_ Sealer = (*sealer)(nil)
+
+	errUnknownCertificate = errors.New("unknown staking certificate")
+	errUnsupportedKey     = errors.New("unsupported staking key type")
+	errInvalidSignature   = errors.New("invalid envelope signature")
+	errTrailingBytes      = errors.New("trailing bytes in envelope")
+)
+
+// Certificates provides the staking certificates of peers.
+type Certificates interface {
+	// PeerCertificate returns the staking certificate of [nodeID], if it is
+	// currently known.
+	PeerCertificate(nodeID ids.NodeID) (*staking.Certificate, bool)
+}
+
+// Sealer encrypts and authenticates application messages exchanged between
+// two nodes using keys derived from their staking TLS identities.
+//
+// A sealed message can only be opened by the recipient it was sealed for and
+// is signed by the staking key of its sender.
+type Sealer interface {
+	// Seal returns [msg], sent on [chainID], encrypted for [recipient] and
+	// signed by this node.
+	Seal(chainID ids.ID, recipient ids.NodeID, msg []byte) ([]byte, error)
+
+	// Open returns the message that [sender] sealed for this node on
+	// [chainID]. An error is returned if the envelope wasn't sealed for this
+	// node by [sender].
+	Open(chainID ids.ID, sender ids.NodeID, envelope []byte) ([]byte, error)
+}
+
+type sealer struct {
+	nodeID ids.NodeID
+	signer crypto.Signer
+	certs  Certificates
+}
+
+// New returns a Sealer for the node [nodeID] whose staking key is [signer].
+// The staking key must be either an RSA or an ECDSA key.
+func New(nodeID ids.NodeID, signer crypto.Signer, certs Certificates) (Sealer, error) {
+	switch signer.(type) {
+	case *rsa.PrivateKey, *ecdsa.PrivateKey:
+	default:
+		return nil, fmt.Errorf("%w: %T", errUnsupportedKey, signer)
+	}
+	return &sealer{
+		nodeID: nodeID,
+		signer: signer,
+		certs:  certs,
+	}, nil
+}
+
+func (s *sealer) Seal(chainID ids.ID, recipient ids.NodeID, msg []byte) ([]byte, error) {
+	cert, ok := s.certs.PeerCertificate(recipient)
+	if !ok {
+		return nil, fmt.Errorf("%w for %s", errUnknownCertificate, recipient)
+	}
+
+	key, encapsulatedKey, err := encapsulateKey(cert.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, nonceLen)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	header := envelopeHeader(chainID, s.nodeID, recipient, encapsulatedKey, nonce)
+	ciphertext := aead.Seal(nil, nonce, msg, header)
+
+	signature, err := s.signer.Sign(
+		rand.Reader,
+		hashing.ComputeHash256(append(header, ciphertext...)),
+		crypto.SHA256,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't sign envelope: %w", err)
+	}
+
+	size := 3*wrappers.IntLen + len(encapsulatedKey) + nonceLen + len(ciphertext) + len(signature)
+	p := wrappers.Packer{
+		Bytes:   make([]byte, 0, size),
+		MaxSize: size,
+	}
+	p.PackBytes(encapsulatedKey)
+	p.PackFixedBytes(nonce)
+	p.PackBytes(ciphertext)
+	p.PackBytes(signature)
+	return p.Bytes, p.Err
+}
+
+func (s *sealer) Open(chainID ids.ID, sender ids.NodeID, envelope []byte) ([]byte, error) {
+	p := wrappers.Packer{Bytes: envelope}
+	encapsulatedKey := p.UnpackBytes()
+	nonce := p.UnpackFixedBytes(nonceLen)
+	ciphertext := p.UnpackBytes()
+	signature := p.UnpackBytes()
+	if p.Err != nil {
+		return nil, fmt.Errorf("couldn't parse envelope: %w", p.Err)
+	}
+	if p.Offset != len(envelope) {
+		return nil, errTrailingBytes
+	}
+
+	cert, ok := s.certs.PeerCertificate(sender)
+	if !ok {
+		return nil, fmt.Errorf("%w for %s", errUnknownCertificate, sender)
+	}
+
+	header := envelopeHeader(chainID, sender, s.nodeID, encapsulatedKey, nonce)
+	if err := staking.CheckSignature(cert, append(header, ciphertext...), signature); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidSignature, err)
+	}
+
+	key, err := s.decapsulateKey(encapsulatedKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Open(nil, nonce, ciphertext, header)
+}
+
+// encapsulateKey returns a new symmetric key along with its encapsulation for
+// the holder of the private key of [publicKey].
+func encapsulateKey(publicKey any) ([]byte, []byte, error) {
+	switch pub := publicKey.(type) {
+	case *rsa.PublicKey:
+		key := make([]byte, keyLen)
+		if _, err := rand.Read(key); err != nil {
+			return nil, nil, err
+		}
+		encapsulatedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, pub, key, nil)
+		return key, encapsulatedKey, err
+	case *ecdsa.PublicKey:
+		recipientKey, err := pub.ECDH()
+		if err != nil {
+			return nil, nil, err
+		}
+		ephemeralKey, err := recipientKey.Curve().GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+		sharedSecret, err := ephemeralKey.ECDH(recipientKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		encapsulatedKey := ephemeralKey.PublicKey().Bytes()
+		return deriveKey(sharedSecret, encapsulatedKey), encapsulatedKey, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: %T", errUnsupportedKey, publicKey)
+	}
+}
+
+// decapsulateKey returns the symmetric key that was encapsulated for this
+// node.
+func (s *sealer) decapsulateKey(encapsulatedKey []byte) ([]byte, error) {
+	switch priv := s.signer.(type) {
+	case *rsa.PrivateKey:
+		return priv.Decrypt(rand.Reader, encapsulatedKey, &rsa.OAEPOptions{
+			Hash: crypto.SHA256,
+		})
+	case *ecdsa.PrivateKey:
+		privateKey, err := priv.ECDH()
+		if err != nil {
+			return nil, err
+		}
+		ephemeralKey, err := privateKey.Curve().NewPublicKey(encapsulatedKey)
+		if err != nil {
+			return nil, err
+		}
+		sharedSecret, err := privateKey.ECDH(ephemeralKey)
+		if err != nil {
+			return nil, err
+		}
+		return deriveKey(sharedSecret, encapsulatedKey), nil
+	default:
+		return nil, fmt.Errorf("%w: %T", errUnsupportedKey, s.signer)
+	}
+}
+
+func deriveKey(sharedSecret []byte, encapsulatedKey []byte) []byte {
+	return hashing.ComputeHash256(append(sharedSecret, encapsulatedKey...))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// envelopeHeader returns the bytes that are authenticated, but not encrypted,
+// by an envelope. Binding the chain and both nodes prevents an envelope from
+// being replayed to a different chain or node.
+func envelopeHeader(
+	chainID ids.ID,
+	sender ids.NodeID,
+	recipient ids.NodeID,
+	encapsulatedKey []byte,
+	nonce []byte,
+) []byte {
+	header := make([]byte, 0, ids.IDLen+2*ids.NodeIDLen+len(encapsulatedKey)+len(nonce))
+	header = append(header, chainID[:]...)
+	header = append(header, sender[:]...)
+	header = append(header, recipient[:]...)
+	header = append(header, encapsulatedKey...)
+	return append(header, nonce...)
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package appcrypto
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/staking"
+)
+
+type testCertificates map[ids.NodeID]*staking.Certificate
+
+func (c testCertificates) PeerCertificate(nodeID ids.NodeID) (*staking.Certificate, bool) {
+	cert, ok := c[nodeID]
+	return cert, ok
+}
+
+func newRSAKey(t *testing.T) (crypto.Signer, *staking.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key, &staking.Certificate{
+		PublicKey:          &key.PublicKey,
+		SignatureAlgorithm: x509.SHA256WithRSA,
+	}
+}
+
+func newECDSAKey(t *testing.T) (crypto.Signer, *staking.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key, &staking.Certificate{
+		PublicKey:          &key.PublicKey,
+		SignatureAlgorithm: x509.ECDSAWithSHA256,
+	}
+}
+
+// newSealers returns sealers for two nodes that know each other's
+// certificates.
+func newSealers(
+	t *testing.T,
+	newKey func(*testing.T) (crypto.Signer, *staking.Certificate),
+) (ids.NodeID, Sealer, ids.NodeID, Sealer) {
+	require := require.New(t)
+
+	var (
+		nodeID0        = ids.GenerateTestNodeID()
+		nodeID1        = ids.GenerateTestNodeID()
+		signer0, cert0 = newKey(t)
+		signer1, cert1 = newKey(t)
+		certs          = testCertificates{
+			nodeID0: cert0,
+			nodeID1: cert1,
+		}
+	)
+
+	sealer0, err := New(nodeID0, signer0, certs)
+	require.NoError(err)
+	sealer1, err := New(nodeID1, signer1, certs)
+	require.NoError(err)
+	return nodeID0, sealer0, nodeID1, sealer1
+}
+
+func TestSealOpen(t *testing.T) {
+	tests := []struct {
+		name   string
+		newKey func(*testing.T) (crypto.Signer, *staking.Certificate)
+	}{
+		{
+			name:   "rsa",
+			newKey: newRSAKey,
+		},
+		{
+			name:   "ecdsa",
+			newKey: newECDSAKey,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			nodeID0, sealer0, nodeID1, sealer1 := newSealers(t, test.newKey)
+			chainID := ids.GenerateTestID()
+			msg := []byte("private subnet data")
+
+			envelope, err := sealer0.Seal(chainID, nodeID1, msg)
+			require.NoError(err)
+			require.NotContains(string(envelope), string(msg))
+
+			openedMsg, err := sealer1.Open(chainID, nodeID0, envelope)
+			require.NoError(err)
+			require.Equal(msg, openedMsg)
+		})
+	}
+}
+
+func TestOpenWrongContext(t *testing.T) {
+	require := require.New(t)
+
+	nodeID0, sealer0, nodeID1, sealer1 := newSealers(t, newECDSAKey)
+	chainID := ids.GenerateTestID()
+
+	envelope, err := sealer0.Seal(chainID, nodeID1, []byte{1, 2, 3})
+	require.NoError(err)
+
+	// Sealed for a different chain
+	_, err = sealer1.Open(ids.GenerateTestID(), nodeID0, envelope)
+	require.ErrorIs(err, errInvalidSignature)
+
+	// Claimed to be sent by a different node
+	_, err = sealer1.Open(chainID, nodeID1, envelope)
+	require.ErrorIs(err, errInvalidSignature)
+
+	// Sent by an unknown node
+	_, err = sealer1.Open(chainID, ids.GenerateTestNodeID(), envelope)
+	require.ErrorIs(err, errUnknownCertificate)
+
+	// Opened by a node it wasn't sealed for
+	_, err = sealer0.Open(chainID, nodeID0, envelope)
+	require.ErrorIs(err, errInvalidSignature)
+
+	// Tampered with in transit
+	envelope[len(envelope)/2] ^= 1
+	_, err = sealer1.Open(chainID, nodeID0, envelope)
+	require.ErrorIs(err, errInvalidSignature)
+}
+
+func TestSealUnknownRecipient(t *testing.T) {
+	_, sealer, _, _ := newSealers(t, newECDSAKey)
+
+	_, err := sealer.Seal(ids.GenerateTestID(), ids.GenerateTestNodeID(), nil)
+	require.ErrorIs(t, err, errUnknownCertificate)
+}
@@ -7,6 +7,7 @@ import (
 	"container/heap"
 	"fmt"
 	"math/rand"
+	"sort"
 	"sync"
 	"time"
 
@@ -24,6 +25,10 @@ import (
 	safemath "github.com/DioneProtocol/odysseygo/utils/math"
 )
 
+// failedQueriesReason is the reason given for nodes benched after
+// consecutive failed queries
+const failedQueriesReason = "consecutive failed queries"
+
 var _ heap.Interface = (*benchedQueue)(nil)
 
 // If a peer consistently does not respond to queries, it will
@@ -43,12 +48,30 @@ type Benchlist interface {
 	// IsBenched returns true if messages to [validatorID]
 	// should not be sent over the network and should immediately fail.
 	IsBenched(nodeID ids.NodeID) bool
+	// Bench benches [nodeID] for [duration], regardless of its failed
+	// queries and of the maximum portion of stake that may be benched. If
+	// [nodeID] is already benched, its expiry and reason are replaced.
+	Bench(nodeID ids.NodeID, duration time.Duration, reason string)
+	// Unbench removes [nodeID] from the bench. Returns false if [nodeID]
+	// wasn't benched.
+	Unbench(nodeID ids.NodeID) bool
+	// Benched returns the currently benched nodes, sorted by the time they
+	// leave the bench.
+	Benched() []BenchedNode
+}
+
+// BenchedNode describes a node that is currently benched
+type BenchedNode struct {
+	NodeID       ids.NodeID
+	Reason       string
+	BenchedUntil time.Time
 }
 
 // Data about a validator who is benched
 type benchData struct {
 	benchedUntil time.Time
 	nodeID       ids.NodeID
+	reason       string
 	index        int
 }
 
@@ -329,6 +352,86 @@ func (b *benchlist) bench(nodeID ids.NodeID) {
 	benchedUntil := minBenchedUntil.Add(time.Duration(rand.Float64() * float64(diff))) // #nosec G404
 
 	// Add to benchlist times with randomized delay
+	b.add(nodeID, benchedUntil, failedQueriesReason)
+	b.log.Debug("benching validator after consecutive failed queries",
+		zap.Stringer("nodeID", nodeID),
+		zap.Duration("benchDuration", benchedUntil.Sub(now)),
+		zap.Int("numFailedQueries", b.threshold),
+	)
+}
+
+// Bench benches [nodeID] until [duration] from now
+func (b *benchlist) Bench(nodeID ids.NodeID, duration time.Duration, reason string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	benchedUntil := b.clock.Time().Add(duration)
+	if node, ok := b.getBenchData(nodeID); ok {
+		node.benchedUntil = benchedUntil
+		node.reason = reason
+		heap.Fix(&b.benchedQueue, node.index)
+		b.setNextLeaveTime()
+	} else {
+		b.add(nodeID, benchedUntil, reason)
+	}
+	b.log.Info("benching node",
+		zap.Stringer("nodeID", nodeID),
+		zap.Duration("benchDuration", duration),
+		zap.String("reason", reason),
+	)
+}
+
+// Unbench removes [nodeID] from the bench
+func (b *benchlist) Unbench(nodeID ids.NodeID) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	node, ok := b.getBenchData(nodeID)
+	if !ok {
+		return false
+	}
+	b.remove(node)
+	b.setNextLeaveTime()
+	return true
+}
+
+// Benched returns the currently benched nodes
+func (b *benchlist) Benched() []BenchedNode {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	benched := make([]BenchedNode, len(b.benchedQueue))
+	for i, node := range b.benchedQueue {
+		benched[i] = BenchedNode{
+			NodeID:       node.nodeID,
+			Reason:       node.reason,
+			BenchedUntil: node.benchedUntil,
+		}
+	}
+	sort.Slice(benched, func(i, j int) bool {
+		return benched[i].BenchedUntil.Before(benched[j].BenchedUntil)
+	})
+	return benched
+}
+
+// Returns the bench data of [nodeID], if it is benched.
+// Assumes [b.lock] is held
+func (b *benchlist) getBenchData(nodeID ids.NodeID) (*benchData, bool) {
+	if !b.benchlistSet.Contains(nodeID) {
+		return nil, false
+	}
+	for _, node := range b.benchedQueue {
+		if node.nodeID == nodeID {
+			return node, true
+		}
+	}
+	return nil, false
+}
+
+// Add [nodeID] to the benchlist until [benchedUntil]
+// Assumes [b.lock] is held
+// Assumes [nodeID] is not already benched
+func (b *benchlist) add(nodeID ids.NodeID, benchedUntil time.Time, reason string) {
 	b.benchlistSet.Add(nodeID)
 	b.benchable.Benched(b.chainID, nodeID)
 
@@ -338,12 +441,7 @@ func (b *benchlist) bench(nodeID ids.NodeID) {
 
 	heap.Push(
 		&b.benchedQueue,
-		&benchData{nodeID: nodeID, benchedUntil: benchedUntil},
-	)
-	b.log.Debug("benching validator after consecutive failed queries",
-		zap.Stringer("nodeID", nodeID),
-		zap.Duration("benchDuration", benchedUntil.Sub(now)),
-		zap.Int("numFailedQueries", b.threshold),
+		&benchData{nodeID: nodeID, benchedUntil: benchedUntil, reason: reason},
 	)
 
 	// Set [b.timer] to fire when next validator should leave bench
@@ -351,5 +449,6 @@ func (b *benchlist) bench(nodeID ids.NodeID) {
 
 	// Update metrics
 	b.metrics.numBenched.Set(float64(b.benchedQueue.Len()))
-	b.metrics.weightBenched.Set(float64(newBenchedStake))
+	benchedStake := b.vdrs.SubsetWeight(b.benchlistSet)
+	b.metrics.weightBenched.Set(float64(benchedStake))
 }
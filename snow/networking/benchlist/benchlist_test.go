@@ -14,6 +14,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/set"
 )
 
 var minimumFailingDuration = 5 * time.Minute
@@ -392,3 +393,80 @@ func TestBenchlistRemove(t *testing.T) {
 
 	require.Equal(3, count)
 }
+
+// Test that nodes can be manually benched and unbenched
+func TestBenchlistManualBench(t *testing.T) {
+	require := require.New(t)
+
+	vdrs := validators.NewSet()
+	vdrID0 := ids.GenerateTestNodeID()
+	vdrID1 := ids.GenerateTestNodeID()
+	require.NoError(vdrs.Add(vdrID0, nil, ids.Empty, 50))
+	require.NoError(vdrs.Add(vdrID1, nil, ids.Empty, 50))
+
+	benched := set.Set[ids.NodeID]{}
+	benchable := &TestBenchable{
+		T: t,
+		BenchedF: func(_ ids.ID, nodeID ids.NodeID) {
+			benched.Add(nodeID)
+		},
+		UnbenchedF: func(_ ids.ID, nodeID ids.NodeID) {
+			benched.Remove(nodeID)
+		},
+	}
+
+	// A max portion of 0 prevents automatic benching, but not manual
+	// benching.
+	benchIntf, err := NewBenchlist(
+		ids.Empty,
+		logging.NoLog{},
+		benchable,
+		vdrs,
+		3,
+		minimumFailingDuration,
+		time.Minute,
+		0,
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+	b := benchIntf.(*benchlist)
+	defer b.timer.Stop()
+	now := time.Now()
+	b.clock.Set(now)
+
+	b.Bench(vdrID0, time.Hour, "flapping")
+	b.Bench(vdrID1, 2*time.Hour, "flapping")
+	require.True(b.IsBenched(vdrID0))
+	require.True(b.IsBenched(vdrID1))
+	require.Equal(set.Of(vdrID0, vdrID1), benched)
+
+	// Benching an already benched node replaces its expiry and reason
+	b.Bench(vdrID0, 3*time.Hour, "still flapping")
+	require.Equal(
+		[]BenchedNode{
+			{
+				NodeID:       vdrID1,
+				Reason:       "flapping",
+				BenchedUntil: now.Add(2 * time.Hour),
+			},
+			{
+				NodeID:       vdrID0,
+				Reason:       "still flapping",
+				BenchedUntil: now.Add(3 * time.Hour),
+			},
+		},
+		b.Benched(),
+	)
+
+	require.True(b.Unbench(vdrID1))
+	require.False(b.Unbench(vdrID1))
+	require.False(b.IsBenched(vdrID1))
+	require.Equal(set.Of(vdrID0), benched)
+
+	// The remaining node leaves the bench once its duration elapses
+	b.clock.Set(now.Add(3 * time.Hour))
+	b.update()
+	require.False(b.IsBenched(vdrID0))
+	require.Empty(b.Benched())
+	require.Empty(benched)
+}
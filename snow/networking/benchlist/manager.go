@@ -11,11 +11,14 @@ import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/subnets"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 )
 
 var (
 	errUnknownValidators = errors.New("unknown validator set for provided chain")
+	errUnknownChain      = errors.New("unknown chain")
+	errBenchlistDisabled = errors.New("benchlist is disabled")
 
 	_ Manager = (*manager)(nil)
 )
@@ -41,6 +44,14 @@ type Manager interface {
 	// [nodeID] is benched. If called on an id.ShortID that does
 	// not map to a validator, it will return an empty array.
 	GetBenched(nodeID ids.NodeID) []ids.ID
+	// Bench benches [nodeID] on chain [chainID] for [duration]
+	Bench(chainID ids.ID, nodeID ids.NodeID, duration time.Duration, reason string) error
+	// Unbench removes [nodeID] from the bench of chain [chainID]. Returns
+	// false if [nodeID] wasn't benched.
+	Unbench(chainID ids.ID, nodeID ids.NodeID) (bool, error)
+	// GetBenchedNodes returns the nodes that are currently benched on each
+	// chain. Chains without benched nodes are omitted.
+	GetBenchedNodes() map[ids.ID][]BenchedNode
 }
 
 // Config defines the configuration for a benchlist
@@ -52,6 +63,9 @@ type Config struct {
 	MinimumFailingDuration time.Duration      `json:"minimumFailingDuration"`
 	Duration               time.Duration      `json:"duration"`
 	MaxPortion             float64            `json:"maxPortion"`
+	// SubnetConfigs overrides the above parameters for the chains of a
+	// subnet
+	SubnetConfigs map[ids.ID]subnets.Config `json:"-"`
 }
 
 type manager struct {
@@ -66,8 +80,12 @@ type manager struct {
 // NewManager returns a manager for chain-specific query benchlisting
 func NewManager(config *Config) Manager {
 	// If the maximum portion of validators allowed to be benchlisted
-	// is 0, return the no-op benchlist
-	if config.MaxPortion <= 0 {
+	// is 0 on every subnet, return the no-op benchlist
+	enabled := config.MaxPortion > 0
+	for _, subnetConfig := range config.SubnetConfigs {
+		enabled = enabled || subnetConfig.BenchlistMaxPortion > 0
+	}
+	if !enabled {
 		return NewNoBenchlist()
 	}
 	return &manager{
@@ -129,15 +147,26 @@ func (m *manager) RegisterChain(ctx *snow.ConsensusContext) error {
 		return errUnknownValidators
 	}
 
+	threshold := m.config.Threshold
+	minimumFailingDuration := m.config.MinimumFailingDuration
+	duration := m.config.Duration
+	maxPortion := m.config.MaxPortion
+	if subnetConfig, ok := m.config.SubnetConfigs[ctx.SubnetID]; ok {
+		threshold = subnetConfig.BenchlistFailThreshold
+		minimumFailingDuration = subnetConfig.BenchlistMinFailingDuration
+		duration = subnetConfig.BenchlistDuration
+		maxPortion = subnetConfig.BenchlistMaxPortion
+	}
+
 	benchlist, err := NewBenchlist(
 		ctx.ChainID,
 		ctx.Log,
 		m.config.Benchable,
 		vdrs,
-		m.config.Threshold,
-		m.config.MinimumFailingDuration,
-		m.config.Duration,
-		m.config.MaxPortion,
+		threshold,
+		minimumFailingDuration,
+		duration,
+		maxPortion,
 		ctx.Registerer,
 	)
 	if err != nil {
@@ -170,6 +199,42 @@ func (m *manager) RegisterFailure(chainID ids.ID, nodeID ids.NodeID) {
 	benchlist.RegisterFailure(nodeID)
 }
 
+func (m *manager) Bench(chainID ids.ID, nodeID ids.NodeID, duration time.Duration, reason string) error {
+	m.lock.RLock()
+	benchlist, exists := m.chainBenchlists[chainID]
+	m.lock.RUnlock()
+
+	if !exists {
+		return errUnknownChain
+	}
+	benchlist.Bench(nodeID, duration, reason)
+	return nil
+}
+
+func (m *manager) Unbench(chainID ids.ID, nodeID ids.NodeID) (bool, error) {
+	m.lock.RLock()
+	benchlist, exists := m.chainBenchlists[chainID]
+	m.lock.RUnlock()
+
+	if !exists {
+		return false, errUnknownChain
+	}
+	return benchlist.Unbench(nodeID), nil
+}
+
+func (m *manager) GetBenchedNodes() map[ids.ID][]BenchedNode {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	benched := make(map[ids.ID][]BenchedNode)
+	for chainID, benchlist := range m.chainBenchlists {
+		if nodes := benchlist.Benched(); len(nodes) > 0 {
+			benched[chainID] = nodes
+		}
+	}
+	return benched
+}
+
 type noBenchlist struct{}
 
 // NewNoBenchlist returns an empty benchlist that will never stop any queries
@@ -192,3 +257,15 @@ func (noBenchlist) IsBenched(ids.NodeID, ids.ID) bool {
 func (noBenchlist) GetBenched(ids.NodeID) []ids.ID {
 	return []ids.ID{}
 }
+
+func (noBenchlist) Bench(ids.ID, ids.NodeID, time.Duration, string) error {
+	return errBenchlistDisabled
+}
+
+func (noBenchlist) Unbench(ids.ID, ids.NodeID) (bool, error) {
+	return false, errBenchlistDisabled
+}
+
+func (noBenchlist) GetBenchedNodes() map[ids.ID][]BenchedNode {
+	return map[ids.ID][]BenchedNode{}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/proto/pb/p2p"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/snow/networking/appcrypto"
 	"github.com/DioneProtocol/odysseygo/snow/networking/tracker"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
 	"github.com/DioneProtocol/odysseygo/subnets"
@@ -126,6 +127,9 @@ type handler struct {
 
 	// Tracks the peers that are currently connected to this subnet
 	peerTracker commontracker.Peers
+
+	// If non-nil, app messages from other nodes must be sealed for this node
+	sealer appcrypto.Sealer
 }
 
 // Initialize this consensus handler
@@ -140,6 +144,7 @@ func New(
 	subnetConnector validators.SubnetConnector,
 	subnet subnets.Subnet,
 	peerTracker commontracker.Peers,
+	sealer appcrypto.Sealer,
 ) (Handler, error) {
 	h := &handler{
 		ctx:             ctx,
@@ -154,6 +159,7 @@ func New(
 		subnetConnector: subnetConnector,
 		subnet:          subnet,
 		peerTracker:     peerTracker,
+		sealer:          sealer,
 	}
 	h.asyncMessagePool.SetLimit(threadPoolSize)
 
@@ -733,7 +739,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 			return engine.QueryFailed(ctx, nodeID, msg.RequestId)
 		}
 
-		return engine.Chits(ctx, nodeID, msg.RequestId, preferredID, acceptedID)
+		return engine.Chits(ctx, nodeID, msg.RequestId, preferredID, acceptedID, msg.PreferredHeight, msg.AcceptedHeight)
 
 	case *message.QueryFailed:
 		return engine.QueryFailed(ctx, nodeID, msg.RequestID)
@@ -827,22 +833,37 @@ func (h *handler) executeAsyncMsg(ctx context.Context, msg Message) error {
 
 	switch m := body.(type) {
 	case *p2p.AppRequest:
+		appBytes, ok := h.open(nodeID, op, m.AppBytes)
+		if !ok {
+			// The requester will time out.
+			return nil
+		}
 		return engine.AppRequest(
 			ctx,
 			nodeID,
 			m.RequestId,
 			msg.Expiration(),
-			m.AppBytes,
+			appBytes,
 		)
 
 	case *p2p.AppResponse:
-		return engine.AppResponse(ctx, nodeID, m.RequestId, m.AppBytes)
+		appBytes, ok := h.open(nodeID, op, m.AppBytes)
+		if !ok {
+			// The request was already marked as answered by the router, so
+			// the engine must be notified of its failure here.
+			return engine.AppRequestFailed(ctx, nodeID, m.RequestId)
+		}
+		return engine.AppResponse(ctx, nodeID, m.RequestId, appBytes)
 
 	case *message.AppRequestFailed:
 		return engine.AppRequestFailed(ctx, nodeID, m.RequestID)
 
 	case *p2p.AppGossip:
-		return engine.AppGossip(ctx, nodeID, m.AppBytes)
+		appBytes, ok := h.open(nodeID, op, m.AppBytes)
+		if !ok {
+			return nil
+		}
+		return engine.AppGossip(ctx, nodeID, appBytes)
 
 	case *message.CrossChainAppRequest:
 		return engine.CrossChainAppRequest(
@@ -876,6 +897,27 @@ func (h *handler) executeAsyncMsg(ctx context.Context, msg Message) error {
 	}
 }
 
+// open returns the app message sent by [nodeID]. If app messages must be
+// sealed, false is returned if [appBytes] wasn't sealed for this node by
+// [nodeID].
+func (h *handler) open(nodeID ids.NodeID, op message.Op, appBytes []byte) ([]byte, bool) {
+	// Messages sent by this node are delivered locally, so they aren't sealed.
+	if h.sealer == nil || nodeID == h.ctx.NodeID {
+		return appBytes, true
+	}
+
+	openedBytes, err := h.sealer.Open(h.ctx.ChainID, nodeID, appBytes)
+	if err != nil {
+		h.ctx.Log.Debug("dropping unsealable app message",
+			zap.Stringer("messageOp", op),
+			zap.Stringer("nodeID", nodeID),
+			zap.Error(err),
+		)
+		return nil, false
+	}
+	return openedBytes, true
+}
+
 // Any returned error is treated as fatal
 func (h *handler) handleChanMsg(msg message.InboundMessage) error {
 	var (
@@ -62,6 +62,7 @@ func TestHandlerDropsTimedOutMessages(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 	handler := handlerIntf.(*handler)
@@ -159,6 +160,7 @@ func TestHandlerClosesOnError(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 	handler := handlerIntf.(*handler)
@@ -252,6 +254,7 @@ func TestHandlerDropsGossipDuringBootstrapping(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 	handler := handlerIntf.(*handler)
@@ -333,6 +336,7 @@ func TestHandlerDispatchInternal(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -410,6 +414,7 @@ func TestHandlerSubnetConnector(t *testing.T) {
 		connector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -582,6 +587,7 @@ func TestDynamicEngineTypeDispatch(t *testing.T) {
 				validators.UnhandledSubnetConnector,
 				subnets.New(ids.EmptyNodeID, subnets.Config{}),
 				commontracker.NewPeers(),
+				nil,
 			)
 			require.NoError(err)
 
@@ -600,7 +606,7 @@ func TestDynamicEngineTypeDispatch(t *testing.T) {
 			engine.ContextF = func() *snow.ConsensusContext {
 				return ctx
 			}
-			engine.ChitsF = func(context.Context, ids.NodeID, uint32, ids.ID, ids.ID) error {
+			engine.ChitsF = func(context.Context, ids.NodeID, uint32, ids.ID, ids.ID, uint64, uint64) error {
 				close(messageReceived)
 				return nil
 			}
@@ -623,6 +629,8 @@ func TestDynamicEngineTypeDispatch(t *testing.T) {
 					uint32(0),
 					ids.Empty,
 					ids.Empty,
+					0,
+					0,
 					ids.EmptyNodeID,
 				),
 				EngineType: test.requestedEngineType,
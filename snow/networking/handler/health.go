@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/snow"
 )
 
 var ErrNotConnectedEnoughStake = errors.New("not connected to enough stake")
@@ -28,9 +30,13 @@ func (h *handler) HealthCheck(ctx context.Context) (interface{}, error) {
 	engineIntf, engineErr := engine.HealthCheck(ctx)
 	networkingIntf, networkingErr := h.networkHealthCheck()
 	intf := map[string]interface{}{
+		"state":      state.State.String(),
 		"engine":     engineIntf,
 		"networking": networkingIntf,
 	}
+	if state.State == snow.Bootstrapping {
+		intf["bootstrapProgress"] = h.ctx.BootstrapProgress.Get()
+	}
 	if engineErr == nil {
 		return intf, networkingErr
 	}
@@ -78,6 +78,7 @@ func TestHealthCheckSubnet(t *testing.T) {
 				validators.UnhandledSubnetConnector,
 				sb,
 				peerTracker,
+				nil,
 			)
 			require.NoError(err)
 
@@ -155,7 +155,76 @@ func (cr *ChainRouter) RegisterRequest(
 	timeoutMsg message.InboundMessage,
 	engineType p2p.EngineType,
 ) {
+	uniqueRequestID, shouldMeasureLatency := cr.registerRequest(
+		nodeID,
+		requestingChainID,
+		respondingChainID,
+		requestID,
+		op,
+		engineType,
+	)
+
+	// Register a timeout to fire if we don't get a reply in time.
+	cr.timeoutManager.RegisterRequest(
+		nodeID,
+		respondingChainID,
+		shouldMeasureLatency,
+		uniqueRequestID,
+		func() {
+			cr.HandleInbound(ctx, timeoutMsg)
+		},
+	)
+}
+
+// RegisterRequestWithTimeout is the same as RegisterRequest except that the
+// timeout fires after [timeout] rather than after the current network timeout.
+func (cr *ChainRouter) RegisterRequestWithTimeout(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	requestingChainID ids.ID,
+	respondingChainID ids.ID,
+	requestID uint32,
+	op message.Op,
+	timeoutMsg message.InboundMessage,
+	engineType p2p.EngineType,
+	timeout time.Duration,
+) {
+	uniqueRequestID, shouldMeasureLatency := cr.registerRequest(
+		nodeID,
+		requestingChainID,
+		respondingChainID,
+		requestID,
+		op,
+		engineType,
+	)
+
+	// Register a timeout to fire if we don't get a reply in time.
+	cr.timeoutManager.RegisterRequestWithTimeout(
+		nodeID,
+		respondingChainID,
+		shouldMeasureLatency,
+		uniqueRequestID,
+		timeout,
+		func() {
+			cr.HandleInbound(ctx, timeoutMsg)
+		},
+	)
+}
+
+// registerRequest adds the request to the set of unfulfilled requests. It
+// returns the unique ID of the request and whether the latency of the request
+// should be measured.
+func (cr *ChainRouter) registerRequest(
+	nodeID ids.NodeID,
+	requestingChainID ids.ID,
+	respondingChainID ids.ID,
+	requestID uint32,
+	op message.Op,
+	engineType p2p.EngineType,
+) (ids.RequestID, bool) {
 	cr.lock.Lock()
+	defer cr.lock.Unlock()
+
 	// When we receive a response message type (Chits, Put, Accepted, etc.)
 	// we validate that we actually sent the corresponding request.
 	// Give this request a unique ID so we can do that validation.
@@ -177,7 +246,6 @@ func (cr *ChainRouter) RegisterRequest(
 		engineType: engineType,
 	})
 	cr.metrics.outstandingRequests.Set(float64(cr.timedRequests.Len()))
-	cr.lock.Unlock()
 
 	// Determine whether we should include the latency of this request in our
 	// measurements.
@@ -187,17 +255,7 @@ func (cr *ChainRouter) RegisterRequest(
 	//   request to them and not respond, causing a timeout, skewing latency
 	//   measurements.
 	shouldMeasureLatency := nodeID != cr.myNodeID && op != message.PutOp
-
-	// Register a timeout to fire if we don't get a reply in time.
-	cr.timeoutManager.RegisterRequest(
-		nodeID,
-		respondingChainID,
-		shouldMeasureLatency,
-		uniqueRequestID,
-		func() {
-			cr.HandleInbound(ctx, timeoutMsg)
-		},
-	)
+	return uniqueRequestID, shouldMeasureLatency
 }
 
 func (cr *ChainRouter) HandleInbound(ctx context.Context, msg message.InboundMessage) {
@@ -266,6 +324,7 @@ func (cr *ChainRouter) HandleInbound(ctx context.Context, msg message.InboundMes
 			zap.Stringer("chainID", destinationChainID),
 			zap.Error(errUnallowedNode),
 		)
+		cr.metrics.droppedUnallowed.Inc()
 		msg.OnFinishedHandling()
 		return
 	}
@@ -637,7 +696,26 @@ func (cr *ChainRouter) HealthCheck(context.Context) (interface{}, error) {
 
 // RemoveChain removes the specified chain so that incoming
 // messages can't be routed to it
+// RemoveChain stops the specified chain and stops routing messages to it.
+// Unlike a chain that stopped on its own, removing a critical chain doesn't
+// shut down the node.
+func (cr *ChainRouter) RemoveChain(ctx context.Context, chainID ids.ID) {
+	cr.stopChain(ctx, chainID)
+}
+
 func (cr *ChainRouter) removeChain(ctx context.Context, chainID ids.ID) {
+	if !cr.stopChain(ctx, chainID) {
+		return
+	}
+
+	if cr.onFatal != nil && cr.criticalChains.Contains(chainID) {
+		go cr.onFatal(1)
+	}
+}
+
+// stopChain stops the specified chain and waits for it to shut down. Returns
+// false if the chain wasn't registered.
+func (cr *ChainRouter) stopChain(ctx context.Context, chainID ids.ID) bool {
 	cr.lock.Lock()
 	chain, exists := cr.chainHandlers[chainID]
 	if !exists {
@@ -645,7 +723,7 @@ func (cr *ChainRouter) removeChain(ctx context.Context, chainID ids.ID) {
 			zap.Stringer("chainID", chainID),
 		)
 		cr.lock.Unlock()
-		return
+		return false
 	}
 	delete(cr.chainHandlers, chainID)
 	cr.lock.Unlock()
@@ -666,10 +744,7 @@ func (cr *ChainRouter) removeChain(ctx context.Context, chainID ids.ID) {
 			zap.Duration("shutdownDuration", shutdownDuration),
 		)
 	}
-
-	if cr.onFatal != nil && cr.criticalChains.Contains(chainID) {
-		go cr.onFatal(1)
-	}
+	return true
 }
 
 func (cr *ChainRouter) clearRequest(
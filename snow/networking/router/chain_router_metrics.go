@@ -14,6 +14,7 @@ type routerMetrics struct {
 	outstandingRequests   prometheus.Gauge
 	longestRunningRequest prometheus.Gauge
 	droppedRequests       prometheus.Counter
+	droppedUnallowed      prometheus.Counter
 }
 
 func newRouterMetrics(namespace string, registerer prometheus.Registerer) (*routerMetrics, error) {
@@ -39,12 +40,20 @@ func newRouterMetrics(namespace string, registerer prometheus.Registerer) (*rout
 			Help:      "Number of dropped requests (all types)",
 		},
 	)
+	rMetrics.droppedUnallowed = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "dropped_unallowed",
+			Help:      "Number of messages dropped because the sender isn't allowed to connect to the chain's subnet",
+		},
+	)
 
 	errs := wrappers.Errs{}
 	errs.Add(
 		registerer.Register(rMetrics.outstandingRequests),
 		registerer.Register(rMetrics.longestRunningRequest),
 		registerer.Register(rMetrics.droppedRequests),
+		registerer.Register(rMetrics.droppedUnallowed),
 	)
 	return rMetrics, errs.Err
 }
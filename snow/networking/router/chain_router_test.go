@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 
 	"github.com/stretchr/testify/require"
 
@@ -99,6 +100,7 @@ func TestShutdown(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(chainCtx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -237,6 +239,7 @@ func TestShutdownTimesOut(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -392,6 +395,7 @@ func TestRouterTimeout(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -676,6 +680,70 @@ func TestRouterTimeout(t *testing.T) {
 	require.True(calledCrossChainAppRequestFailed)
 }
 
+func TestRemoveCriticalChain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	require := require.New(t)
+
+	tm, err := timeout.NewManager(
+		&timer.AdaptiveTimeoutConfig{
+			InitialTimeout:     3 * time.Second,
+			MinimumTimeout:     3 * time.Second,
+			MaximumTimeout:     5 * time.Minute,
+			TimeoutCoefficient: 1,
+			TimeoutHalflife:    5 * time.Minute,
+		},
+		benchlist.NewNoBenchlist(),
+		"",
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+	go tm.Dispatch()
+
+	ctx := snow.DefaultConsensusContextTest()
+	fatalCalled := make(chan int, 1)
+	chainRouter := ChainRouter{}
+	require.NoError(chainRouter.Initialize(
+		ids.EmptyNodeID,
+		logging.NoLog{},
+		tm,
+		time.Millisecond,
+		set.Of(ctx.ChainID),
+		true,
+		set.Set[ids.ID]{},
+		func(exitCode int) {
+			fatalCalled <- exitCode
+		},
+		HealthConfig{},
+		"",
+		prometheus.NewRegistry(),
+	))
+
+	h := handler.NewMockHandler(ctrl)
+	h.EXPECT().Context().Return(ctx).AnyTimes()
+	h.EXPECT().SetOnStopped(gomock.Any()).AnyTimes()
+	h.EXPECT().Push(gomock.Any(), gomock.Any()).AnyTimes()
+	chainRouter.AddChain(context.Background(), h)
+
+	h.EXPECT().Stop(gomock.Any()).Times(1)
+	h.EXPECT().AwaitStopped(gomock.Any()).Return(time.Duration(0), nil).Times(1)
+	chainRouter.RemoveChain(context.Background(), ctx.ChainID)
+
+	chainRouter.lock.Lock()
+	require.NotContains(chainRouter.chainHandlers, ctx.ChainID)
+	chainRouter.lock.Unlock()
+
+	// Removing an unknown chain is a no-op
+	chainRouter.RemoveChain(context.Background(), ctx.ChainID)
+	require.Empty(fatalCalled)
+
+	// The chain can be added again
+	chainRouter.AddChain(context.Background(), h)
+
+	chainRouter.lock.Lock()
+	require.Contains(chainRouter.chainHandlers, ctx.ChainID)
+	chainRouter.lock.Unlock()
+}
+
 func TestRouterHonorsRequestedEngine(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	require := require.New(t)
@@ -861,6 +929,7 @@ func TestRouterClearTimeouts(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -1031,6 +1100,8 @@ func TestRouterClearTimeouts(t *testing.T) {
 			requestID,
 			ids.Empty,
 			ids.Empty,
+			0,
+			0,
 			nodeID,
 		)
 		chainRouter.HandleInbound(context.Background(), msg)
@@ -1153,6 +1224,7 @@ func TestValidatorOnlyMessageDrops(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		sb,
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -1222,6 +1294,7 @@ func TestValidatorOnlyMessageDrops(t *testing.T) {
 	chainRouter.HandleInbound(context.Background(), inMsg)
 
 	require.False(calledF) // should not be called
+	require.Equal(float64(1), testutil.ToFloat64(chainRouter.metrics.droppedUnallowed))
 
 	// Validator case
 	calledF = false
@@ -1239,6 +1312,7 @@ func TestValidatorOnlyMessageDrops(t *testing.T) {
 
 	wg.Wait()
 	require.True(calledF) // should be called since this is a validator request
+	require.Equal(float64(1), testutil.ToFloat64(chainRouter.metrics.droppedUnallowed))
 }
 
 func TestRouterCrossChainMessages(t *testing.T) {
@@ -1305,6 +1379,7 @@ func TestRouterCrossChainMessages(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(requester.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -1324,6 +1399,7 @@ func TestRouterCrossChainMessages(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(responder.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -1572,6 +1648,7 @@ func TestValidatorOnlyAllowedNodeMessageDrops(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		sb,
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
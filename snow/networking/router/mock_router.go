@@ -148,6 +148,30 @@ func (mr *MockRouterMockRecorder) RegisterRequest(arg0, arg1, arg2, arg3, arg4,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRequest", reflect.TypeOf((*MockRouter)(nil).RegisterRequest), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 }
 
+// RegisterRequestWithTimeout mocks base method.
+func (m *MockRouter) RegisterRequestWithTimeout(arg0 context.Context, arg1 ids.NodeID, arg2, arg3 ids.ID, arg4 uint32, arg5 message.Op, arg6 message.InboundMessage, arg7 p2p.EngineType, arg8 time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRequestWithTimeout", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+}
+
+// RegisterRequestWithTimeout indicates an expected call of RegisterRequestWithTimeout.
+func (mr *MockRouterMockRecorder) RegisterRequestWithTimeout(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRequestWithTimeout", reflect.TypeOf((*MockRouter)(nil).RegisterRequestWithTimeout), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+}
+
+// RemoveChain mocks base method.
+func (m *MockRouter) RemoveChain(arg0 context.Context, arg1 ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RemoveChain", arg0, arg1)
+}
+
+// RemoveChain indicates an expected call of RemoveChain.
+func (mr *MockRouterMockRecorder) RemoveChain(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChain", reflect.TypeOf((*MockRouter)(nil).RemoveChain), arg0, arg1)
+}
+
 // Shutdown mocks base method.
 func (m *MockRouter) Shutdown(arg0 context.Context) {
 	m.ctrl.T.Helper()
@@ -41,6 +41,7 @@ type Router interface {
 	) error
 	Shutdown(context.Context)
 	AddChain(ctx context.Context, chain handler.Handler)
+	RemoveChain(ctx context.Context, chainID ids.ID)
 	health.Checker
 }
 
@@ -58,4 +59,19 @@ type InternalHandler interface {
 		failedMsg message.InboundMessage,
 		engineType p2p.EngineType,
 	)
+
+	// RegisterRequestWithTimeout is the same as RegisterRequest except that
+	// the request times out after [timeout] rather than after the current
+	// network timeout.
+	RegisterRequestWithTimeout(
+		ctx context.Context,
+		nodeID ids.NodeID,
+		sourceChainID ids.ID,
+		destinationChainID ids.ID,
+		requestID uint32,
+		op message.Op,
+		failedMsg message.InboundMessage,
+		engineType p2p.EngineType,
+		timeout time.Duration,
+	)
 }
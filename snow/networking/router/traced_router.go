@@ -88,6 +88,30 @@ func (r *tracedRouter) RegisterRequest(
 	)
 }
 
+func (r *tracedRouter) RegisterRequestWithTimeout(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	requestingChainID ids.ID,
+	respondingChainID ids.ID,
+	requestID uint32,
+	op message.Op,
+	failedMsg message.InboundMessage,
+	engineType p2p.EngineType,
+	timeout time.Duration,
+) {
+	r.router.RegisterRequestWithTimeout(
+		ctx,
+		nodeID,
+		requestingChainID,
+		respondingChainID,
+		requestID,
+		op,
+		failedMsg,
+		engineType,
+		timeout,
+	)
+}
+
 func (r *tracedRouter) HandleInbound(ctx context.Context, msg message.InboundMessage) {
 	m := msg.Message()
 	destinationChainID, err := message.GetChainID(m)
@@ -131,6 +155,15 @@ func (r *tracedRouter) AddChain(ctx context.Context, chain handler.Handler) {
 	r.router.AddChain(ctx, chain)
 }
 
+func (r *tracedRouter) RemoveChain(ctx context.Context, chainID ids.ID) {
+	ctx, span := r.tracer.Start(ctx, "tracedRouter.RemoveChain", oteltrace.WithAttributes(
+		attribute.Stringer("chainID", chainID),
+	))
+	defer span.End()
+
+	r.router.RemoveChain(ctx, chainID)
+}
+
 func (r *tracedRouter) Connected(nodeID ids.NodeID, nodeVersion *version.Application, subnetID ids.ID) {
 	r.router.Connected(nodeID, nodeVersion, subnetID)
 }
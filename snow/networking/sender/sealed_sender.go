@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sender
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/message"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/snow/networking/appcrypto"
+	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/subnets"
+	"github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+)
+
+var (
+	_ common.Sender                   = (*sealedSender)(nil)
+	_ common.AppRequestDeadlineSender = (*sealedSender)(nil)
+)
+
+// sealedSender seals every application message it sends to another node so
+// that only that node can read it. Because every recipient receives a
+// differently sealed message, app gossip is sent to sampled validators rather
+// than to the peers sampled by the network.
+type sealedSender struct {
+	common.Sender
+
+	ctx        *snow.ConsensusContext
+	sealer     appcrypto.Sealer
+	validators validators.Set
+	subnet     subnets.Subnet
+}
+
+// Seal returns a sender that seals the application messages sent by [sender]
+// with [sealer].
+func Seal(
+	sender common.Sender,
+	ctx *snow.ConsensusContext,
+	sealer appcrypto.Sealer,
+	validators validators.Set,
+	subnet subnets.Subnet,
+) common.Sender {
+	return &sealedSender{
+		Sender:     sender,
+		ctx:        ctx,
+		sealer:     sealer,
+		validators: validators,
+		subnet:     subnet,
+	}
+}
+
+func (s *sealedSender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, appRequestBytes []byte) error {
+	for nodeID := range nodeIDs {
+		sealedBytes, ok := s.seal(message.AppRequestOp, nodeID, appRequestBytes)
+		if !ok {
+			// The request is still registered so that the engine is notified
+			// of its failure. The recipient will drop the empty envelope.
+			sealedBytes = nil
+		}
+		if err := s.Sender.SendAppRequest(ctx, set.Of(nodeID), requestID, sealedBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sealedSender) SendAppRequestWithDeadline(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, deadline time.Duration, appRequestBytes []byte) error {
+	deadlineSender, ok := s.Sender.(common.AppRequestDeadlineSender)
+	if !ok {
+		return s.SendAppRequest(ctx, nodeIDs, requestID, appRequestBytes)
+	}
+	for nodeID := range nodeIDs {
+		sealedBytes, ok := s.seal(message.AppRequestOp, nodeID, appRequestBytes)
+		if !ok {
+			sealedBytes = nil
+		}
+		if err := deadlineSender.SendAppRequestWithDeadline(ctx, set.Of(nodeID), requestID, deadline, sealedBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sealedSender) SendAppResponse(ctx context.Context, nodeID ids.NodeID, requestID uint32, appResponseBytes []byte) error {
+	sealedBytes, ok := s.seal(message.AppResponseOp, nodeID, appResponseBytes)
+	if !ok {
+		return nil
+	}
+	return s.Sender.SendAppResponse(ctx, nodeID, requestID, sealedBytes)
+}
+
+func (s *sealedSender) SendAppGossipSpecific(ctx context.Context, nodeIDs set.Set[ids.NodeID], appGossipBytes []byte) error {
+	for nodeID := range nodeIDs {
+		sealedBytes, ok := s.seal(message.AppGossipOp, nodeID, appGossipBytes)
+		if !ok {
+			continue
+		}
+		if err := s.Sender.SendAppGossipSpecific(ctx, set.Of(nodeID), sealedBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sealedSender) SendAppGossip(ctx context.Context, appGossipBytes []byte) error {
+	size := math.Min(
+		int(s.subnet.Config().AppGossipValidatorSize),
+		s.validators.Len(),
+	)
+	nodeIDs, err := s.validators.Sample(size)
+	if err != nil {
+		s.ctx.Log.Debug("failed to sample validators for app gossip",
+			zap.Stringer("chainID", s.ctx.ChainID),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	recipients := set.Of(nodeIDs...)
+	recipients.Remove(s.ctx.NodeID)
+	return s.SendAppGossipSpecific(ctx, recipients, appGossipBytes)
+}
+
+// seal returns [msg] sealed for [nodeID]. Messages sent to this node are
+// delivered locally, so they are not sealed.
+func (s *sealedSender) seal(op message.Op, nodeID ids.NodeID, msg []byte) ([]byte, bool) {
+	if nodeID == s.ctx.NodeID {
+		return msg, true
+	}
+
+	sealedMsg, err := s.sealer.Seal(s.ctx.ChainID, nodeID, msg)
+	if err != nil {
+		s.ctx.Log.Debug("failed to seal message",
+			zap.Stringer("messageOp", op),
+			zap.Stringer("nodeID", nodeID),
+			zap.Stringer("chainID", s.ctx.ChainID),
+			zap.Error(err),
+		)
+		return nil, false
+	}
+	return sealedMsg, true
+}
@@ -5,7 +5,9 @@ package sender
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -25,7 +27,12 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/set"
 )
 
-var _ common.Sender = (*sender)(nil)
+var (
+	_ common.Sender                   = (*sender)(nil)
+	_ common.AppRequestDeadlineSender = (*sender)(nil)
+
+	errNonPositiveDeadline = errors.New("deadline must be positive")
+)
 
 // sender is a wrapper around an ExternalSender.
 // Messages to this node are put directly into [router] rather than
@@ -1145,7 +1152,7 @@ func (s *sender) SendPullQuery(ctx context.Context, nodeIDs set.Set[ids.NodeID],
 }
 
 // SendChits sends chits
-func (s *sender) SendChits(ctx context.Context, nodeID ids.NodeID, requestID uint32, preferredID, acceptedID ids.ID) {
+func (s *sender) SendChits(ctx context.Context, nodeID ids.NodeID, requestID uint32, preferredID, acceptedID ids.ID, preferredHeight, acceptedHeight uint64) {
 	ctx = utils.Detach(ctx)
 
 	// If [nodeID] is myself, send this message directly
@@ -1156,6 +1163,8 @@ func (s *sender) SendChits(ctx context.Context, nodeID ids.NodeID, requestID uin
 			requestID,
 			preferredID,
 			acceptedID,
+			preferredHeight,
+			acceptedHeight,
 			nodeID,
 		)
 		go s.router.HandleInbound(ctx, inMsg)
@@ -1163,7 +1172,7 @@ func (s *sender) SendChits(ctx context.Context, nodeID ids.NodeID, requestID uin
 	}
 
 	// Create the outbound message.
-	outMsg, err := s.msgCreator.Chits(s.ctx.ChainID, requestID, preferredID, acceptedID)
+	outMsg, err := s.msgCreator.Chits(s.ctx.ChainID, requestID, preferredID, acceptedID, preferredHeight, acceptedHeight)
 	if err != nil {
 		s.ctx.Log.Error("failed to build message",
 			zap.Stringer("messageOp", message.ChitsOp),
@@ -1247,6 +1256,24 @@ func (s *sender) SendCrossChainAppResponse(ctx context.Context, chainID ids.ID,
 // The meaning of this request, and how it should be handled, is defined by the
 // VM.
 func (s *sender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, appRequestBytes []byte) error {
+	return s.sendAppRequest(ctx, nodeIDs, requestID, 0, appRequestBytes)
+}
+
+// SendAppRequestWithDeadline sends an application-level request to the given
+// nodes that fails if a node doesn't respond within [deadline].
+func (s *sender) SendAppRequestWithDeadline(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, deadline time.Duration, appRequestBytes []byte) error {
+	if deadline <= 0 {
+		return fmt.Errorf("%w: %s", errNonPositiveDeadline, deadline)
+	}
+	if maxDeadline := s.timeouts.MaximumTimeoutDuration(); deadline > maxDeadline {
+		deadline = maxDeadline
+	}
+	return s.sendAppRequest(ctx, nodeIDs, requestID, deadline, appRequestBytes)
+}
+
+// sendAppRequest sends an application-level request to the given nodes. If
+// [deadline] is 0, the request times out after the current network timeout.
+func (s *sender) sendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, deadline time.Duration, appRequestBytes []byte) error {
 	ctx = utils.Detach(ctx)
 
 	// Tell the router to expect a response message or a message notifying
@@ -1260,21 +1287,37 @@ func (s *sender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID]
 			s.ctx.ChainID,
 			requestID,
 		)
-		s.router.RegisterRequest(
-			ctx,
-			nodeID,
-			s.ctx.ChainID,
-			s.ctx.ChainID,
-			requestID,
-			message.AppResponseOp,
-			inMsg,
-			p2p.EngineType_ENGINE_TYPE_UNSPECIFIED,
-		)
+		if deadline == 0 {
+			s.router.RegisterRequest(
+				ctx,
+				nodeID,
+				s.ctx.ChainID,
+				s.ctx.ChainID,
+				requestID,
+				message.AppResponseOp,
+				inMsg,
+				p2p.EngineType_ENGINE_TYPE_UNSPECIFIED,
+			)
+		} else {
+			s.router.RegisterRequestWithTimeout(
+				ctx,
+				nodeID,
+				s.ctx.ChainID,
+				s.ctx.ChainID,
+				requestID,
+				message.AppResponseOp,
+				inMsg,
+				p2p.EngineType_ENGINE_TYPE_UNSPECIFIED,
+				deadline,
+			)
+		}
 	}
 
-	// Note that this timeout duration won't exactly match the one that gets
-	// registered. That's OK.
-	deadline := s.timeouts.TimeoutDuration()
+	if deadline == 0 {
+		// Note that this timeout duration won't exactly match the one that gets
+		// registered. That's OK.
+		deadline = s.timeouts.TimeoutDuration()
+	}
 
 	// Sending a message to myself. No need to send it over the network. Just
 	// put it right into the router. Do so asynchronously to avoid deadlock.
@@ -79,6 +79,7 @@ func TestTimeout(t *testing.T) {
 		metrics,
 		"dummyNamespace",
 		constants.DefaultNetworkCompressionType,
+		constants.DefaultNetworkCompressionThreshold,
 		10*time.Second,
 	)
 	require.NoError(err)
@@ -130,6 +131,7 @@ func TestTimeout(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -337,6 +339,7 @@ func TestReliableMessages(t *testing.T) {
 		metrics,
 		"dummyNamespace",
 		constants.DefaultNetworkCompressionType,
+		constants.DefaultNetworkCompressionThreshold,
 		10*time.Second,
 	)
 	require.NoError(err)
@@ -389,6 +392,7 @@ func TestReliableMessages(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -487,6 +491,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 		metrics,
 		"dummyNamespace",
 		constants.DefaultNetworkCompressionType,
+		constants.DefaultNetworkCompressionThreshold,
 		10*time.Second,
 	)
 	require.NoError(err)
@@ -539,6 +544,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -1338,3 +1344,107 @@ func TestSender_Single_Request(t *testing.T) {
 		})
 	}
 }
+
+func TestSender_AppRequestWithDeadline(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	var (
+		chainID           = ids.GenerateTestID()
+		subnetID          = ids.GenerateTestID()
+		destinationNodeID = ids.GenerateTestNodeID()
+		maxDeadline       = 10 * time.Second
+		requestID         = uint32(1337)
+		requestBytes      = []byte{1, 2, 3}
+		ctx               = snow.DefaultContextTest()
+		engineType        = p2p.EngineType_ENGINE_TYPE_SNOWMAN
+	)
+	ctx.ChainID = chainID
+	ctx.SubnetID = subnetID
+	snowCtx := &snow.ConsensusContext{
+		Context:           ctx,
+		Registerer:        prometheus.NewRegistry(),
+		OdysseyRegisterer: prometheus.NewRegistry(),
+	}
+
+	var (
+		msgCreator     = message.NewMockOutboundMsgBuilder(ctrl)
+		externalSender = NewMockExternalSender(ctrl)
+		timeoutManager = timeout.NewMockManager(ctrl)
+		router         = router.NewMockRouter(ctrl)
+	)
+	s, err := New(
+		snowCtx,
+		msgCreator,
+		externalSender,
+		router,
+		timeoutManager,
+		engineType,
+		subnets.New(ctx.NodeID, defaultSubnetConfig),
+	)
+	require.NoError(err)
+	require.Implements((*common.AppRequestDeadlineSender)(nil), s)
+	deadlineSender := s.(common.AppRequestDeadlineSender)
+
+	timeoutManager.EXPECT().MaximumTimeoutDuration().Return(maxDeadline).AnyTimes()
+	timeoutManager.EXPECT().IsBenched(destinationNodeID, chainID).Return(false).AnyTimes()
+
+	tests := []struct {
+		name             string
+		deadline         time.Duration
+		expectedDeadline time.Duration
+	}{
+		{
+			name:             "below max",
+			deadline:         time.Second,
+			expectedDeadline: time.Second,
+		},
+		{
+			name:             "capped to max",
+			deadline:         time.Hour,
+			expectedDeadline: maxDeadline,
+		},
+	}
+	for _, test := range tests {
+		router.EXPECT().RegisterRequestWithTimeout(
+			gomock.Any(),      // Context
+			destinationNodeID, // Node ID
+			chainID,           // Source Chain
+			chainID,           // Destination Chain
+			requestID,         // Request ID
+			message.AppResponseOp,
+			message.InternalAppRequestFailed(destinationNodeID, chainID, requestID),
+			p2p.EngineType_ENGINE_TYPE_UNSPECIFIED,
+			test.expectedDeadline,
+		)
+		msgCreator.EXPECT().AppRequest(
+			chainID,
+			requestID,
+			test.expectedDeadline,
+			requestBytes,
+		).Return(nil, nil)
+		externalSender.EXPECT().Send(
+			gomock.Any(),
+			set.Of(destinationNodeID),
+			subnetID,
+			gomock.Any(),
+		).Return(set.Of(destinationNodeID))
+
+		require.NoError(deadlineSender.SendAppRequestWithDeadline(
+			context.Background(),
+			set.Of(destinationNodeID),
+			requestID,
+			test.deadline,
+			requestBytes,
+		), test.name)
+	}
+
+	err = deadlineSender.SendAppRequestWithDeadline(
+		context.Background(),
+		set.Of(destinationNodeID),
+		requestID,
+		0,
+		requestBytes,
+	)
+	require.ErrorIs(err, errNonPositiveDeadline)
+}
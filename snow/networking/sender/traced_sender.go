@@ -5,6 +5,7 @@ package sender
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 
@@ -17,7 +18,10 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/set"
 )
 
-var _ common.Sender = (*tracedSender)(nil)
+var (
+	_ common.Sender                   = (*tracedSender)(nil)
+	_ common.AppRequestDeadlineSender = (*tracedSender)(nil)
+)
 
 type tracedSender struct {
 	sender common.Sender
@@ -177,16 +181,18 @@ func (s *tracedSender) SendPullQuery(ctx context.Context, nodeIDs set.Set[ids.No
 	s.sender.SendPullQuery(ctx, nodeIDs, requestID, containerID)
 }
 
-func (s *tracedSender) SendChits(ctx context.Context, nodeID ids.NodeID, requestID uint32, preferredID ids.ID, acceptedID ids.ID) {
+func (s *tracedSender) SendChits(ctx context.Context, nodeID ids.NodeID, requestID uint32, preferredID ids.ID, acceptedID ids.ID, preferredHeight uint64, acceptedHeight uint64) {
 	ctx, span := s.tracer.Start(ctx, "tracedSender.SendChits", oteltrace.WithAttributes(
 		attribute.Stringer("recipients", nodeID),
 		attribute.Int64("requestID", int64(requestID)),
 		attribute.Stringer("preferredID", preferredID),
 		attribute.Stringer("acceptedID", acceptedID),
+		attribute.Int64("preferredHeight", int64(preferredHeight)),
+		attribute.Int64("acceptedHeight", int64(acceptedHeight)),
 	))
 	defer span.End()
 
-	s.sender.SendChits(ctx, nodeID, requestID, preferredID, acceptedID)
+	s.sender.SendChits(ctx, nodeID, requestID, preferredID, acceptedID, preferredHeight, acceptedHeight)
 }
 
 func (s *tracedSender) SendCrossChainAppRequest(ctx context.Context, chainID ids.ID, requestID uint32, appRequestBytes []byte) error {
@@ -221,6 +227,21 @@ func (s *tracedSender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.N
 	return s.sender.SendAppRequest(ctx, nodeIDs, requestID, appRequestBytes)
 }
 
+func (s *tracedSender) SendAppRequestWithDeadline(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, deadline time.Duration, appRequestBytes []byte) error {
+	ctx, span := s.tracer.Start(ctx, "tracedSender.SendAppRequestWithDeadline", oteltrace.WithAttributes(
+		attribute.Int64("requestID", int64(requestID)),
+		attribute.Int64("deadline", int64(deadline)),
+		attribute.Int("requestLen", len(appRequestBytes)),
+	))
+	defer span.End()
+
+	deadlineSender, ok := s.sender.(common.AppRequestDeadlineSender)
+	if !ok {
+		return s.sender.SendAppRequest(ctx, nodeIDs, requestID, appRequestBytes)
+	}
+	return deadlineSender.SendAppRequestWithDeadline(ctx, nodeIDs, requestID, deadline, appRequestBytes)
+}
+
 func (s *tracedSender) SendAppResponse(ctx context.Context, nodeID ids.NodeID, requestID uint32, appResponseBytes []byte) error {
 	ctx, span := s.tracer.Start(ctx, "tracedSender.SendAppResponse", oteltrace.WithAttributes(
 		attribute.Stringer("recipients", nodeID),
@@ -25,6 +25,8 @@ type Manager interface {
 	Dispatch()
 	// TimeoutDuration returns the current timeout duration.
 	TimeoutDuration() time.Duration
+	// MaximumTimeoutDuration returns the maximum timeout duration.
+	MaximumTimeoutDuration() time.Duration
 	// IsBenched returns true if messages to [nodeID] regarding [chainID]
 	// should not be sent over the network and should immediately fail.
 	IsBenched(nodeID ids.NodeID, chainID ids.ID) bool
@@ -32,6 +34,9 @@ type Manager interface {
 	// Must be called before any method calls that use the
 	// ID of the chain.
 	RegisterChain(ctx *snow.ConsensusContext) error
+	// DeregisterChain removes the given chain so that it can be registered
+	// again. The benchlist of the chain is kept.
+	DeregisterChain(chainID ids.ID)
 	// RegisterRequest notes that we expect a response of type [op] from
 	// [nodeID] for chain [chainID]. If we don't receive a response in
 	// time, [timeoutHandler] is executed.
@@ -42,6 +47,17 @@ type Manager interface {
 		requestID ids.RequestID,
 		timeoutHandler func(),
 	)
+	// RegisterRequestWithTimeout is the same as RegisterRequest except that
+	// the request times out after [timeout] rather than after the current
+	// network timeout. [timeout] is capped to MaximumTimeoutDuration.
+	RegisterRequestWithTimeout(
+		nodeID ids.NodeID,
+		chainID ids.ID,
+		measureLatency bool,
+		requestID ids.RequestID,
+		timeout time.Duration,
+		timeoutHandler func(),
+	)
 	// Registers that we would have sent a request to a validator but they
 	// are unreachable because they are benched or because of network conditions
 	// (e.g. we're not connected), so we didn't send the query. For the sake
@@ -98,6 +114,10 @@ func (m *manager) TimeoutDuration() time.Duration {
 	return m.tm.TimeoutDuration()
 }
 
+func (m *manager) MaximumTimeoutDuration() time.Duration {
+	return m.tm.MaximumTimeoutDuration()
+}
+
 // IsBenched returns true if messages to [nodeID] regarding [chainID]
 // should not be sent over the network and should immediately fail.
 func (m *manager) IsBenched(nodeID ids.NodeID, chainID ids.ID) bool {
@@ -114,6 +134,10 @@ func (m *manager) RegisterChain(ctx *snow.ConsensusContext) error {
 	return nil
 }
 
+func (m *manager) DeregisterChain(chainID ids.ID) {
+	m.metrics.DeregisterChain(chainID)
+}
+
 // RegisterRequest notes that we expect a response of type [op] from
 // [nodeID] regarding chain [chainID]. If we don't receive a response in
 // time, [timeoutHandler]  is executed.
@@ -132,6 +156,25 @@ func (m *manager) RegisterRequest(
 	m.tm.Put(requestID, measureLatency, newTimeoutHandler)
 }
 
+// RegisterRequestWithTimeout notes that we expect a response of type [op]
+// from [nodeID] regarding chain [chainID]. If we don't receive a response
+// within [timeout], [timeoutHandler] is executed.
+func (m *manager) RegisterRequestWithTimeout(
+	nodeID ids.NodeID,
+	chainID ids.ID,
+	measureLatency bool,
+	requestID ids.RequestID,
+	timeout time.Duration,
+	timeoutHandler func(),
+) {
+	newTimeoutHandler := func() {
+		// If this request timed out, tell the benchlist manager
+		m.benchlistMgr.RegisterFailure(chainID, nodeID)
+		timeoutHandler()
+	}
+	m.tm.PutWithTimeout(requestID, measureLatency, timeout, newTimeoutHandler)
+}
+
 // RegisterResponse registers that we received a response from [nodeID]
 // regarding the given request ID and chain.
 func (m *manager) RegisterResponse(
@@ -47,6 +47,15 @@ func (m *metrics) RegisterChain(ctx *snow.ConsensusContext) error {
 	return nil
 }
 
+// DeregisterChain removes the metrics of [chainID], allowing the chain to be
+// registered again.
+func (m *metrics) DeregisterChain(chainID ids.ID) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.chainToMetrics, chainID)
+}
+
 // Record that a response of type [op] took [latency]
 func (m *metrics) Observe(nodeID ids.NodeID, chainID ids.ID, op message.Op, latency time.Duration) {
 	m.lock.Lock()
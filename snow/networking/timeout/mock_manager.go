@@ -40,6 +40,18 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 	return m.recorder
 }
 
+// DeregisterChain mocks base method.
+func (m *MockManager) DeregisterChain(arg0 ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeregisterChain", arg0)
+}
+
+// DeregisterChain indicates an expected call of DeregisterChain.
+func (mr *MockManagerMockRecorder) DeregisterChain(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterChain", reflect.TypeOf((*MockManager)(nil).DeregisterChain), arg0)
+}
+
 // Dispatch mocks base method.
 func (m *MockManager) Dispatch() {
 	m.ctrl.T.Helper()
@@ -66,6 +78,20 @@ func (mr *MockManagerMockRecorder) IsBenched(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBenched", reflect.TypeOf((*MockManager)(nil).IsBenched), arg0, arg1)
 }
 
+// MaximumTimeoutDuration mocks base method.
+func (m *MockManager) MaximumTimeoutDuration() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaximumTimeoutDuration")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// MaximumTimeoutDuration indicates an expected call of MaximumTimeoutDuration.
+func (mr *MockManagerMockRecorder) MaximumTimeoutDuration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaximumTimeoutDuration", reflect.TypeOf((*MockManager)(nil).MaximumTimeoutDuration))
+}
+
 // RegisterChain mocks base method.
 func (m *MockManager) RegisterChain(arg0 *snow.ConsensusContext) error {
 	m.ctrl.T.Helper()
@@ -104,6 +130,18 @@ func (mr *MockManagerMockRecorder) RegisterRequestToUnreachableValidator() *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRequestToUnreachableValidator", reflect.TypeOf((*MockManager)(nil).RegisterRequestToUnreachableValidator))
 }
 
+// RegisterRequestWithTimeout mocks base method.
+func (m *MockManager) RegisterRequestWithTimeout(arg0 ids.NodeID, arg1 ids.ID, arg2 bool, arg3 ids.RequestID, arg4 time.Duration, arg5 func()) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterRequestWithTimeout", arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// RegisterRequestWithTimeout indicates an expected call of RegisterRequestWithTimeout.
+func (mr *MockManagerMockRecorder) RegisterRequestWithTimeout(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRequestWithTimeout", reflect.TypeOf((*MockManager)(nil).RegisterRequestWithTimeout), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // RegisterResponse mocks base method.
 func (m *MockManager) RegisterResponse(arg0 ids.NodeID, arg1 ids.ID, arg2 ids.RequestID, arg3 message.Op, arg4 time.Duration) {
 	m.ctrl.T.Helper()
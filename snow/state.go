@@ -5,6 +5,7 @@ package snow
 
 import (
 	"errors"
+	"time"
 
 	"github.com/DioneProtocol/odysseygo/proto/pb/p2p"
 )
@@ -39,3 +40,18 @@ type EngineState struct {
 	Type  p2p.EngineType
 	State State
 }
+
+// BootstrapProgress describes how far along bootstrapping of a chain is.
+type BootstrapProgress struct {
+	// Number of containers fetched so far
+	Fetched uint64
+	// Number of containers expected to be fetched. Zero if unknown.
+	ToFetch uint64
+	// Number of fetched containers executed so far
+	Executed uint64
+	// Number of fetched containers to execute
+	ToExecute uint64
+	// Estimated time until the containers are fetched or, once executing has
+	// started, until they are executed
+	ETA time.Duration
+}
@@ -0,0 +1,208 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package uptime
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+)
+
+const (
+	// MaxMaintenanceDuration is the longest downtime window a validator may
+	// announce.
+	MaxMaintenanceDuration = 24 * time.Hour
+
+	// MaxMaintenanceWindows is the maximum number of windows tracked per node.
+	// Once exceeded, the oldest window is forgotten.
+	MaxMaintenanceWindows = 16
+)
+
+var (
+	ErrInvalidMaintenanceWindow     = errors.New("maintenance window must end after it starts")
+	ErrMaintenanceWindowTooLong     = errors.New("maintenance window exceeds maximum duration")
+	ErrOverlappingMaintenanceWindow = errors.New("maintenance window overlaps an existing window")
+)
+
+// MaintenanceWindow is a bounded period of planned downtime announced by a
+// validator.
+type MaintenanceWindow struct {
+	Start time.Time
+	End   time.Time
+	// UpDuration is the time the validator was counted as up on the primary
+	// network during the window, as of the last time its uptime was written
+	// to the state.
+	UpDuration time.Duration
+}
+
+// Verify returns nil iff the window is well formed.
+func (w MaintenanceWindow) Verify() error {
+	switch {
+	case !w.Start.Before(w.End):
+		return ErrInvalidMaintenanceWindow
+	case w.End.Sub(w.Start) > MaxMaintenanceDuration:
+		return ErrMaintenanceWindowTooLong
+	default:
+		return nil
+	}
+}
+
+// overlap returns the amount of time that [w] overlaps with [start, end).
+func (w MaintenanceWindow) overlap(start, end time.Time) time.Duration {
+	if w.Start.After(start) {
+		start = w.Start
+	}
+	if w.End.Before(end) {
+		end = w.End
+	}
+	if !start.Before(end) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+type MaintenanceTracker interface {
+	// AddMaintenanceWindow records that [nodeID] announced planned downtime
+	// during [window]. Windows that ended before [nodeID] started its current
+	// staking period on the primary network are pruned.
+	AddMaintenanceWindow(nodeID ids.NodeID, window MaintenanceWindow) error
+	// GetMaintenanceWindows returns the windows announced by [nodeID], sorted
+	// by start time.
+	GetMaintenanceWindows(nodeID ids.NodeID) ([]MaintenanceWindow, error)
+	// CalculateUptimePercentExcludingMaintenance is the same as
+	// CalculateUptimePercentFrom, except that any time spent inside an
+	// announced maintenance window is excluded from the calculation, whether
+	// the node was up or not. Maintenance windows are announced for the
+	// primary network, so the uptime of other subnets isn't adjusted.
+	CalculateUptimePercentExcludingMaintenance(nodeID ids.NodeID, subnetID ids.ID, startTime time.Time) (float64, error)
+}
+
+func (m *manager) AddMaintenanceWindow(nodeID ids.NodeID, window MaintenanceWindow) error {
+	if err := window.Verify(); err != nil {
+		return err
+	}
+
+	windows, err := m.state.GetMaintenanceWindows(nodeID)
+	if err != nil {
+		return err
+	}
+
+	insertIndex := len(windows)
+	for i, existing := range windows {
+		if existing.Start.Equal(window.Start) && existing.End.Equal(window.End) {
+			// This window was already recorded.
+			return nil
+		}
+		if existing.overlap(window.Start, window.End) > 0 {
+			return ErrOverlappingMaintenanceWindow
+		}
+		if window.Start.Before(existing.Start) && insertIndex == len(windows) {
+			insertIndex = i
+		}
+	}
+
+	updated := make([]MaintenanceWindow, 0, len(windows)+1)
+	updated = append(updated, windows[:insertIndex]...)
+	updated = append(updated, window)
+	updated = append(updated, windows[insertIndex:]...)
+	if len(updated) > MaxMaintenanceWindows {
+		updated = updated[len(updated)-MaxMaintenanceWindows:]
+	}
+
+	updated, err = m.pruneMaintenanceWindows(nodeID, updated)
+	if err != nil {
+		return err
+	}
+	return m.state.SetMaintenanceWindows(nodeID, updated)
+}
+
+func (m *manager) GetMaintenanceWindows(nodeID ids.NodeID) ([]MaintenanceWindow, error) {
+	return m.state.GetMaintenanceWindows(nodeID)
+}
+
+func (m *manager) CalculateUptimePercentExcludingMaintenance(nodeID ids.NodeID, subnetID ids.ID, startTime time.Time) (float64, error) {
+	if subnetID != constants.PrimaryNetworkID {
+		return m.CalculateUptimePercentFrom(nodeID, subnetID, startTime)
+	}
+
+	upDuration, now, upStart, err := m.calculateUptime(nodeID, subnetID)
+	if err != nil {
+		return 0, err
+	}
+
+	windows, err := m.state.GetMaintenanceWindows(nodeID)
+	if err != nil {
+		return 0, err
+	}
+
+	var exempt, exemptUp time.Duration
+	for _, window := range windows {
+		exempt += window.overlap(startTime, now)
+		exemptUp += window.UpDuration + window.overlap(upStart, now)
+	}
+
+	bestPossibleUpDuration := now.Sub(startTime) - exempt
+	upDuration -= exemptUp
+	if bestPossibleUpDuration <= 0 || upDuration >= bestPossibleUpDuration {
+		return 1, nil
+	}
+	if upDuration <= 0 {
+		return 0, nil
+	}
+	uptime := float64(upDuration) / float64(bestPossibleUpDuration)
+	return uptime, nil
+}
+
+// accrueMaintenanceUptime records that [nodeID] was counted as up on
+// [subnetID] during [start, end) in the maintenance windows that overlap with
+// that period.
+func (m *manager) accrueMaintenanceUptime(nodeID ids.NodeID, subnetID ids.ID, start, end time.Time) error {
+	if subnetID != constants.PrimaryNetworkID || !start.Before(end) {
+		return nil
+	}
+
+	windows, err := m.state.GetMaintenanceWindows(nodeID)
+	if err != nil {
+		return err
+	}
+
+	modified := false
+	for i, window := range windows {
+		overlap := window.overlap(start, end)
+		if overlap == 0 {
+			continue
+		}
+		windows[i].UpDuration += overlap
+		modified = true
+	}
+	if !modified {
+		return nil
+	}
+	return m.state.SetMaintenanceWindows(nodeID, windows)
+}
+
+// pruneMaintenanceWindows removes the [windows] that ended before [nodeID]
+// started validating the primary network. Those windows can't excuse any
+// downtime of the current staking period. If [nodeID] isn't currently a
+// validator, [windows] is returned unmodified.
+func (m *manager) pruneMaintenanceWindows(nodeID ids.NodeID, windows []MaintenanceWindow) ([]MaintenanceWindow, error) {
+	startTime, err := m.state.GetStartTime(nodeID, constants.PrimaryNetworkID)
+	if err == database.ErrNotFound {
+		return windows, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pruned := windows[:0]
+	for _, window := range windows {
+		if window.End.After(startTime) {
+			pruned = append(pruned, window)
+		}
+	}
+	return pruned, nil
+}
@@ -0,0 +1,268 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package uptime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+)
+
+func TestMaintenanceWindowVerify(t *testing.T) {
+	start := time.Unix(1_000, 0)
+	tests := []struct {
+		name        string
+		window      MaintenanceWindow
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			window: MaintenanceWindow{
+				Start: start,
+				End:   start.Add(time.Hour),
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "ends before start",
+			window: MaintenanceWindow{
+				Start: start,
+				End:   start.Add(-time.Hour),
+			},
+			expectedErr: ErrInvalidMaintenanceWindow,
+		},
+		{
+			name: "empty",
+			window: MaintenanceWindow{
+				Start: start,
+				End:   start,
+			},
+			expectedErr: ErrInvalidMaintenanceWindow,
+		},
+		{
+			name: "too long",
+			window: MaintenanceWindow{
+				Start: start,
+				End:   start.Add(MaxMaintenanceDuration + time.Second),
+			},
+			expectedErr: ErrMaintenanceWindowTooLong,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.ErrorIs(t, test.window.Verify(), test.expectedErr)
+		})
+	}
+}
+
+func TestAddMaintenanceWindow(t *testing.T) {
+	require := require.New(t)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	start := time.Unix(1_000, 0)
+
+	up := NewManager(NewTestState())
+
+	second := MaintenanceWindow{
+		Start: start.Add(2 * time.Hour),
+		End:   start.Add(3 * time.Hour),
+	}
+	first := MaintenanceWindow{
+		Start: start,
+		End:   start.Add(time.Hour),
+	}
+	require.NoError(up.AddMaintenanceWindow(nodeID0, second))
+	require.NoError(up.AddMaintenanceWindow(nodeID0, first))
+
+	// Re-adding an existing window is a noop
+	require.NoError(up.AddMaintenanceWindow(nodeID0, first))
+
+	err := up.AddMaintenanceWindow(nodeID0, MaintenanceWindow{
+		Start: start.Add(30 * time.Minute),
+		End:   start.Add(90 * time.Minute),
+	})
+	require.ErrorIs(err, ErrOverlappingMaintenanceWindow)
+
+	windows, err := up.GetMaintenanceWindows(nodeID0)
+	require.NoError(err)
+	require.Equal([]MaintenanceWindow{first, second}, windows)
+
+	windows, err = up.GetMaintenanceWindows(ids.GenerateTestNodeID())
+	require.NoError(err)
+	require.Empty(windows)
+}
+
+func TestMaintenanceWindowsPersisted(t *testing.T) {
+	require := require.New(t)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	window := MaintenanceWindow{
+		Start: time.Unix(1_000, 0),
+		End:   time.Unix(2_000, 0),
+	}
+
+	s := NewTestState()
+	require.NoError(NewManager(s).AddMaintenanceWindow(nodeID0, window))
+
+	// A restarted manager sees the windows recorded in the state
+	windows, err := NewManager(s).GetMaintenanceWindows(nodeID0)
+	require.NoError(err)
+	require.Equal([]MaintenanceWindow{window}, windows)
+}
+
+func TestAddMaintenanceWindowPrunesExpired(t *testing.T) {
+	require := require.New(t)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	startTime := time.Unix(10_000, 0)
+
+	s := NewTestState()
+	s.AddNode(nodeID0, constants.PrimaryNetworkID, startTime)
+
+	// Windows announced during a prior staking period
+	expired := MaintenanceWindow{
+		Start: startTime.Add(-2 * time.Hour),
+		End:   startTime.Add(-time.Hour),
+	}
+	endsAtStart := MaintenanceWindow{
+		Start: startTime.Add(-time.Hour),
+		End:   startTime,
+	}
+	require.NoError(s.SetMaintenanceWindows(nodeID0, []MaintenanceWindow{expired, endsAtStart}))
+
+	spansStart := MaintenanceWindow{
+		Start: startTime.Add(-time.Minute),
+		End:   startTime.Add(time.Minute),
+	}
+	up := NewManager(s)
+	require.ErrorIs(up.AddMaintenanceWindow(nodeID0, spansStart), ErrOverlappingMaintenanceWindow)
+
+	current := MaintenanceWindow{
+		Start: startTime.Add(time.Hour),
+		End:   startTime.Add(2 * time.Hour),
+	}
+	require.NoError(up.AddMaintenanceWindow(nodeID0, current))
+
+	windows, err := up.GetMaintenanceWindows(nodeID0)
+	require.NoError(err)
+	require.Equal([]MaintenanceWindow{current}, windows)
+}
+
+func TestAddMaintenanceWindowEvictsOldest(t *testing.T) {
+	require := require.New(t)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	start := time.Unix(1_000, 0)
+
+	up := NewManager(NewTestState())
+	for i := 0; i <= MaxMaintenanceWindows; i++ {
+		windowStart := start.Add(time.Duration(i) * time.Hour)
+		require.NoError(up.AddMaintenanceWindow(nodeID0, MaintenanceWindow{
+			Start: windowStart,
+			End:   windowStart.Add(time.Minute),
+		}))
+	}
+
+	windows, err := up.GetMaintenanceWindows(nodeID0)
+	require.NoError(err)
+	require.Len(windows, MaxMaintenanceWindows)
+	require.Equal(start.Add(time.Hour), windows[0].Start)
+}
+
+func TestCalculateUptimePercentExcludingMaintenance(t *testing.T) {
+	require := require.New(t)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	subnetID := constants.PrimaryNetworkID
+	startTime := time.Now().Truncate(time.Second)
+
+	s := NewTestState()
+	s.AddNode(nodeID0, subnetID, startTime)
+
+	up := NewManager(s).(*manager)
+	up.clock.Set(startTime)
+	require.NoError(up.StartTracking([]ids.NodeID{nodeID0}, subnetID))
+
+	// The node is online for 2 hours, offline for the 1 hour maintenance
+	// window, and then online again for 1 hour.
+	require.NoError(up.Connect(nodeID0, subnetID))
+	up.clock.Set(startTime.Add(2 * time.Hour))
+	require.NoError(up.Disconnect(nodeID0))
+	up.clock.Set(startTime.Add(3 * time.Hour))
+	require.NoError(up.Connect(nodeID0, subnetID))
+	up.clock.Set(startTime.Add(4 * time.Hour))
+
+	uptime, err := up.CalculateUptimePercentFrom(nodeID0, subnetID, startTime)
+	require.NoError(err)
+	require.Equal(0.75, uptime)
+
+	require.NoError(up.AddMaintenanceWindow(nodeID0, MaintenanceWindow{
+		Start: startTime.Add(2 * time.Hour),
+		End:   startTime.Add(3 * time.Hour),
+	}))
+
+	uptime, err = up.CalculateUptimePercentExcludingMaintenance(nodeID0, subnetID, startTime)
+	require.NoError(err)
+	require.Equal(float64(1), uptime)
+
+	// The unadjusted uptime is unaffected by the maintenance window
+	uptime, err = up.CalculateUptimePercentFrom(nodeID0, subnetID, startTime)
+	require.NoError(err)
+	require.Equal(0.75, uptime)
+}
+
+func TestCalculateUptimePercentExcludingMaintenanceIgnoresUptimeInWindows(t *testing.T) {
+	require := require.New(t)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	subnetID := constants.PrimaryNetworkID
+	startTime := time.Now().Truncate(time.Second)
+
+	s := NewTestState()
+	s.AddNode(nodeID0, subnetID, startTime)
+
+	up := NewManager(s).(*manager)
+	up.clock.Set(startTime)
+	require.NoError(up.StartTracking([]ids.NodeID{nodeID0}, subnetID))
+	require.NoError(up.AddMaintenanceWindow(nodeID0, MaintenanceWindow{
+		Start: startTime.Add(10 * time.Hour),
+		End:   startTime.Add(34 * time.Hour),
+	}))
+
+	// The node stays online during its maintenance window
+	require.NoError(up.Connect(nodeID0, subnetID))
+	up.clock.Set(startTime.Add(20 * time.Hour))
+
+	uptime, err := up.CalculateUptimePercentExcludingMaintenance(nodeID0, subnetID, startTime)
+	require.NoError(err)
+	require.Equal(float64(1), uptime)
+
+	// The node is offline for 24 hours outside of its maintenance window
+	up.clock.Set(startTime.Add(50 * time.Hour))
+	require.NoError(up.Disconnect(nodeID0))
+	up.clock.Set(startTime.Add(74 * time.Hour))
+	require.NoError(up.Connect(nodeID0, subnetID))
+	up.clock.Set(startTime.Add(100 * time.Hour))
+
+	uptime, err = up.CalculateUptimePercentFrom(nodeID0, subnetID, startTime)
+	require.NoError(err)
+	require.Equal(0.76, uptime)
+
+	// Only the 76 hours outside of the maintenance window are considered, of
+	// which the node was online for 52 hours.
+	uptime, err = up.CalculateUptimePercentExcludingMaintenance(nodeID0, subnetID, startTime)
+	require.NoError(err)
+	require.Equal(float64(52*time.Hour)/float64(76*time.Hour), uptime)
+
+	// The uptime of other subnets isn't adjusted
+	otherSubnetID := ids.GenerateTestID()
+	s.AddNode(nodeID0, otherSubnetID, startTime)
+	uptime, err = up.CalculateUptimePercentExcludingMaintenance(nodeID0, otherSubnetID, startTime)
+	require.NoError(err)
+	require.Equal(float64(1), uptime)
+}
@@ -17,6 +17,7 @@ var _ TestManager = (*manager)(nil)
 type Manager interface {
 	Tracker
 	Calculator
+	MaintenanceTracker
 }
 
 type Tracker interface {
@@ -26,6 +27,15 @@ type Tracker interface {
 	Connect(nodeID ids.NodeID, subnetID ids.ID) error
 	IsConnected(nodeID ids.NodeID, subnetID ids.ID) bool
 	Disconnect(nodeID ids.NodeID) error
+
+	// ConnectedSince returns the time [nodeID] connected to [subnetID]. Returns
+	// false if [nodeID] isn't currently connected to [subnetID].
+	ConnectedSince(nodeID ids.NodeID, subnetID ids.ID) (time.Time, bool)
+	// LastConnected returns the last time [nodeID] was observed to be
+	// connected to [subnetID]. If [nodeID] is currently connected, the current
+	// time is returned. Returns false if [nodeID] hasn't been connected to
+	// [subnetID] since this node started.
+	LastConnected(nodeID ids.NodeID, subnetID ids.ID) (time.Time, bool)
 }
 
 type Calculator interface {
@@ -46,13 +56,15 @@ type manager struct {
 
 	state          State
 	connections    map[ids.NodeID]map[ids.ID]time.Time // nodeID -> subnetID -> time
+	disconnections map[ids.NodeID]map[ids.ID]time.Time // nodeID -> subnetID -> time, for subnets the node isn't connected to
 	trackedSubnets set.Set[ids.ID]
 }
 
 func NewManager(state State) Manager {
 	return &manager{
-		state:       state,
-		connections: make(map[ids.NodeID]map[ids.ID]time.Time),
+		state:          state,
+		connections:    make(map[ids.NodeID]map[ids.ID]time.Time),
+		disconnections: make(map[ids.NodeID]map[ids.ID]time.Time),
 	}
 }
 
@@ -75,6 +87,9 @@ func (m *manager) StartTracking(nodeIDs []ids.NodeID, subnetID ids.ID) error {
 		if err := m.state.SetUptime(nodeID, subnetID, newUpDuration, now); err != nil {
 			return err
 		}
+		if err := m.accrueMaintenanceUptime(nodeID, subnetID, lastUpdated, now); err != nil {
+			return err
+		}
 	}
 	m.trackedSubnets.Add(subnetID)
 	return nil
@@ -123,6 +138,12 @@ func (m *manager) Connect(nodeID ids.NodeID, subnetID ids.ID) error {
 		m.connections[nodeID] = subnetConnections
 	}
 	subnetConnections[subnetID] = m.clock.UnixTime()
+
+	subnetDisconnections := m.disconnections[nodeID]
+	delete(subnetDisconnections, subnetID)
+	if len(subnetDisconnections) == 0 {
+		delete(m.disconnections, nodeID)
+	}
 	return nil
 }
 
@@ -132,38 +153,68 @@ func (m *manager) IsConnected(nodeID ids.NodeID, subnetID ids.ID) bool {
 }
 
 func (m *manager) Disconnect(nodeID ids.NodeID) error {
+	now := m.clock.UnixTime()
 	// Update every subnet that this node was connected to
 	for subnetID := range m.connections[nodeID] {
 		if err := m.updateSubnetUptime(nodeID, subnetID); err != nil {
 			return err
 		}
+
+		subnetDisconnections, ok := m.disconnections[nodeID]
+		if !ok {
+			subnetDisconnections = make(map[ids.ID]time.Time)
+			m.disconnections[nodeID] = subnetDisconnections
+		}
+		subnetDisconnections[subnetID] = now
 	}
 	delete(m.connections, nodeID)
 	return nil
 }
 
+func (m *manager) ConnectedSince(nodeID ids.NodeID, subnetID ids.ID) (time.Time, bool) {
+	timeConnected, connected := m.connections[nodeID][subnetID]
+	return timeConnected, connected
+}
+
+func (m *manager) LastConnected(nodeID ids.NodeID, subnetID ids.ID) (time.Time, bool) {
+	if m.IsConnected(nodeID, subnetID) {
+		return m.clock.UnixTime(), true
+	}
+	timeDisconnected, ok := m.disconnections[nodeID][subnetID]
+	return timeDisconnected, ok
+}
+
 func (m *manager) CalculateUptime(nodeID ids.NodeID, subnetID ids.ID) (time.Duration, time.Time, error) {
+	upDuration, lastUpdated, _, err := m.calculateUptime(nodeID, subnetID)
+	return upDuration, lastUpdated, err
+}
+
+// calculateUptime is the same as CalculateUptime, except that it additionally
+// returns the start of the period that was counted as up since the uptime was
+// last written to the state. If no such period exists, the returned start
+// equals the returned time.
+func (m *manager) calculateUptime(nodeID ids.NodeID, subnetID ids.ID) (time.Duration, time.Time, time.Time, error) {
 	upDuration, lastUpdated, err := m.state.GetUptime(nodeID, subnetID)
 	if err != nil {
-		return 0, time.Time{}, err
+		return 0, time.Time{}, time.Time{}, err
 	}
 
 	now := m.clock.UnixTime()
 	// If we are in a weird reality where time has gone backwards, make sure
 	// that we don't double count or delete any uptime.
 	if now.Before(lastUpdated) {
-		return upDuration, lastUpdated, nil
+		return upDuration, lastUpdated, lastUpdated, nil
 	}
 
 	if !m.trackedSubnets.Contains(subnetID) {
 		durationOffline := now.Sub(lastUpdated)
 		newUpDuration := upDuration + durationOffline
-		return newUpDuration, now, nil
+		return newUpDuration, now, lastUpdated, nil
 	}
 
 	timeConnected, isConnected := m.connections[nodeID][subnetID]
 	if !isConnected {
-		return upDuration, now, nil
+		return upDuration, now, now, nil
 	}
 
 	// The time the peer connected needs to be adjusted to ensure no time period
@@ -175,14 +226,14 @@ func (m *manager) CalculateUptime(nodeID ids.NodeID, subnetID ids.ID) (time.Dura
 	// If we are in a weird reality where time has gone backwards, make sure
 	// that we don't double count or delete any uptime.
 	if now.Before(timeConnected) {
-		return upDuration, now, nil
+		return upDuration, now, now, nil
 	}
 
 	// Increase the uptimes by the amount of time this node has been running
 	// since the last time it's uptime was written to disk.
 	durationConnected := now.Sub(timeConnected)
 	newUpDuration := upDuration + durationConnected
-	return newUpDuration, now, nil
+	return newUpDuration, now, timeConnected, nil
 }
 
 func (m *manager) CalculateUptimePercent(nodeID ids.NodeID, subnetID ids.ID) (float64, error) {
@@ -218,7 +269,7 @@ func (m *manager) updateSubnetUptime(nodeID ids.NodeID, subnetID ids.ID) error {
 		return nil
 	}
 
-	newDuration, newLastUpdated, err := m.CalculateUptime(nodeID, subnetID)
+	newDuration, newLastUpdated, upStart, err := m.calculateUptime(nodeID, subnetID)
 	if err == database.ErrNotFound {
 		// If a non-validator disconnects, we don't care
 		return nil
@@ -227,5 +278,8 @@ func (m *manager) updateSubnetUptime(nodeID ids.NodeID, subnetID ids.ID) error {
 		return err
 	}
 
-	return m.state.SetUptime(nodeID, subnetID, newDuration, newLastUpdated)
+	if err := m.state.SetUptime(nodeID, subnetID, newDuration, newLastUpdated); err != nil {
+		return err
+	}
+	return m.accrueMaintenanceUptime(nodeID, subnetID, upStart, newLastUpdated)
 }
@@ -323,6 +323,54 @@ func TestConnectAndDisconnect(t *testing.T) {
 	}
 }
 
+func TestConnectionTimes(t *testing.T) {
+	require := require.New(t)
+
+	nodeID0 := ids.GenerateTestNodeID()
+	subnetID := ids.GenerateTestID()
+	currentTime := time.Now()
+
+	s := NewTestState()
+	s.AddNode(nodeID0, subnetID, currentTime)
+
+	up := NewManager(s).(*manager)
+	up.clock.Set(currentTime)
+	require.NoError(up.StartTracking([]ids.NodeID{nodeID0}, subnetID))
+
+	_, connected := up.ConnectedSince(nodeID0, subnetID)
+	require.False(connected)
+	_, seen := up.LastConnected(nodeID0, subnetID)
+	require.False(seen)
+
+	connectTime := up.clock.UnixTime()
+	require.NoError(up.Connect(nodeID0, subnetID))
+
+	currentTime = currentTime.Add(time.Second)
+	up.clock.Set(currentTime)
+
+	connectedSince, connected := up.ConnectedSince(nodeID0, subnetID)
+	require.True(connected)
+	require.Equal(connectTime, connectedSince)
+	lastConnected, seen := up.LastConnected(nodeID0, subnetID)
+	require.True(seen)
+	require.Equal(up.clock.UnixTime(), lastConnected)
+
+	disconnectTime := up.clock.UnixTime()
+	require.NoError(up.Disconnect(nodeID0))
+
+	currentTime = currentTime.Add(time.Second)
+	up.clock.Set(currentTime)
+
+	_, connected = up.ConnectedSince(nodeID0, subnetID)
+	require.False(connected)
+	lastConnected, seen = up.LastConnected(nodeID0, subnetID)
+	require.True(seen)
+	require.Equal(disconnectTime, lastConnected)
+
+	require.NoError(up.Connect(nodeID0, subnetID))
+	require.Empty(up.disconnections)
+}
+
 func TestConnectAndDisconnectBeforeTracking(t *testing.T) {
 	require := require.New(t)
 
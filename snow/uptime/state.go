@@ -40,4 +40,12 @@ type State interface {
 		nodeID ids.NodeID,
 		subnetID ids.ID,
 	) (startTime time.Time, err error)
+
+	// GetMaintenanceWindows returns the maintenance windows recorded for
+	// [nodeID], sorted by start time.
+	GetMaintenanceWindows(nodeID ids.NodeID) ([]MaintenanceWindow, error)
+
+	// SetMaintenanceWindows replaces the maintenance windows recorded for
+	// [nodeID]. If [windows] is empty, the record of [nodeID] is removed.
+	SetMaintenanceWindows(nodeID ids.NodeID, windows []MaintenanceWindow) error
 }
@@ -22,11 +22,13 @@ type TestState struct {
 	dbReadError  error
 	dbWriteError error
 	nodes        map[ids.NodeID]map[ids.ID]*uptime
+	maintenance  map[ids.NodeID][]MaintenanceWindow
 }
 
 func NewTestState() *TestState {
 	return &TestState{
-		nodes: make(map[ids.NodeID]map[ids.ID]*uptime),
+		nodes:       make(map[ids.NodeID]map[ids.ID]*uptime),
+		maintenance: make(map[ids.NodeID][]MaintenanceWindow),
 	}
 }
 
@@ -68,3 +70,21 @@ func (s *TestState) GetStartTime(nodeID ids.NodeID, subnetID ids.ID) (time.Time,
 	}
 	return up.startTime, s.dbReadError
 }
+
+func (s *TestState) GetMaintenanceWindows(nodeID ids.NodeID) ([]MaintenanceWindow, error) {
+	windows := s.maintenance[nodeID]
+	result := make([]MaintenanceWindow, len(windows))
+	copy(result, windows)
+	return result, s.dbReadError
+}
+
+func (s *TestState) SetMaintenanceWindows(nodeID ids.NodeID, windows []MaintenanceWindow) error {
+	if len(windows) == 0 {
+		delete(s.maintenance, nodeID)
+		return s.dbWriteError
+	}
+	stored := make([]MaintenanceWindow, len(windows))
+	copy(stored, windows)
+	s.maintenance[nodeID] = stored
+	return s.dbWriteError
+}
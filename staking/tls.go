@@ -10,6 +10,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
@@ -19,58 +20,86 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/perms"
 )
 
+var (
+	ErrStakingKeyExists  = errors.New("staking key already exists")
+	ErrStakingCertExists = errors.New("staking certificate already exists")
+	errMissingStakingKey = errors.New("staking certificate exists without its key")
+)
+
 // InitNodeStakingKeyPair generates a self-signed TLS key/cert pair to use in
 // staking. The key and files will be placed at [keyPath] and [certPath],
 // respectively. If there is already a file at [keyPath], returns nil.
+//
+// If there is a file at [certPath] but not at [keyPath], an error is returned
+// rather than replacing the certificate, as doing so would silently change the
+// node's identity.
 func InitNodeStakingKeyPair(keyPath, certPath string) error {
 	// If there is already a file at [keyPath], do nothing
 	if _, err := os.Stat(keyPath); !os.IsNotExist(err) {
 		return nil
 	}
+	if _, err := os.Stat(certPath); !os.IsNotExist(err) {
+		return fmt.Errorf("%w: found certificate at %s but no key at %s", errMissingStakingKey, certPath, keyPath)
+	}
+
+	_, err := NewNodeStakingKeyPair(keyPath, certPath)
+	return err
+}
+
+// NewNodeStakingKeyPair generates a self-signed TLS key/cert pair to use in
+// staking and writes them to [keyPath] and [certPath], respectively. Returns
+// an error if either file already exists.
+func NewNodeStakingKeyPair(keyPath, certPath string) (*tls.Certificate, error) {
+	if _, err := os.Stat(keyPath); !os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w at %s", ErrStakingKeyExists, keyPath)
+	}
+	if _, err := os.Stat(certPath); !os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w at %s", ErrStakingCertExists, certPath)
+	}
 
 	certBytes, keyBytes, err := NewCertAndKeyBytes()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Ensure directory where key/cert will live exist
 	if err := os.MkdirAll(filepath.Dir(certPath), perms.ReadWriteExecute); err != nil {
-		return fmt.Errorf("couldn't create path for cert: %w", err)
+		return nil, fmt.Errorf("couldn't create path for cert: %w", err)
 	}
 	if err := os.MkdirAll(filepath.Dir(keyPath), perms.ReadWriteExecute); err != nil {
-		return fmt.Errorf("couldn't create path for key: %w", err)
+		return nil, fmt.Errorf("couldn't create path for key: %w", err)
 	}
 
 	// Write cert to disk
 	certFile, err := os.Create(certPath)
 	if err != nil {
-		return fmt.Errorf("couldn't create cert file: %w", err)
+		return nil, fmt.Errorf("couldn't create cert file: %w", err)
 	}
 	if _, err := certFile.Write(certBytes); err != nil {
-		return fmt.Errorf("couldn't write cert file: %w", err)
+		return nil, fmt.Errorf("couldn't write cert file: %w", err)
 	}
 	if err := certFile.Close(); err != nil {
-		return fmt.Errorf("couldn't close cert file: %w", err)
+		return nil, fmt.Errorf("couldn't close cert file: %w", err)
 	}
 	if err := os.Chmod(certPath, perms.ReadOnly); err != nil { // Make cert read-only
-		return fmt.Errorf("couldn't change permissions on cert: %w", err)
+		return nil, fmt.Errorf("couldn't change permissions on cert: %w", err)
 	}
 
 	// Write key to disk
 	keyOut, err := os.Create(keyPath)
 	if err != nil {
-		return fmt.Errorf("couldn't create key file: %w", err)
+		return nil, fmt.Errorf("couldn't create key file: %w", err)
 	}
 	if _, err := keyOut.Write(keyBytes); err != nil {
-		return fmt.Errorf("couldn't write private key: %w", err)
+		return nil, fmt.Errorf("couldn't write private key: %w", err)
 	}
 	if err := keyOut.Close(); err != nil {
-		return fmt.Errorf("couldn't close key file: %w", err)
+		return nil, fmt.Errorf("couldn't close key file: %w", err)
 	}
 	if err := os.Chmod(keyPath, perms.ReadOnly); err != nil { // Make key read-only
-		return fmt.Errorf("couldn't change permissions on key: %w", err)
+		return nil, fmt.Errorf("couldn't change permissions on key: %w", err)
 	}
-	return nil
+	return LoadTLSCertFromBytes(keyBytes, certBytes)
 }
 
 func LoadTLSCertFromBytes(keyBytes, certBytes []byte) (*tls.Certificate, error) {
@@ -98,6 +127,19 @@ func LoadTLSCertFromFiles(keyPath, certPath string) (*tls.Certificate, error) {
 	return &cert, nil
 }
 
+// EncodeTLSCertToPEM returns the PEM encodings of [cert]'s certificate and
+// private key, in the format read by LoadTLSCertFromBytes.
+func EncodeTLSCertToPEM(cert *tls.Certificate) ([]byte, []byte, error) {
+	certBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+
+	privBytes, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't marshal private key: %w", err)
+	}
+	keyBytes := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privBytes})
+	return certBytes, keyBytes, nil
+}
+
 func NewTLSCert() (*tls.Certificate, error) {
 	certBytes, keyBytes, err := NewCertAndKeyBytes()
 	if err != nil {
@@ -7,6 +7,8 @@ import (
 	"crypto"
 	"crypto/rand"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -29,3 +31,47 @@ func TestMakeKeys(t *testing.T) {
 
 	require.NoError(cert.Leaf.CheckSignature(cert.Leaf.SignatureAlgorithm, msg, sig))
 }
+
+func TestNewNodeStakingKeyPair(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "staker.key")
+	certPath := filepath.Join(dir, "staker.crt")
+
+	cert, err := NewNodeStakingKeyPair(keyPath, certPath)
+	require.NoError(err)
+
+	loadedCert, err := LoadTLSCertFromFiles(keyPath, certPath)
+	require.NoError(err)
+	require.Equal(cert.Leaf.Raw, loadedCert.Leaf.Raw)
+
+	certBytes, keyBytes, err := EncodeTLSCertToPEM(loadedCert)
+	require.NoError(err)
+	encodedCert, err := LoadTLSCertFromBytes(keyBytes, certBytes)
+	require.NoError(err)
+	require.Equal(cert.Leaf.Raw, encodedCert.Leaf.Raw)
+
+	// Existing files are never overwritten
+	_, err = NewNodeStakingKeyPair(keyPath, filepath.Join(dir, "other.crt"))
+	require.ErrorIs(err, ErrStakingKeyExists)
+	_, err = NewNodeStakingKeyPair(filepath.Join(dir, "other.key"), certPath)
+	require.ErrorIs(err, ErrStakingCertExists)
+}
+
+func TestInitNodeStakingKeyPairMissingKey(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "staker.key")
+	certPath := filepath.Join(dir, "staker.crt")
+
+	_, err := NewNodeStakingKeyPair(keyPath, certPath)
+	require.NoError(err)
+	require.NoError(InitNodeStakingKeyPair(keyPath, certPath))
+
+	// A certificate without its key must not be replaced by a new identity
+	require.NoError(os.Remove(keyPath))
+	err = InitNodeStakingKeyPair(keyPath, certPath)
+	require.ErrorIs(err, errMissingStakingKey)
+}
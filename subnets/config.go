@@ -13,7 +13,13 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/set"
 )
 
-var errAllowedNodesWhenNotValidatorOnly = errors.New("allowedNodes can only be set when ValidatorOnly is true")
+var (
+	errAllowedNodesWhenNotValidatorOnly       = errors.New("allowedNodes can only be set when ValidatorOnly is true")
+	errEncryptAppMessagesWhenNotValidatorOnly = errors.New("encryptAppMessages can only be set when ValidatorOnly is true")
+	errNegativeBenchlistDuration              = errors.New("benchlistDuration must be >= 0")
+	errNegativeBenchlistMinFailingDuration    = errors.New("benchlistMinFailingDuration must be >= 0")
+	errInvalidBenchlistMaxPortion             = errors.New("benchlistMaxPortion must be in [0,1)")
+)
 
 type GossipConfig struct {
 	AcceptedFrontierValidatorSize    uint `json:"gossipAcceptedFrontierValidatorSize" yaml:"gossipAcceptedFrontierValidatorSize"`
@@ -27,8 +33,24 @@ type GossipConfig struct {
 	AppGossipPeerSize                uint `json:"appGossipPeerSize" yaml:"appGossipPeerSize"`
 }
 
+// BenchlistConfig configures when the peers of this Subnet's Chains are
+// benched after failing to respond to queries.
+type BenchlistConfig struct {
+	// A node is benched after [BenchlistFailThreshold] consecutive queries
+	// to it fail over at least [BenchlistMinFailingDuration].
+	BenchlistFailThreshold      int           `json:"benchlistFailThreshold" yaml:"benchlistFailThreshold"`
+	BenchlistMinFailingDuration time.Duration `json:"benchlistMinFailingDuration" yaml:"benchlistMinFailingDuration"`
+	// A benched node stays benched for between half of and the full
+	// [BenchlistDuration].
+	BenchlistDuration time.Duration `json:"benchlistDuration" yaml:"benchlistDuration"`
+	// BenchlistMaxPortion is the maximum portion of the Subnet's stake that
+	// may be benched at once. If 0, nodes are never benched automatically.
+	BenchlistMaxPortion float64 `json:"benchlistMaxPortion" yaml:"benchlistMaxPortion"`
+}
+
 type Config struct {
 	GossipConfig
+	BenchlistConfig
 
 	// ValidatorOnly indicates that this Subnet's Chains are available to only subnet validators.
 	// No chain related messages will go out to non-validators.
@@ -37,7 +59,13 @@ type Config struct {
 	ValidatorOnly bool `json:"validatorOnly" yaml:"validatorOnly"`
 	// AllowedNodes is the set of node IDs that are explicitly allowed to connect to this Subnet when
 	// ValidatorOnly is enabled.
-	AllowedNodes        set.Set[ids.NodeID] `json:"allowedNodes" yaml:"allowedNodes"`
+	AllowedNodes set.Set[ids.NodeID] `json:"allowedNodes" yaml:"allowedNodes"`
+	// EncryptAppMessages indicates that app requests, responses, and gossip
+	// sent between nodes on this Subnet's Chains must be encrypted for their
+	// recipient and signed by their sender, using keys derived from the nodes'
+	// staking TLS identities. App messages that aren't sealed this way are
+	// dropped. Requires [ValidatorOnly].
+	EncryptAppMessages  bool                `json:"encryptAppMessages" yaml:"encryptAppMessages"`
 	ConsensusParameters snowball.Parameters `json:"consensusParameters" yaml:"consensusParameters"`
 
 	// ProposerMinBlockDelay is the minimum delay this node will enforce when
@@ -69,8 +97,19 @@ func (c *Config) Valid() error {
 	if err := c.ConsensusParameters.Verify(); err != nil {
 		return fmt.Errorf("consensus %w", err)
 	}
+	switch {
+	case c.BenchlistDuration < 0:
+		return errNegativeBenchlistDuration
+	case c.BenchlistMinFailingDuration < 0:
+		return errNegativeBenchlistMinFailingDuration
+	case c.BenchlistMaxPortion < 0 || c.BenchlistMaxPortion >= 1:
+		return errInvalidBenchlistMaxPortion
+	}
 	if !c.ValidatorOnly && c.AllowedNodes.Len() > 0 {
 		return errAllowedNodesWhenNotValidatorOnly
 	}
+	if !c.ValidatorOnly && c.EncryptAppMessages {
+		return errEncryptAppMessagesWhenNotValidatorOnly
+	}
 	return nil
 }
@@ -22,6 +22,7 @@ var validParameters = snowball.Parameters{
 	OptimalProcessing:     1,
 	MaxOutstandingItems:   1,
 	MaxItemProcessingTime: 1,
+	MaxProcessingDepth:    1,
 }
 
 func TestValid(t *testing.T) {
@@ -49,6 +50,35 @@ func TestValid(t *testing.T) {
 			},
 			expectedErr: errAllowedNodesWhenNotValidatorOnly,
 		},
+		{
+			name: "encrypted app messages when not validator only",
+			s: Config{
+				EncryptAppMessages:  true,
+				ValidatorOnly:       false,
+				ConsensusParameters: validParameters,
+			},
+			expectedErr: errEncryptAppMessagesWhenNotValidatorOnly,
+		},
+		{
+			name: "negative benchlist duration",
+			s: Config{
+				ConsensusParameters: validParameters,
+				BenchlistConfig: BenchlistConfig{
+					BenchlistDuration: -1,
+				},
+			},
+			expectedErr: errNegativeBenchlistDuration,
+		},
+		{
+			name: "benchlist max portion too large",
+			s: Config{
+				ConsensusParameters: validParameters,
+				BenchlistConfig: BenchlistConfig{
+					BenchlistMaxPortion: 1,
+				},
+			},
+			expectedErr: errInvalidBenchlistMaxPortion,
+		},
 		{
 			name: "valid",
 			s: Config{
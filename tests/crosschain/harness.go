@@ -0,0 +1,435 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package crosschain provides an in-process harness that runs an ALPHA chain
+// and an Omega chain on top of the same shared memory, so that atomic
+// transfers between them can be tested without running a network.
+package crosschain
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/api/keystore"
+	"github.com/DioneProtocol/odysseygo/chains"
+	"github.com/DioneProtocol/odysseygo/chains/atomic"
+	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
+	"github.com/DioneProtocol/odysseygo/genesis"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/snow/engine/snowman/block"
+	"github.com/DioneProtocol/odysseygo/snow/uptime"
+	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/version"
+	"github.com/DioneProtocol/odysseygo/vms/alpha"
+	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
+	"github.com/DioneProtocol/odysseygo/vms/nftfx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+
+	apiutils "github.com/DioneProtocol/odysseygo/api"
+	alphaconfig "github.com/DioneProtocol/odysseygo/vms/alpha/config"
+	keystoreutils "github.com/DioneProtocol/odysseygo/vms/components/keystore"
+	omegaapi "github.com/DioneProtocol/odysseygo/vms/omegavm/api"
+	omegaconfig "github.com/DioneProtocol/odysseygo/vms/omegavm/config"
+)
+
+const (
+	// TxFee is the fee charged by both chains for every tx
+	TxFee = units.MilliDione
+	// Balance is the amount of DIONE every funded key holds on each chain at
+	// genesis
+	Balance = 100 * units.Dione
+
+	// Username and Password of the keystore user that holds the funded keys on
+	// both chains
+	Username = "crosschain"
+	Password = "EwAVj95iE6FbAEqQjzx8F7Ex" //#nosec G101
+)
+
+var (
+	AChainID = ids.Empty.Prefix(0)
+	DChainID = ids.Empty.Prefix(1)
+
+	// Keys are funded with [Balance] DIONE on both chains at genesis
+	Keys = secp256k1.TestKeys()
+)
+
+// Harness runs an ALPHA chain and an Omega chain that share atomic memory.
+// The chains serve their APIs over HTTP at [URI], so they can be driven with
+// the regular API clients. Txs issued to a chain are only accepted once the
+// harness is asked to accept a block on that chain.
+type Harness struct {
+	// URI of the HTTP server that serves the APIs of both chains
+	URI string
+	// Keystore that holds [Keys] for the user [Username] on both chains
+	Keystore keystore.Keystore
+	// The atomic memory shared by both chains
+	SharedMemory *atomic.Memory
+	// DIONEAssetID is the ID of DIONE on both chains
+	DIONEAssetID ids.ID
+
+	Alpha       *alpha.VM
+	AlphaClient alpha.Client
+	Omega       *omegavm.VM
+	OmegaClient omegavm.Client
+
+	alphaCtx *snow.Context
+	omegaCtx *snow.Context
+	server   *httptest.Server
+}
+
+// New starts a harness. The chains are shut down when [tb] finishes.
+func New(tb testing.TB) *Harness {
+	require := require.New(tb)
+
+	baseDB := manager.NewMemDB(version.Semantic1_0_0)
+	sharedMemory := atomic.NewMemory(prefixdb.New([]byte{0}, baseDB.Current().Database))
+	feeCollector, err := feecollector.New(prefixdb.New([]byte{1}, baseDB.Current().Database))
+	require.NoError(err)
+
+	ks := keystore.New(
+		snow.DefaultContextTest().Log,
+		baseDB.NewPrefixDBManager([]byte{2}),
+	)
+	require.NoError(ks.CreateUser(Username, Password))
+
+	alphaGenesisBytes := buildAlphaGenesis(tb)
+	dioneAssetID, err := genesis.DIONEAssetID(alphaGenesisBytes)
+	require.NoError(err)
+
+	h := &Harness{
+		Keystore:     ks,
+		SharedMemory: sharedMemory,
+		DIONEAssetID: dioneAssetID,
+		Alpha: &alpha.VM{
+			Config: alphaconfig.Config{
+				TxFee:            TxFee,
+				CreateAssetTxFee: TxFee,
+			},
+		},
+		Omega: &omegavm.VM{
+			Config: omegaConfig(),
+		},
+	}
+	h.alphaCtx = newContext(tb, AChainID, dioneAssetID, sharedMemory, feeCollector, ks)
+	h.omegaCtx = newContext(tb, constants.OmegaChainID, dioneAssetID, sharedMemory, feeCollector, ks)
+
+	h.alphaCtx.Lock.Lock()
+	require.NoError(h.Alpha.Initialize(
+		context.Background(),
+		h.alphaCtx,
+		baseDB.NewPrefixDBManager([]byte{3}),
+		alphaGenesisBytes,
+		nil,
+		nil,
+		nil,
+		[]*common.Fx{
+			{
+				ID: secp256k1fx.ID,
+				Fx: &secp256k1fx.Fx{},
+			},
+			{
+				ID: nftfx.ID,
+				Fx: &nftfx.Fx{},
+			},
+		},
+		&common.SenderTest{},
+	))
+	require.NoError(h.Alpha.SetState(context.Background(), snow.Bootstrapping))
+	require.NoError(h.Alpha.Linearize(context.Background(), ids.GenerateTestID(), make(chan common.Message, 1)))
+	require.NoError(h.Alpha.SetState(context.Background(), snow.NormalOp))
+	h.alphaCtx.Lock.Unlock()
+
+	h.omegaCtx.Lock.Lock()
+	require.NoError(h.Omega.Initialize(
+		context.Background(),
+		h.omegaCtx,
+		baseDB.NewPrefixDBManager([]byte{4}),
+		buildOmegaGenesis(tb, dioneAssetID),
+		nil,
+		nil,
+		make(chan common.Message, 1),
+		nil,
+		&common.SenderTest{
+			SendAppGossipF: func(context.Context, []byte) error {
+				return nil
+			},
+		},
+	))
+	require.NoError(h.Omega.SetState(context.Background(), snow.NormalOp))
+	h.omegaCtx.Lock.Unlock()
+
+	for _, chainCtx := range []*snow.Context{h.alphaCtx, h.omegaCtx} {
+		user, err := keystoreutils.NewUserFromKeystore(chainCtx.Keystore, Username, Password)
+		require.NoError(err)
+		require.NoError(user.PutKeys(Keys...))
+		require.NoError(user.Close())
+	}
+
+	mux := http.NewServeMux()
+	alphaHandlers, err := h.Alpha.CreateHandlers(context.Background())
+	require.NoError(err)
+	for extension, handler := range alphaHandlers {
+		mux.Handle("/ext/bc/A"+extension, lockedHandler(&h.alphaCtx.Lock, handler))
+	}
+	omegaHandlers, err := h.Omega.CreateHandlers(context.Background())
+	require.NoError(err)
+	for extension, handler := range omegaHandlers {
+		mux.Handle("/ext/O"+extension, lockedHandler(&h.omegaCtx.Lock, handler))
+	}
+
+	h.server = httptest.NewServer(mux)
+	h.URI = h.server.URL
+	h.AlphaClient = alpha.NewClient(h.URI, "A")
+	h.OmegaClient = omegavm.NewClient(h.URI)
+
+	tb.Cleanup(h.shutdown)
+	return h
+}
+
+// UserPass returns the credentials of the keystore user that holds [Keys].
+func (*Harness) UserPass() apiutils.UserPass {
+	return apiutils.UserPass{
+		Username: Username,
+		Password: Password,
+	}
+}
+
+// AcceptAlpha builds, verifies, and accepts a block containing the pending
+// txs of the ALPHA chain.
+func (h *Harness) AcceptAlpha(ctx context.Context) error {
+	h.alphaCtx.Lock.Lock()
+	defer h.alphaCtx.Lock.Unlock()
+
+	return acceptBlock(ctx, h.Alpha)
+}
+
+// AcceptOmega builds, verifies, and accepts a block containing the pending
+// txs of the Omega chain.
+func (h *Harness) AcceptOmega(ctx context.Context) error {
+	h.omegaCtx.Lock.Lock()
+	defer h.omegaCtx.Lock.Unlock()
+
+	return acceptBlock(ctx, h.Omega)
+}
+
+// AtomicUTXOs returns the number of UTXOs that [sourceChainID] has exported
+// to [destinationChainID] and that haven't been imported yet.
+func (h *Harness) AtomicUTXOs(sourceChainID, destinationChainID ids.ID, addrs ...ids.ShortID) (int, error) {
+	traits := make([][]byte, len(addrs))
+	for i, addr := range addrs {
+		traits[i] = addr.Bytes()
+	}
+	utxos, _, _, err := h.SharedMemory.NewSharedMemory(destinationChainID).Indexed(
+		sourceChainID,
+		traits,
+		nil,
+		nil,
+		int(units.KiB),
+	)
+	return len(utxos), err
+}
+
+func (h *Harness) shutdown() {
+	h.server.Close()
+
+	h.alphaCtx.Lock.Lock()
+	_ = h.Alpha.Shutdown(context.Background())
+	h.alphaCtx.Lock.Unlock()
+
+	h.omegaCtx.Lock.Lock()
+	_ = h.Omega.Shutdown(context.Background())
+	h.omegaCtx.Lock.Unlock()
+}
+
+func acceptBlock(ctx context.Context, vm block.ChainVM) error {
+	blk, err := vm.BuildBlock(ctx)
+	if err != nil {
+		return err
+	}
+	if err := blk.Verify(ctx); err != nil {
+		return err
+	}
+	if err := vm.SetPreference(ctx, blk.ID()); err != nil {
+		return err
+	}
+	return blk.Accept(ctx)
+}
+
+// lockedHandler holds the chain's lock, as requested by [handler], while
+// serving requests, the same way the node's API server does.
+func lockedHandler(lock *sync.RWMutex, handler *common.HTTPHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch handler.LockOptions {
+		case common.WriteLock:
+			lock.Lock()
+			defer lock.Unlock()
+		case common.ReadLock:
+			lock.RLock()
+			defer lock.RUnlock()
+		}
+		handler.Handler.ServeHTTP(w, r)
+	})
+}
+
+func newContext(
+	tb testing.TB,
+	chainID ids.ID,
+	dioneAssetID ids.ID,
+	sharedMemory *atomic.Memory,
+	feeCollector feecollector.FeeCollector,
+	ks keystore.Keystore,
+) *snow.Context {
+	require := require.New(tb)
+
+	ctx := snow.DefaultContextTest()
+	ctx.NetworkID = constants.UnitTestID
+	ctx.SubnetID = constants.PrimaryNetworkID
+	ctx.ChainID = chainID
+	ctx.AChainID = AChainID
+	ctx.DChainID = DChainID
+	ctx.DIONEAssetID = dioneAssetID
+	ctx.SharedMemory = sharedMemory.NewSharedMemory(chainID)
+	ctx.FeeCollector = feeCollector
+	ctx.Keystore = ks.NewBlockchainKeyStore(chainID)
+
+	aliaser := ctx.BCLookup.(ids.Aliaser)
+	require.NoError(aliaser.Alias(AChainID, "A"))
+	require.NoError(aliaser.Alias(AChainID, AChainID.String()))
+	require.NoError(aliaser.Alias(constants.OmegaChainID, "O"))
+	require.NoError(aliaser.Alias(constants.OmegaChainID, constants.OmegaChainID.String()))
+
+	ctx.ValidatorState = &validators.TestState{
+		GetSubnetIDF: func(context.Context, ids.ID) (ids.ID, error) {
+			return constants.PrimaryNetworkID, nil
+		},
+	}
+	return ctx
+}
+
+func omegaConfig() omegaconfig.Config {
+	vdrs := validators.NewManager()
+	_ = vdrs.Add(constants.PrimaryNetworkID, validators.NewSet())
+	return omegaconfig.Config{
+		Chains:                    chains.TestManager,
+		UptimeLockedCalculator:    uptime.NewLockedCalculator(),
+		Validators:                vdrs,
+		TxFee:                     TxFee,
+		CreateSubnetTxFee:         TxFee,
+		TransformSubnetTxFee:      TxFee,
+		CreateBlockchainTxFee:     TxFee,
+		MinValidatorStake:         units.Dione,
+		MaxValidatorStake:         units.MegaDione,
+		MinDelegatorStake:         units.Dione,
+		MinValidatorStakeDuration: 24 * time.Hour,
+		MaxValidatorStakeDuration: 365 * 24 * time.Hour,
+		MinDelegatorStakeDuration: 24 * time.Hour,
+		MaxDelegatorStakeDuration: 365 * 24 * time.Hour,
+		RewardConfig: reward.Config{
+			MaxConsumptionRate: .12 * reward.PercentDenominator,
+			MinConsumptionRate: .10 * reward.PercentDenominator,
+			MintingPeriod:      365 * 24 * time.Hour,
+			SupplyCap:          720 * units.MegaDione,
+		},
+	}
+}
+
+func buildAlphaGenesis(tb testing.TB) []byte {
+	require := require.New(tb)
+
+	holders := make([]interface{}, len(Keys))
+	for i, key := range Keys {
+		addr, err := address.FormatBech32(constants.UnitTestHRP, key.Address().Bytes())
+		require.NoError(err)
+		holders[i] = alpha.Holder{
+			Amount:  json.Uint64(Balance),
+			Address: addr,
+		}
+	}
+
+	reply := alpha.BuildGenesisReply{}
+	require.NoError(alpha.CreateStaticService().BuildGenesis(nil, &alpha.BuildGenesisArgs{
+		Encoding: formatting.Hex,
+		GenesisData: map[string]alpha.AssetDefinition{
+			"DIONE": {
+				Name:         "DIONE",
+				Symbol:       "DIONE",
+				Denomination: 9,
+				InitialState: map[string][]interface{}{
+					"fixedCap": holders,
+				},
+			},
+		},
+	}, &reply))
+
+	genesisBytes, err := formatting.Decode(reply.Encoding, reply.Bytes)
+	require.NoError(err)
+	return genesisBytes
+}
+
+func buildOmegaGenesis(tb testing.TB, dioneAssetID ids.ID) []byte {
+	require := require.New(tb)
+
+	utxos := make([]omegaapi.UTXO, len(Keys))
+	for i, key := range Keys {
+		addr, err := address.FormatBech32(constants.UnitTestHRP, key.Address().Bytes())
+		require.NoError(err)
+		utxos[i] = omegaapi.UTXO{
+			Amount:  json.Uint64(Balance),
+			Address: addr,
+		}
+	}
+
+	// The Omega chain requires a validator. It isn't rewarded while the
+	// harness is running.
+	genesisTime := time.Now().Add(-time.Hour)
+	validatorAddr, err := address.FormatBech32(constants.UnitTestHRP, ids.GenerateTestShortID().Bytes())
+	require.NoError(err)
+	validator := omegaapi.PermissionlessValidator{
+		Staker: omegaapi.Staker{
+			StartTime: json.Uint64(genesisTime.Unix()),
+			EndTime:   json.Uint64(genesisTime.Add(365 * 24 * time.Hour).Unix()),
+			NodeID:    ids.GenerateTestNodeID(),
+		},
+		RewardOwner: &omegaapi.Owner{
+			Threshold: 1,
+			Addresses: []string{validatorAddr},
+		},
+		Staked: []omegaapi.UTXO{{
+			Amount:  json.Uint64(units.KiloDione),
+			Address: validatorAddr,
+		}},
+		DelegationFee: reward.PercentDenominator,
+	}
+
+	reply := omegaapi.BuildGenesisReply{}
+	require.NoError((&omegaapi.StaticService{}).BuildGenesis(nil, &omegaapi.BuildGenesisArgs{
+		Encoding:      formatting.Hex,
+		NetworkID:     json.Uint32(constants.UnitTestID),
+		DioneAssetID:  dioneAssetID,
+		UTXOs:         utxos,
+		Validators:    []omegaapi.PermissionlessValidator{validator},
+		Time:          json.Uint64(genesisTime.Unix()),
+		InitialSupply: json.Uint64(360 * units.MegaDione),
+	}, &reply))
+
+	genesisBytes, err := formatting.Decode(reply.Encoding, reply.Bytes)
+	require.NoError(err)
+	return genesisBytes
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package crosschain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+)
+
+const transferAmount = 10 * units.Dione
+
+func TestAlphaOmegaRoundTrip(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	h := New(t)
+	var (
+		sender    = Keys[0].Address()
+		importer  = Keys[1].Address()
+		recipient = ids.GenerateTestShortID()
+	)
+
+	// Export from the ALPHA chain to a key held by the keystore user, so that
+	// it can be imported on the Omega chain.
+	_, err := h.AlphaClient.Export(
+		ctx,
+		h.UserPass(),
+		[]ids.ShortID{sender},
+		sender,
+		transferAmount,
+		importer,
+		"O",
+		"DIONE",
+		"",
+	)
+	require.NoError(err)
+	require.NoError(h.AcceptAlpha(ctx))
+
+	numUTXOs, err := h.AtomicUTXOs(AChainID, constants.OmegaChainID, importer)
+	require.NoError(err)
+	require.Equal(1, numUTXOs)
+
+	_, err = h.OmegaClient.ImportDIONE(
+		ctx,
+		h.UserPass(),
+		[]ids.ShortID{importer},
+		importer,
+		recipient,
+		"A",
+	)
+	require.NoError(err)
+	require.NoError(h.AcceptOmega(ctx))
+
+	numUTXOs, err = h.AtomicUTXOs(AChainID, constants.OmegaChainID, importer)
+	require.NoError(err)
+	require.Zero(numUTXOs)
+
+	omegaBalance, err := h.OmegaClient.GetBalance(ctx, []ids.ShortID{recipient})
+	require.NoError(err)
+	require.Equal(transferAmount-TxFee, uint64(omegaBalance.Balance))
+
+	// Send the funds back to the ALPHA chain.
+	_, err = h.OmegaClient.ExportDIONE(
+		ctx,
+		h.UserPass(),
+		[]ids.ShortID{sender},
+		sender,
+		importer,
+		"A",
+		transferAmount,
+	)
+	require.NoError(err)
+	require.NoError(h.AcceptOmega(ctx))
+
+	numUTXOs, err = h.AtomicUTXOs(constants.OmegaChainID, AChainID, importer)
+	require.NoError(err)
+	require.Equal(1, numUTXOs)
+
+	_, err = h.AlphaClient.Import(ctx, h.UserPass(), recipient, "O")
+	require.NoError(err)
+	require.NoError(h.AcceptAlpha(ctx))
+
+	numUTXOs, err = h.AtomicUTXOs(constants.OmegaChainID, AChainID, importer)
+	require.NoError(err)
+	require.Zero(numUTXOs)
+
+	alphaBalance, err := h.AlphaClient.GetBalance(ctx, recipient, "DIONE", false)
+	require.NoError(err)
+	require.Equal(transferAmount-TxFee, uint64(alphaBalance.Balance))
+}
+
+func TestDoubleImportFails(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	h := New(t)
+	var (
+		sender   = Keys[0].Address()
+		importer = Keys[1].Address()
+	)
+
+	_, err := h.OmegaClient.ExportDIONE(
+		ctx,
+		h.UserPass(),
+		[]ids.ShortID{sender},
+		sender,
+		importer,
+		"A",
+		transferAmount,
+	)
+	require.NoError(err)
+	require.NoError(h.AcceptOmega(ctx))
+
+	_, err = h.AlphaClient.Import(ctx, h.UserPass(), ids.GenerateTestShortID(), "O")
+	require.NoError(err)
+	require.NoError(h.AcceptAlpha(ctx))
+
+	// The exported UTXO was consumed by the first import
+	_, err = h.AlphaClient.Import(ctx, h.UserPass(), ids.GenerateTestShortID(), "O")
+	require.ErrorContains(err, "no import inputs")
+}
@@ -53,7 +53,7 @@ var _ = e2e.DescribeOChain("[Workflow]", func() {
 
 			tests.Outf("{{blue}} fetching minimal stake amounts {{/}}\n")
 			ctx, cancel := context.WithTimeout(context.Background(), e2e.DefaultWalletCreationTimeout)
-			minValStake, minDelStake, err := oChainClient.GetMinStake(ctx, constants.OmegaChainID)
+			minValStake, minDelStake, _, err := oChainClient.GetMinStake(ctx, constants.OmegaChainID)
 			cancel()
 			gomega.Expect(err).Should(gomega.BeNil())
 			tests.Outf("{{green}} minimal validator stake: %d {{/}}\n", minValStake)
@@ -10,6 +10,12 @@ type Atomic[T any] struct {
 	value T
 }
 
+func NewAtomic[T any](value T) *Atomic[T] {
+	return &Atomic[T]{
+		value: value,
+	}
+}
+
 func (a *Atomic[T]) Get() T {
 	a.lock.RLock()
 	defer a.lock.RUnlock()
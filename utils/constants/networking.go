@@ -51,6 +51,7 @@ const (
 	DefaultNetworkReadHandshakeTimeout  = 15 * time.Second
 
 	DefaultNetworkCompressionType           = compression.TypeZstd
+	DefaultNetworkCompressionThreshold      = 256 // bytes
 	DefaultNetworkMaxClockDifference        = time.Minute
 	DefaultNetworkRequireValidatorToConnect = false
 	DefaultNetworkPeerReadBufferSize        = 8 * units.KiB
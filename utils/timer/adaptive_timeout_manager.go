@@ -93,9 +93,15 @@ type AdaptiveTimeoutManager interface {
 	Stop()
 	// Returns the current network timeout duration.
 	TimeoutDuration() time.Duration
+	// Returns the maximum network timeout duration.
+	MaximumTimeoutDuration() time.Duration
 	// Registers a timeout for the item with the given [id].
 	// If the timeout occurs before the item is Removed, [timeoutHandler] is called.
 	Put(id ids.RequestID, measureLatency bool, timeoutHandler func())
+	// PutWithTimeout is the same as Put except that the item times out after
+	// [timeout] rather than after the current network timeout. [timeout] is
+	// capped to the maximum network timeout.
+	PutWithTimeout(id ids.RequestID, measureLatency bool, timeout time.Duration, timeoutHandler func())
 	// Remove the timeout associated with [id].
 	// Its timeout handler will not be called.
 	Remove(id ids.RequestID)
@@ -196,23 +202,37 @@ func (tm *adaptiveTimeoutManager) Stop() {
 	tm.timer.Stop()
 }
 
+func (tm *adaptiveTimeoutManager) MaximumTimeoutDuration() time.Duration {
+	return tm.maximumTimeout
+}
+
 func (tm *adaptiveTimeoutManager) Put(id ids.RequestID, measureLatency bool, timeoutHandler func()) {
 	tm.lock.Lock()
 	defer tm.lock.Unlock()
 
-	tm.put(id, measureLatency, timeoutHandler)
+	tm.put(id, measureLatency, tm.currentTimeout, timeoutHandler)
+}
+
+func (tm *adaptiveTimeoutManager) PutWithTimeout(id ids.RequestID, measureLatency bool, timeout time.Duration, timeoutHandler func()) {
+	tm.lock.Lock()
+	defer tm.lock.Unlock()
+
+	if timeout > tm.maximumTimeout {
+		timeout = tm.maximumTimeout
+	}
+	tm.put(id, measureLatency, timeout, timeoutHandler)
 }
 
 // Assumes [tm.lock] is held
-func (tm *adaptiveTimeoutManager) put(id ids.RequestID, measureLatency bool, handler func()) {
+func (tm *adaptiveTimeoutManager) put(id ids.RequestID, measureLatency bool, duration time.Duration, handler func()) {
 	now := tm.clock.Time()
 	tm.remove(id, now)
 
 	timeout := &adaptiveTimeout{
 		id:             id,
 		handler:        handler,
-		duration:       tm.currentTimeout,
-		deadline:       now.Add(tm.currentTimeout),
+		duration:       duration,
+		deadline:       now.Add(duration),
 		measureLatency: measureLatency,
 	}
 	tm.timeoutMap[id] = timeout
@@ -133,3 +133,32 @@ func TestAdaptiveTimeoutManager(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestAdaptiveTimeoutManagerPutWithTimeout(t *testing.T) {
+	require := require.New(t)
+
+	tm, err := NewAdaptiveTimeoutManager(
+		&AdaptiveTimeoutConfig{
+			InitialTimeout:     time.Millisecond,
+			MinimumTimeout:     time.Millisecond,
+			MaximumTimeout:     10 * time.Millisecond,
+			TimeoutHalflife:    5 * time.Minute,
+			TimeoutCoefficient: 1.25,
+		},
+		"",
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+	go tm.Dispatch()
+	defer tm.Stop()
+
+	require.Equal(10*time.Millisecond, tm.MaximumTimeoutDuration())
+
+	// The requested timeout is capped to the maximum timeout, so this fires
+	// well before an hour passes.
+	timedOut := make(chan struct{})
+	tm.PutWithTimeout(ids.RequestID{}, false, time.Hour, func() {
+		close(timedOut)
+	})
+	<-timedOut
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	_ "embed"
+
+	"github.com/DioneProtocol/odysseygo/utils/constants"
 )
 
 // RPCChainVMProtocol should be bumped anytime changes are made which require
@@ -98,6 +100,14 @@ var (
 		// constants.TestnetID: time.Date(2023, time.April, 6, 15, 0, 0, 0, time.UTC),
 	}
 	CortinaDefaultTime = time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC)
+
+	// The Durango upgrade isn't scheduled on the public networks yet. Local
+	// and custom networks activate it from genesis.
+	DurangoTimes = map[uint32]time.Time{
+		constants.MainnetID: time.Date(10000, time.December, 1, 0, 0, 0, 0, time.UTC),
+		constants.TestnetID: time.Date(10000, time.December, 1, 0, 0, 0, 0, time.UTC),
+	}
+	DurangoDefaultTime = time.Date(2020, time.December, 5, 5, 0, 0, 0, time.UTC)
 )
 
 func init() {
@@ -170,6 +180,13 @@ func GetCortinaTime(networkID uint32) time.Time {
 	return CortinaDefaultTime
 }
 
+func GetDurangoTime(networkID uint32) time.Time {
+	if upgradeTime, exists := DurangoTimes[networkID]; exists {
+		return upgradeTime
+	}
+	return DurangoDefaultTime
+}
+
 func GetCompatibility(networkID uint32) Compatibility {
 	return NewCompatibility(
 		CurrentApp,
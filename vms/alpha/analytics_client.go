@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package alpha
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/api"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/utils/rpc"
+)
+
+var _ AnalyticsClient = (*analyticsClient)(nil)
+
+// AnalyticsClient for interacting with the analytics API of the alpha chain
+// [chain]
+type AnalyticsClient interface {
+	// GetCoSpendCluster returns the addresses that funded transactions together
+	// with [addr] using [assetID], starting at the [cursor]th transaction of
+	// [addr]. Also returns the cursor of the next page.
+	GetCoSpendCluster(
+		ctx context.Context,
+		addr ids.ShortID,
+		assetID string,
+		cursor uint64,
+		pageSize uint64,
+		options ...rpc.Option,
+	) ([]CoSpender, uint64, error)
+}
+
+type analyticsClient struct {
+	requester rpc.EndpointRequester
+}
+
+// NewAnalyticsClient returns a client for the analytics API of [chain]
+func NewAnalyticsClient(uri, chain string) AnalyticsClient {
+	path := fmt.Sprintf(
+		"%s/ext/%s/%s/analytics",
+		uri,
+		constants.ChainAliasPrefix,
+		chain,
+	)
+	return &analyticsClient{
+		requester: rpc.NewEndpointRequester(path),
+	}
+}
+
+func (c *analyticsClient) GetCoSpendCluster(
+	ctx context.Context,
+	addr ids.ShortID,
+	assetID string,
+	cursor uint64,
+	pageSize uint64,
+	options ...rpc.Option,
+) ([]CoSpender, uint64, error) {
+	res := &GetCoSpendClusterReply{}
+	err := c.requester.SendRequest(ctx, "analytics.getCoSpendCluster", &GetAddressTxsArgs{
+		JSONAddress: api.JSONAddress{Address: addr.String()},
+		Cursor:      json.Uint64(cursor),
+		PageSize:    json.Uint64(pageSize),
+		AssetID:     assetID,
+	}, res, options...)
+	return res.CoSpenders, uint64(res.Cursor), err
+}
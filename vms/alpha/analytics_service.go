@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package alpha
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+)
+
+var errAnalyticsRequiresIndexing = errors.New("analytics requires transaction indexing to be enabled")
+
+// AnalyticsService exposes heuristics derived from the address transaction
+// index. It is only registered when analytics are enabled in the VM config.
+type AnalyticsService struct {
+	vm *VM
+}
+
+// CoSpender is an address that funded transactions together with the queried
+// address.
+type CoSpender struct {
+	Address string `json:"address"`
+	// IDs of the transactions that consumed UTXOs owned by both addresses
+	TxIDs []ids.ID `json:"txIDs"`
+}
+
+// GetCoSpendClusterReply is the response from calling GetCoSpendCluster
+type GetCoSpendClusterReply struct {
+	// Sorted by address
+	CoSpenders []CoSpender `json:"coSpenders"`
+	// Cursor used as a page index / offset
+	Cursor json.Uint64 `json:"cursor"`
+}
+
+// GetCoSpendCluster returns the addresses that owned UTXOs consumed by the same
+// transactions as UTXOs owned by the given address. Co-spending addresses are
+// likely to be controlled by the same entity.
+//
+// The transactions of the address are paginated in the same way as
+// GetAddressTxs. Only UTXOs produced on this chain are considered, so inputs
+// imported from other chains are ignored.
+func (s *AnalyticsService) GetCoSpendCluster(_ *http.Request, args *GetAddressTxsArgs, reply *GetCoSpendClusterReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "analytics"),
+		zap.String("method", "getCoSpendCluster"),
+		logging.UserString("address", args.Address),
+		logging.UserString("assetID", args.AssetID),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+	if pageSize > maxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
+	} else if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	address, err := dione.ParseServiceAddress(s.vm, args.Address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse argument 'address' to address: %w", err)
+	}
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return fmt.Errorf("specified `assetID` is invalid: %w", err)
+	}
+
+	txIDs, err := s.vm.addressTxsIndexer.Read(address[:], assetID, cursor, pageSize)
+	if err != nil {
+		return err
+	}
+
+	// Key: Co-spending address
+	// Value: IDs of the txs that were co-spent
+	coSpends := make(map[ids.ShortID][]ids.ID)
+	for _, txID := range txIDs {
+		spenders, err := s.spenders(txID)
+		if err != nil {
+			return err
+		}
+		if !spenders.Contains(address) {
+			// The address only received funds in this tx
+			continue
+		}
+		spenders.Remove(address)
+		for spender := range spenders {
+			coSpends[spender] = append(coSpends[spender], txID)
+		}
+	}
+
+	addrs := maps.Keys(coSpends)
+	utils.Sort(addrs)
+
+	reply.CoSpenders = make([]CoSpender, len(addrs))
+	for i, addr := range addrs {
+		addrStr, err := s.vm.FormatLocalAddress(addr)
+		if err != nil {
+			return fmt.Errorf("problem formatting address: %w", err)
+		}
+		reply.CoSpenders[i] = CoSpender{
+			Address: addrStr,
+			TxIDs:   coSpends[addr],
+		}
+	}
+
+	// To get the next set of co-spenders, the user should provide this cursor.
+	reply.Cursor = json.Uint64(cursor + uint64(len(txIDs)))
+	return nil
+}
+
+// spenders returns the owners of the UTXOs consumed by [txID].
+func (s *AnalyticsService) spenders(txID ids.ID) (set.Set[ids.ShortID], error) {
+	tx, err := s.vm.state.GetTx(txID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get tx %s: %w", txID, err)
+	}
+
+	spenders := set.Set[ids.ShortID]{}
+	for _, utxoID := range tx.Unsigned.InputUTXOs() {
+		if utxoID.Symbolic() {
+			continue
+		}
+
+		producer, err := s.vm.state.GetTx(utxoID.TxID)
+		if err == database.ErrNotFound {
+			// The UTXO was imported from another chain
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get tx %s: %w", utxoID.TxID, err)
+		}
+
+		for _, utxo := range producer.UTXOs() {
+			if utxo.OutputIndex != utxoID.OutputIndex {
+				continue
+			}
+			out, ok := utxo.Out.(dione.Addressable)
+			if !ok {
+				break
+			}
+			for _, addrBytes := range out.Addresses() {
+				addr, err := ids.ToShortID(addrBytes)
+				if err != nil {
+					return nil, err
+				}
+				spenders.Add(addr)
+			}
+			break
+		}
+	}
+	return spenders, nil
+}
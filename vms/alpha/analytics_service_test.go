@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package alpha
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/DioneProtocol/odysseygo/api"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/states"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/index"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestAnalyticsServiceGetCoSpendCluster(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	ctx := newContext(t)
+	state := states.NewMockState(ctrl)
+	indexer, err := index.NewIndexer(memdb.New(), logging.NoLog{}, "", prometheus.NewRegistry(), false)
+	require.NoError(err)
+
+	service := &AnalyticsService{vm: &VM{
+		ctx:               ctx,
+		state:             state,
+		addressTxsIndexer: indexer,
+		AddressManager:    dione.NewAddressManager(ctx),
+		Aliaser:           ids.NewAliaser(),
+	}}
+
+	var (
+		assetID = ids.GenerateTestID()
+		addr0   = keys[0].PublicKey().Address()
+		addr1   = keys[1].PublicKey().Address()
+		addr2   = keys[2].PublicKey().Address()
+	)
+	newOutput := func(addr ids.ShortID) *dione.TransferableOutput {
+		return &dione.TransferableOutput{
+			Asset: dione.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{addr},
+				},
+			},
+		}
+	}
+	newTx := func(ins []*dione.TransferableInput, outs []*dione.TransferableOutput) *txs.Tx {
+		tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: dione.BaseTx{
+			Ins:  ins,
+			Outs: outs,
+		}}}
+		tx.SetBytes(utils.RandomBytes(16), utils.RandomBytes(16))
+		return tx
+	}
+
+	producerTx := newTx(nil, []*dione.TransferableOutput{
+		newOutput(addr0),
+		newOutput(addr1),
+		newOutput(addr2),
+	})
+	producedUTXOs := producerTx.UTXOs()
+	newInput := func(utxo *dione.UTXO) *dione.TransferableInput {
+		return &dione.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  utxo.Asset,
+			In:     &secp256k1fx.TransferInput{Amt: 1},
+		}
+	}
+
+	// [addr0] and [addr1] fund a tx together.
+	coSpendTx := newTx(
+		[]*dione.TransferableInput{
+			newInput(producedUTXOs[0]),
+			newInput(producedUTXOs[1]),
+		},
+		nil,
+	)
+	require.NoError(indexer.Accept(coSpendTx.ID(), producedUTXOs[:2], nil))
+
+	// [addr2] pays [addr0], which doesn't make them co-spenders.
+	paymentTx := newTx(
+		[]*dione.TransferableInput{
+			newInput(producedUTXOs[2]),
+		},
+		[]*dione.TransferableOutput{
+			newOutput(addr0),
+		},
+	)
+	require.NoError(indexer.Accept(paymentTx.ID(), producedUTXOs[2:], paymentTx.UTXOs()))
+
+	state.EXPECT().GetTx(producerTx.ID()).Return(producerTx, nil).AnyTimes()
+	state.EXPECT().GetTx(coSpendTx.ID()).Return(coSpendTx, nil)
+	state.EXPECT().GetTx(paymentTx.ID()).Return(paymentTx, nil)
+
+	addr1Str, err := service.vm.FormatLocalAddress(addr1)
+	require.NoError(err)
+
+	reply := &GetCoSpendClusterReply{}
+	require.NoError(service.GetCoSpendCluster(nil, &GetAddressTxsArgs{
+		JSONAddress: api.JSONAddress{Address: addr0.String()},
+		AssetID:     assetID.String(),
+	}, reply))
+	require.Equal(&GetCoSpendClusterReply{
+		CoSpenders: []CoSpender{
+			{
+				Address: addr1Str,
+				TxIDs:   []ids.ID{coSpendTx.ID()},
+			},
+		},
+		Cursor: 2,
+	}, reply)
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package alpha
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
+)
+
+var (
+	assetAliasesPrefix = []byte("assetAliases")
+
+	errEmptyAlias       = errors.New("alias can't be empty")
+	errAliasIsID        = errors.New("alias can't be an ID")
+	errAliasInUse       = errors.New("alias is already in use")
+	errUnknownAlias     = errors.New("alias wasn't added by an operator")
+	errAdminAPIDisabled = errors.New("admin API is disabled")
+)
+
+// initAssetAliases restores the asset aliases added by the node operator.
+// Must be called after the genesis aliases have been registered.
+func (vm *VM) initAssetAliases() error {
+	vm.assetAliases = prefixdb.New(assetAliasesPrefix, vm.db)
+
+	it := vm.assetAliases.NewIterator()
+	defer it.Release()
+
+	for it.Next() {
+		assetID, err := ids.ToID(it.Value())
+		if err != nil {
+			return err
+		}
+		if err := vm.Alias(assetID, string(it.Key())); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// addAssetAlias gives the asset [assetID] the alias [alias] and persists it
+// across restarts.
+func (vm *VM) addAssetAlias(assetID ids.ID, alias string) error {
+	if alias == "" {
+		return errEmptyAlias
+	}
+	if _, err := ids.FromString(alias); err == nil {
+		return fmt.Errorf("%w: %s", errAliasIsID, alias)
+	}
+	if _, err := vm.Lookup(alias); err == nil {
+		return fmt.Errorf("%w: %s", errAliasInUse, alias)
+	}
+
+	tx, err := vm.state.GetTx(assetID)
+	if err != nil {
+		return fmt.Errorf("couldn't get asset %s: %w", assetID, err)
+	}
+	if _, ok := tx.Unsigned.(*txs.CreateAssetTx); !ok {
+		return fmt.Errorf("%w: %s", errTxNotCreateAsset, assetID)
+	}
+
+	if err := vm.assetAliases.Put([]byte(alias), assetID[:]); err != nil {
+		return err
+	}
+	if err := vm.db.Commit(); err != nil {
+		return err
+	}
+	return vm.Alias(assetID, alias)
+}
+
+// removeAssetAlias removes [alias], which must have been added with
+// addAssetAlias. Genesis aliases can't be removed.
+func (vm *VM) removeAssetAlias(alias string) error {
+	assetIDBytes, err := vm.assetAliases.Get([]byte(alias))
+	if err == database.ErrNotFound {
+		return fmt.Errorf("%w: %s", errUnknownAlias, alias)
+	}
+	if err != nil {
+		return err
+	}
+	assetID, err := ids.ToID(assetIDBytes)
+	if err != nil {
+		return err
+	}
+
+	if err := vm.assetAliases.Delete([]byte(alias)); err != nil {
+		return err
+	}
+	if err := vm.db.Commit(); err != nil {
+		return err
+	}
+
+	// The aliaser can only remove all the aliases of an ID, so the remaining
+	// aliases are re-added in their original order.
+	aliases, err := vm.Aliases(assetID)
+	if err != nil {
+		return err
+	}
+	remaining := make([]string, 0, len(aliases))
+	for _, a := range aliases {
+		if a != alias {
+			remaining = append(remaining, a)
+		}
+	}
+	vm.RemoveAliases(assetID)
+	for _, a := range remaining {
+		if err := vm.Alias(assetID, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/codec"
 	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
 	"github.com/DioneProtocol/odysseygo/database/versiondb"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
@@ -28,11 +29,13 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/version"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/block"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/config"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/fxs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/states"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/mempool"
+	"github.com/DioneProtocol/odysseygo/vms/components/acceptance"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 
@@ -505,7 +508,7 @@ func TestBlockBuilderAddLocalTx(t *testing.T) {
 
 	registerer := prometheus.NewRegistry()
 	toEngine := make(chan common.Message, 100)
-	mempool, err := mempool.New("mempool", registerer, toEngine)
+	mempool, err := mempool.New("mempool", registerer, toEngine, ids.Empty, config.Config{})
 	require.NoError(err)
 	// add a tx to the mempool
 	tx := transactions[0]
@@ -547,7 +550,10 @@ func TestBlockBuilderAddLocalTx(t *testing.T) {
 	metrics, err := metrics.New("", registerer)
 	require.NoError(err)
 
-	manager := blkexecutor.NewManager(mempool, metrics, state, backend, clk, onAccept)
+	acceptTimes, err := acceptance.NewTracker(prefixdb.New([]byte("acceptTimes"), baseDB), "", registerer)
+	require.NoError(err)
+
+	manager := blkexecutor.NewManager(mempool, metrics, state, backend, clk, onAccept, acceptTimes)
 
 	manager.SetPreference(parentBlk.ID())
 
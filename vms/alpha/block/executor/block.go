@@ -231,6 +231,14 @@ func (b *Block) Accept(context.Context) error {
 	blkState.onAcceptState.Apply(b.manager.state)
 
 	defer b.manager.state.Abort()
+	if err := b.manager.acceptTimes.Accept(blkID, b.Timestamp()); err != nil {
+		return fmt.Errorf(
+			"failed to record acceptance time of block %s: %w",
+			blkID,
+			err,
+		)
+	}
+
 	batch, err := b.manager.state.CommitBatch()
 	if err != nil {
 		return fmt.Errorf(
@@ -28,6 +28,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/executor"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/mempool"
+	"github.com/DioneProtocol/odysseygo/vms/components/acceptance"
 	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
 )
 
@@ -632,6 +633,48 @@ func TestBlockAccept(t *testing.T) {
 			},
 			expectedErr: ErrBlockNotFound,
 		},
+		{
+			name: "can't record acceptance time",
+			blockFunc: func(ctrl *gomock.Controller) *Block {
+				blockID := ids.GenerateTestID()
+				mockBlock := block.NewMockBlock(ctrl)
+				mockBlock.EXPECT().ID().Return(blockID).AnyTimes()
+				mockBlock.EXPECT().Txs().Return([]*txs.Tx{}).AnyTimes()
+				mockBlock.EXPECT().Timestamp().Return(time.Time{}).AnyTimes()
+
+				mempool := mempool.NewMockMempool(ctrl)
+				mempool.EXPECT().Remove(gomock.Any()).AnyTimes()
+
+				mockManagerState := states.NewMockState(ctrl)
+				mockManagerState.EXPECT().Abort()
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().Accept(blockID, time.Time{}).Return(errTest)
+
+				mockOnAcceptState := states.NewMockDiff(ctrl)
+				mockOnAcceptState.EXPECT().Apply(mockManagerState)
+
+				return &Block{
+					Block: mockBlock,
+					manager: &manager{
+						state:       mockManagerState,
+						acceptTimes: acceptTimes,
+						mempool:     mempool,
+						backend: &executor.Backend{
+							Ctx: &snow.Context{
+								Log: logging.NoLog{},
+							},
+						},
+						blkIDToState: map[ids.ID]*blockState{
+							blockID: {
+								onAcceptState: mockOnAcceptState,
+							},
+						},
+					},
+				}
+			},
+			expectedErr: errTest,
+		},
 		{
 			name: "can't get commit batch",
 			blockFunc: func(ctrl *gomock.Controller) *Block {
@@ -639,6 +682,7 @@ func TestBlockAccept(t *testing.T) {
 				mockBlock := block.NewMockBlock(ctrl)
 				mockBlock.EXPECT().ID().Return(blockID).AnyTimes()
 				mockBlock.EXPECT().Txs().Return([]*txs.Tx{}).AnyTimes()
+				mockBlock.EXPECT().Timestamp().Return(time.Time{}).AnyTimes()
 
 				mempool := mempool.NewMockMempool(ctrl)
 				mempool.EXPECT().Remove(gomock.Any()).AnyTimes()
@@ -647,14 +691,18 @@ func TestBlockAccept(t *testing.T) {
 				mockManagerState.EXPECT().CommitBatch().Return(nil, errTest)
 				mockManagerState.EXPECT().Abort()
 
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().Accept(blockID, time.Time{}).Return(nil)
+
 				mockOnAcceptState := states.NewMockDiff(ctrl)
 				mockOnAcceptState.EXPECT().Apply(mockManagerState)
 
 				return &Block{
 					Block: mockBlock,
 					manager: &manager{
-						state:   mockManagerState,
-						mempool: mempool,
+						state:       mockManagerState,
+						acceptTimes: acceptTimes,
+						mempool:     mempool,
 						backend: &executor.Backend{
 							Ctx: &snow.Context{
 								Log: logging.NoLog{},
@@ -677,6 +725,7 @@ func TestBlockAccept(t *testing.T) {
 				mockBlock := block.NewMockBlock(ctrl)
 				mockBlock.EXPECT().ID().Return(blockID).AnyTimes()
 				mockBlock.EXPECT().Txs().Return([]*txs.Tx{}).AnyTimes()
+				mockBlock.EXPECT().Timestamp().Return(time.Time{}).AnyTimes()
 				mockBlock.EXPECT().AccumulatedFee(ids.ID{}).Return(uint64(100)).Times(1)
 
 				mempool := mempool.NewMockMempool(ctrl)
@@ -688,6 +737,9 @@ func TestBlockAccept(t *testing.T) {
 				mockManagerState.EXPECT().CommitBatch().Return(nil, nil)
 				mockManagerState.EXPECT().Abort()
 
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().Accept(blockID, time.Time{}).Return(nil)
+
 				mockSharedMemory := atomic.NewMockSharedMemory(ctrl)
 				mockSharedMemory.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(errTest)
 
@@ -700,8 +752,9 @@ func TestBlockAccept(t *testing.T) {
 				return &Block{
 					Block: mockBlock,
 					manager: &manager{
-						state:   mockManagerState,
-						mempool: mempool,
+						state:       mockManagerState,
+						acceptTimes: acceptTimes,
+						mempool:     mempool,
 						backend: &executor.Backend{
 							Ctx: &snow.Context{
 								SharedMemory: mockSharedMemory,
@@ -726,6 +779,7 @@ func TestBlockAccept(t *testing.T) {
 				mockBlock := block.NewMockBlock(ctrl)
 				mockBlock.EXPECT().ID().Return(blockID).AnyTimes()
 				mockBlock.EXPECT().Txs().Return([]*txs.Tx{}).AnyTimes()
+				mockBlock.EXPECT().Timestamp().Return(time.Time{}).AnyTimes()
 				mockBlock.EXPECT().AccumulatedFee(ids.ID{}).Return(uint64(100)).Times(1)
 
 				mempool := mempool.NewMockMempool(ctrl)
@@ -737,6 +791,9 @@ func TestBlockAccept(t *testing.T) {
 				mockManagerState.EXPECT().CommitBatch().Return(nil, nil)
 				mockManagerState.EXPECT().Abort()
 
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().Accept(blockID, time.Time{}).Return(nil)
+
 				mockSharedMemory := atomic.NewMockSharedMemory(ctrl)
 				mockSharedMemory.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(nil)
 
@@ -752,9 +809,10 @@ func TestBlockAccept(t *testing.T) {
 				return &Block{
 					Block: mockBlock,
 					manager: &manager{
-						state:   mockManagerState,
-						mempool: mempool,
-						metrics: metrics,
+						state:       mockManagerState,
+						acceptTimes: acceptTimes,
+						mempool:     mempool,
+						metrics:     metrics,
 						backend: &executor.Backend{
 							Ctx: &snow.Context{
 								SharedMemory: mockSharedMemory,
@@ -781,6 +839,7 @@ func TestBlockAccept(t *testing.T) {
 				mockBlock.EXPECT().Height().Return(uint64(0)).AnyTimes()
 				mockBlock.EXPECT().Parent().Return(ids.GenerateTestID()).AnyTimes()
 				mockBlock.EXPECT().Txs().Return([]*txs.Tx{}).AnyTimes()
+				mockBlock.EXPECT().Timestamp().Return(time.Time{}).AnyTimes()
 				mockBlock.EXPECT().AccumulatedFee(ids.ID{}).Return(uint64(100)).Times(1)
 
 				mempool := mempool.NewMockMempool(ctrl)
@@ -791,6 +850,9 @@ func TestBlockAccept(t *testing.T) {
 				// because we mock the call to shared memory
 				mockManagerState.EXPECT().CommitBatch().Return(nil, nil)
 				mockManagerState.EXPECT().Abort()
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().Accept(blockID, time.Time{}).Return(nil)
 				mockManagerState.EXPECT().Checksums().Return(ids.Empty, ids.Empty)
 
 				mockSharedMemory := atomic.NewMockSharedMemory(ctrl)
@@ -808,9 +870,10 @@ func TestBlockAccept(t *testing.T) {
 				return &Block{
 					Block: mockBlock,
 					manager: &manager{
-						state:   mockManagerState,
-						mempool: mempool,
-						metrics: metrics,
+						state:       mockManagerState,
+						acceptTimes: acceptTimes,
+						mempool:     mempool,
+						metrics:     metrics,
 						backend: &executor.Backend{
 							Ctx: &snow.Context{
 								SharedMemory: mockSharedMemory,
@@ -17,6 +17,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/executor"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/mempool"
+	"github.com/DioneProtocol/odysseygo/vms/components/acceptance"
 )
 
 var (
@@ -55,6 +56,7 @@ func NewManager(
 	backend *executor.Backend,
 	clk *mockable.Clock,
 	onAccept func(*txs.Tx) error,
+	acceptTimes acceptance.Tracker,
 ) Manager {
 	lastAccepted := state.GetLastAccepted()
 	return &manager{
@@ -64,6 +66,7 @@ func NewManager(
 		mempool:      mempool,
 		clk:          clk,
 		onAccept:     onAccept,
+		acceptTimes:  acceptTimes,
 		blkIDToState: map[ids.ID]*blockState{},
 		lastAccepted: lastAccepted,
 		preferred:    lastAccepted,
@@ -80,6 +83,10 @@ type manager struct {
 	// before its state changes are applied.
 	// Invariant: any error returned by onAccept should be considered fatal.
 	onAccept func(*txs.Tx) error
+	// acceptTimes records the local time that blocks are accepted at.
+	// Invariant: acceptTimes must write into the same database as [state], so
+	// that the acceptance time is committed atomically with the block.
+	acceptTimes acceptance.Tracker
 
 	// blkIDToState is a map from a block's ID to the state of the block.
 	// Blocks are put into this map when they are verified.
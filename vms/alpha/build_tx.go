@@ -0,0 +1,489 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package alpha
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/api"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/fxs"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+
+	safemath "github.com/DioneProtocol/odysseygo/utils/math"
+)
+
+var (
+	errNoFromAddrs         = errors.New("no from addresses provided")
+	errWrongNumCredentials = errors.New("wrong number of credentials")
+	errWrongSignatureLen   = errors.New("wrong signature length")
+)
+
+// BuildSpendHeader is the set of arguments shared by the methods that build
+// unsigned transactions. Only the addresses that fund the tx are required, so
+// the keys never need to be imported into the keystore.
+type BuildSpendHeader struct {
+	// Addresses whose UTXOs fund the tx
+	api.JSONFromAddrs
+
+	// Address to send change to. Defaults to the first from address.
+	api.JSONChangeAddr
+
+	// Encoding of the returned unsigned tx
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// BuildTxReply is the response from the methods that build unsigned
+// transactions
+type BuildTxReply struct {
+	// The unsigned tx
+	Tx       string              `json:"tx"`
+	Encoding formatting.Encoding `json:"encoding"`
+
+	// Signers[i] are the addresses that must sign the unsigned tx, in order,
+	// to create the i-th credential of the tx.
+	Signers [][]string `json:"signers"`
+}
+
+// BuildSendArgs are arguments for passing into BuildSend requests
+type BuildSendArgs struct {
+	// From addrs, change addr, encoding
+	BuildSpendHeader
+
+	// The amount, assetID, and destination to send funds to
+	SendOutput
+
+	// Memo field
+	Memo string `json:"memo"`
+}
+
+// BuildSend returns an unsigned tx that sends funds from the provided
+// addresses along with the addresses that must sign it.
+func (s *Service) BuildSend(_ *http.Request, args *BuildSendArgs, reply *BuildTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "buildSend"),
+		logging.UserStrings("from", args.From),
+	)
+
+	memoBytes := []byte(args.Memo)
+	if l := len(memoBytes); l > dione.MaxMemoSize {
+		return fmt.Errorf("max memo length is %d but provided memo field is length %d", dione.MaxMemoSize, l)
+	}
+
+	outs, amountsWithFee, err := s.parseSendOutputs([]SendOutput{args.SendOutput})
+	if err != nil {
+		return err
+	}
+
+	ins, changeOuts, signers, err := s.buildSpend(&args.BuildSpendHeader, amountsWithFee)
+	if err != nil {
+		return err
+	}
+	outs = append(outs, changeOuts...)
+	dione.SortTransferableOutputs(outs, s.vm.parser.Codec())
+
+	return s.buildTxReply(&txs.BaseTx{BaseTx: dione.BaseTx{
+		NetworkID:    s.vm.ctx.NetworkID,
+		BlockchainID: s.vm.ctx.ChainID,
+		Outs:         outs,
+		Ins:          ins,
+		Memo:         memoBytes,
+	}}, signers, args.Encoding, reply)
+}
+
+// BuildCreateAssetArgs are arguments for passing into BuildCreateAsset
+// requests
+type BuildCreateAssetArgs struct {
+	BuildSpendHeader           // From addrs, change addr, encoding
+	Name             string    `json:"name"`
+	Symbol           string    `json:"symbol"`
+	Denomination     byte      `json:"denomination"`
+	InitialHolders   []*Holder `json:"initialHolders"`
+	MinterSets       []Owners  `json:"minterSets"`
+}
+
+// BuildCreateAsset returns an unsigned tx that creates an asset and is funded
+// by the provided addresses along with the addresses that must sign it.
+func (s *Service) BuildCreateAsset(_ *http.Request, args *BuildCreateAssetArgs, reply *BuildTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "buildCreateAsset"),
+		logging.UserString("name", args.Name),
+		logging.UserString("symbol", args.Symbol),
+		zap.Int("numInitialHolders", len(args.InitialHolders)),
+		zap.Int("numMinters", len(args.MinterSets)),
+	)
+
+	if len(args.InitialHolders) == 0 && len(args.MinterSets) == 0 {
+		return errNoHoldersOrMinters
+	}
+
+	initialState, err := s.parseInitialState(args.InitialHolders, args.MinterSets)
+	if err != nil {
+		return err
+	}
+
+	ins, outs, signers, err := s.buildSpend(&args.BuildSpendHeader, map[ids.ID]uint64{
+		s.vm.feeAssetID: s.vm.CreateAssetTxFee,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.buildTxReply(&txs.CreateAssetTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    s.vm.ctx.NetworkID,
+			BlockchainID: s.vm.ctx.ChainID,
+			Outs:         outs,
+			Ins:          ins,
+		}},
+		Name:         args.Name,
+		Symbol:       args.Symbol,
+		Denomination: args.Denomination,
+		States:       []*txs.InitialState{initialState},
+	}, signers, args.Encoding, reply)
+}
+
+// BuildExportArgs are arguments for passing into BuildExport requests
+type BuildExportArgs struct {
+	// From addrs, change addr, encoding
+	BuildSpendHeader
+
+	// Amount of the asset to send
+	Amount json.Uint64 `json:"amount"`
+
+	// Chain the funds are going to. Optional. Used if To address does not
+	// include the chainID.
+	TargetChain string `json:"targetChain"`
+
+	// Address that will receive the funds. This address may include the
+	// chainID, which is used to determine what the destination chain is.
+	To string `json:"to"`
+
+	AssetID string `json:"assetID"`
+
+	// Memo field
+	Memo string `json:"memo"`
+}
+
+// BuildExport returns an unsigned tx that exports funds from the provided
+// addresses to another chain along with the addresses that must sign it.
+func (s *Service) BuildExport(_ *http.Request, args *BuildExportArgs, reply *BuildTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "buildExport"),
+		logging.UserStrings("from", args.From),
+	)
+
+	memoBytes := []byte(args.Memo)
+	if l := len(memoBytes); l > dione.MaxMemoSize {
+		return fmt.Errorf("max memo length is %d but provided memo field is length %d", dione.MaxMemoSize, l)
+	}
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return err
+	}
+
+	chainID, to, err := s.parseExportTo(args.To, args.TargetChain)
+	if err != nil {
+		return err
+	}
+
+	if args.Amount == 0 {
+		return errZeroAmount
+	}
+
+	amounts, err := s.exportAmounts(assetID, uint64(args.Amount))
+	if err != nil {
+		return err
+	}
+
+	ins, outs, signers, err := s.buildSpend(&args.BuildSpendHeader, amounts)
+	if err != nil {
+		return err
+	}
+
+	return s.buildTxReply(&txs.ExportTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    s.vm.ctx.NetworkID,
+			BlockchainID: s.vm.ctx.ChainID,
+			Outs:         outs,
+			Ins:          ins,
+			Memo:         memoBytes,
+		}},
+		DestinationChain: chainID,
+		ExportedOuts: []*dione.TransferableOutput{{
+			Asset: dione.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: uint64(args.Amount),
+				OutputOwners: secp256k1fx.OutputOwners{
+					Locktime:  0,
+					Threshold: 1,
+					Addrs:     []ids.ShortID{to},
+				},
+			},
+		}},
+	}, signers, args.Encoding, reply)
+}
+
+// IssueSignedTxArgs are arguments for passing into IssueSignedTx requests
+type IssueSignedTxArgs struct {
+	// The unsigned tx, as returned by one of the build methods
+	Tx string `json:"tx"`
+
+	// Signatures[i] are the signatures, in order, of the i-th credential of
+	// the tx.
+	Signatures [][]string `json:"signatures"`
+
+	// Encoding of the unsigned tx and of the signatures
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// IssueSignedTx attaches the provided signatures to an unsigned tx and issues
+// the signed tx.
+func (s *Service) IssueSignedTx(_ *http.Request, args *IssueSignedTxArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "issueSignedTx"),
+		logging.UserString("tx", args.Tx),
+	)
+
+	unsignedBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+
+	tx := &txs.Tx{}
+	if _, err := s.vm.parser.Codec().Unmarshal(unsignedBytes, &tx.Unsigned); err != nil {
+		return fmt.Errorf("problem parsing transaction: %w", err)
+	}
+
+	tx.Creds = make([]*fxs.FxCredential, len(args.Signatures))
+	for i, sigStrs := range args.Signatures {
+		cred := &secp256k1fx.Credential{
+			Sigs: make([][secp256k1.SignatureLen]byte, len(sigStrs)),
+		}
+		for j, sigStr := range sigStrs {
+			sig, err := formatting.Decode(args.Encoding, sigStr)
+			if err != nil {
+				return fmt.Errorf("problem decoding signature %d of credential %d: %w", j, i, err)
+			}
+			if len(sig) != secp256k1.SignatureLen {
+				return fmt.Errorf("%w: expected %d bytes but got %d", errWrongSignatureLen, secp256k1.SignatureLen, len(sig))
+			}
+			copy(cred.Sigs[j][:], sig)
+		}
+		tx.Creds[i] = &fxs.FxCredential{Verifiable: cred}
+	}
+	if numInputs := len(tx.Unsigned.InputUTXOs()); numInputs != len(tx.Creds) {
+		return fmt.Errorf("%w: expected %d but got %d", errWrongNumCredentials, numInputs, len(tx.Creds))
+	}
+
+	if err := s.vm.parser.InitializeTx(tx); err != nil {
+		return err
+	}
+
+	txID, err := s.vm.IssueTx(tx.Bytes())
+	if err != nil {
+		return fmt.Errorf("problem issuing transaction: %w", err)
+	}
+
+	reply.TxID = txID
+	return nil
+}
+
+// buildSpend selects the UTXOs of the from addresses of [header] that fund
+// [amounts]. Returns the inputs that consume them, the outputs that return
+// the change and the addresses that must sign each input.
+func (s *Service) buildSpend(header *BuildSpendHeader, amounts map[ids.ID]uint64) (
+	[]*dione.TransferableInput,
+	[]*dione.TransferableOutput,
+	[][]ids.ShortID,
+	error,
+) {
+	if len(header.From) == 0 {
+		return nil, nil, nil, errNoFromAddrs
+	}
+	fromAddrs, err := dione.ParseServiceAddresses(s.vm, header.From)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defaultChangeAddr, err := dione.ParseServiceAddress(s.vm, header.From[0])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	changeAddr, err := s.vm.selectChangeAddr(defaultChangeAddr, header.ChangeAddr)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	amountsSpent, ins, signers, err := s.vm.spendFromAddrs(fromAddrs, amounts)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	outs := []*dione.TransferableOutput{}
+	for assetID, amountSpent := range amountsSpent {
+		if amount := amounts[assetID]; amountSpent > amount {
+			outs = append(outs, &dione.TransferableOutput{
+				Asset: dione.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: amountSpent - amount,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Locktime:  0,
+						Threshold: 1,
+						Addrs:     []ids.ShortID{changeAddr},
+					},
+				},
+			})
+		}
+	}
+	dione.SortTransferableOutputs(outs, s.vm.parser.Codec())
+	return ins, outs, signers, nil
+}
+
+// buildTxReply populates [reply] with [utx] and the addresses that must sign
+// it.
+func (s *Service) buildTxReply(
+	utx txs.UnsignedTx,
+	signers [][]ids.ShortID,
+	encoding formatting.Encoding,
+	reply *BuildTxReply,
+) error {
+	unsignedBytes, err := s.vm.parser.Codec().Marshal(txs.CodecVersion, &utx)
+	if err != nil {
+		return fmt.Errorf("problem creating transaction: %w", err)
+	}
+
+	reply.Tx, err = formatting.Encode(encoding, unsignedBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't encode tx as string: %w", err)
+	}
+	reply.Encoding = encoding
+
+	reply.Signers = make([][]string, len(signers))
+	for i, addrs := range signers {
+		reply.Signers[i] = make([]string, len(addrs))
+		for j, addr := range addrs {
+			reply.Signers[i][j], err = s.vm.FormatLocalAddress(addr)
+			if err != nil {
+				return fmt.Errorf("problem formatting address: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// spendFromAddrs is like Spend, but only requires the addresses that own the
+// funds rather than their keys. Returns the addresses that must sign each
+// input in place of the keys.
+func (vm *VM) spendFromAddrs(
+	addrs set.Set[ids.ShortID],
+	amounts map[ids.ID]uint64,
+) (
+	map[ids.ID]uint64,
+	[]*dione.TransferableInput,
+	[][]ids.ShortID,
+	error,
+) {
+	utxos, err := dione.GetAllUTXOs(vm.state, addrs)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("problem retrieving UTXOs: %w", err)
+	}
+
+	time := vm.clock.Unix()
+	amountsSpent := make(map[ids.ID]uint64, len(amounts))
+	ins := []*dione.TransferableInput{}
+	signersByInput := make(map[ids.ID][]ids.ShortID)
+	for _, utxo := range utxos {
+		assetID := utxo.AssetID()
+		amountSpent := amountsSpent[assetID]
+		if amountSpent >= amounts[assetID] {
+			// we already have enough inputs allocated to this asset
+			continue
+		}
+
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			// this output doesn't have an amount, so it can't fund the tx
+			continue
+		}
+		sigIndices, signers, ok := matchAddrs(&out.OutputOwners, addrs, time)
+		if !ok {
+			// this utxo can't be spent with the provided addresses right now
+			continue
+		}
+
+		newAmountSpent, err := safemath.Add64(amountSpent, out.Amt)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("problem calculating spent amount: %w", err)
+		}
+		amountsSpent[assetID] = newAmountSpent
+
+		ins = append(ins, &dione.TransferableInput{
+			UTXOID: utxo.UTXOID,
+			Asset:  dione.Asset{ID: assetID},
+			In: &secp256k1fx.TransferInput{
+				Amt: out.Amt,
+				Input: secp256k1fx.Input{
+					SigIndices: sigIndices,
+				},
+			},
+		})
+		signersByInput[utxo.InputID()] = signers
+	}
+
+	for assetID, amount := range amounts {
+		if amountsSpent[assetID] < amount {
+			return nil, nil, nil, fmt.Errorf("want to spend %d of asset %s but only have %d",
+				amount,
+				assetID,
+				amountsSpent[assetID],
+			)
+		}
+	}
+
+	utils.Sort(ins)
+	signers := make([][]ids.ShortID, len(ins))
+	for i, in := range ins {
+		signers[i] = signersByInput[in.InputID()]
+	}
+	return amountsSpent, ins, signers, nil
+}
+
+// matchAddrs returns the signature indices and the addresses that are needed
+// for [addrs] to spend an output owned by [owners] at [time]. This mirrors
+// secp256k1fx.Keychain.Match without requiring the keys.
+func matchAddrs(
+	owners *secp256k1fx.OutputOwners,
+	addrs set.Set[ids.ShortID],
+	time uint64,
+) ([]uint32, []ids.ShortID, bool) {
+	if time < owners.Locktime {
+		return nil, nil, false
+	}
+	sigIndices := make([]uint32, 0, owners.Threshold)
+	signers := make([]ids.ShortID, 0, owners.Threshold)
+	for i := uint32(0); i < uint32(len(owners.Addrs)) && uint32(len(signers)) < owners.Threshold; i++ {
+		if addr := owners.Addrs[i]; addrs.Contains(addr) {
+			sigIndices = append(sigIndices, i)
+			signers = append(signers, addr)
+		}
+	}
+	return sigIndices, signers, uint32(len(signers)) == owners.Threshold
+}
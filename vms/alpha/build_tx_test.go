@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package alpha
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/api"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
+)
+
+// signBuiltTx signs the unsigned tx in [reply] with the keys of its signers
+func signBuiltTx(t *testing.T, env *environment, reply *BuildTxReply) [][]string {
+	require := require.New(t)
+
+	unsignedBytes, err := formatting.Decode(reply.Encoding, reply.Tx)
+	require.NoError(err)
+	hash := hashing.ComputeHash256(unsignedBytes)
+
+	keysByAddr := make(map[string]*secp256k1.PrivateKey, len(keys))
+	for _, key := range keys {
+		addrStr, err := env.vm.FormatLocalAddress(key.PublicKey().Address())
+		require.NoError(err)
+		keysByAddr[addrStr] = key
+	}
+
+	sigs := make([][]string, len(reply.Signers))
+	for i, signers := range reply.Signers {
+		sigs[i] = make([]string, len(signers))
+		for j, signer := range signers {
+			key, ok := keysByAddr[signer]
+			require.True(ok)
+			sig, err := key.SignHash(hash)
+			require.NoError(err)
+			sigs[i][j], err = formatting.Encode(reply.Encoding, sig)
+			require.NoError(err)
+		}
+	}
+	return sigs
+}
+
+func TestBuildSendAndIssueSignedTx(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	assetID := env.genesisTx.ID()
+	fromAddrStr, err := env.vm.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+	changeAddrStr, err := env.vm.FormatLocalAddress(testChangeAddr)
+	require.NoError(err)
+	toAddrStr, err := env.vm.FormatLocalAddress(keys[1].PublicKey().Address())
+	require.NoError(err)
+
+	args := &BuildSendArgs{
+		BuildSpendHeader: BuildSpendHeader{
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddrStr},
+			Encoding:       formatting.Hex,
+		},
+		SendOutput: SendOutput{
+			Amount:  500,
+			AssetID: assetID.String(),
+			To:      toAddrStr,
+		},
+		Memo: "hardware wallet",
+	}
+	reply := &BuildTxReply{}
+	err = env.service.BuildSend(nil, args, reply)
+	require.ErrorIs(err, errNoFromAddrs)
+
+	args.From = []string{fromAddrStr}
+	require.NoError(env.service.BuildSend(nil, args, reply))
+	require.Equal(formatting.Hex, reply.Encoding)
+	require.Len(reply.Signers, 1)
+	require.Equal([]string{fromAddrStr}, reply.Signers[0])
+
+	sigs := signBuiltTx(t, env, reply)
+
+	issueArgs := &IssueSignedTxArgs{
+		Tx:       reply.Tx,
+		Encoding: reply.Encoding,
+	}
+	issueReply := &api.JSONTxID{}
+	err = env.service.IssueSignedTx(nil, issueArgs, issueReply)
+	require.ErrorIs(err, errWrongNumCredentials)
+
+	issueArgs.Signatures = sigs
+	require.NoError(env.service.IssueSignedTx(nil, issueArgs, issueReply))
+
+	buildAndAccept(require, env.vm, env.issuer, issueReply.TxID)
+
+	tx, err := env.vm.state.GetTx(issueReply.TxID)
+	require.NoError(err)
+	require.IsType(&txs.BaseTx{}, tx.Unsigned)
+	baseTx := tx.Unsigned.(*txs.BaseTx)
+	require.Equal([]byte("hardware wallet"), []byte(baseTx.Memo))
+	require.Len(baseTx.Outs, 2)
+}
+
+func TestBuildCreateAsset(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	fromAddrStr, err := env.vm.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+
+	args := &BuildCreateAssetArgs{
+		BuildSpendHeader: BuildSpendHeader{
+			Encoding: formatting.Hex,
+		},
+		Name:   "testAsset",
+		Symbol: "TEST",
+		InitialHolders: []*Holder{{
+			Amount:  123456789,
+			Address: fromAddrStr,
+		}},
+	}
+	args.From = []string{fromAddrStr}
+	reply := &BuildTxReply{}
+	require.NoError(env.service.BuildCreateAsset(nil, args, reply))
+
+	issueReply := &api.JSONTxID{}
+	require.NoError(env.service.IssueSignedTx(nil, &IssueSignedTxArgs{
+		Tx:         reply.Tx,
+		Signatures: signBuiltTx(t, env, reply),
+		Encoding:   reply.Encoding,
+	}, issueReply))
+
+	buildAndAccept(require, env.vm, env.issuer, issueReply.TxID)
+
+	tx, err := env.vm.state.GetTx(issueReply.TxID)
+	require.NoError(err)
+	require.IsType(&txs.CreateAssetTx{}, tx.Unsigned)
+}
@@ -24,12 +24,31 @@ var _ Client = (*client)(nil)
 // Client for interacting with an ALPHA (A-Chain) instance
 type Client interface {
 	WalletClient
+	// IssueTxs issues a batch of txs and returns the outcome of each of them
+	IssueTxs(ctx context.Context, txs [][]byte, options ...rpc.Option) ([]api.IssueTxResult, error)
 	// GetBlock returns the block with the given id.
 	GetBlock(ctx context.Context, blkID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetBlockByHeight returns the block at the given [height].
 	GetBlockByHeight(ctx context.Context, height uint64, options ...rpc.Option) ([]byte, error)
 	// GetHeight returns the height of the last accepted block.
 	GetHeight(ctx context.Context, options ...rpc.Option) (uint64, error)
+	// GetTxFee returns the fees charged by the chain
+	GetTxFee(ctx context.Context, options ...rpc.Option) (*GetTxFeeReply, error)
+	// EstimateTip returns the distribution of the tips paid by txs in the
+	// mempool
+	EstimateTip(ctx context.Context, options ...rpc.Option) (*EstimateTipReply, error)
+	// ReserveUTXOs reserves [utxoIDs], which [user] must be able to spend, for
+	// [session] of [user] for [ttl] and returns the time at which the
+	// reservation expires
+	ReserveUTXOs(ctx context.Context, user api.UserPass, session string, utxoIDs []ids.ID, ttl time.Duration, options ...rpc.Option) (time.Time, error)
+	// ReleaseUTXOs releases the reservations of [utxoIDs] held by [session] of
+	// [user]
+	ReleaseUTXOs(ctx context.Context, user api.UserPass, session string, utxoIDs []ids.ID, options ...rpc.Option) error
+	// OpenKeySession opens a session holding [keys] in memory for [ttl] and
+	// returns the credentials that are used in place of a keystore user's
+	OpenKeySession(ctx context.Context, keys []*secp256k1.PrivateKey, ttl time.Duration, options ...rpc.Option) (api.UserPass, error)
+	// CloseKeySession closes the key session [user]
+	CloseKeySession(ctx context.Context, user api.UserPass, options ...rpc.Option) error
 	// GetTxStatus returns the status of [txID]
 	//
 	// Deprecated: GetTxStatus only returns Accepted or Unknown, GetTx should be
@@ -63,8 +82,21 @@ type Client interface {
 		startUTXOID ids.ID,
 		options ...rpc.Option,
 	) ([][]byte, ids.ShortID, ids.ID, error)
+	// EstimateFee returns the fee of a send of [outputs] funded by [from],
+	// along with the number of inputs and outputs the send would have
+	EstimateFee(
+		ctx context.Context,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		outputs []ClientEstimateFeeOutput,
+		options ...rpc.Option,
+	) (*EstimateFeeReply, error)
 	// GetAssetDescription returns a description of [assetID]
 	GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (*GetAssetDescriptionReply, error)
+	// AddAssetAlias gives [assetID] the alias [alias] on the node
+	AddAssetAlias(ctx context.Context, assetID string, alias string, options ...rpc.Option) error
+	// RemoveAssetAlias removes [alias], which was added with AddAssetAlias
+	RemoveAssetAlias(ctx context.Context, alias string, options ...rpc.Option) error
 	// GetBalance returns the balance of [assetID] held by [addr].
 	// If [includePartial], balance includes partial owned (i.e. in a multisig) funds.
 	//
@@ -192,6 +224,61 @@ type Client interface {
 		to ids.ShortID,
 		options ...rpc.Option,
 	) (ids.ID, error)
+	// CreatePropertyAsset creates a new property asset and returns its assetID
+	//
+	// Deprecated: Transactions should be issued using the
+	// `odysseygo/wallet/chain/a.Wallet` utility.
+	CreatePropertyAsset(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		name string,
+		symbol string,
+		minters []ClientOwners,
+		options ...rpc.Option,
+	) (ids.ID, error)
+	// MintProperty issues a transaction that mints [assetID] to [to] and
+	// returns the ID of the newly created transaction
+	//
+	// Deprecated: Transactions should be issued using the
+	// `odysseygo/wallet/chain/a.Wallet` utility.
+	MintProperty(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		assetID string,
+		to ids.ShortID,
+		options ...rpc.Option,
+	) (ids.ID, error)
+	// BurnProperty issues a transaction that burns all of the user's units of
+	// [assetID] and returns the ID of the newly created transaction
+	//
+	// Deprecated: Transactions should be issued using the
+	// `odysseygo/wallet/chain/a.Wallet` utility.
+	BurnProperty(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		assetID string,
+		options ...rpc.Option,
+	) (ids.ID, error)
+	// ConsolidateUTXOs issues the transactions that consolidate the user's
+	// UTXOs of [assetID] into [targetCount] UTXOs and returns their IDs
+	//
+	// Deprecated: Transactions should be issued using the
+	// `odysseygo/wallet/chain/a.Wallet` utility.
+	ConsolidateUTXOs(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		assetID string,
+		targetCount uint32,
+		options ...rpc.Option,
+	) ([]ids.ID, error)
 	// Import sends an import transaction to import funds from [sourceChain] and
 	// returns the ID of the newly created transaction
 	//
@@ -212,6 +299,61 @@ type Client interface {
 		to ids.ShortID,
 		toChainIDAlias string,
 		assetID string,
+		memo string,
+		options ...rpc.Option,
+	) (ids.ID, error)
+	// BuildSend returns an unsigned tx that sends [amount] of [assetID] from
+	// [from] to [to] along with the addresses that must sign it
+	BuildSend(
+		ctx context.Context,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		amount uint64,
+		assetID string,
+		to ids.ShortID,
+		memo string,
+		options ...rpc.Option,
+	) (*BuildTxReply, error)
+	// BuildCreateAsset returns an unsigned tx that creates a new asset and is
+	// funded by [from] along with the addresses that must sign it
+	BuildCreateAsset(
+		ctx context.Context,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		name string,
+		symbol string,
+		denomination byte,
+		holders []*ClientHolder,
+		minters []ClientOwners,
+		options ...rpc.Option,
+	) (*BuildTxReply, error)
+	// BuildExport returns an unsigned tx that exports [amount] of [assetID]
+	// from [from] to [to] on [targetChain] along with the addresses that must
+	// sign it
+	BuildExport(
+		ctx context.Context,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		amount uint64,
+		to ids.ShortID,
+		targetChain string,
+		assetID string,
+		memo string,
+		options ...rpc.Option,
+	) (*BuildTxReply, error)
+	// SignTx adds the signatures of [user]'s keys that are missing from the
+	// partially signed [txBytes]. Returns the updated tx and whether all of
+	// its signatures have been provided.
+	//
+	// Deprecated: Transactions should be issued using the
+	// `odysseygo/wallet/chain/a.Wallet` utility.
+	SignTx(ctx context.Context, user api.UserPass, txBytes []byte, options ...rpc.Option) ([]byte, bool, error)
+	// IssueSignedTx attaches [sigs] to [unsignedTxBytes] and issues the signed
+	// tx. [sigs][i] are the signatures of the i-th credential of the tx.
+	IssueSignedTx(
+		ctx context.Context,
+		unsignedTxBytes []byte,
+		sigs [][][secp256k1.SignatureLen]byte,
 		options ...rpc.Option,
 	) (ids.ID, error)
 }
@@ -264,6 +406,50 @@ func (c *client) GetHeight(ctx context.Context, options ...rpc.Option) (uint64,
 	return uint64(res.Height), err
 }
 
+func (c *client) GetTxFee(ctx context.Context, options ...rpc.Option) (*GetTxFeeReply, error) {
+	res := &GetTxFeeReply{}
+	err := c.requester.SendRequest(ctx, "alpha.getTxFee", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) EstimateTip(ctx context.Context, options ...rpc.Option) (*EstimateTipReply, error) {
+	res := &EstimateTipReply{}
+	err := c.requester.SendRequest(ctx, "alpha.estimateTip", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) ReserveUTXOs(ctx context.Context, user api.UserPass, session string, utxoIDs []ids.ID, ttl time.Duration, options ...rpc.Option) (time.Time, error) {
+	res := &ReserveUTXOsReply{}
+	err := c.requester.SendRequest(ctx, "alpha.reserveUTXOs", &ReserveUTXOsArgs{
+		UserPass: user,
+		Session:  session,
+		UTXOIDs:  utxoIDs,
+		TTL:      json.Uint64(ttl / time.Second),
+	}, res, options...)
+	return time.Unix(int64(res.Expiry), 0), err
+}
+
+func (c *client) ReleaseUTXOs(ctx context.Context, user api.UserPass, session string, utxoIDs []ids.ID, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "alpha.releaseUTXOs", &ReleaseUTXOsArgs{
+		UserPass: user,
+		Session:  session,
+		UTXOIDs:  utxoIDs,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) OpenKeySession(ctx context.Context, keys []*secp256k1.PrivateKey, ttl time.Duration, options ...rpc.Option) (api.UserPass, error) {
+	res := &OpenKeySessionReply{}
+	err := c.requester.SendRequest(ctx, "alpha.openKeySession", &OpenKeySessionArgs{
+		PrivateKeys: keys,
+		TTL:         json.Uint64(ttl / time.Second),
+	}, res, options...)
+	return res.UserPass, err
+}
+
+func (c *client) CloseKeySession(ctx context.Context, user api.UserPass, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "alpha.closeKeySession", &user, &api.EmptyReply{}, options...)
+}
+
 func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (ids.ID, error) {
 	txStr, err := formatting.Encode(formatting.Hex, txBytes)
 	if err != nil {
@@ -277,9 +463,26 @@ func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Opt
 	return res.TxID, err
 }
 
+func (c *client) IssueTxs(ctx context.Context, txs [][]byte, options ...rpc.Option) ([]api.IssueTxResult, error) {
+	txStrs := make([]string, len(txs))
+	for i, txBytes := range txs {
+		txStr, err := formatting.Encode(formatting.Hex, txBytes)
+		if err != nil {
+			return nil, err
+		}
+		txStrs[i] = txStr
+	}
+	res := &api.IssueTxsReply{}
+	err := c.requester.SendRequest(ctx, "alpha.issueTxs", &api.FormattedTxs{
+		Txs:      txStrs,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res.Results, err
+}
+
 func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (choices.Status, error) {
 	res := &GetTxStatusReply{}
-	err := c.requester.SendRequest(ctx, "alpha.getTxStatus", &api.JSONTxID{
+	err := c.requester.SendRequest(ctx, "alpha.getTxStatus", &GetTxStatusArgs{
 		TxID: txID,
 	}, res, options...)
 	return res.Status, err
@@ -368,6 +571,35 @@ func (c *client) GetAtomicUTXOs(
 	return utxos, endAddr, endUTXOID, err
 }
 
+// ClientEstimateFeeOutput is an output of a prospective send
+type ClientEstimateFeeOutput struct {
+	Amount  uint64
+	AssetID ids.ID
+}
+
+func (c *client) EstimateFee(
+	ctx context.Context,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	clientOutputs []ClientEstimateFeeOutput,
+	options ...rpc.Option,
+) (*EstimateFeeReply, error) {
+	outputs := make([]EstimateFeeOutput, len(clientOutputs))
+	for i, clientOutput := range clientOutputs {
+		outputs[i] = EstimateFeeOutput{
+			Amount:  json.Uint64(clientOutput.Amount),
+			AssetID: clientOutput.AssetID.String(),
+		}
+	}
+	res := &EstimateFeeReply{}
+	err := c.requester.SendRequest(ctx, "alpha.estimateFee", &EstimateFeeArgs{
+		JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+		JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		Outputs:        outputs,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (*GetAssetDescriptionReply, error) {
 	res := &GetAssetDescriptionReply{}
 	err := c.requester.SendRequest(ctx, "alpha.getAssetDescription", &GetAssetDescriptionArgs{
@@ -376,6 +608,19 @@ func (c *client) GetAssetDescription(ctx context.Context, assetID string, option
 	return res, err
 }
 
+func (c *client) AddAssetAlias(ctx context.Context, assetID string, alias string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "alpha.addAssetAlias", &AddAssetAliasArgs{
+		AssetID: assetID,
+		Alias:   alias,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) RemoveAssetAlias(ctx context.Context, alias string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "alpha.removeAssetAlias", &RemoveAssetAliasArgs{
+		Alias: alias,
+	}, &api.EmptyReply{}, options...)
+}
+
 func (c *client) GetBalance(
 	ctx context.Context,
 	addr ids.ShortID,
@@ -731,6 +976,101 @@ func (c *client) MintNFT(
 	return res.TxID, err
 }
 
+func (c *client) CreatePropertyAsset(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	name string,
+	symbol string,
+	clientMinters []ClientOwners,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	res := &FormattedAssetID{}
+	minters := make([]Owners, len(clientMinters))
+	for i, clientMinter := range clientMinters {
+		minters[i] = Owners{
+			Threshold: json.Uint32(clientMinter.Threshold),
+			Minters:   ids.ShortIDsToStrings(clientMinter.Minters),
+		}
+	}
+	err := c.requester.SendRequest(ctx, "alpha.createPropertyAsset", &CreatePropertyAssetArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		Name:       name,
+		Symbol:     symbol,
+		MinterSets: minters,
+	}, res, options...)
+	return res.AssetID, err
+}
+
+func (c *client) MintProperty(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	assetID string,
+	to ids.ShortID,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	res := &api.JSONTxID{}
+	err := c.requester.SendRequest(ctx, "alpha.mintProperty", &MintPropertyArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		AssetID: assetID,
+		To:      to.String(),
+	}, res, options...)
+	return res.TxID, err
+}
+
+func (c *client) BurnProperty(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	assetID string,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	res := &api.JSONTxID{}
+	err := c.requester.SendRequest(ctx, "alpha.burnProperty", &BurnPropertyArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		AssetID: assetID,
+	}, res, options...)
+	return res.TxID, err
+}
+
+func (c *client) ConsolidateUTXOs(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	assetID string,
+	targetCount uint32,
+	options ...rpc.Option,
+) ([]ids.ID, error) {
+	res := &ConsolidateUTXOsReply{}
+	err := c.requester.SendRequest(ctx, "alpha.consolidateUTXOs", &ConsolidateUTXOsArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		AssetID:     assetID,
+		TargetCount: json.Uint32(targetCount),
+	}, res, options...)
+	return res.TxIDs, err
+}
+
 func (c *client) Import(ctx context.Context, user api.UserPass, to ids.ShortID, sourceChain string, options ...rpc.Option) (ids.ID, error) {
 	res := &api.JSONTxID{}
 	err := c.requester.SendRequest(ctx, "alpha.import", &ImportArgs{
@@ -750,6 +1090,7 @@ func (c *client) Export(
 	to ids.ShortID,
 	targetChain string,
 	assetID string,
+	memo string,
 	options ...rpc.Option,
 ) (ids.ID, error) {
 	res := &api.JSONTxID{}
@@ -763,6 +1104,154 @@ func (c *client) Export(
 		TargetChain: targetChain,
 		To:          to.String(),
 		AssetID:     assetID,
+		Memo:        memo,
+	}, res, options...)
+	return res.TxID, err
+}
+
+func (c *client) BuildSend(
+	ctx context.Context,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	amount uint64,
+	assetID string,
+	to ids.ShortID,
+	memo string,
+	options ...rpc.Option,
+) (*BuildTxReply, error) {
+	res := &BuildTxReply{}
+	err := c.requester.SendRequest(ctx, "alpha.buildSend", &BuildSendArgs{
+		BuildSpendHeader: BuildSpendHeader{
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+			Encoding:       formatting.Hex,
+		},
+		SendOutput: SendOutput{
+			Amount:  json.Uint64(amount),
+			AssetID: assetID,
+			To:      to.String(),
+		},
+		Memo: memo,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) BuildCreateAsset(
+	ctx context.Context,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	name string,
+	symbol string,
+	denomination byte,
+	clientHolders []*ClientHolder,
+	clientMinters []ClientOwners,
+	options ...rpc.Option,
+) (*BuildTxReply, error) {
+	res := &BuildTxReply{}
+	holders := make([]*Holder, len(clientHolders))
+	for i, clientHolder := range clientHolders {
+		holders[i] = &Holder{
+			Amount:  json.Uint64(clientHolder.Amount),
+			Address: clientHolder.Address.String(),
+		}
+	}
+	minters := make([]Owners, len(clientMinters))
+	for i, clientMinter := range clientMinters {
+		minters[i] = Owners{
+			Threshold: json.Uint32(clientMinter.Threshold),
+			Minters:   ids.ShortIDsToStrings(clientMinter.Minters),
+		}
+	}
+	err := c.requester.SendRequest(ctx, "alpha.buildCreateAsset", &BuildCreateAssetArgs{
+		BuildSpendHeader: BuildSpendHeader{
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+			Encoding:       formatting.Hex,
+		},
+		Name:           name,
+		Symbol:         symbol,
+		Denomination:   denomination,
+		InitialHolders: holders,
+		MinterSets:     minters,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) BuildExport(
+	ctx context.Context,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	amount uint64,
+	to ids.ShortID,
+	targetChain string,
+	assetID string,
+	memo string,
+	options ...rpc.Option,
+) (*BuildTxReply, error) {
+	res := &BuildTxReply{}
+	err := c.requester.SendRequest(ctx, "alpha.buildExport", &BuildExportArgs{
+		BuildSpendHeader: BuildSpendHeader{
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+			Encoding:       formatting.Hex,
+		},
+		Amount:      json.Uint64(amount),
+		TargetChain: targetChain,
+		To:          to.String(),
+		AssetID:     assetID,
+		Memo:        memo,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) IssueSignedTx(
+	ctx context.Context,
+	unsignedTxBytes []byte,
+	sigs [][][secp256k1.SignatureLen]byte,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	txStr, err := formatting.Encode(formatting.Hex, unsignedTxBytes)
+	if err != nil {
+		return ids.ID{}, err
+	}
+	sigStrs := make([][]string, len(sigs))
+	for i, credSigs := range sigs {
+		sigStrs[i] = make([]string, len(credSigs))
+		for j, sig := range credSigs {
+			sigStrs[i][j], err = formatting.Encode(formatting.Hex, sig[:])
+			if err != nil {
+				return ids.ID{}, err
+			}
+		}
+	}
+	res := &api.JSONTxID{}
+	err = c.requester.SendRequest(ctx, "alpha.issueSignedTx", &IssueSignedTxArgs{
+		Tx:         txStr,
+		Signatures: sigStrs,
+		Encoding:   formatting.Hex,
 	}, res, options...)
 	return res.TxID, err
 }
+
+func (c *client) SignTx(
+	ctx context.Context,
+	user api.UserPass,
+	txBytes []byte,
+	options ...rpc.Option,
+) ([]byte, bool, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, false, err
+	}
+	res := &SignTxReply{}
+	err = c.requester.SendRequest(ctx, "alpha.signTx", &SignTxArgs{
+		UserPass: user,
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	if err != nil {
+		return nil, false, err
+	}
+	signedBytes, err := formatting.Decode(res.Encoding, res.Tx)
+	return signedBytes, res.Signed, err
+}
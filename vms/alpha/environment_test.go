@@ -261,11 +261,11 @@ func newContext(tb testing.TB) *snow.Context {
 	require.NoError(aliaser.Alias(constants.OmegaChainID, constants.OmegaChainID.String()))
 
 	ctx.ValidatorState = &validators.TestState{
-		GetSubnetIDF: func(_ context.Context, chainID ids.ID) (ids.ID, error) {
+		GetSubnetIDF: func(_ context.Context, peerChainID ids.ID) (ids.ID, error) {
 			subnetID, ok := map[ids.ID]ids.ID{
 				constants.OmegaChainID: ctx.SubnetID,
 				chainID:                ctx.SubnetID,
-			}[chainID]
+			}[peerChainID]
 			if !ok {
 				return ids.Empty, errMissing
 			}
@@ -5,7 +5,13 @@ package alpha
 
 import "context"
 
-// TODO: add health checks
-func (*VM) HealthCheck(context.Context) (interface{}, error) {
-	return nil, nil
+// HealthCheck reports the size of the mempool once the chain is linearized
+func (vm *VM) HealthCheck(context.Context) (interface{}, error) {
+	// The mempool is only created once the chain is linearized
+	if vm.mempool == nil {
+		return nil, nil
+	}
+	return map[string]interface{}{
+		"mempoolSize": len(vm.mempool.List()),
+	}, nil
 }
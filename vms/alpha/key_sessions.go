@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package alpha
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/api/keystore"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+const (
+	// defaultKeySessionTTL is how long a key session is open for if no TTL is
+	// provided
+	defaultKeySessionTTL = 10 * time.Minute
+	maxKeySessionTTL     = time.Hour
+
+	// maxKeySessions is the maximum number of key sessions that can be open at
+	// once
+	maxKeySessions = 1024
+
+	// keySessionPrefix prefixes the username of every key session. Keystore
+	// users can't be created with this prefix, so a username can't refer to
+	// both a key session and a keystore user.
+	keySessionPrefix = keystore.ReservedUsernamePrefix
+)
+
+var (
+	errMissingPrivateKeys = errors.New("argument 'privateKeys' not given")
+	errTooManyKeySessions = fmt.Errorf("can't open more than %d key sessions", maxKeySessions)
+	errUnknownKeySession  = errors.New("unknown or expired key session")
+)
+
+type keySession struct {
+	password string
+	keys     []*secp256k1.PrivateKey
+	expiry   time.Time
+}
+
+// keySessions holds private keys in memory for a limited time. A session is
+// used in place of a keystore user by passing its username and password to
+// the methods that take a user. The keys are never written to disk.
+type keySessions struct {
+	// Username -> session
+	sessions map[string]*keySession
+}
+
+func newKeySessions() *keySessions {
+	return &keySessions{
+		sessions: make(map[string]*keySession),
+	}
+}
+
+// Open opens a session holding [keys] until [expiry] and returns its
+// username and password.
+func (k *keySessions) Open(keys []*secp256k1.PrivateKey, expiry time.Time, now time.Time) (string, string, error) {
+	k.prune(now)
+	if len(k.sessions) >= maxKeySessions {
+		return "", "", errTooManyKeySessions
+	}
+
+	username, err := randomString()
+	if err != nil {
+		return "", "", err
+	}
+	password, err := randomString()
+	if err != nil {
+		return "", "", err
+	}
+
+	username = keySessionPrefix + username
+	k.sessions[username] = &keySession{
+		password: password,
+		keys:     keys,
+		expiry:   expiry,
+	}
+	return username, password, nil
+}
+
+// Close closes the session [username], forgetting its keys.
+func (k *keySessions) Close(username, password string, now time.Time) error {
+	if _, err := k.get(username, password, now); err != nil {
+		return err
+	}
+	delete(k.sessions, username)
+	return nil
+}
+
+// Keychain returns the keys of the session [username] that control
+// [addrsToUse], or all of its keys if [addrsToUse] is empty. Returns false if
+// [username] isn't the username of a key session.
+func (k *keySessions) Keychain(
+	username string,
+	password string,
+	addrsToUse set.Set[ids.ShortID],
+	now time.Time,
+) (*secp256k1fx.Keychain, bool, error) {
+	if !isKeySession(username) {
+		return nil, false, nil
+	}
+	session, err := k.get(username, password, now)
+	if err != nil {
+		return nil, true, err
+	}
+
+	kc := secp256k1fx.NewKeychain()
+	for _, key := range session.keys {
+		if addrsToUse.Len() == 0 || addrsToUse.Contains(key.PublicKey().Address()) {
+			kc.Add(key)
+		}
+	}
+	return kc, true, nil
+}
+
+func (k *keySessions) get(username, password string, now time.Time) (*keySession, error) {
+	k.prune(now)
+
+	session, ok := k.sessions[username]
+	if !ok || subtle.ConstantTimeCompare([]byte(session.password), []byte(password)) != 1 {
+		return nil, fmt.Errorf("%w: %s", errUnknownKeySession, username)
+	}
+	return session, nil
+}
+
+// prune closes the sessions that expired before [now].
+func (k *keySessions) prune(now time.Time) {
+	for username, session := range k.sessions {
+		if now.After(session.expiry) {
+			delete(k.sessions, username)
+		}
+	}
+}
+
+// isKeySession returns true if [username] is the username of a key session
+// rather than of a keystore user.
+func isKeySession(username string) bool {
+	return strings.HasPrefix(username, keySessionPrefix)
+}
+
+func randomString() (string, error) {
+	var id ids.ID
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", fmt.Errorf("couldn't generate random bytes: %w", err)
+	}
+	return id.String(), nil
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package alpha
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/utils/units"
+)
+
+const (
+	// defaultMempoolRegossipFrequency is how often the txs in the mempool are
+	// re-gossiped if it isn't specified in the config.
+	defaultMempoolRegossipFrequency = time.Minute
+
+	// mempoolClearWriteSize is the batch size used when clearing the persisted
+	// mempool.
+	mempoolClearWriteSize = units.MiB
+)
+
+// loadPersistedMempool reads the txs that were in the mempool when the node
+// last shut down. They are re-issued once the chain is linearized and
+// bootstrapped.
+func (vm *VM) loadPersistedMempool() error {
+	it := vm.mempoolDB.NewIterator()
+	defer it.Release()
+
+	for it.Next() {
+		tx, err := vm.parser.ParseTx(it.Value())
+		if err != nil {
+			vm.ctx.Log.Warn("dropping persisted mempool tx",
+				zap.Error(err),
+			)
+			continue
+		}
+		vm.persistedTxs = append(vm.persistedTxs, tx)
+	}
+	return it.Error()
+}
+
+// reissuePersistedMempool adds the txs read by loadPersistedMempool back into
+// the mempool and gossips them. Txs that are no longer valid are dropped.
+//
+// Invariant: Assumes the context lock is held, the chain is linearized and
+// the chain is bootstrapped.
+func (vm *VM) reissuePersistedMempool() {
+	for _, tx := range vm.persistedTxs {
+		if err := vm.network.IssueTx(context.TODO(), tx); err != nil {
+			vm.ctx.Log.Debug("dropping persisted mempool tx",
+				zap.Stringer("txID", tx.ID()),
+				zap.Error(err),
+			)
+		}
+	}
+	vm.ctx.Log.Info("reissued persisted mempool txs",
+		zap.Int("numTxs", len(vm.persistedTxs)),
+	)
+	vm.persistedTxs = nil
+}
+
+// persistMempool replaces the persisted mempool with the txs currently in the
+// mempool, in the order they were added.
+//
+// Invariant: Assumes the context lock is held and the chain is linearized.
+func (vm *VM) persistMempool() error {
+	if err := database.Clear(vm.mempoolDB, mempoolClearWriteSize); err != nil {
+		return err
+	}
+
+	batch := vm.mempoolDB.NewBatch()
+	for i, tx := range vm.mempool.List() {
+		if err := batch.Put(database.PackUInt64(uint64(i)), tx.Bytes()); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// runMempoolRegossip periodically re-gossips the txs in the mempool until
+// [ctx] is cancelled, so that txs that were missed by the network are not
+// stuck in this node's mempool.
+func (vm *VM) runMempoolRegossip(ctx context.Context, frequency time.Duration) {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		vm.ctx.Lock.Lock()
+		if !vm.bootstrapped {
+			vm.ctx.Lock.Unlock()
+			continue
+		}
+		pendingTxs := vm.mempool.List()
+		vm.ctx.Lock.Unlock()
+
+		for _, tx := range pendingTxs {
+			if err := vm.network.RegossipTx(ctx, tx); err != nil {
+				vm.ctx.Log.Warn("failed to re-gossip tx",
+					zap.Stringer("txID", tx.ID()),
+					zap.Error(err),
+				)
+			}
+		}
+		vm.ctx.Log.Debug("re-gossiped mempool txs",
+			zap.Int("numTxs", len(pendingTxs)),
+		)
+	}
+}
@@ -5,14 +5,20 @@ package network
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/DioneProtocol/odysseygo/cache"
+	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/snow/choices"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/block/executor"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/mempool"
@@ -23,7 +29,11 @@ import (
 // in the cache, not entire transactions.
 const recentTxsCacheSize = 512
 
-var _ Network = (*network)(nil)
+var (
+	_ Network = (*network)(nil)
+
+	errUnexpectedTxStatusResponse = errors.New("unexpected tx status response")
+)
 
 type Network interface {
 	common.AppHandler
@@ -33,6 +43,25 @@ type Network interface {
 	//
 	// Invariant: Assumes the context lock is held.
 	IssueTx(context.Context, *txs.Tx) error
+
+	// RegossipTx gossips [tx] to the network, even if it was recently
+	// gossiped.
+	RegossipTx(context.Context, *txs.Tx) error
+
+	// QueryTxStatus asks [nodeIDs] for their view of the status of [txID].
+	// It blocks until every node has either responded or failed to respond,
+	// or until [ctx] is done, and returns the statuses that were reported.
+	//
+	// Invariant: Assumes the context lock is not held.
+	QueryTxStatus(ctx context.Context, nodeIDs set.Set[ids.NodeID], txID ids.ID) (map[ids.NodeID]choices.Status, error)
+}
+
+// txStatusQuery tracks an outstanding QueryTxStatus call.
+type txStatusQuery struct {
+	txID        ids.ID
+	outstanding set.Set[ids.NodeID]
+	statuses    map[ids.NodeID]choices.Status
+	done        chan struct{}
 }
 
 type network struct {
@@ -48,6 +77,11 @@ type network struct {
 	// gossip related attributes
 	recentTxsLock sync.Mutex
 	recentTxs     *cache.LRU[ids.ID, struct{}]
+
+	// tx status query related attributes
+	queriesLock   sync.Mutex
+	nextRequestID uint32
+	queries       map[uint32]*txStatusQuery
 }
 
 func New(
@@ -69,9 +103,109 @@ func New(
 		recentTxs: &cache.LRU[ids.ID, struct{}]{
 			Size: recentTxsCacheSize,
 		},
+
+		queries: make(map[uint32]*txStatusQuery),
 	}
 }
 
+func (n *network) AppRequest(ctx context.Context, nodeID ids.NodeID, requestID uint32, _ time.Time, msgBytes []byte) error {
+	n.ctx.Log.Debug("called AppRequest message handler",
+		zap.Stringer("nodeID", nodeID),
+		zap.Uint32("requestID", requestID),
+		zap.Int("messageLen", len(msgBytes)),
+	)
+
+	msgIntf, err := message.Parse(msgBytes)
+	if err != nil {
+		n.ctx.Log.Debug("dropping AppRequest message",
+			zap.String("reason", "failed to parse message"),
+		)
+		return nil
+	}
+
+	msg, ok := msgIntf.(*message.TxStatusRequest)
+	if !ok {
+		n.ctx.Log.Debug("dropping unexpected message",
+			zap.Stringer("nodeID", nodeID),
+		)
+		return nil
+	}
+
+	n.ctx.Lock.Lock()
+	status, err := n.localTxStatus(msg.TxID)
+	n.ctx.Lock.Unlock()
+	if err != nil {
+		n.ctx.Log.Debug("failed to lookup tx status",
+			zap.Stringer("nodeID", nodeID),
+			zap.Stringer("txID", msg.TxID),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	responseBytes, err := message.Build(&message.TxStatusResponse{
+		TxID:   msg.TxID,
+		Status: status,
+	})
+	if err != nil {
+		return err
+	}
+	return n.appSender.SendAppResponse(ctx, nodeID, requestID, responseBytes)
+}
+
+func (n *network) AppResponse(_ context.Context, nodeID ids.NodeID, requestID uint32, msgBytes []byte) error {
+	n.ctx.Log.Debug("called AppResponse message handler",
+		zap.Stringer("nodeID", nodeID),
+		zap.Uint32("requestID", requestID),
+		zap.Int("messageLen", len(msgBytes)),
+	)
+
+	n.queriesLock.Lock()
+	defer n.queriesLock.Unlock()
+
+	query, ok := n.queries[requestID]
+	if !ok || !query.outstanding.Contains(nodeID) {
+		n.ctx.Log.Debug("dropping unexpected AppResponse message",
+			zap.Stringer("nodeID", nodeID),
+			zap.Uint32("requestID", requestID),
+		)
+		return nil
+	}
+	markResponded(query, nodeID)
+
+	msgIntf, err := message.Parse(msgBytes)
+	if err != nil {
+		n.ctx.Log.Debug("dropping AppResponse message",
+			zap.String("reason", "failed to parse message"),
+		)
+		return nil
+	}
+
+	msg, ok := msgIntf.(*message.TxStatusResponse)
+	if !ok || msg.TxID != query.txID || msg.Status.Valid() != nil {
+		n.ctx.Log.Debug("dropping AppResponse message",
+			zap.Stringer("nodeID", nodeID),
+			zap.Error(errUnexpectedTxStatusResponse),
+		)
+		return nil
+	}
+
+	query.statuses[nodeID] = msg.Status
+	return nil
+}
+
+func (n *network) AppRequestFailed(_ context.Context, nodeID ids.NodeID, requestID uint32) error {
+	n.queriesLock.Lock()
+	defer n.queriesLock.Unlock()
+
+	query, ok := n.queries[requestID]
+	if !ok || !query.outstanding.Contains(nodeID) {
+		return nil
+	}
+	markResponded(query, nodeID)
+	return nil
+}
+
 func (n *network) AppGossip(ctx context.Context, nodeID ids.NodeID, msgBytes []byte) error {
 	n.ctx.Log.Debug("called AppGossip message handler",
 		zap.Stringer("nodeID", nodeID),
@@ -138,6 +272,115 @@ func (n *network) IssueTx(ctx context.Context, tx *txs.Tx) error {
 	return nil
 }
 
+func (n *network) RegossipTx(ctx context.Context, tx *txs.Tx) error {
+	msgBytes, err := message.Build(&message.Tx{
+		Tx: tx.Bytes(),
+	})
+	if err != nil {
+		return err
+	}
+
+	txID := tx.ID()
+	n.recentTxsLock.Lock()
+	n.recentTxs.Put(txID, struct{}{})
+	n.recentTxsLock.Unlock()
+
+	n.ctx.Log.Debug("re-gossiping tx",
+		zap.Stringer("txID", txID),
+	)
+	return n.appSender.SendAppGossip(ctx, msgBytes)
+}
+
+func (n *network) QueryTxStatus(
+	ctx context.Context,
+	nodeIDs set.Set[ids.NodeID],
+	txID ids.ID,
+) (map[ids.NodeID]choices.Status, error) {
+	statuses := make(map[ids.NodeID]choices.Status, nodeIDs.Len())
+	if nodeIDs.Len() == 0 {
+		return statuses, nil
+	}
+
+	msgBytes, err := message.Build(&message.TxStatusRequest{
+		TxID: txID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	query := &txStatusQuery{
+		txID:        txID,
+		outstanding: set.Of(nodeIDs.List()...),
+		statuses:    statuses,
+		done:        make(chan struct{}),
+	}
+
+	n.queriesLock.Lock()
+	requestID := n.nextRequestID
+	n.nextRequestID++
+	n.queries[requestID] = query
+	n.queriesLock.Unlock()
+
+	if err := n.appSender.SendAppRequest(ctx, nodeIDs, requestID, msgBytes); err != nil {
+		n.queriesLock.Lock()
+		delete(n.queries, requestID)
+		n.queriesLock.Unlock()
+		return nil, err
+	}
+
+	select {
+	case <-query.done:
+	case <-ctx.Done():
+	}
+
+	n.queriesLock.Lock()
+	defer n.queriesLock.Unlock()
+
+	// Any responses that arrive after this point are dropped.
+	delete(n.queries, requestID)
+
+	results := make(map[ids.NodeID]choices.Status, len(query.statuses))
+	for nodeID, status := range query.statuses {
+		results[nodeID] = status
+	}
+	return results, nil
+}
+
+// localTxStatus returns this node's view of the status of [txID].
+//
+// Invariant: Assumes the context lock is held.
+func (n *network) localTxStatus(txID ids.ID) (choices.Status, error) {
+	lastAccepted := n.manager.LastAccepted()
+	state, ok := n.manager.GetState(lastAccepted)
+	if !ok {
+		return choices.Unknown, fmt.Errorf("%w: %s", executor.ErrChainNotSynced, lastAccepted)
+	}
+
+	_, err := state.GetTx(txID)
+	switch err {
+	case nil:
+		return choices.Accepted, nil
+	case database.ErrNotFound:
+	default:
+		return choices.Unknown, err
+	}
+
+	if n.mempool.Has(txID) {
+		return choices.Processing, nil
+	}
+	return choices.Unknown, nil
+}
+
+// markResponded records that [nodeID] is no longer outstanding for [query].
+//
+// Invariant: Assumes [queriesLock] is held.
+func markResponded(query *txStatusQuery, nodeID ids.NodeID) {
+	query.outstanding.Remove(nodeID)
+	if query.outstanding.Len() == 0 {
+		close(query.done)
+	}
+}
+
 // returns nil if the tx is in the mempool
 func (n *network) issueTx(tx *txs.Tx) error {
 	txID := tx.ID()
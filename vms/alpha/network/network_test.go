@@ -7,17 +7,22 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"go.uber.org/mock/gomock"
 
+	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/snow/choices"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/block/executor"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/fxs"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/states"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/mempool"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
@@ -337,3 +342,150 @@ func TestNetworkGossipTx(t *testing.T) {
 	n.gossipTx(context.Background(), ids.GenerateTestID(), msgBytes)
 	// Did make a call to SendAppGossip
 }
+
+func TestNetworkRegossipTx(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	parser, err := txs.NewParser([]fxs.Fx{
+		&secp256k1fx.Fx{},
+	})
+	require.NoError(err)
+
+	tx := &txs.Tx{
+		Unsigned: &txs.BaseTx{
+			BaseTx: dione.BaseTx{
+				NetworkID:    1,
+				BlockchainID: ids.GenerateTestID(),
+			},
+		},
+	}
+	require.NoError(parser.InitializeTx(tx))
+
+	appSender := common.NewMockSender(ctrl)
+	nIntf := New(
+		&snow.Context{
+			Log: logging.NoLog{},
+		},
+		parser,
+		executor.NewMockManager(ctrl),
+		mempool.NewMockMempool(ctrl),
+		appSender,
+	)
+	require.IsType(&network{}, nIntf)
+	n := nIntf.(*network)
+
+	// The tx is gossiped even though it was recently gossiped
+	n.recentTxs.Put(tx.ID(), struct{}{})
+	msgBytes, err := message.Build(&message.Tx{
+		Tx: tx.Bytes(),
+	})
+	require.NoError(err)
+	appSender.EXPECT().SendAppGossip(gomock.Any(), msgBytes).Return(nil)
+	require.NoError(n.RegossipTx(context.Background(), tx))
+}
+
+func TestNetworkAppRequestTxStatus(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	parser, err := txs.NewParser([]fxs.Fx{
+		&secp256k1fx.Fx{},
+	})
+	require.NoError(err)
+
+	var (
+		nodeID       = ids.GenerateTestNodeID()
+		lastAccepted = ids.GenerateTestID()
+		txID         = ids.GenerateTestID()
+	)
+
+	state := states.NewMockChain(ctrl)
+	state.EXPECT().GetTx(txID).Return(nil, database.ErrNotFound)
+
+	manager := executor.NewMockManager(ctrl)
+	manager.EXPECT().LastAccepted().Return(lastAccepted)
+	manager.EXPECT().GetState(lastAccepted).Return(state, true)
+
+	mempool := mempool.NewMockMempool(ctrl)
+	mempool.EXPECT().Has(txID).Return(true)
+
+	expectedResponse, err := message.Build(&message.TxStatusResponse{
+		TxID:   txID,
+		Status: choices.Processing,
+	})
+	require.NoError(err)
+
+	appSender := common.NewMockSender(ctrl)
+	appSender.EXPECT().SendAppResponse(gomock.Any(), nodeID, uint32(1), expectedResponse).Return(nil)
+
+	n := New(
+		&snow.Context{
+			Log: logging.NoLog{},
+		},
+		parser,
+		manager,
+		mempool,
+		appSender,
+	)
+
+	requestBytes, err := message.Build(&message.TxStatusRequest{
+		TxID: txID,
+	})
+	require.NoError(err)
+	require.NoError(n.AppRequest(context.Background(), nodeID, 1, time.Time{}, requestBytes))
+}
+
+func TestNetworkQueryTxStatus(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	parser, err := txs.NewParser([]fxs.Fx{
+		&secp256k1fx.Fx{},
+	})
+	require.NoError(err)
+
+	var (
+		acceptedNodeID = ids.GenerateTestNodeID()
+		failedNodeID   = ids.GenerateTestNodeID()
+		txID           = ids.GenerateTestID()
+	)
+
+	appSender := common.NewMockSender(ctrl)
+
+	n := New(
+		&snow.Context{
+			Log: logging.NoLog{},
+		},
+		parser,
+		executor.NewMockManager(ctrl),
+		mempool.NewMockMempool(ctrl),
+		appSender,
+	)
+
+	responseBytes, err := message.Build(&message.TxStatusResponse{
+		TxID:   txID,
+		Status: choices.Accepted,
+	})
+	require.NoError(err)
+
+	appSender.EXPECT().SendAppRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ set.Set[ids.NodeID], requestID uint32, _ []byte) error {
+			go func() {
+				require.NoError(n.AppResponse(ctx, acceptedNodeID, requestID, responseBytes))
+				require.NoError(n.AppRequestFailed(ctx, failedNodeID, requestID))
+			}()
+			return nil
+		},
+	)
+
+	statuses, err := n.QueryTxStatus(
+		context.Background(),
+		set.Of(acceptedNodeID, failedNodeID),
+		txID,
+	)
+	require.NoError(err)
+	require.Equal(map[ids.NodeID]choices.Status{
+		acceptedNodeID: choices.Accepted,
+	}, statuses)
+}
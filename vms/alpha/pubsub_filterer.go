@@ -13,17 +13,31 @@ import (
 var _ pubsub.Filterer = (*connector)(nil)
 
 type connector struct {
-	tx *txs.Tx
+	tx       *txs.Tx
+	consumed []*dione.UTXO
 }
 
-func NewPubSubFilterer(tx *txs.Tx) pubsub.Filterer {
-	return &connector{tx: tx}
+// NewPubSubFilterer returns a filterer that matches the addresses of the UTXOs
+// produced by [tx] and of the UTXOs in [consumed], which [tx] spends.
+func NewPubSubFilterer(tx *txs.Tx, consumed []*dione.UTXO) pubsub.Filterer {
+	return &connector{
+		tx:       tx,
+		consumed: consumed,
+	}
 }
 
 // Apply the filter on the addresses.
 func (f *connector) Filter(filters []pubsub.Filter) ([]bool, interface{}) {
 	resp := make([]bool, len(filters))
-	for _, utxo := range f.tx.UTXOs() {
+	filter(resp, filters, f.tx.UTXOs())
+	filter(resp, filters, f.consumed)
+	return resp, api.JSONTxID{
+		TxID: f.tx.ID(),
+	}
+}
+
+func filter(resp []bool, filters []pubsub.Filter, utxos []*dione.UTXO) {
+	for _, utxo := range utxos {
 		addressable, ok := utxo.Out.(dione.Addressable)
 		if !ok {
 			continue
@@ -38,7 +52,4 @@ func (f *connector) Filter(filters []pubsub.Filter) ([]bool, interface{}) {
 			}
 		}
 	}
-	return resp, api.JSONTxID{
-		TxID: f.tx.ID(),
-	}
 }
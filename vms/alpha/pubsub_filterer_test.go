@@ -44,7 +44,48 @@ func TestFilter(t *testing.T) {
 	fp := pubsub.NewFilterParam()
 	require.NoError(fp.Add(addrBytes))
 
-	parser := NewPubSubFilterer(&tx)
+	parser := NewPubSubFilterer(&tx, nil)
 	fr, _ := parser.Filter([]pubsub.Filter{&mockFilter{addr: addrBytes}})
 	require.Equal([]bool{true}, fr)
 }
+
+func TestFilterConsumed(t *testing.T) {
+	require := require.New(t)
+
+	spenderID := ids.ShortID{1}
+	otherID := ids.ShortID{2}
+	tx := txs.Tx{Unsigned: &txs.BaseTx{BaseTx: dione.BaseTx{
+		Outs: []*dione.TransferableOutput{
+			{
+				Out: &secp256k1fx.TransferOutput{
+					OutputOwners: secp256k1fx.OutputOwners{
+						Addrs: []ids.ShortID{otherID},
+					},
+				},
+			},
+		},
+	}}}
+	consumed := []*dione.UTXO{
+		{
+			Out: &secp256k1fx.TransferOutput{
+				OutputOwners: secp256k1fx.OutputOwners{
+					Addrs: []ids.ShortID{spenderID},
+				},
+			},
+		},
+	}
+
+	filters := []pubsub.Filter{
+		&mockFilter{addr: spenderID[:]},
+		&mockFilter{addr: otherID[:]},
+		&mockFilter{addr: ids.ShortEmpty[:]},
+	}
+
+	parser := NewPubSubFilterer(&tx, nil)
+	fr, _ := parser.Filter(filters)
+	require.Equal([]bool{false, true, false}, fr)
+
+	parser = NewPubSubFilterer(&tx, consumed)
+	fr, _ = parser.Filter(filters)
+	require.Equal([]bool{true, true, false}, fr)
+}
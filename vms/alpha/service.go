@@ -4,10 +4,12 @@
 package alpha
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
 	"net/http"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -18,14 +20,18 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
 	"github.com/DioneProtocol/odysseygo/utils/json"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/sampler"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/mempool"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/keystore"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
 	"github.com/DioneProtocol/odysseygo/vms/nftfx"
+	"github.com/DioneProtocol/odysseygo/vms/propertyfx"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 
 	safemath "github.com/DioneProtocol/odysseygo/utils/math"
@@ -35,8 +41,18 @@ const (
 	// Max number of addresses that can be passed in as argument to GetUTXOs
 	maxGetUTXOsAddrs = 1024
 
+	// Max number of txs that can be passed in as argument to IssueTxs
+	maxIssueTxs = 1024
+
 	// Max number of items allowed in a page
 	maxPageSize uint64 = 1024
+
+	// Number of validators queried when looking up the status of a tx that
+	// this node doesn't know about
+	txStatusQuerySampleSize = 10
+
+	// Max amount of time to wait for validators to report a tx status
+	txStatusQueryTimeout = 2 * time.Second
 )
 
 var (
@@ -51,6 +67,14 @@ var (
 	errNoKeys             = errors.New("from addresses have no keys or funds")
 	errMissingPrivateKey  = errors.New("argument 'privateKey' not given")
 	errNotLinearized      = errors.New("chain is not linearized")
+	errUnsupportedTx      = errors.New("unsupported transaction type")
+	errUnsupportedInput   = errors.New("unsupported input type")
+	errUnsupportedCred    = errors.New("unsupported credential type")
+	errWrongNumSigs       = errors.New("wrong number of signatures")
+	errPayloadAndPayloads = errors.New("payload and to can't be combined with payloads")
+	errBelowFee           = errors.New("consolidated amount doesn't exceed the tx fee")
+	errTxTooLarge         = errors.New("tx is too large")
+	errTooManyTxs         = errors.New("too many txs")
 )
 
 // FormattedAssetID defines a JSON formatted struct containing an assetID as a string
@@ -77,6 +101,10 @@ func (s *Service) GetBlock(_ *http.Request, args *api.GetBlockArgs, reply *api.G
 	if err != nil {
 		return fmt.Errorf("couldn't get block with id %s: %w", args.BlockID, err)
 	}
+	reply.AcceptedAt, err = s.getAcceptedAt(args.BlockID)
+	if err != nil {
+		return err
+	}
 	reply.Encoding = args.Encoding
 
 	if args.Encoding == formatting.JSON {
@@ -129,6 +157,10 @@ func (s *Service) GetBlockByHeight(_ *http.Request, args *api.GetBlockByHeightAr
 		)
 		return fmt.Errorf("couldn't get block with id %s: %w", blockID, err)
 	}
+	reply.AcceptedAt, err = s.getAcceptedAt(blockID)
+	if err != nil {
+		return err
+	}
 
 	if args.Encoding == formatting.JSON {
 		block.InitCtx(s.vm.ctx)
@@ -155,6 +187,20 @@ func (s *Service) GetBlockByHeight(_ *http.Request, args *api.GetBlockByHeightAr
 	return nil
 }
 
+// getAcceptedAt returns the local time that [blkID] was accepted at, or nil if
+// it wasn't recorded.
+func (s *Service) getAcceptedAt(blkID ids.ID) (*time.Time, error) {
+	acceptedAt, err := s.vm.acceptTimes.AcceptedAt(blkID)
+	switch err {
+	case nil:
+		return &acceptedAt, nil
+	case database.ErrNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("couldn't get acceptance time of block %s: %w", blkID, err)
+	}
+}
+
 // GetHeight returns the height of the last accepted block.
 func (s *Service) GetHeight(_ *http.Request, _ *struct{}, reply *api.GetHeightResponse) error {
 	s.vm.ctx.Log.Debug("API called",
@@ -180,6 +226,333 @@ func (s *Service) GetHeight(_ *http.Request, _ *struct{}, reply *api.GetHeightRe
 	return nil
 }
 
+// GetTxFeeReply is the response from GetTxFee
+type GetTxFeeReply struct {
+	TxFee            json.Uint64 `json:"txFee"`
+	CreateAssetTxFee json.Uint64 `json:"createAssetTxFee"`
+	FeeAssetID       ids.ID      `json:"feeAssetID"`
+}
+
+// GetTxFee returns the fees charged by this chain.
+func (s *Service) GetTxFee(_ *http.Request, _ *struct{}, reply *GetTxFeeReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "getTxFee"),
+	)
+
+	reply.TxFee = json.Uint64(s.vm.TxFee)
+	reply.CreateAssetTxFee = json.Uint64(s.vm.CreateAssetTxFee)
+	reply.FeeAssetID = s.vm.feeAssetID
+	return nil
+}
+
+// EstimateTipReply describes the distribution of the tips paid by the txs
+// currently in the mempool. Tips are denominated in the fee asset and are paid
+// in addition to the required fee.
+type EstimateTipReply struct {
+	NumTxs    json.Uint64 `json:"numTxs"`
+	MinTip    json.Uint64 `json:"minTip"`
+	P25Tip    json.Uint64 `json:"p25Tip"`
+	MedianTip json.Uint64 `json:"medianTip"`
+	P75Tip    json.Uint64 `json:"p75Tip"`
+	MaxTip    json.Uint64 `json:"maxTip"`
+}
+
+// EstimateTip returns the distribution of the tips currently paid by txs in
+// the mempool. Txs paying a higher tip are included in blocks first.
+func (s *Service) EstimateTip(_ *http.Request, _ *struct{}, reply *EstimateTipReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "estimateTip"),
+	)
+
+	if s.vm.mempool == nil {
+		return errNotLinearized
+	}
+
+	// Sorted in decreasing order
+	tips := s.vm.mempool.Tips()
+	numTxs := len(tips)
+	reply.NumTxs = json.Uint64(numTxs)
+	if numTxs == 0 {
+		return nil
+	}
+
+	percentile := func(p int) json.Uint64 {
+		return json.Uint64(tips[(numTxs-1)*(100-p)/100])
+	}
+	reply.MinTip = percentile(0)
+	reply.P25Tip = percentile(25)
+	reply.MedianTip = percentile(50)
+	reply.P75Tip = percentile(75)
+	reply.MaxTip = percentile(100)
+	return nil
+}
+
+// EstimateFeeArgs are arguments for passing into EstimateFee requests
+type EstimateFeeArgs struct {
+	// Addresses whose UTXOs would fund the send
+	api.JSONFromAddrs
+
+	// Address change would be sent to. Defaults to the first from address.
+	api.JSONChangeAddr
+
+	// Outputs of the send, one per recipient
+	Outputs []EstimateFeeOutput `json:"outputs"`
+}
+
+// EstimateFeeOutput is an output of a prospective send
+type EstimateFeeOutput struct {
+	Amount  json.Uint64 `json:"amount"`
+	AssetID string      `json:"assetID"`
+}
+
+// EstimateFeeReply is the response from EstimateFee
+type EstimateFeeReply struct {
+	Fee        json.Uint64 `json:"fee"`
+	FeeAssetID ids.ID      `json:"feeAssetID"`
+	// Number of UTXOs that would be consumed
+	NumInputs json.Uint64 `json:"numInputs"`
+	// Number of UTXOs that would be produced, including change
+	NumOutputs json.Uint64 `json:"numOutputs"`
+}
+
+// EstimateFee returns the fee of a send of [args.Outputs] funded by
+// [args.From], along with the number of inputs and outputs the send would
+// have. UTXOs are selected the same way as by BuildSend, so an error is
+// returned if the addresses can't fund the send.
+func (s *Service) EstimateFee(_ *http.Request, args *EstimateFeeArgs, reply *EstimateFeeReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "estimateFee"),
+		logging.UserStrings("from", args.From),
+		zap.Int("numOutputs", len(args.Outputs)),
+	)
+
+	if len(args.Outputs) == 0 {
+		return errNoOutputs
+	}
+
+	amounts := map[ids.ID]uint64{
+		s.vm.feeAssetID: s.vm.TxFee,
+	}
+	for _, output := range args.Outputs {
+		if output.Amount == 0 {
+			return errZeroAmount
+		}
+		assetID, err := s.vm.lookupAssetID(output.AssetID)
+		if err != nil {
+			return err
+		}
+		amount, err := safemath.Add64(amounts[assetID], uint64(output.Amount))
+		if err != nil {
+			return fmt.Errorf("problem calculating required spend amount: %w", err)
+		}
+		amounts[assetID] = amount
+	}
+
+	ins, changeOuts, _, err := s.buildSpend(&BuildSpendHeader{
+		JSONFromAddrs:  args.JSONFromAddrs,
+		JSONChangeAddr: args.JSONChangeAddr,
+	}, amounts)
+	if err != nil {
+		return err
+	}
+
+	reply.Fee = json.Uint64(s.vm.TxFee)
+	reply.FeeAssetID = s.vm.feeAssetID
+	reply.NumInputs = json.Uint64(len(ins))
+	reply.NumOutputs = json.Uint64(len(args.Outputs) + len(changeOuts))
+	return nil
+}
+
+// ReserveUTXOsArgs are the arguments for ReserveUTXOs
+type ReserveUTXOsArgs struct {
+	// User the UTXOs are reserved for
+	api.UserPass
+	// Session of the user the UTXOs are reserved for
+	Session string `json:"session"`
+	// IDs of the UTXOs to reserve
+	UTXOIDs []ids.ID `json:"utxoIDs"`
+	// Number of seconds the UTXOs are reserved for. If 0, the UTXOs are
+	// reserved for 1 minute.
+	TTL json.Uint64 `json:"ttl"`
+}
+
+// ReserveUTXOsReply is the response from ReserveUTXOs
+type ReserveUTXOsReply struct {
+	// Unix time, in seconds, at which the reservation expires
+	Expiry json.Uint64 `json:"expiry"`
+}
+
+// ReserveUTXOs reserves UTXOs of a user for a client session that is building
+// a tx spending them. Until the reservation is released or expires, the UTXOs
+// can't be reserved by other sessions and aren't spent by the txs this node
+// builds for the user. This allows concurrent tx builders of the same user to
+// avoid constructing conflicting txs.
+func (s *Service) ReserveUTXOs(_ *http.Request, args *ReserveUTXOsArgs, reply *ReserveUTXOsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "reserveUTXOs"),
+		logging.UserString("username", args.Username),
+		logging.UserString("session", args.Session),
+		zap.Int("numUTXOs", len(args.UTXOIDs)),
+		zap.Uint64("ttl", uint64(args.TTL)),
+	)
+
+	switch {
+	case args.Session == "":
+		return errNoSession
+	case len(args.UTXOIDs) == 0:
+		return errNoUTXOs
+	}
+
+	ttl := defaultReservationTTL
+	if args.TTL != 0 {
+		ttl = time.Duration(args.TTL) * time.Second
+	}
+	if ttl > maxReservationTTL {
+		return fmt.Errorf("ttl %s exceeds the maximum of %s", ttl, maxReservationTTL)
+	}
+
+	kc, err := s.vm.loadKeychain(args.Username, args.Password, nil)
+	if err != nil {
+		return err
+	}
+
+	now := s.vm.clock.Time()
+	unixNow := uint64(now.Unix())
+	for _, utxoID := range args.UTXOIDs {
+		utxo, err := s.vm.state.GetUTXO(utxoID)
+		if err != nil {
+			return fmt.Errorf("couldn't get UTXO %s: %w", utxoID, err)
+		}
+		if _, _, err := kc.Spend(utxo.Out, unixNow); err != nil {
+			return fmt.Errorf("%w: %s", errUTXONotOwned, utxoID)
+		}
+	}
+
+	expiry := now.Add(ttl)
+	if err := s.vm.utxoReservations.Reserve(args.Username, args.Session, args.UTXOIDs, expiry, now); err != nil {
+		return err
+	}
+	reply.Expiry = json.Uint64(expiry.Unix())
+	return nil
+}
+
+// ReleaseUTXOsArgs are the arguments for ReleaseUTXOs
+type ReleaseUTXOsArgs struct {
+	// User the UTXOs were reserved for
+	api.UserPass
+	// Session of the user the UTXOs were reserved for
+	Session string `json:"session"`
+	// IDs of the UTXOs to release
+	UTXOIDs []ids.ID `json:"utxoIDs"`
+}
+
+// ReleaseUTXOs releases UTXOs previously reserved by a session of a user with
+// ReserveUTXOs. UTXOs that aren't reserved by the session are ignored.
+func (s *Service) ReleaseUTXOs(_ *http.Request, args *ReleaseUTXOsArgs, _ *api.EmptyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "releaseUTXOs"),
+		logging.UserString("username", args.Username),
+		logging.UserString("session", args.Session),
+		zap.Int("numUTXOs", len(args.UTXOIDs)),
+	)
+
+	if args.Session == "" {
+		return errNoSession
+	}
+
+	if _, err := s.vm.loadKeychain(args.Username, args.Password, nil); err != nil {
+		return err
+	}
+
+	s.vm.utxoReservations.Release(args.Username, args.Session, args.UTXOIDs)
+	return nil
+}
+
+// OpenKeySessionArgs are the arguments for OpenKeySession
+type OpenKeySessionArgs struct {
+	// Keys held by the session
+	PrivateKeys []*secp256k1.PrivateKey `json:"privateKeys"`
+	// Number of seconds the session is open for. If 0, the session is open
+	// for 10 minutes.
+	TTL json.Uint64 `json:"ttl"`
+}
+
+// OpenKeySessionReply is the response from OpenKeySession
+type OpenKeySessionReply struct {
+	// Credentials that are used in place of a keystore user's
+	api.UserPass
+	// Addresses controlled by the session
+	Addresses []string `json:"addresses"`
+	// Unix time, in seconds, at which the session expires
+	Expiry json.Uint64 `json:"expiry"`
+}
+
+// OpenKeySession opens a session that holds the provided keys in memory until
+// it is closed or expires. The keys are never written to disk. The returned
+// username and password can be passed to any method that takes a keystore
+// user, such as Send or Mint, to spend with the keys of the session.
+func (s *Service) OpenKeySession(_ *http.Request, args *OpenKeySessionArgs, reply *OpenKeySessionReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "openKeySession"),
+		zap.Int("numKeys", len(args.PrivateKeys)),
+		zap.Uint64("ttl", uint64(args.TTL)),
+	)
+
+	if len(args.PrivateKeys) == 0 {
+		return errMissingPrivateKeys
+	}
+	for _, key := range args.PrivateKeys {
+		if key == nil {
+			return errMissingPrivateKeys
+		}
+	}
+
+	ttl := defaultKeySessionTTL
+	if args.TTL != 0 {
+		ttl = time.Duration(args.TTL) * time.Second
+	}
+	if ttl > maxKeySessionTTL {
+		return fmt.Errorf("ttl %s exceeds the maximum of %s", ttl, maxKeySessionTTL)
+	}
+
+	now := s.vm.clock.Time()
+	expiry := now.Add(ttl)
+	username, password, err := s.vm.keySessions.Open(args.PrivateKeys, expiry, now)
+	if err != nil {
+		return err
+	}
+
+	reply.Username = username
+	reply.Password = password
+	reply.Addresses = make([]string, len(args.PrivateKeys))
+	for i, key := range args.PrivateKeys {
+		reply.Addresses[i], err = s.vm.FormatLocalAddress(key.PublicKey().Address())
+		if err != nil {
+			return fmt.Errorf("problem formatting address: %w", err)
+		}
+	}
+	reply.Expiry = json.Uint64(expiry.Unix())
+	return nil
+}
+
+// CloseKeySession closes a session opened with OpenKeySession, forgetting its
+// keys.
+func (s *Service) CloseKeySession(_ *http.Request, args *api.UserPass, _ *api.EmptyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "closeKeySession"),
+		logging.UserString("username", args.Username),
+	)
+
+	return s.vm.keySessions.Close(args.Username, args.Password, s.vm.clock.Time())
+}
+
 // IssueTx attempts to issue a transaction into consensus
 func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, reply *api.JSONTxID) error {
 	s.vm.ctx.Log.Debug("API called",
@@ -188,22 +561,239 @@ func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, reply *api.JSO
 		logging.UserString("tx", args.Tx),
 	)
 
+	txID, err := s.issueTx(args.Encoding, args.Tx)
+	if err != nil {
+		return err
+	}
+
+	reply.TxID = txID
+	return nil
+}
+
+// IssueTxs attempts to issue a batch of txs into consensus. A tx that fails to
+// be issued doesn't prevent the remaining txs from being issued.
+func (s *Service) IssueTxs(_ *http.Request, args *api.FormattedTxs, reply *api.IssueTxsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "issueTxs"),
+		zap.Int("numTxs", len(args.Txs)),
+	)
+
+	if len(args.Txs) > maxIssueTxs {
+		return fmt.Errorf("%w: %d > %d", errTooManyTxs, len(args.Txs), maxIssueTxs)
+	}
+
+	reply.Results = make([]api.IssueTxResult, len(args.Txs))
+	for i, txStr := range args.Txs {
+		txID, err := s.issueTx(args.Encoding, txStr)
+		reply.Results[i].TxID = txID
+		if err != nil {
+			reply.Results[i].Error = err.Error()
+		}
+	}
+	return nil
+}
+
+// issueTx decodes, parses and issues [txStr] into consensus. The returned txID
+// is empty if the tx couldn't be parsed.
+func (s *Service) issueTx(encoding formatting.Encoding, txStr string) (ids.ID, error) {
+	txBytes, err := formatting.Decode(encoding, txStr)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	tx, err := s.vm.parser.ParseTx(txBytes)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("couldn't parse tx: %w", err)
+	}
+	if err := s.vm.issueTx(tx); err != nil {
+		return tx.ID(), fmt.Errorf("couldn't issue tx: %w", err)
+	}
+	return tx.ID(), nil
+}
+
+// SignTxArgs are arguments for passing into SignTx requests
+type SignTxArgs struct {
+	// User whose keys sign the tx
+	api.UserPass
+
+	// The partially signed tx
+	Tx       string              `json:"tx"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// SignTxReply is the response from calling SignTx
+type SignTxReply struct {
+	Tx       string              `json:"tx"`
+	Encoding formatting.Encoding `json:"encoding"`
+
+	// True if all the signatures of the tx have been provided, in which case
+	// the tx can be issued with IssueTx.
+	Signed bool `json:"signed"`
+}
+
+// SignTx adds the signatures of the user's keys that are missing from a
+// partially signed tx, such as one returned by Send or Mint when the spent
+// funds have other owners.
+func (s *Service) SignTx(_ *http.Request, args *SignTxArgs, reply *SignTxReply) error {
+	s.vm.ctx.Log.Warn("deprecated API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "signTx"),
+		logging.UserString("username", args.Username),
+	)
+
 	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
 	if err != nil {
 		return fmt.Errorf("problem decoding transaction: %w", err)
 	}
-	txID, err := s.vm.IssueTx(txBytes)
+	tx, err := s.vm.parser.ParseTx(txBytes)
+	if err != nil {
+		return fmt.Errorf("problem parsing transaction: %w", err)
+	}
+
+	signers, err := s.credentialSigners(tx)
 	if err != nil {
 		return err
 	}
+	if numCreds := len(tx.Creds); numCreds != len(signers) {
+		return fmt.Errorf("expected %d credentials but got %d", len(signers), numCreds)
+	}
 
-	reply.TxID = txID
+	kc, err := s.vm.loadKeychain(args.Username, args.Password, nil)
+	if err != nil {
+		return err
+	}
+
+	hash := hashing.ComputeHash256(tx.Unsigned.Bytes())
+	reply.Signed = true
+	for i, fxCred := range tx.Creds {
+		cred, ok := fxCred.Verifiable.(*secp256k1fx.Credential)
+		if !ok {
+			return fmt.Errorf("%w: %T", errUnsupportedCred, fxCred.Verifiable)
+		}
+		addrs := signers[i]
+		if len(cred.Sigs) != len(addrs) {
+			return fmt.Errorf("%w: credential %d has %d but requires %d", errWrongNumSigs, i, len(cred.Sigs), len(addrs))
+		}
+		for j, addr := range addrs {
+			if cred.Sigs[j] != [secp256k1.SignatureLen]byte{} {
+				// this signature was already provided
+				continue
+			}
+			key, ok := kc.Get(addr)
+			if !ok {
+				// this signature must be provided by another owner
+				reply.Signed = false
+				continue
+			}
+			sig, err := key.SignHash(hash)
+			if err != nil {
+				return fmt.Errorf("problem signing transaction: %w", err)
+			}
+			copy(cred.Sigs[j][:], sig)
+		}
+	}
+
+	if err := s.vm.parser.InitializeTx(tx); err != nil {
+		return err
+	}
+	reply.Tx, err = formatting.Encode(args.Encoding, tx.Bytes())
+	if err != nil {
+		return fmt.Errorf("couldn't encode tx as string: %w", err)
+	}
+	reply.Encoding = args.Encoding
 	return nil
 }
 
+// credentialSigners returns, for each credential of [tx], the addresses whose
+// signatures make up the credential.
+func (s *Service) credentialSigners(tx *txs.Tx) ([][]ids.ShortID, error) {
+	var (
+		ins []*dione.TransferableInput
+		ops []*txs.Operation
+	)
+	switch utx := tx.Unsigned.(type) {
+	case *txs.BaseTx:
+		ins = utx.Ins
+	case *txs.CreateAssetTx:
+		ins = utx.Ins
+	case *txs.ExportTx:
+		ins = utx.Ins
+	case *txs.OperationTx:
+		ins, ops = utx.Ins, utx.Ops
+	default:
+		return nil, fmt.Errorf("%w: %T", errUnsupportedTx, utx)
+	}
+
+	signers := make([][]ids.ShortID, 0, len(ins)+len(ops))
+	for _, in := range ins {
+		input, ok := in.In.(*secp256k1fx.TransferInput)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", errUnsupportedInput, in.In)
+		}
+		addrs, err := s.inputSigners(&in.UTXOID, input.SigIndices)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, addrs)
+	}
+	for _, op := range ops {
+		mintOp, ok := op.Op.(*secp256k1fx.MintOperation)
+		if !ok || len(op.UTXOIDs) != 1 {
+			return nil, fmt.Errorf("%w: %T", errUnsupportedInput, op.Op)
+		}
+		addrs, err := s.inputSigners(op.UTXOIDs[0], mintOp.MintInput.SigIndices)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, addrs)
+	}
+	return signers, nil
+}
+
+// inputSigners returns the addresses that must sign to consume [utxoID] with
+// [sigIndices].
+func (s *Service) inputSigners(utxoID *dione.UTXOID, sigIndices []uint32) ([]ids.ShortID, error) {
+	utxo, err := s.vm.state.GetUTXO(utxoID.InputID())
+	if err != nil {
+		return nil, fmt.Errorf("problem retrieving UTXO %s: %w", utxoID, err)
+	}
+
+	var owners *secp256k1fx.OutputOwners
+	switch out := utxo.Out.(type) {
+	case *secp256k1fx.TransferOutput:
+		owners = &out.OutputOwners
+	case *secp256k1fx.MintOutput:
+		owners = &out.OutputOwners
+	default:
+		return nil, fmt.Errorf("%w: UTXO %s has output type %T", errUnsupportedInput, utxoID, out)
+	}
+
+	addrs := make([]ids.ShortID, len(sigIndices))
+	for i, sigIndex := range sigIndices {
+		if sigIndex >= uint32(len(owners.Addrs)) {
+			return nil, fmt.Errorf("signature index %d of UTXO %s is out of bounds", sigIndex, utxoID)
+		}
+		addrs[i] = owners.Addrs[sigIndex]
+	}
+	return addrs, nil
+}
+
+// GetTxStatusArgs are the arguments for GetTxStatus
+type GetTxStatusArgs struct {
+	TxID ids.ID `json:"txID"`
+	// QueryPeers, if true and the tx is unknown to this node, asks a sample of
+	// validators for their view of the tx status.
+	QueryPeers bool `json:"queryPeers"`
+}
+
 // GetTxStatusReply defines the GetTxStatus replies returned from the API
 type GetTxStatusReply struct {
 	Status choices.Status `json:"status"`
+	// NetworkStatus is the best-effort status reported by the queried
+	// validators. Only populated if peers were queried.
+	NetworkStatus choices.Status `json:"networkStatus,omitempty"`
+	// NumResponses is the number of validators that reported a status.
+	NumResponses json.Uint32 `json:"numResponses,omitempty"`
 }
 
 type GetAddressTxsArgs struct {
@@ -281,11 +871,12 @@ func (s *Service) GetAddressTxs(_ *http.Request, args *GetAddressTxsArgs, reply
 //
 // Deprecated: GetTxStatus only returns Accepted or Unknown, GetTx should be
 // used instead to determine if the tx was accepted.
-func (s *Service) GetTxStatus(_ *http.Request, args *api.JSONTxID, reply *GetTxStatusReply) error {
+func (s *Service) GetTxStatus(r *http.Request, args *GetTxStatusArgs, reply *GetTxStatusReply) error {
 	s.vm.ctx.Log.Debug("deprecated API called",
 		zap.String("service", "alpha"),
 		zap.String("method", "getTxStatus"),
 		zap.Stringer("txID", args.TxID),
+		zap.Bool("queryPeers", args.QueryPeers),
 	)
 
 	if args.TxID == ids.Empty {
@@ -296,14 +887,84 @@ func (s *Service) GetTxStatus(_ *http.Request, args *api.JSONTxID, reply *GetTxS
 	switch err {
 	case nil:
 		reply.Status = choices.Accepted
+		return nil
 	case database.ErrNotFound:
 		reply.Status = choices.Unknown
 	default:
 		return err
 	}
+
+	if !args.QueryPeers || s.vm.network == nil {
+		return nil
+	}
+
+	ctx := context.Background()
+	if r != nil {
+		ctx = r.Context()
+	}
+	nodeIDs, err := s.sampleValidators(ctx, txStatusQuerySampleSize)
+	if err != nil {
+		return fmt.Errorf("couldn't sample validators: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, txStatusQueryTimeout)
+	defer cancel()
+
+	// Release the context lock while waiting on peers so that consensus and
+	// incoming messages aren't blocked for the duration of the query.
+	s.vm.ctx.Lock.Unlock()
+	statuses, err := s.vm.network.QueryTxStatus(ctx, nodeIDs, args.TxID)
+	s.vm.ctx.Lock.Lock()
+	if err != nil {
+		return fmt.Errorf("couldn't query tx status: %w", err)
+	}
+
+	reply.NumResponses = json.Uint32(len(statuses))
+	for _, status := range statuses {
+		switch {
+		case status == choices.Accepted:
+			reply.NetworkStatus = choices.Accepted
+		case status == choices.Processing && reply.NetworkStatus != choices.Accepted:
+			reply.NetworkStatus = choices.Processing
+		}
+	}
 	return nil
 }
 
+// sampleValidators returns up to [size] uniformly sampled validators of this
+// chain's subnet, excluding this node.
+func (s *Service) sampleValidators(ctx context.Context, size int) (set.Set[ids.NodeID], error) {
+	height, err := s.vm.ctx.ValidatorState.GetCurrentHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vdrs, err := s.vm.ctx.ValidatorState.GetValidatorSet(ctx, height, s.vm.ctx.SubnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeIDs := make([]ids.NodeID, 0, len(vdrs))
+	for nodeID := range vdrs {
+		if nodeID != s.vm.ctx.NodeID {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+	size = safemath.Min(size, len(nodeIDs))
+
+	sampler := sampler.NewUniform()
+	sampler.Initialize(uint64(len(nodeIDs)))
+	indices, err := sampler.Sample(size)
+	if err != nil {
+		return nil, err
+	}
+
+	sampled := set.NewSet[ids.NodeID](size)
+	for _, index := range indices {
+		sampled.Add(nodeIDs[index])
+	}
+	return sampled, nil
+}
+
 // GetTx returns the specified transaction
 func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, reply *api.GetTxReply) error {
 	s.vm.ctx.Log.Debug("API called",
@@ -481,11 +1142,62 @@ func (s *Service) GetAssetDescription(_ *http.Request, args *GetAssetDescription
 	return nil
 }
 
+// AddAssetAliasArgs are arguments for passing into AddAssetAlias requests
+type AddAssetAliasArgs struct {
+	AssetID string `json:"assetID"`
+	Alias   string `json:"alias"`
+}
+
+// AddAssetAlias gives an asset an alias, which is persisted across restarts.
+// The alias may then be used in place of the asset's ID and is reported by
+// GetAllBalances. Requires the admin API to be enabled.
+func (s *Service) AddAssetAlias(_ *http.Request, args *AddAssetAliasArgs, _ *api.EmptyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "addAssetAlias"),
+		logging.UserString("assetID", args.AssetID),
+		logging.UserString("alias", args.Alias),
+	)
+
+	if !s.vm.adminAPIEnabled {
+		return errAdminAPIDisabled
+	}
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return err
+	}
+	return s.vm.addAssetAlias(assetID, args.Alias)
+}
+
+// RemoveAssetAliasArgs are arguments for passing into RemoveAssetAlias
+// requests
+type RemoveAssetAliasArgs struct {
+	Alias string `json:"alias"`
+}
+
+// RemoveAssetAlias removes an alias that was added with AddAssetAlias.
+// Requires the admin API to be enabled.
+func (s *Service) RemoveAssetAlias(_ *http.Request, args *RemoveAssetAliasArgs, _ *api.EmptyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "removeAssetAlias"),
+		logging.UserString("alias", args.Alias),
+	)
+
+	if !s.vm.adminAPIEnabled {
+		return errAdminAPIDisabled
+	}
+	return s.vm.removeAssetAlias(args.Alias)
+}
+
 // GetBalanceArgs are arguments for passing into GetBalance requests
 type GetBalanceArgs struct {
-	Address        string `json:"address"`
-	AssetID        string `json:"assetID"`
-	IncludePartial bool   `json:"includePartial"`
+	Address string `json:"address"`
+	// Additional addresses whose balance is included
+	Addresses      []string `json:"addresses"`
+	AssetID        string   `json:"assetID"`
+	IncludePartial bool     `json:"includePartial"`
 }
 
 // GetBalanceReply defines the GetBalance replies returned from the API
@@ -494,22 +1206,24 @@ type GetBalanceReply struct {
 	UTXOIDs []dione.UTXOID `json:"utxoIDs"`
 }
 
-// GetBalance returns the balance of an asset held by an address.
-// If ![args.IncludePartial], returns only the balance held solely
-// (1 out of 1 multisig) by the address and with a locktime in the past.
-// Otherwise, returned balance includes assets held only partially by the
-// address, and includes balances with locktime in the future.
+// GetBalance returns the balance of an asset held by the provided addresses.
+// If ![args.IncludePartial], returns only the balance held solely by the
+// addresses (the addresses meet the UTXO's threshold without any other owner)
+// and with a locktime in the past. Otherwise, returned balance includes assets held only
+// partially by the addresses, and includes balances with locktime in the
+// future.
 func (s *Service) GetBalance(_ *http.Request, args *GetBalanceArgs, reply *GetBalanceReply) error {
 	s.vm.ctx.Log.Debug("deprecated API called",
 		zap.String("service", "alpha"),
 		zap.String("method", "getBalance"),
 		logging.UserString("address", args.Address),
+		logging.UserStrings("addresses", args.Addresses),
 		logging.UserString("assetID", args.AssetID),
 	)
 
-	addr, err := dione.ParseServiceAddress(s.vm, args.Address)
+	addrSet, err := s.parseBalanceAddresses(args.Address, args.Addresses)
 	if err != nil {
-		return fmt.Errorf("problem parsing address '%s': %w", args.Address, err)
+		return err
 	}
 
 	assetID, err := s.vm.lookupAssetID(args.AssetID)
@@ -517,9 +1231,6 @@ func (s *Service) GetBalance(_ *http.Request, args *GetBalanceArgs, reply *GetBa
 		return err
 	}
 
-	addrSet := set.Set[ids.ShortID]{}
-	addrSet.Add(addr)
-
 	utxos, err := dione.GetAllUTXOs(s.vm.state, addrSet)
 	if err != nil {
 		return fmt.Errorf("problem retrieving UTXOs: %w", err)
@@ -536,8 +1247,7 @@ func (s *Service) GetBalance(_ *http.Request, args *GetBalanceArgs, reply *GetBa
 		if !ok {
 			continue
 		}
-		owners := transferable.OutputOwners
-		if !args.IncludePartial && (len(owners.Addrs) != 1 || owners.Locktime > now) {
+		if !args.IncludePartial && !isSolelyOwned(&transferable.OutputOwners, addrSet, now) {
 			continue
 		}
 		amt, err := safemath.Add64(transferable.Amount(), uint64(reply.Balance))
@@ -551,6 +1261,45 @@ func (s *Service) GetBalance(_ *http.Request, args *GetBalanceArgs, reply *GetBa
 	return nil
 }
 
+// parseBalanceAddresses returns the set of [addr] and [addrs], ignoring
+// [addr] if it is empty.
+func (s *Service) parseBalanceAddresses(addr string, addrs []string) (set.Set[ids.ShortID], error) {
+	if addr != "" {
+		addrs = append([]string{addr}, addrs...)
+	}
+	switch {
+	case len(addrs) == 0:
+		return nil, errNoAddresses
+	case len(addrs) > maxGetUTXOsAddrs:
+		return nil, fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(addrs), maxGetUTXOsAddrs)
+	}
+
+	addrSet := set.NewSet[ids.ShortID](len(addrs))
+	for _, addrStr := range addrs {
+		addr, err := dione.ParseServiceAddress(s.vm, addrStr)
+		if err != nil {
+			return nil, fmt.Errorf("problem parsing address '%s': %w", addrStr, err)
+		}
+		addrSet.Add(addr)
+	}
+	return addrSet, nil
+}
+
+// isSolelyOwned returns true if [addrs] can spend an output owned by [owners]
+// at [time] without the signature of any other address.
+func isSolelyOwned(owners *secp256k1fx.OutputOwners, addrs set.Set[ids.ShortID], time uint64) bool {
+	if owners.Locktime > time {
+		return false
+	}
+	numMatched := uint32(0)
+	for _, addr := range owners.Addrs {
+		if addrs.Contains(addr) {
+			numMatched++
+		}
+	}
+	return numMatched >= owners.Threshold
+}
+
 type Balance struct {
 	AssetID string      `json:"asset"`
 	Balance json.Uint64 `json:"balance"`
@@ -558,7 +1307,9 @@ type Balance struct {
 
 type GetAllBalancesArgs struct {
 	api.JSONAddress
-	IncludePartial bool `json:"includePartial"`
+	// Additional addresses whose balances are included
+	Addresses      []string `json:"addresses"`
+	IncludePartial bool     `json:"includePartial"`
 }
 
 // GetAllBalancesReply is the response from a call to GetAllBalances
@@ -568,25 +1319,25 @@ type GetAllBalancesReply struct {
 
 // GetAllBalances returns a map where:
 //
-// Key: ID of an asset such that [args.Address] has a non-zero balance of the asset
-// Value: The balance of the asset held by the address
+// Key: ID of an asset such that the addresses have a non-zero balance of the asset
+// Value: The balance of the asset held by the addresses
 //
-// If ![args.IncludePartial], returns only unlocked balance/UTXOs with a 1-out-of-1 multisig.
-// Otherwise, returned balance/UTXOs includes assets held only partially by the
-// address, and includes balances with locktime in the future.
+// If ![args.IncludePartial], returns only unlocked balance/UTXOs held solely by
+// the addresses. Otherwise, returned balance/UTXOs includes assets held only
+// partially by the addresses, and includes balances with locktime in the
+// future.
 func (s *Service) GetAllBalances(_ *http.Request, args *GetAllBalancesArgs, reply *GetAllBalancesReply) error {
 	s.vm.ctx.Log.Debug("deprecated API called",
 		zap.String("service", "alpha"),
 		zap.String("method", "getAllBalances"),
 		logging.UserString("address", args.Address),
+		logging.UserStrings("addresses", args.Addresses),
 	)
 
-	address, err := dione.ParseServiceAddress(s.vm, args.Address)
+	addrSet, err := s.parseBalanceAddresses(args.Address, args.Addresses)
 	if err != nil {
-		return fmt.Errorf("problem parsing address '%s': %w", args.Address, err)
+		return err
 	}
-	addrSet := set.Set[ids.ShortID]{}
-	addrSet.Add(address)
 
 	utxos, err := dione.GetAllUTXOs(s.vm.state, addrSet)
 	if err != nil {
@@ -602,8 +1353,7 @@ func (s *Service) GetAllBalances(_ *http.Request, args *GetAllBalancesArgs, repl
 		if !ok {
 			continue
 		}
-		owners := transferable.OutputOwners
-		if !args.IncludePartial && (len(owners.Addrs) != 1 || owners.Locktime > now) {
+		if !args.IncludePartial && !isSolelyOwned(&transferable.OutputOwners, addrSet, now) {
 			continue
 		}
 		assetID := utxo.AssetID()
@@ -721,14 +1471,48 @@ func (s *Service) CreateAsset(_ *http.Request, args *CreateAssetArgs, reply *Ass
 		})
 	}
 
+	initialState, err := s.parseInitialState(args.InitialHolders, args.MinterSets)
+	if err != nil {
+		return err
+	}
+
+	tx := txs.Tx{Unsigned: &txs.CreateAssetTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    s.vm.ctx.NetworkID,
+			BlockchainID: s.vm.ctx.ChainID,
+			Outs:         outs,
+			Ins:          ins,
+		}},
+		Name:         args.Name,
+		Symbol:       args.Symbol,
+		Denomination: args.Denomination,
+		States:       []*txs.InitialState{initialState},
+	}}
+	if err := tx.SignSECP256K1Fx(s.vm.parser.Codec(), keys); err != nil {
+		return err
+	}
+
+	assetID, err := s.vm.IssueTx(tx.Bytes())
+	if err != nil {
+		return fmt.Errorf("problem issuing transaction: %w", err)
+	}
+
+	reply.AssetID = assetID
+	reply.ChangeAddr, err = s.vm.FormatLocalAddress(changeAddr)
+	return err
+}
+
+// parseInitialState returns the secp256k1fx initial state of an asset that is
+// held by [holders] and can be minted by [minterSets].
+func (s *Service) parseInitialState(holders []*Holder, minterSets []Owners) (*txs.InitialState, error) {
 	initialState := &txs.InitialState{
 		FxIndex: 0, // TODO: Should lookup secp256k1fx FxID
-		Outs:    make([]verify.State, 0, len(args.InitialHolders)+len(args.MinterSets)),
+		Outs:    make([]verify.State, 0, len(holders)+len(minterSets)),
 	}
-	for _, holder := range args.InitialHolders {
+	for _, holder := range holders {
 		addr, err := dione.ParseServiceAddress(s.vm, holder.Address)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		initialState.Outs = append(initialState.Outs, &secp256k1fx.TransferOutput{
 			Amt: uint64(holder.Amount),
@@ -738,7 +1522,7 @@ func (s *Service) CreateAsset(_ *http.Request, args *CreateAssetArgs, reply *Ass
 			},
 		})
 	}
-	for _, owner := range args.MinterSets {
+	for _, owner := range minterSets {
 		minter := &secp256k1fx.MintOutput{
 			OutputOwners: secp256k1fx.OutputOwners{
 				Threshold: uint32(owner.Threshold),
@@ -747,38 +1531,14 @@ func (s *Service) CreateAsset(_ *http.Request, args *CreateAssetArgs, reply *Ass
 		}
 		minterAddrsSet, err := dione.ParseServiceAddresses(s.vm, owner.Minters)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		minter.Addrs = minterAddrsSet.List()
 		utils.Sort(minter.Addrs)
 		initialState.Outs = append(initialState.Outs, minter)
 	}
 	initialState.Sort(s.vm.parser.Codec())
-
-	tx := txs.Tx{Unsigned: &txs.CreateAssetTx{
-		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
-			NetworkID:    s.vm.ctx.NetworkID,
-			BlockchainID: s.vm.ctx.ChainID,
-			Outs:         outs,
-			Ins:          ins,
-		}},
-		Name:         args.Name,
-		Symbol:       args.Symbol,
-		Denomination: args.Denomination,
-		States:       []*txs.InitialState{initialState},
-	}}
-	if err := tx.SignSECP256K1Fx(s.vm.parser.Codec(), keys); err != nil {
-		return err
-	}
-
-	assetID, err := s.vm.IssueTx(tx.Bytes())
-	if err != nil {
-		return fmt.Errorf("problem issuing transaction: %w", err)
-	}
-
-	reply.AssetID = assetID
-	reply.ChangeAddr, err = s.vm.FormatLocalAddress(changeAddr)
-	return err
+	return initialState, nil
 }
 
 // CreateFixedCapAsset returns ID of the newly created asset
@@ -1092,6 +1852,10 @@ type SendArgs struct {
 
 	// Memo field
 	Memo string `json:"memo"`
+
+	// If true, UTXOs that also require signatures from other owners may be
+	// spent. Ignored by wallet.send.
+	Partial bool `json:"partial"`
 }
 
 // SendMultipleArgs are arguments for passing into SendMultiple requests
@@ -1104,19 +1868,35 @@ type SendMultipleArgs struct {
 
 	// Memo field
 	Memo string `json:"memo"`
+
+	// If true, UTXOs that also require signatures from other owners may be
+	// spent. Ignored by wallet.sendMultiple.
+	Partial bool `json:"partial"`
+}
+
+// SpendReply is the response from the methods that spend the funds of a user
+type SpendReply struct {
+	api.JSONTxIDChangeAddr
+
+	// The partially signed tx. Set instead of the tx ID if the tx still
+	// requires signatures from the other owners of the spent funds, who can
+	// add them with SignTx.
+	Tx       string              `json:"tx,omitempty"`
+	Encoding formatting.Encoding `json:"encoding"`
 }
 
 // Send returns the ID of the newly created transaction
-func (s *Service) Send(r *http.Request, args *SendArgs, reply *api.JSONTxIDChangeAddr) error {
+func (s *Service) Send(r *http.Request, args *SendArgs, reply *SpendReply) error {
 	return s.SendMultiple(r, &SendMultipleArgs{
 		JSONSpendHeader: args.JSONSpendHeader,
 		Outputs:         []SendOutput{args.SendOutput},
 		Memo:            args.Memo,
+		Partial:         args.Partial,
 	}, reply)
 }
 
 // SendMultiple sends a transaction with multiple outputs.
-func (s *Service) SendMultiple(_ *http.Request, args *SendMultipleArgs, reply *api.JSONTxIDChangeAddr) error {
+func (s *Service) SendMultiple(_ *http.Request, args *SendMultipleArgs, reply *SpendReply) error {
 	s.vm.ctx.Log.Warn("deprecated API called",
 		zap.String("service", "alpha"),
 		zap.String("method", "sendMultiple"),
@@ -1153,35 +1933,134 @@ func (s *Service) SendMultiple(_ *http.Request, args *SendMultipleArgs, reply *a
 	}
 
 	// Calculate required input amounts and create the desired outputs
+	outs, amountsWithFee, err := s.parseSendOutputs(args.Outputs)
+	if err != nil {
+		return err
+	}
+
+	spend := s.vm.Spend
+	if args.Partial {
+		spend = s.vm.SpendPartial
+	}
+	amountsSpent, ins, keys, err := spend(
+		utxos,
+		kc,
+		amountsWithFee,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Add the required change outputs
+	for assetID, amountWithFee := range amountsWithFee {
+		amountSpent := amountsSpent[assetID]
+
+		if amountSpent > amountWithFee {
+			outs = append(outs, &dione.TransferableOutput{
+				Asset: dione.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: amountSpent - amountWithFee,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Locktime:  0,
+						Threshold: 1,
+						Addrs:     []ids.ShortID{changeAddr},
+					},
+				},
+			})
+		}
+	}
+	dione.SortTransferableOutputs(outs, s.vm.parser.Codec())
+
+	tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: dione.BaseTx{
+		NetworkID:    s.vm.ctx.NetworkID,
+		BlockchainID: s.vm.ctx.ChainID,
+		Outs:         outs,
+		Ins:          ins,
+		Memo:         memoBytes,
+	}}}
+	return s.issueSpend(tx, keys, changeAddr, reply)
+}
+
+// issueSpend signs [tx] with [keys] and issues it. If some of the signatures
+// must be provided by other owners of the spent funds, the partially signed
+// tx is returned in [reply] instead.
+func (s *Service) issueSpend(
+	tx *txs.Tx,
+	keys [][]*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+	reply *SpendReply,
+) error {
+	if err := tx.SignSECP256K1Fx(s.vm.parser.Codec(), keys); err != nil {
+		return err
+	}
+
+	var err error
+	reply.ChangeAddr, err = s.vm.FormatLocalAddress(changeAddr)
+	if err != nil {
+		return err
+	}
+
+	if !isFullySigned(keys) {
+		reply.Encoding = formatting.Hex
+		reply.Tx, err = formatting.Encode(reply.Encoding, tx.Bytes())
+		if err != nil {
+			return fmt.Errorf("couldn't encode tx as string: %w", err)
+		}
+		return nil
+	}
+
+	reply.TxID, err = s.vm.IssueTx(tx.Bytes())
+	if err != nil {
+		return fmt.Errorf("problem issuing transaction: %w", err)
+	}
+	return nil
+}
+
+// isFullySigned returns true if all of the [keys] are known
+func isFullySigned(keys [][]*secp256k1.PrivateKey) bool {
+	for _, credKeys := range keys {
+		for _, key := range credKeys {
+			if key == nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// parseSendOutputs returns the tx outputs that send [outputs] along with the
+// amount of each asset that must be spent to fund them and the tx fee.
+func (s *Service) parseSendOutputs(outputs []SendOutput) ([]*dione.TransferableOutput, map[ids.ID]uint64, error) {
 	// String repr. of asset ID --> asset ID
 	assetIDs := make(map[string]ids.ID)
 	// Asset ID --> amount of that asset being sent
 	amounts := make(map[ids.ID]uint64)
 	// Outputs of our tx
 	outs := []*dione.TransferableOutput{}
-	for _, output := range args.Outputs {
+	for _, output := range outputs {
 		if output.Amount == 0 {
-			return errZeroAmount
+			return nil, nil, errZeroAmount
 		}
 		assetID, ok := assetIDs[output.AssetID] // Asset ID of next output
 		if !ok {
+			var err error
 			assetID, err = s.vm.lookupAssetID(output.AssetID)
 			if err != nil {
-				return fmt.Errorf("couldn't find asset %s", output.AssetID)
+				return nil, nil, fmt.Errorf("couldn't find asset %s", output.AssetID)
 			}
 			assetIDs[output.AssetID] = assetID
 		}
 		currentAmount := amounts[assetID]
 		newAmount, err := safemath.Add64(currentAmount, uint64(output.Amount))
 		if err != nil {
-			return fmt.Errorf("problem calculating required spend amount: %w", err)
+			return nil, nil, fmt.Errorf("problem calculating required spend amount: %w", err)
 		}
 		amounts[assetID] = newAmount
 
 		// Parse the to address
 		to, err := dione.ParseServiceAddress(s.vm, output.To)
 		if err != nil {
-			return fmt.Errorf("problem parsing to address %q: %w", output.To, err)
+			return nil, nil, fmt.Errorf("problem parsing to address %q: %w", output.To, err)
 		}
 
 		// Create the Output
@@ -1198,35 +2077,198 @@ func (s *Service) SendMultiple(_ *http.Request, args *SendMultipleArgs, reply *a
 		})
 	}
 
-	amountsWithFee := make(map[ids.ID]uint64, len(amounts)+1)
-	for assetID, amount := range amounts {
-		amountsWithFee[assetID] = amount
-	}
-
 	amountWithFee, err := safemath.Add64(amounts[s.vm.feeAssetID], s.vm.TxFee)
 	if err != nil {
-		return fmt.Errorf("problem calculating required spend amount: %w", err)
+		return nil, nil, fmt.Errorf("problem calculating required spend amount: %w", err)
 	}
-	amountsWithFee[s.vm.feeAssetID] = amountWithFee
+	amounts[s.vm.feeAssetID] = amountWithFee
+	return outs, amounts, nil
+}
 
-	amountsSpent, ins, keys, err := s.vm.Spend(
-		utxos,
-		kc,
-		amountsWithFee,
+// ConsolidateUTXOsArgs are arguments for passing into ConsolidateUTXOs
+// requests
+type ConsolidateUTXOsArgs struct {
+	api.JSONSpendHeader        // User, password, from addrs, change addr
+	AssetID             string `json:"assetID"`
+	// Number of UTXOs of the asset to consolidate down to. Defaults to 1.
+	TargetCount json.Uint32 `json:"targetCount"`
+}
+
+// ConsolidateUTXOsReply is the response from ConsolidateUTXOs
+type ConsolidateUTXOsReply struct {
+	// IDs of the txs that consolidate the UTXOs
+	TxIDs []ids.ID `json:"txIDs"`
+	// Number of UTXOs of the asset the user will hold once the txs are
+	// accepted
+	NumUTXOs json.Uint64 `json:"numUTXOs"`
+	api.JSONChangeAddr
+}
+
+// ConsolidateUTXOs sweeps the UTXOs of an asset held by the user into as few
+// UTXOs, owned by the change address, as needed to reach [args.TargetCount].
+// UTXOs are merged by one or more txs, each of which is limited to the
+// maximum tx size. Since the txs don't spend each other's outputs, the target
+// may not be reached if it would require a tx that is too large, in which case
+// ConsolidateUTXOs can be called again once the txs are accepted.
+func (s *Service) ConsolidateUTXOs(_ *http.Request, args *ConsolidateUTXOsArgs, reply *ConsolidateUTXOsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "consolidateUTXOs"),
+		logging.UserString("username", args.Username),
+		logging.UserString("assetID", args.AssetID),
+		zap.Uint32("targetCount", uint32(args.TargetCount)),
 	)
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
 	if err != nil {
 		return err
 	}
+	targetCount := uint64(args.TargetCount)
+	if targetCount == 0 {
+		targetCount = 1
+	}
 
-	// Add the required change outputs
-	for assetID, amountWithFee := range amountsWithFee {
-		amountSpent := amountsSpent[assetID]
+	// Parse the from addresses
+	fromAddrs, err := dione.ParseServiceAddresses(s.vm, args.From)
+	if err != nil {
+		return err
+	}
 
-		if amountSpent > amountWithFee {
+	// Get the UTXOs/keys for the from addresses
+	utxos, kc, err := s.vm.LoadUser(args.Username, args.Password, fromAddrs)
+	if err != nil {
+		return err
+	}
+
+	// Parse the change address.
+	if len(kc.Keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr, err := s.vm.selectChangeAddr(kc.Keys[0].PublicKey().Address(), args.ChangeAddr)
+	if err != nil {
+		return err
+	}
+
+	// Only the UTXOs that the user can spend alone are consolidated
+	now := s.vm.clock.Unix()
+	assetUTXOs := []*dione.UTXO{}
+	feeUTXOs := []*dione.UTXO{}
+	for _, utxo := range utxos {
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			continue
+		}
+		if _, _, ok := kc.Match(&out.OutputOwners, now); !ok {
+			continue
+		}
+		switch utxo.AssetID() {
+		case assetID:
+			assetUTXOs = append(assetUTXOs, utxo)
+		case s.vm.feeAssetID:
+			feeUTXOs = append(feeUTXOs, utxo)
+		}
+	}
+
+	numUTXOs := uint64(len(assetUTXOs))
+	maxInputs := len(assetUTXOs)
+	for numUTXOs > targetCount && len(assetUTXOs) > 1 {
+		// Each tx turns [numInputs] UTXOs into 1
+		numInputs := len(assetUTXOs)
+		if numInputs > maxInputs {
+			numInputs = maxInputs
+		}
+		if excess := numUTXOs - targetCount + 1; uint64(numInputs) > excess {
+			numInputs = int(excess)
+		}
+
+		tx, err := s.buildConsolidationTx(assetUTXOs[:numInputs], feeUTXOs, kc, assetID, changeAddr)
+		if err != nil {
+			return consolidationError(reply.TxIDs, err)
+		}
+		if len(tx.Bytes()) > mempool.MaxTxSize {
+			maxInputs = numInputs / 2
+			if maxInputs < 2 {
+				return consolidationError(reply.TxIDs, errTxTooLarge)
+			}
+			continue
+		}
+
+		txID, err := s.vm.IssueTx(tx.Bytes())
+		if err != nil {
+			return consolidationError(reply.TxIDs, fmt.Errorf("problem issuing transaction: %w", err))
+		}
+		reply.TxIDs = append(reply.TxIDs, txID)
+
+		// Remove the UTXOs consumed by the tx
+		consumed := tx.Unsigned.InputIDs()
+		assetUTXOs = assetUTXOs[numInputs:]
+		unspentFeeUTXOs := feeUTXOs[:0]
+		for _, utxo := range feeUTXOs {
+			if !consumed.Contains(utxo.InputID()) {
+				unspentFeeUTXOs = append(unspentFeeUTXOs, utxo)
+			}
+		}
+		feeUTXOs = unspentFeeUTXOs
+		numUTXOs -= uint64(numInputs - 1)
+	}
+
+	reply.NumUTXOs = json.Uint64(numUTXOs)
+	reply.ChangeAddr, err = s.vm.FormatLocalAddress(changeAddr)
+	return err
+}
+
+// consolidationError returns [err] along with the IDs of the consolidation txs
+// that were issued before it occurred, as the reply isn't sent back to the
+// caller once an error is returned.
+func consolidationError(txIDs []ids.ID, err error) error {
+	if len(txIDs) == 0 {
+		return err
+	}
+	return fmt.Errorf("issued txs %s before failing: %w", txIDs, err)
+}
+
+// buildConsolidationTx returns a signed tx that sends the funds of [utxos],
+// which must all be of [assetID], to [changeAddr] in a single output. If
+// [assetID] isn't the fee asset, the fee is paid with [feeUTXOs].
+func (s *Service) buildConsolidationTx(
+	utxos []*dione.UTXO,
+	feeUTXOs []*dione.UTXO,
+	kc *secp256k1fx.Keychain,
+	assetID ids.ID,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	amountsSpent, ins, keys, err := s.vm.SpendAll(utxos, kc)
+	if err != nil {
+		return nil, err
+	}
+
+	outs := []*dione.TransferableOutput{}
+	amount := amountsSpent[assetID]
+	if assetID == s.vm.feeAssetID {
+		if amount <= s.vm.TxFee {
+			return nil, fmt.Errorf("%w: consolidated %d but the fee is %d", errBelowFee, amount, s.vm.TxFee)
+		}
+		amount -= s.vm.TxFee
+	} else {
+		feeSpent, feeIns, feeKeys, err := s.vm.Spend(
+			feeUTXOs,
+			kc,
+			map[ids.ID]uint64{
+				s.vm.feeAssetID: s.vm.TxFee,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		ins = append(ins, feeIns...)
+		keys = append(keys, feeKeys...)
+		dione.SortTransferableInputsWithSigners(ins, keys)
+
+		if amountSpent := feeSpent[s.vm.feeAssetID]; amountSpent > s.vm.TxFee {
 			outs = append(outs, &dione.TransferableOutput{
-				Asset: dione.Asset{ID: assetID},
+				Asset: dione.Asset{ID: s.vm.feeAssetID},
 				Out: &secp256k1fx.TransferOutput{
-					Amt: amountSpent - amountWithFee,
+					Amt: amountSpent - s.vm.TxFee,
 					OutputOwners: secp256k1fx.OutputOwners{
 						Locktime:  0,
 						Threshold: 1,
@@ -1236,27 +2278,26 @@ func (s *Service) SendMultiple(_ *http.Request, args *SendMultipleArgs, reply *a
 			})
 		}
 	}
+	outs = append(outs, &dione.TransferableOutput{
+		Asset: dione.Asset{ID: assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: amount,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Locktime:  0,
+				Threshold: 1,
+				Addrs:     []ids.ShortID{changeAddr},
+			},
+		},
+	})
 	dione.SortTransferableOutputs(outs, s.vm.parser.Codec())
 
-	tx := txs.Tx{Unsigned: &txs.BaseTx{BaseTx: dione.BaseTx{
+	tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: dione.BaseTx{
 		NetworkID:    s.vm.ctx.NetworkID,
 		BlockchainID: s.vm.ctx.ChainID,
 		Outs:         outs,
 		Ins:          ins,
-		Memo:         memoBytes,
 	}}}
-	if err := tx.SignSECP256K1Fx(s.vm.parser.Codec(), keys); err != nil {
-		return err
-	}
-
-	txID, err := s.vm.IssueTx(tx.Bytes())
-	if err != nil {
-		return fmt.Errorf("problem issuing transaction: %w", err)
-	}
-
-	reply.TxID = txID
-	reply.ChangeAddr, err = s.vm.FormatLocalAddress(changeAddr)
-	return err
+	return tx, tx.SignSECP256K1Fx(s.vm.parser.Codec(), keys)
 }
 
 // MintArgs are arguments for passing into Mint requests
@@ -1265,10 +2306,14 @@ type MintArgs struct {
 	Amount              json.Uint64 `json:"amount"`
 	AssetID             string      `json:"assetID"`
 	To                  string      `json:"to"`
+
+	// If true, UTXOs that also require signatures from other owners may be
+	// spent, including the mint output of the asset.
+	Partial bool `json:"partial"`
 }
 
 // Mint issues a transaction that mints more of the asset
-func (s *Service) Mint(_ *http.Request, args *MintArgs, reply *api.JSONTxIDChangeAddr) error {
+func (s *Service) Mint(_ *http.Request, args *MintArgs, reply *SpendReply) error {
 	s.vm.ctx.Log.Warn("deprecated API called",
 		zap.String("service", "alpha"),
 		zap.String("method", "mint"),
@@ -1310,7 +2355,12 @@ func (s *Service) Mint(_ *http.Request, args *MintArgs, reply *api.JSONTxIDChang
 		return err
 	}
 
-	amountsSpent, ins, keys, err := s.vm.Spend(
+	spend, mint := s.vm.Spend, s.vm.Mint
+	if args.Partial {
+		spend, mint = s.vm.SpendPartial, s.vm.MintPartial
+	}
+
+	amountsSpent, ins, keys, err := spend(
 		feeUTXOs,
 		feeKc,
 		map[ids.ID]uint64{
@@ -1342,7 +2392,7 @@ func (s *Service) Mint(_ *http.Request, args *MintArgs, reply *api.JSONTxIDChang
 		return err
 	}
 
-	ops, opKeys, err := s.vm.Mint(
+	ops, opKeys, err := mint(
 		utxos,
 		kc,
 		map[ids.ID]uint64{
@@ -1353,29 +2403,18 @@ func (s *Service) Mint(_ *http.Request, args *MintArgs, reply *api.JSONTxIDChang
 	if err != nil {
 		return err
 	}
-	keys = append(keys, opKeys...)
-
-	tx := txs.Tx{Unsigned: &txs.OperationTx{
-		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
-			NetworkID:    s.vm.ctx.NetworkID,
-			BlockchainID: s.vm.ctx.ChainID,
-			Outs:         outs,
-			Ins:          ins,
-		}},
-		Ops: ops,
-	}}
-	if err := tx.SignSECP256K1Fx(s.vm.parser.Codec(), keys); err != nil {
-		return err
-	}
-
-	txID, err := s.vm.IssueTx(tx.Bytes())
-	if err != nil {
-		return fmt.Errorf("problem issuing transaction: %w", err)
-	}
-
-	reply.TxID = txID
-	reply.ChangeAddr, err = s.vm.FormatLocalAddress(changeAddr)
-	return err
+	keys = append(keys, opKeys...)
+
+	tx := &txs.Tx{Unsigned: &txs.OperationTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    s.vm.ctx.NetworkID,
+			BlockchainID: s.vm.ctx.ChainID,
+			Outs:         outs,
+			Ins:          ins,
+		}},
+		Ops: ops,
+	}}
+	return s.issueSpend(tx, keys, changeAddr, reply)
 }
 
 // SendNFTArgs are arguments for passing into SendNFT requests
@@ -1497,6 +2536,15 @@ type MintNFTArgs struct {
 	Payload             string              `json:"payload"`
 	To                  string              `json:"to"`
 	Encoding            formatting.Encoding `json:"encoding"`
+
+	// NFTs to mint in the same tx. Can't be combined with Payload and To.
+	Payloads []NFTPayload `json:"payloads"`
+}
+
+// NFTPayload is the payload of an NFT to mint and its recipient
+type NFTPayload struct {
+	Payload string `json:"payload"`
+	To      string `json:"to"`
 }
 
 // MintNFT issues a MintNFT transaction and returns the ID of the newly created transaction
@@ -1512,14 +2560,29 @@ func (s *Service) MintNFT(_ *http.Request, args *MintNFTArgs, reply *api.JSONTxI
 		return err
 	}
 
-	to, err := dione.ParseServiceAddress(s.vm, args.To)
-	if err != nil {
-		return fmt.Errorf("problem parsing to address %q: %w", args.To, err)
+	nftPayloads := args.Payloads
+	switch {
+	case len(nftPayloads) == 0:
+		nftPayloads = []NFTPayload{{
+			Payload: args.Payload,
+			To:      args.To,
+		}}
+	case args.Payload != "" || args.To != "":
+		return errPayloadAndPayloads
 	}
 
-	payloadBytes, err := formatting.Decode(args.Encoding, args.Payload)
-	if err != nil {
-		return fmt.Errorf("problem decoding payload bytes: %w", err)
+	payloads := make([][]byte, len(nftPayloads))
+	to := make([]ids.ShortID, len(nftPayloads))
+	for i, nftPayload := range nftPayloads {
+		to[i], err = dione.ParseServiceAddress(s.vm, nftPayload.To)
+		if err != nil {
+			return fmt.Errorf("problem parsing to address %q: %w", nftPayload.To, err)
+		}
+
+		payloads[i], err = formatting.Decode(args.Encoding, nftPayload.Payload)
+		if err != nil {
+			return fmt.Errorf("problem decoding payload bytes: %w", err)
+		}
 	}
 
 	// Parse the from addresses
@@ -1579,7 +2642,7 @@ func (s *Service) MintNFT(_ *http.Request, args *MintNFTArgs, reply *api.JSONTxI
 		utxos,
 		kc,
 		assetID,
-		payloadBytes,
+		payloads,
 		to,
 	)
 	if err != nil {
@@ -1612,6 +2675,264 @@ func (s *Service) MintNFT(_ *http.Request, args *MintNFTArgs, reply *api.JSONTxI
 	return err
 }
 
+// CreatePropertyAssetArgs are arguments for passing into CreatePropertyAsset
+// requests
+type CreatePropertyAssetArgs struct {
+	api.JSONSpendHeader          // User, password, from addrs, change addr
+	Name                string   `json:"name"`
+	Symbol              string   `json:"symbol"`
+	MinterSets          []Owners `json:"minterSets"`
+}
+
+// CreatePropertyAsset returns ID of the newly created property asset. Each
+// minter set may mint the property any number of times.
+func (s *Service) CreatePropertyAsset(_ *http.Request, args *CreatePropertyAssetArgs, reply *AssetIDChangeAddr) error {
+	s.vm.ctx.Log.Warn("deprecated API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "createPropertyAsset"),
+		logging.UserString("name", args.Name),
+		logging.UserString("symbol", args.Symbol),
+		zap.Int("numMinters", len(args.MinterSets)),
+	)
+
+	if len(args.MinterSets) == 0 {
+		return errNoMinters
+	}
+
+	fxIndex, err := s.vm.getFxIndex(propertyfx.ID)
+	if err != nil {
+		return err
+	}
+
+	initialState := &txs.InitialState{
+		FxIndex: fxIndex,
+		Outs:    make([]verify.State, 0, len(args.MinterSets)),
+	}
+	for _, owner := range args.MinterSets {
+		minter := &propertyfx.MintOutput{
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: uint32(owner.Threshold),
+			},
+		}
+		minterAddrsSet, err := dione.ParseServiceAddresses(s.vm, owner.Minters)
+		if err != nil {
+			return err
+		}
+		minter.Addrs = minterAddrsSet.List()
+		utils.Sort(minter.Addrs)
+		initialState.Outs = append(initialState.Outs, minter)
+	}
+	initialState.Sort(s.vm.parser.Codec())
+
+	ins, outs, keys, changeAddr, err := s.spendFee(&args.JSONSpendHeader, s.vm.CreateAssetTxFee)
+	if err != nil {
+		return err
+	}
+
+	tx := txs.Tx{Unsigned: &txs.CreateAssetTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    s.vm.ctx.NetworkID,
+			BlockchainID: s.vm.ctx.ChainID,
+			Outs:         outs,
+			Ins:          ins,
+		}},
+		Name:         args.Name,
+		Symbol:       args.Symbol,
+		Denomination: 0, // Properties are non-fungible
+		States:       []*txs.InitialState{initialState},
+	}}
+	if err := tx.SignSECP256K1Fx(s.vm.parser.Codec(), keys); err != nil {
+		return err
+	}
+
+	assetID, err := s.vm.IssueTx(tx.Bytes())
+	if err != nil {
+		return fmt.Errorf("problem issuing transaction: %w", err)
+	}
+
+	reply.AssetID = assetID
+	reply.ChangeAddr, err = s.vm.FormatLocalAddress(changeAddr)
+	return err
+}
+
+// MintPropertyArgs are arguments for passing into MintProperty requests
+type MintPropertyArgs struct {
+	api.JSONSpendHeader        // User, password, from addrs, change addr
+	AssetID             string `json:"assetID"`
+	To                  string `json:"to"`
+}
+
+// MintProperty issues a transaction that mints a property to [args.To] and
+// returns the ID of the newly created transaction
+func (s *Service) MintProperty(_ *http.Request, args *MintPropertyArgs, reply *api.JSONTxIDChangeAddr) error {
+	s.vm.ctx.Log.Warn("deprecated API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "mintProperty"),
+		logging.UserString("username", args.Username),
+	)
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return err
+	}
+
+	to, err := dione.ParseServiceAddress(s.vm, args.To)
+	if err != nil {
+		return fmt.Errorf("problem parsing to address %q: %w", args.To, err)
+	}
+
+	ins, outs, secpKeys, changeAddr, err := s.spendFee(&args.JSONSpendHeader, s.vm.TxFee)
+	if err != nil {
+		return err
+	}
+
+	// Get all UTXOs/keys
+	utxos, kc, err := s.vm.LoadUser(args.Username, args.Password, nil)
+	if err != nil {
+		return err
+	}
+
+	ops, propertyKeys, err := s.vm.MintProperty(utxos, kc, assetID, to)
+	if err != nil {
+		return err
+	}
+
+	return s.issuePropertyOps(ins, outs, ops, secpKeys, propertyKeys, changeAddr, reply)
+}
+
+// BurnPropertyArgs are arguments for passing into BurnProperty requests
+type BurnPropertyArgs struct {
+	api.JSONSpendHeader        // User, password, from addrs, change addr
+	AssetID             string `json:"assetID"`
+}
+
+// BurnProperty issues a transaction that burns all of the user's units of a
+// property and returns the ID of the newly created transaction
+func (s *Service) BurnProperty(_ *http.Request, args *BurnPropertyArgs, reply *api.JSONTxIDChangeAddr) error {
+	s.vm.ctx.Log.Warn("deprecated API called",
+		zap.String("service", "alpha"),
+		zap.String("method", "burnProperty"),
+		logging.UserString("username", args.Username),
+	)
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return err
+	}
+
+	ins, outs, secpKeys, changeAddr, err := s.spendFee(&args.JSONSpendHeader, s.vm.TxFee)
+	if err != nil {
+		return err
+	}
+
+	// Get all UTXOs/keys
+	utxos, kc, err := s.vm.LoadUser(args.Username, args.Password, nil)
+	if err != nil {
+		return err
+	}
+
+	ops, propertyKeys, err := s.vm.SpendProperty(utxos, kc, assetID)
+	if err != nil {
+		return err
+	}
+
+	return s.issuePropertyOps(ins, outs, ops, secpKeys, propertyKeys, changeAddr, reply)
+}
+
+// spendFee returns the inputs, change outputs and signers that pay [fee] from
+// the addresses in [header], along with the change address.
+func (s *Service) spendFee(header *api.JSONSpendHeader, fee uint64) (
+	[]*dione.TransferableInput,
+	[]*dione.TransferableOutput,
+	[][]*secp256k1.PrivateKey,
+	ids.ShortID,
+	error,
+) {
+	// Parse the from addresses
+	fromAddrs, err := dione.ParseServiceAddresses(s.vm, header.From)
+	if err != nil {
+		return nil, nil, nil, ids.ShortEmpty, err
+	}
+
+	// Get the UTXOs/keys for the from addresses
+	utxos, kc, err := s.vm.LoadUser(header.Username, header.Password, fromAddrs)
+	if err != nil {
+		return nil, nil, nil, ids.ShortEmpty, err
+	}
+
+	// Parse the change address.
+	if len(kc.Keys) == 0 {
+		return nil, nil, nil, ids.ShortEmpty, errNoKeys
+	}
+	changeAddr, err := s.vm.selectChangeAddr(kc.Keys[0].PublicKey().Address(), header.ChangeAddr)
+	if err != nil {
+		return nil, nil, nil, ids.ShortEmpty, err
+	}
+
+	amountsSpent, ins, keys, err := s.vm.Spend(
+		utxos,
+		kc,
+		map[ids.ID]uint64{
+			s.vm.feeAssetID: fee,
+		},
+	)
+	if err != nil {
+		return nil, nil, nil, ids.ShortEmpty, err
+	}
+
+	outs := []*dione.TransferableOutput{}
+	if amountSpent := amountsSpent[s.vm.feeAssetID]; amountSpent > fee {
+		outs = append(outs, &dione.TransferableOutput{
+			Asset: dione.Asset{ID: s.vm.feeAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: amountSpent - fee,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Locktime:  0,
+					Threshold: 1,
+					Addrs:     []ids.ShortID{changeAddr},
+				},
+			},
+		})
+	}
+	return ins, outs, keys, changeAddr, nil
+}
+
+// issuePropertyOps issues an operation tx performing the propertyfx [ops]
+func (s *Service) issuePropertyOps(
+	ins []*dione.TransferableInput,
+	outs []*dione.TransferableOutput,
+	ops []*txs.Operation,
+	secpKeys [][]*secp256k1.PrivateKey,
+	propertyKeys [][]*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+	reply *api.JSONTxIDChangeAddr,
+) error {
+	tx := txs.Tx{Unsigned: &txs.OperationTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    s.vm.ctx.NetworkID,
+			BlockchainID: s.vm.ctx.ChainID,
+			Outs:         outs,
+			Ins:          ins,
+		}},
+		Ops: ops,
+	}}
+	if err := tx.SignSECP256K1Fx(s.vm.parser.Codec(), secpKeys); err != nil {
+		return err
+	}
+	if err := tx.SignPropertyFx(s.vm.parser.Codec(), propertyKeys); err != nil {
+		return err
+	}
+
+	txID, err := s.vm.IssueTx(tx.Bytes())
+	if err != nil {
+		return fmt.Errorf("problem issuing transaction: %w", err)
+	}
+
+	reply.TxID = txID
+	reply.ChangeAddr, err = s.vm.FormatLocalAddress(changeAddr)
+	return err
+}
+
 // ImportArgs are arguments for passing into Import requests
 type ImportArgs struct {
 	// User that controls To
@@ -1624,8 +2945,10 @@ type ImportArgs struct {
 	To string `json:"to"`
 }
 
-// Import imports an asset to this chain from the O/D-Chain.
-// The DIONE must have already been exported from the O/D-Chain.
+// Import imports assets to this chain from [args.SourceChain], which may be
+// any chain that shares memory with this chain, such as the O-Chain, the
+// D-Chain or another chain of this subnet. The assets must have already been
+// exported from the source chain.
 // Returns the ID of the newly created atomic transaction
 func (s *Service) Import(_ *http.Request, args *ImportArgs, reply *api.JSONTxID) error {
 	s.vm.ctx.Log.Warn("deprecated API called",
@@ -1638,6 +2961,9 @@ func (s *Service) Import(_ *http.Request, args *ImportArgs, reply *api.JSONTxID)
 	if err != nil {
 		return fmt.Errorf("problem parsing chainID %q: %w", args.SourceChain, err)
 	}
+	if err := s.verifyPeerChain(chainID); err != nil {
+		return err
+	}
 
 	to, err := dione.ParseServiceAddress(s.vm, args.To)
 	if err != nil {
@@ -1745,10 +3071,15 @@ type ExportArgs struct {
 	To string `json:"to"`
 
 	AssetID string `json:"assetID"`
+
+	// Memo field
+	Memo string `json:"memo"`
 }
 
-// Export sends an asset from this chain to the O/D-Chain.
-// After this tx is accepted, the DIONE must be imported to the O/D-chain with an importTx.
+// Export sends an asset from this chain to any chain that shares memory with
+// this chain, such as the O-Chain, the D-Chain or another chain of this subnet.
+// After this tx is accepted, the asset must be imported to the target chain
+// with an importTx.
 // Returns the ID of the newly created atomic transaction
 func (s *Service) Export(_ *http.Request, args *ExportArgs, reply *api.JSONTxIDChangeAddr) error {
 	s.vm.ctx.Log.Warn("deprecated API called",
@@ -1757,6 +3088,12 @@ func (s *Service) Export(_ *http.Request, args *ExportArgs, reply *api.JSONTxIDC
 		logging.UserString("username", args.Username),
 	)
 
+	// Validate the memo field
+	memoBytes := []byte(args.Memo)
+	if l := len(memoBytes); l > dione.MaxMemoSize {
+		return fmt.Errorf("max memo length is %d but provided memo field is length %d", dione.MaxMemoSize, l)
+	}
+
 	// Parse the asset ID
 	assetID, err := s.vm.lookupAssetID(args.AssetID)
 	if err != nil {
@@ -1764,16 +3101,9 @@ func (s *Service) Export(_ *http.Request, args *ExportArgs, reply *api.JSONTxIDC
 	}
 
 	// Get the chainID and parse the to address
-	chainID, to, err := s.vm.ParseAddress(args.To)
+	chainID, to, err := s.parseExportTo(args.To, args.TargetChain)
 	if err != nil {
-		chainID, err = s.vm.ctx.BCLookup.Lookup(args.TargetChain)
-		if err != nil {
-			return err
-		}
-		to, err = ids.ShortFromString(args.To)
-		if err != nil {
-			return err
-		}
+		return err
 	}
 
 	if args.Amount == 0 {
@@ -1801,16 +3131,9 @@ func (s *Service) Export(_ *http.Request, args *ExportArgs, reply *api.JSONTxIDC
 		return err
 	}
 
-	amounts := map[ids.ID]uint64{}
-	if assetID == s.vm.feeAssetID {
-		amountWithFee, err := safemath.Add64(uint64(args.Amount), s.vm.TxFee)
-		if err != nil {
-			return fmt.Errorf("problem calculating required spend amount: %w", err)
-		}
-		amounts[s.vm.feeAssetID] = amountWithFee
-	} else {
-		amounts[s.vm.feeAssetID] = s.vm.TxFee
-		amounts[assetID] = uint64(args.Amount)
+	amounts, err := s.exportAmounts(assetID, uint64(args.Amount))
+	if err != nil {
+		return err
 	}
 
 	amountsSpent, ins, keys, err := s.vm.Spend(utxos, kc, amounts)
@@ -1855,6 +3178,7 @@ func (s *Service) Export(_ *http.Request, args *ExportArgs, reply *api.JSONTxIDC
 			BlockchainID: s.vm.ctx.ChainID,
 			Outs:         outs,
 			Ins:          ins,
+			Memo:         memoBytes,
 		}},
 		DestinationChain: chainID,
 		ExportedOuts:     exportOuts,
@@ -1872,3 +3196,48 @@ func (s *Service) Export(_ *http.Request, args *ExportArgs, reply *api.JSONTxIDC
 	reply.ChangeAddr, err = s.vm.FormatLocalAddress(changeAddr)
 	return err
 }
+
+// parseExportTo returns the destination chain and recipient of an export to
+// [to]. [targetChain] is only used if [to] doesn't include the chainID.
+func (s *Service) parseExportTo(to string, targetChain string) (ids.ID, ids.ShortID, error) {
+	chainID, toAddr, err := s.vm.ParseAddress(to)
+	if err != nil {
+		chainID, err = s.vm.ctx.BCLookup.Lookup(targetChain)
+		if err != nil {
+			return ids.Empty, ids.ShortEmpty, err
+		}
+		toAddr, err = ids.ShortFromString(to)
+		if err != nil {
+			return ids.Empty, ids.ShortEmpty, err
+		}
+	}
+	return chainID, toAddr, s.verifyPeerChain(chainID)
+}
+
+// verifyPeerChain returns an error if this chain can't exchange assets with
+// [chainID] through shared memory. This is checked again when the tx is
+// verified, but failing here gives a clearer error.
+func (s *Service) verifyPeerChain(chainID ids.ID) error {
+	if err := verify.SameSubnet(context.TODO(), s.vm.ctx, chainID); err != nil {
+		return fmt.Errorf("can't exchange assets with chain %s: %w", chainID, err)
+	}
+	return nil
+}
+
+// exportAmounts returns the amount of each asset that must be spent to export
+// [amount] of [assetID] and pay the tx fee.
+func (s *Service) exportAmounts(assetID ids.ID, amount uint64) (map[ids.ID]uint64, error) {
+	if assetID != s.vm.feeAssetID {
+		return map[ids.ID]uint64{
+			s.vm.feeAssetID: s.vm.TxFee,
+			assetID:         amount,
+		}, nil
+	}
+	amountWithFee, err := safemath.Add64(amount, s.vm.TxFee)
+	if err != nil {
+		return nil, fmt.Errorf("problem calculating required spend amount: %w", err)
+	}
+	return map[ids.ID]uint64{
+		s.vm.feeAssetID: amountWithFee,
+	}, nil
+}
@@ -27,17 +27,21 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/choices"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/utils/formatting"
 	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
 	"github.com/DioneProtocol/odysseygo/utils/json"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/block"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/block/executor"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/config"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/states"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/mempool"
+	"github.com/DioneProtocol/odysseygo/vms/components/acceptance"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/index"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
@@ -69,6 +73,52 @@ func TestServiceIssueTx(t *testing.T) {
 	require.Equal(tx.ID(), txReply.TxID)
 }
 
+func TestServiceIssueTxs(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	tx := newTx(t, env.genesisBytes, env.vm, "DIONE")
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+
+	// Signed by a key that doesn't own the consumed UTXO
+	invalidTx := &txs.Tx{Unsigned: tx.Unsigned}
+	require.NoError(invalidTx.SignSECP256K1Fx(env.vm.parser.Codec(), [][]*secp256k1.PrivateKey{{keys[1]}}))
+	invalidTxStr, err := formatting.Encode(formatting.Hex, invalidTx.Bytes())
+	require.NoError(err)
+
+	txsArgs := &api.FormattedTxs{
+		Txs: []string{
+			"",
+			txStr,
+			invalidTxStr,
+		},
+		Encoding: formatting.Hex,
+	}
+	txsReply := &api.IssueTxsReply{}
+	require.NoError(env.service.IssueTxs(nil, txsArgs, txsReply))
+	require.Len(txsReply.Results, 3)
+
+	require.Equal(ids.Empty, txsReply.Results[0].TxID)
+	require.NotEmpty(txsReply.Results[0].Error)
+
+	require.Equal(tx.ID(), txsReply.Results[1].TxID)
+	require.Empty(txsReply.Results[1].Error)
+
+	// The ID of a tx that failed to be issued is reported if it was parsed
+	require.Equal(invalidTx.ID(), txsReply.Results[2].TxID)
+	require.NotEmpty(txsReply.Results[2].Error)
+
+	txsArgs.Txs = make([]string, maxIssueTxs+1)
+	err = env.service.IssueTxs(nil, txsArgs, txsReply)
+	require.ErrorIs(err, errTooManyTxs)
+}
+
 func TestServiceGetTxStatus(t *testing.T) {
 	require := require.New(t)
 
@@ -78,7 +128,7 @@ func TestServiceGetTxStatus(t *testing.T) {
 		env.vm.ctx.Lock.Unlock()
 	}()
 
-	statusArgs := &api.JSONTxID{}
+	statusArgs := &GetTxStatusArgs{}
 	statusReply := &GetTxStatusReply{}
 	err := env.service.GetTxStatus(nil, statusArgs, statusReply)
 	require.ErrorIs(err, errNilTxID)
@@ -86,7 +136,7 @@ func TestServiceGetTxStatus(t *testing.T) {
 	newTx := newDioneBaseTxWithOutputs(t, env.genesisBytes, env.vm)
 	txID := newTx.ID()
 
-	statusArgs = &api.JSONTxID{
+	statusArgs = &GetTxStatusArgs{
 		TxID: txID,
 	}
 	statusReply = &GetTxStatusReply{}
@@ -100,7 +150,356 @@ func TestServiceGetTxStatus(t *testing.T) {
 	require.Equal(choices.Accepted, statusReply.Status)
 }
 
+func TestServiceGetTxFee(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	reply := &GetTxFeeReply{}
+	require.NoError(env.service.GetTxFee(nil, nil, reply))
+	require.Equal(json.Uint64(env.vm.TxFee), reply.TxFee)
+	require.Equal(json.Uint64(env.vm.CreateAssetTxFee), reply.CreateAssetTxFee)
+	require.Equal(env.vm.feeAssetID, reply.FeeAssetID)
+}
+
+func TestServiceEstimateTip(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	mempool := mempool.NewMockMempool(ctrl)
+	service := &Service{
+		vm: &VM{
+			mempool: mempool,
+			ctx: &snow.Context{
+				Log: logging.NoLog{},
+			},
+		},
+	}
+
+	mempool.EXPECT().Tips().Return(nil)
+	reply := &EstimateTipReply{}
+	require.NoError(service.EstimateTip(nil, nil, reply))
+	require.Equal(&EstimateTipReply{}, reply)
+
+	mempool.EXPECT().Tips().Return([]uint64{900, 700, 500, 300, 0})
+	reply = &EstimateTipReply{}
+	require.NoError(service.EstimateTip(nil, nil, reply))
+	require.Equal(&EstimateTipReply{
+		NumTxs:    5,
+		MinTip:    0,
+		P25Tip:    300,
+		MedianTip: 500,
+		P75Tip:    700,
+		MaxTip:    900,
+	}, reply)
+}
+
+func TestServiceEstimateFee(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	fromAddrsStr := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addrStr, err := env.vm.FormatLocalAddress(addr)
+		require.NoError(err)
+		fromAddrsStr[i] = addrStr
+	}
+	assetID := env.genesisTx.ID().String()
+
+	args := &EstimateFeeArgs{
+		JSONFromAddrs: api.JSONFromAddrs{From: fromAddrsStr},
+	}
+	reply := &EstimateFeeReply{}
+	err := env.service.EstimateFee(nil, args, reply)
+	require.ErrorIs(err, errNoOutputs)
+
+	// A single UTXO exactly funds the send, so there is no change
+	args.Outputs = []EstimateFeeOutput{{
+		Amount:  json.Uint64(startBalance - env.vm.TxFee),
+		AssetID: assetID,
+	}}
+	require.NoError(env.service.EstimateFee(nil, args, reply))
+	require.Equal(&EstimateFeeReply{
+		Fee:        json.Uint64(env.vm.TxFee),
+		FeeAssetID: env.vm.feeAssetID,
+		NumInputs:  1,
+		NumOutputs: 1,
+	}, reply)
+
+	// Two UTXOs fund the send of two outputs, with change left over
+	args.Outputs = append(args.Outputs, EstimateFeeOutput{
+		Amount:  1,
+		AssetID: assetID,
+	})
+	reply = &EstimateFeeReply{}
+	require.NoError(env.service.EstimateFee(nil, args, reply))
+	require.Equal(json.Uint64(2), reply.NumInputs)
+	require.Equal(json.Uint64(3), reply.NumOutputs)
+
+	// The addresses can't fund the send
+	args.Outputs = []EstimateFeeOutput{{
+		Amount:  json.Uint64(uint64(len(fromAddrsStr)) * startBalance),
+		AssetID: assetID,
+	}}
+	require.Error(env.service.EstimateFee(nil, args, &EstimateFeeReply{}))
+}
+
+func TestServiceReserveUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	const (
+		otherUsername = "otherUser"
+		keylessUser   = "keylessUser"
+	)
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{
+			{
+				username:    username,
+				password:    password,
+				initialKeys: keys,
+			},
+			{
+				username:    otherUsername,
+				password:    password,
+				initialKeys: keys,
+			},
+			{
+				username: keylessUser,
+				password: password,
+			},
+		},
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	now := time.Unix(1_000_000, 0)
+	env.vm.clock.Set(now)
+
+	userPass := api.UserPass{
+		Username: username,
+		Password: password,
+	}
+	utxos, _, err := env.vm.LoadUser(username, password, nil)
+	require.NoError(err)
+	require.NotEmpty(utxos)
+	utxoID := utxos[0].InputID()
+
+	reply := &ReserveUTXOsReply{}
+	require.NoError(env.service.ReserveUTXOs(nil, &ReserveUTXOsArgs{
+		UserPass: userPass,
+		Session:  "session0",
+		UTXOIDs:  []ids.ID{utxoID},
+	}, reply))
+	require.Equal(json.Uint64(now.Add(defaultReservationTTL).Unix()), reply.Expiry)
+
+	// The reserved UTXO isn't spent by the node for the user
+	unreserved, _, err := env.vm.LoadUser(username, password, nil)
+	require.NoError(err)
+	require.Len(unreserved, len(utxos)-1)
+	for _, utxo := range unreserved {
+		require.NotEqual(utxoID, utxo.InputID())
+	}
+
+	// The reservation doesn't hide the UTXO from other users
+	otherUTXOs, _, err := env.vm.LoadUser(otherUsername, password, nil)
+	require.NoError(err)
+	require.Len(otherUTXOs, len(utxos))
+
+	// Another session or user can't reserve the UTXO or release its
+	// reservation
+	err = env.service.ReserveUTXOs(nil, &ReserveUTXOsArgs{
+		UserPass: userPass,
+		Session:  "session1",
+		UTXOIDs:  []ids.ID{utxoID},
+	}, &ReserveUTXOsReply{})
+	require.ErrorIs(err, errUTXOReserved)
+	err = env.service.ReserveUTXOs(nil, &ReserveUTXOsArgs{
+		UserPass: api.UserPass{
+			Username: otherUsername,
+			Password: password,
+		},
+		Session: "session0",
+		UTXOIDs: []ids.ID{utxoID},
+	}, &ReserveUTXOsReply{})
+	require.ErrorIs(err, errUTXOReserved)
+	require.NoError(env.service.ReleaseUTXOs(nil, &ReleaseUTXOsArgs{
+		UserPass: userPass,
+		Session:  "session1",
+		UTXOIDs:  []ids.ID{utxoID},
+	}, &api.EmptyReply{}))
+	require.NoError(env.service.ReleaseUTXOs(nil, &ReleaseUTXOsArgs{
+		UserPass: api.UserPass{
+			Username: otherUsername,
+			Password: password,
+		},
+		Session: "session0",
+		UTXOIDs: []ids.ID{utxoID},
+	}, &api.EmptyReply{}))
+	unreserved, _, err = env.vm.LoadUser(username, password, nil)
+	require.NoError(err)
+	require.Len(unreserved, len(utxos)-1)
+
+	// The reservation expires after its TTL
+	env.vm.clock.Set(now.Add(defaultReservationTTL + time.Second))
+	unreserved, _, err = env.vm.LoadUser(username, password, nil)
+	require.NoError(err)
+	require.Len(unreserved, len(utxos))
+
+	require.NoError(env.service.ReserveUTXOs(nil, &ReserveUTXOsArgs{
+		UserPass: userPass,
+		Session:  "session1",
+		UTXOIDs:  []ids.ID{utxoID},
+		TTL:      json.Uint64(maxReservationTTL / time.Second),
+	}, reply))
+	require.NoError(env.service.ReleaseUTXOs(nil, &ReleaseUTXOsArgs{
+		UserPass: userPass,
+		Session:  "session1",
+		UTXOIDs:  []ids.ID{utxoID},
+	}, &api.EmptyReply{}))
+	unreserved, _, err = env.vm.LoadUser(username, password, nil)
+	require.NoError(err)
+	require.Len(unreserved, len(utxos))
+
+	// Only existing UTXOs can be reserved
+	err = env.service.ReserveUTXOs(nil, &ReserveUTXOsArgs{
+		UserPass: userPass,
+		Session:  "session0",
+		UTXOIDs:  []ids.ID{ids.GenerateTestID()},
+	}, &ReserveUTXOsReply{})
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// Only UTXOs the user can spend can be reserved
+	err = env.service.ReserveUTXOs(nil, &ReserveUTXOsArgs{
+		UserPass: api.UserPass{
+			Username: keylessUser,
+			Password: password,
+		},
+		Session: "session0",
+		UTXOIDs: []ids.ID{utxoID},
+	}, &ReserveUTXOsReply{})
+	require.ErrorIs(err, errUTXONotOwned)
+
+	// The user's credentials are required
+	err = env.service.ReserveUTXOs(nil, &ReserveUTXOsArgs{
+		UserPass: api.UserPass{
+			Username: username,
+			Password: "wrong password",
+		},
+		Session: "session0",
+		UTXOIDs: []ids.ID{utxoID},
+	}, &ReserveUTXOsReply{})
+	require.Error(err) //nolint:forbidigo // the keystore doesn't export its errors
+
+	err = env.service.ReserveUTXOs(nil, &ReserveUTXOsArgs{
+		UserPass: userPass,
+		UTXOIDs:  []ids.ID{utxoID},
+	}, &ReserveUTXOsReply{})
+	require.ErrorIs(err, errNoSession)
+}
+
 // Test the GetBalance method when argument Strict is true
+func TestServiceAssetAliases(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		vmDynamicConfig: &Config{
+			IndexTransactions: true,
+			AdminAPIEnabled:   true,
+		},
+		additionalFxs: []*common.Fx{{
+			ID: propertyfx.ID,
+			Fx: &propertyfx.Fx{},
+		}},
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	createAssetTx := newDioneCreateAssetTxWithOutputs(t, env.vm)
+	env.vm.state.AddTx(createAssetTx)
+	require.NoError(env.vm.state.Commit())
+	assetID := createAssetTx.ID()
+
+	reply := &api.EmptyReply{}
+	err := env.service.AddAssetAlias(nil, &AddAssetAliasArgs{
+		AssetID: assetID.String(),
+		Alias:   "",
+	}, reply)
+	require.ErrorIs(err, errEmptyAlias)
+
+	err = env.service.AddAssetAlias(nil, &AddAssetAliasArgs{
+		AssetID: env.genesisTx.ID().String(),
+		Alias:   assetID.String(),
+	}, reply)
+	require.ErrorIs(err, errAliasIsID)
+
+	// The genesis alias can't be reused
+	genesisAlias, err := env.vm.PrimaryAlias(env.genesisTx.ID())
+	require.NoError(err)
+	err = env.service.AddAssetAlias(nil, &AddAssetAliasArgs{
+		AssetID: assetID.String(),
+		Alias:   genesisAlias,
+	}, reply)
+	require.ErrorIs(err, errAliasInUse)
+
+	require.NoError(env.service.AddAssetAlias(nil, &AddAssetAliasArgs{
+		AssetID: assetID.String(),
+		Alias:   "TEST",
+	}, reply))
+	require.NoError(env.service.AddAssetAlias(nil, &AddAssetAliasArgs{
+		AssetID: "TEST",
+		Alias:   "TEST2",
+	}, reply))
+
+	aliasedID, err := env.vm.lookupAssetID("TEST2")
+	require.NoError(err)
+	require.Equal(assetID, aliasedID)
+	require.Equal("TEST", env.vm.PrimaryAliasOrDefault(assetID))
+
+	// The aliases are restored from disk
+	env.vm.Aliaser = ids.NewAliaser()
+	require.NoError(env.vm.initAssetAliases())
+	aliases, err := env.vm.Aliases(assetID)
+	require.NoError(err)
+	require.Equal([]string{"TEST", "TEST2"}, aliases)
+
+	// Genesis aliases can't be removed
+	err = env.service.RemoveAssetAlias(nil, &RemoveAssetAliasArgs{
+		Alias: genesisAlias,
+	}, reply)
+	require.ErrorIs(err, errUnknownAlias)
+
+	require.NoError(env.service.RemoveAssetAlias(nil, &RemoveAssetAliasArgs{
+		Alias: "TEST",
+	}, reply))
+	aliases, err = env.vm.Aliases(assetID)
+	require.NoError(err)
+	require.Equal([]string{"TEST2"}, aliases)
+
+	env.vm.Aliaser = ids.NewAliaser()
+	require.NoError(env.vm.initAssetAliases())
+	_, err = env.vm.Lookup("TEST")
+	require.ErrorIs(err, ids.ErrNoIDWithAlias)
+
+	env.vm.adminAPIEnabled = false
+	err = env.service.RemoveAssetAlias(nil, &RemoveAssetAliasArgs{
+		Alias: "TEST2",
+	}, reply)
+	require.ErrorIs(err, errAdminAPIDisabled)
+}
+
 func TestServiceGetBalanceStrict(t *testing.T) {
 	require := require.New(t)
 
@@ -197,9 +596,10 @@ func TestServiceGetBalanceStrict(t *testing.T) {
 	}
 	balanceReply = &GetBalanceReply{}
 	require.NoError(env.service.GetBalance(nil, balanceArgs, balanceReply))
-	// The balance should not include the UTXO since it is only partly owned by [addr]
-	require.Zero(balanceReply.Balance)
-	require.Empty(balanceReply.UTXOIDs)
+	// The balance should include the 1 out of 2 UTXO since [addr] alone can
+	// spend it
+	require.Equal(uint64(1337), uint64(balanceReply.Balance))
+	require.Len(balanceReply.UTXOIDs, 1)
 
 	// A UTXO with a 1 out of 1 multisig
 	// but with a locktime in the future
@@ -242,9 +642,110 @@ func TestServiceGetBalanceStrict(t *testing.T) {
 	}
 	balanceReply = &GetBalanceReply{}
 	require.NoError(env.service.GetBalance(nil, balanceArgs, balanceReply))
-	// The balance should not include the UTXO since it is only partly owned by [addr]
-	require.Zero(balanceReply.Balance)
-	require.Empty(balanceReply.UTXOIDs)
+	// The balance should not include the UTXO since it is locked
+	require.Equal(uint64(1337), uint64(balanceReply.Balance))
+	require.Len(balanceReply.UTXOIDs, 1)
+}
+
+func TestServiceGetBalanceAddresses(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	assetID := ids.GenerateTestID()
+	addr0 := ids.GenerateTestShortID()
+	addr0Str, err := env.vm.FormatLocalAddress(addr0)
+	require.NoError(err)
+	addr1 := ids.GenerateTestShortID()
+	addr1Str, err := env.vm.FormatLocalAddress(addr1)
+	require.NoError(err)
+
+	// A UTXO with a 2 out of 2 multisig of [addr0] and [addr1]
+	twoOfTwoUTXO := &dione.UTXO{
+		UTXOID: dione.UTXOID{
+			TxID:        ids.GenerateTestID(),
+			OutputIndex: 0,
+		},
+		Asset: dione.Asset{ID: assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1337,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr0, addr1},
+			},
+		},
+	}
+	// A UTXO owned solely by [addr1]
+	oneOfOneUTXO := &dione.UTXO{
+		UTXOID: dione.UTXOID{
+			TxID:        ids.GenerateTestID(),
+			OutputIndex: 0,
+		},
+		Asset: dione.Asset{ID: assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 42,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr1},
+			},
+		},
+	}
+	// A UTXO with a 1 out of 2 multisig of [addr0] and another address
+	oneOfTwoUTXO := &dione.UTXO{
+		UTXOID: dione.UTXOID{
+			TxID:        ids.GenerateTestID(),
+			OutputIndex: 0,
+		},
+		Asset: dione.Asset{ID: assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 7,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{ids.GenerateTestShortID(), addr0},
+			},
+		},
+	}
+	env.vm.state.AddUTXO(twoOfTwoUTXO)
+	env.vm.state.AddUTXO(oneOfOneUTXO)
+	env.vm.state.AddUTXO(oneOfTwoUTXO)
+	require.NoError(env.vm.state.Commit())
+
+	// At least one address must be provided
+	balanceReply := &GetBalanceReply{}
+	err = env.service.GetBalance(nil, &GetBalanceArgs{
+		AssetID: assetID.String(),
+	}, balanceReply)
+	require.ErrorIs(err, errNoAddresses)
+
+	// [addr0] alone can only spend the 1 out of 2 UTXO
+	balanceReply = &GetBalanceReply{}
+	require.NoError(env.service.GetBalance(nil, &GetBalanceArgs{
+		Addresses: []string{addr0Str},
+		AssetID:   assetID.String(),
+	}, balanceReply))
+	require.Equal(uint64(7), uint64(balanceReply.Balance))
+	require.Equal([]dione.UTXOID{oneOfTwoUTXO.UTXOID}, balanceReply.UTXOIDs)
+
+	// Together the addresses can spend all the UTXOs
+	balanceReply = &GetBalanceReply{}
+	require.NoError(env.service.GetBalance(nil, &GetBalanceArgs{
+		Address:   addr0Str,
+		Addresses: []string{addr1Str},
+		AssetID:   assetID.String(),
+	}, balanceReply))
+	require.Equal(uint64(1337+42+7), uint64(balanceReply.Balance))
+	require.Len(balanceReply.UTXOIDs, 3)
+
+	balancesReply := &GetAllBalancesReply{}
+	require.NoError(env.service.GetAllBalances(nil, &GetAllBalancesArgs{
+		Addresses: []string{addr0Str, addr1Str},
+	}, balancesReply))
+	require.Len(balancesReply.Balances, 1)
+	require.Equal(uint64(1337+42+7), uint64(balancesReply.Balances[0].Balance))
 }
 
 func TestServiceGetTxs(t *testing.T) {
@@ -376,8 +877,11 @@ func TestServiceGetAllBalances(t *testing.T) {
 	}
 	reply = &GetAllBalancesReply{}
 	require.NoError(env.service.GetAllBalances(nil, balanceArgs, reply))
-	// The balance should not include the UTXO since it is only partly owned by [addr]
-	require.Empty(reply.Balances)
+	// The balance should include the 1 out of 2 UTXO since [addr] alone can
+	// spend it
+	require.Len(reply.Balances, 1)
+	require.Equal(assetID.String(), reply.Balances[0].AssetID)
+	require.Equal(uint64(1337), uint64(reply.Balances[0].Balance))
 
 	// A UTXO with a 1 out of 1 multisig
 	// but with a locktime in the future
@@ -419,8 +923,10 @@ func TestServiceGetAllBalances(t *testing.T) {
 	}
 	reply = &GetAllBalancesReply{}
 	require.NoError(env.service.GetAllBalances(nil, balanceArgs, reply))
-	// The balance should not include the UTXO since it is only partly owned by [addr]
-	require.Empty(reply.Balances)
+	// The balance should not include the UTXO since it is locked
+	require.Len(reply.Balances, 1)
+	require.Equal(assetID.String(), reply.Balances[0].AssetID)
+	require.Equal(uint64(1337), uint64(reply.Balances[0].Balance))
 
 	// A UTXO for a different asset
 	otherAssetID := ids.GenerateTestID()
@@ -464,8 +970,10 @@ func TestServiceGetAllBalances(t *testing.T) {
 	}
 	reply = &GetAllBalancesReply{}
 	require.NoError(env.service.GetAllBalances(nil, balanceArgs, reply))
-	// The balance should include the UTXO since it is partly owned by [addr]
-	require.Empty(reply.Balances)
+	// The balance should not include the UTXO since it is only partly owned by [addr]
+	require.Len(reply.Balances, 1)
+	require.Equal(assetID.String(), reply.Balances[0].AssetID)
+	require.Equal(uint64(1337), uint64(reply.Balances[0].Balance))
 }
 
 func TestServiceGetTx(t *testing.T) {
@@ -1513,7 +2021,7 @@ func TestCreateVariableCapAsset(t *testing.T) {
 				AssetID: createdAssetID,
 				To:      minterAddrStr, // Send newly minted tokens to this address
 			}
-			mintReply := &api.JSONTxIDChangeAddr{}
+			mintReply := &SpendReply{}
 			require.NoError(env.service.Mint(nil, mintArgs, mintReply))
 			require.Equal(changeAddrStr, mintReply.ChangeAddr)
 
@@ -1534,7 +2042,7 @@ func TestCreateVariableCapAsset(t *testing.T) {
 					To:      fromAddrsStr[0],
 				},
 			}
-			sendReply := &api.JSONTxIDChangeAddr{}
+			sendReply := &SpendReply{}
 			require.NoError(env.service.Send(nil, sendArgs, sendReply))
 			require.Equal(changeAddrStr, sendReply.ChangeAddr)
 		})
@@ -1663,6 +2171,179 @@ func TestNFTWorkflow(t *testing.T) {
 	}
 }
 
+func TestMintNFTPayloads(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{{
+			username:    username,
+			password:    password,
+			initialKeys: keys,
+		}},
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	addrStrs := make([]string, len(keys))
+	for i, key := range keys {
+		addrStr, err := env.vm.FormatLocalAddress(key.PublicKey().Address())
+		require.NoError(err)
+		addrStrs[i] = addrStr
+	}
+
+	// Each group of the asset can mint one NFT per tx
+	minterSets := make([]Owners, len(keys))
+	for i := range minterSets {
+		minterSets[i] = Owners{
+			Threshold: 1,
+			Minters:   []string{addrStrs[0]},
+		}
+	}
+	createArgs := &CreateNFTAssetArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass: api.UserPass{
+				Username: username,
+				Password: password,
+			},
+		},
+		Name:       "COLLECTION",
+		Symbol:     "COL",
+		MinterSets: minterSets,
+	}
+	createReply := &AssetIDChangeAddr{}
+	require.NoError(env.service.CreateNFTAsset(nil, createArgs, createReply))
+	buildAndAccept(require, env.vm, env.issuer, createReply.AssetID)
+
+	nftPayloads := make([]NFTPayload, len(keys)+1)
+	for i := range nftPayloads {
+		payload, err := formatting.Encode(formatting.Hex, []byte{byte(i)})
+		require.NoError(err)
+		nftPayloads[i] = NFTPayload{
+			Payload: payload,
+			To:      addrStrs[i%len(addrStrs)],
+		}
+	}
+	mintArgs := &MintNFTArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass: api.UserPass{
+				Username: username,
+				Password: password,
+			},
+		},
+		AssetID:  createReply.AssetID.String(),
+		To:       addrStrs[0],
+		Encoding: formatting.Hex,
+		Payloads: nftPayloads,
+	}
+	mintReply := &api.JSONTxIDChangeAddr{}
+	err := env.service.MintNFT(nil, mintArgs, mintReply)
+	require.ErrorIs(err, errPayloadAndPayloads)
+
+	// There are more payloads than groups
+	mintArgs.To = ""
+	require.Error(env.service.MintNFT(nil, mintArgs, mintReply))
+
+	mintArgs.Payloads = nftPayloads[:len(keys)]
+	require.NoError(env.service.MintNFT(nil, mintArgs, mintReply))
+	buildAndAccept(require, env.vm, env.issuer, mintReply.TxID)
+
+	tx, err := env.vm.state.GetTx(mintReply.TxID)
+	require.NoError(err)
+	require.IsType(&txs.OperationTx{}, tx.Unsigned)
+	opTx := tx.Unsigned.(*txs.OperationTx)
+	require.Len(opTx.Ops, len(keys))
+
+	minted := make(map[byte]ids.ShortID, len(keys))
+	for _, op := range opTx.Ops {
+		require.IsType(&nftfx.MintOperation{}, op.Op)
+		mintOp := op.Op.(*nftfx.MintOperation)
+		require.Len(mintOp.Payload, 1)
+		require.Len(mintOp.Outputs, 1)
+		minted[mintOp.Payload[0]] = mintOp.Outputs[0].Addrs[0]
+	}
+	for i, key := range keys {
+		require.Equal(key.PublicKey().Address(), minted[byte(i)])
+	}
+}
+
+func TestCreateMintBurnProperty(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{{
+			username:    username,
+			password:    password,
+			initialKeys: keys,
+		}},
+		additionalFxs: []*common.Fx{{
+			ID: propertyfx.ID,
+			Fx: &propertyfx.Fx{},
+		}},
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	userPass := api.UserPass{
+		Username: username,
+		Password: password,
+	}
+	addrStr, err := env.vm.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+
+	createArgs := &CreatePropertyAssetArgs{
+		JSONSpendHeader: api.JSONSpendHeader{UserPass: userPass},
+		Name:            "DEED",
+		Symbol:          "DEED",
+	}
+	createReply := &AssetIDChangeAddr{}
+	err = env.service.CreatePropertyAsset(nil, createArgs, createReply)
+	require.ErrorIs(err, errNoMinters)
+
+	createArgs.MinterSets = []Owners{{
+		Threshold: 1,
+		Minters:   []string{addrStr},
+	}}
+	require.NoError(env.service.CreatePropertyAsset(nil, createArgs, createReply))
+	buildAndAccept(require, env.vm, env.issuer, createReply.AssetID)
+	assetID := createReply.AssetID
+
+	// The property can be minted more than once
+	mintArgs := &MintPropertyArgs{
+		JSONSpendHeader: api.JSONSpendHeader{UserPass: userPass},
+		AssetID:         assetID.String(),
+		To:              addrStr,
+	}
+	for i := 0; i < 2; i++ {
+		mintReply := &api.JSONTxIDChangeAddr{}
+		require.NoError(env.service.MintProperty(nil, mintArgs, mintReply))
+		buildAndAccept(require, env.vm, env.issuer, mintReply.TxID)
+	}
+
+	burnArgs := &BurnPropertyArgs{
+		JSONSpendHeader: api.JSONSpendHeader{UserPass: userPass},
+		AssetID:         assetID.String(),
+	}
+	burnReply := &api.JSONTxIDChangeAddr{}
+	require.NoError(env.service.BurnProperty(nil, burnArgs, burnReply))
+	buildAndAccept(require, env.vm, env.issuer, burnReply.TxID)
+
+	tx, err := env.vm.state.GetTx(burnReply.TxID)
+	require.NoError(err)
+	require.IsType(&txs.OperationTx{}, tx.Unsigned)
+	opTx := tx.Unsigned.(*txs.OperationTx)
+	require.Len(opTx.Ops, 2)
+	for _, op := range opTx.Ops {
+		require.IsType(&propertyfx.BurnOperation{}, op.Op)
+	}
+
+	// Nothing is left to burn
+	require.Error(env.service.BurnProperty(nil, burnArgs, burnReply))
+}
+
 func TestImportExportKey(t *testing.T) {
 	require := require.New(t)
 
@@ -1792,13 +2473,313 @@ func TestSend(t *testing.T) {
 			To:      addrStr,
 		},
 	}
-	reply := &api.JSONTxIDChangeAddr{}
+	reply := &SpendReply{}
 	require.NoError(env.service.Send(nil, args, reply))
 	require.Equal(changeAddrStr, reply.ChangeAddr)
 
 	buildAndAccept(require, env.vm, env.issuer, reply.TxID)
 }
 
+func TestSendWithKeySession(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	now := env.vm.clock.Time()
+	env.vm.clock.Set(now)
+
+	openArgs := &OpenKeySessionArgs{}
+	openReply := &OpenKeySessionReply{}
+	err := env.service.OpenKeySession(nil, openArgs, openReply)
+	require.ErrorIs(err, errMissingPrivateKeys)
+
+	openArgs.PrivateKeys = []*secp256k1.PrivateKey{keys[0]}
+	require.NoError(env.service.OpenKeySession(nil, openArgs, openReply))
+	require.Equal(json.Uint64(now.Add(defaultKeySessionTTL).Unix()), openReply.Expiry)
+	addrStr, err := env.vm.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+	require.Equal([]string{addrStr}, openReply.Addresses)
+
+	// The session is used in place of a keystore user
+	args := &SendArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass: openReply.UserPass,
+		},
+		SendOutput: SendOutput{
+			Amount:  500,
+			AssetID: env.genesisTx.ID().String(),
+			To:      addrStr,
+		},
+	}
+	reply := &SpendReply{}
+	require.NoError(env.service.Send(nil, args, reply))
+	require.Equal(addrStr, reply.ChangeAddr)
+	buildAndAccept(require, env.vm, env.issuer, reply.TxID)
+
+	// The password of the session is required
+	wrongUser := api.UserPass{
+		Username: openReply.Username,
+		Password: password,
+	}
+	err = env.service.CloseKeySession(nil, &wrongUser, &api.EmptyReply{})
+	require.ErrorIs(err, errUnknownKeySession)
+
+	require.NoError(env.service.CloseKeySession(nil, &openReply.UserPass, &api.EmptyReply{}))
+	err = env.service.Send(nil, args, reply)
+	require.ErrorIs(err, errUnknownKeySession)
+
+	// Sessions expire
+	require.NoError(env.service.OpenKeySession(nil, openArgs, openReply))
+	env.vm.clock.Set(now.Add(defaultKeySessionTTL + time.Second))
+	args.UserPass = openReply.UserPass
+	err = env.service.Send(nil, args, reply)
+	require.ErrorIs(err, errUnknownKeySession)
+}
+
+func TestConsolidateUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{{
+			username:    username,
+			password:    password,
+			initialKeys: []*secp256k1.PrivateKey{keys[0]},
+		}},
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	assetID := env.genesisTx.ID()
+	addr := keys[0].PublicKey().Address()
+	for i := 0; i < 10; i++ {
+		env.vm.state.AddUTXO(&dione.UTXO{
+			UTXOID: dione.UTXOID{
+				TxID:        ids.GenerateTestID(),
+				OutputIndex: 0,
+			},
+			Asset: dione.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 1000,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{addr},
+				},
+			},
+		})
+	}
+	require.NoError(env.vm.state.Commit())
+
+	numAssetUTXOs := func() int {
+		utxos, _, err := env.vm.LoadUser(username, password, nil)
+		require.NoError(err)
+		num := 0
+		for _, utxo := range utxos {
+			if utxo.AssetID() == assetID {
+				num++
+			}
+		}
+		return num
+	}
+	numUTXOs := numAssetUTXOs()
+	require.Greater(numUTXOs, 10)
+
+	args := &ConsolidateUTXOsArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass: api.UserPass{
+				Username: username,
+				Password: password,
+			},
+		},
+		AssetID:     assetID.String(),
+		TargetCount: 3,
+	}
+	reply := &ConsolidateUTXOsReply{}
+	require.NoError(env.service.ConsolidateUTXOs(nil, args, reply))
+	require.Equal(json.Uint64(3), reply.NumUTXOs)
+	require.Len(reply.TxIDs, 1)
+	buildAndAccept(require, env.vm, env.issuer, reply.TxIDs[0])
+
+	tx, err := env.vm.state.GetTx(reply.TxIDs[0])
+	require.NoError(err)
+	require.IsType(&txs.BaseTx{}, tx.Unsigned)
+	baseTx := tx.Unsigned.(*txs.BaseTx)
+	require.Len(baseTx.Ins, numUTXOs-2)
+	require.Len(baseTx.Outs, 1)
+
+	require.Equal(3, numAssetUTXOs())
+
+	// Nothing is left to consolidate
+	reply = &ConsolidateUTXOsReply{}
+	require.NoError(env.service.ConsolidateUTXOs(nil, args, reply))
+	require.Equal(json.Uint64(3), reply.NumUTXOs)
+	require.Empty(reply.TxIDs)
+
+	args.TargetCount = 0
+	reply = &ConsolidateUTXOsReply{}
+	require.NoError(env.service.ConsolidateUTXOs(nil, args, reply))
+	require.Equal(json.Uint64(1), reply.NumUTXOs)
+	require.Len(reply.TxIDs, 1)
+	buildAndAccept(require, env.vm, env.issuer, reply.TxIDs[0])
+
+	require.Equal(1, numAssetUTXOs())
+}
+
+func TestConsolidationError(t *testing.T) {
+	require := require.New(t)
+
+	err := consolidationError(nil, errTxTooLarge)
+	require.Equal(errTxTooLarge, err)
+
+	txIDs := []ids.ID{ids.GenerateTestID(), ids.GenerateTestID()}
+	err = consolidationError(txIDs, errTxTooLarge)
+	require.ErrorIs(err, errTxTooLarge)
+	for _, txID := range txIDs {
+		require.Contains(err.Error(), txID.String())
+	}
+}
+
+func TestSendPartialAndSignTx(t *testing.T) {
+	require := require.New(t)
+
+	factory := secp256k1.Factory{}
+	aliceKey, err := factory.NewPrivateKey()
+	require.NoError(err)
+	bobKey, err := factory.NewPrivateKey()
+	require.NoError(err)
+
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{
+			{
+				username:    "alice",
+				password:    password,
+				initialKeys: []*secp256k1.PrivateKey{aliceKey},
+			},
+			{
+				username:    "bob",
+				password:    password,
+				initialKeys: []*secp256k1.PrivateKey{bobKey},
+			},
+		},
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	// Fund an output that requires the signatures of both alice and bob
+	assetID := env.genesisTx.ID()
+	multisigAmount := 10 * env.vm.TxFee
+	funderAddr := keys[0].PublicKey().Address()
+	utxos, err := dione.GetAllUTXOs(env.vm.state, set.Of(funderAddr))
+	require.NoError(err)
+	amountsSpent, ins, signers, err := env.vm.Spend(
+		utxos,
+		secp256k1fx.NewKeychain(keys[0]),
+		map[ids.ID]uint64{
+			assetID: multisigAmount + env.vm.TxFee,
+		},
+	)
+	require.NoError(err)
+	multisigAddrs := []ids.ShortID{
+		aliceKey.PublicKey().Address(),
+		bobKey.PublicKey().Address(),
+	}
+	utils.Sort(multisigAddrs)
+	outs := []*dione.TransferableOutput{
+		{
+			Asset: dione.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: multisigAmount,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 2,
+					Addrs:     multisigAddrs,
+				},
+			},
+		},
+		{
+			Asset: dione.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: amountsSpent[assetID] - multisigAmount - env.vm.TxFee,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{funderAddr},
+				},
+			},
+		},
+	}
+	dione.SortTransferableOutputs(outs, env.vm.parser.Codec())
+	fundingTx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: dione.BaseTx{
+		NetworkID:    env.vm.ctx.NetworkID,
+		BlockchainID: env.vm.ctx.ChainID,
+		Outs:         outs,
+		Ins:          ins,
+	}}}
+	require.NoError(fundingTx.SignSECP256K1Fx(env.vm.parser.Codec(), signers))
+	issueAndAccept(require, env.vm, env.issuer, fundingTx)
+
+	toAddrStr, err := env.vm.FormatLocalAddress(keys[1].PublicKey().Address())
+	require.NoError(err)
+	args := &SendArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass: api.UserPass{
+				Username: "alice",
+				Password: password,
+			},
+		},
+		SendOutput: SendOutput{
+			Amount:  json.Uint64(env.vm.TxFee),
+			AssetID: assetID.String(),
+			To:      toAddrStr,
+		},
+	}
+	reply := &SpendReply{}
+
+	// Alice can't spend the funds without bob
+	require.ErrorContains(env.service.Send(nil, args, reply), "want to spend")
+
+	args.Partial = true
+	require.NoError(env.service.Send(nil, args, reply))
+	require.Equal(ids.Empty, reply.TxID)
+	require.NotEmpty(reply.Tx)
+
+	// Alice can't add bob's signature
+	aliceSignReply := &SignTxReply{}
+	require.NoError(env.service.SignTx(nil, &SignTxArgs{
+		UserPass: api.UserPass{
+			Username: "alice",
+			Password: password,
+		},
+		Tx:       reply.Tx,
+		Encoding: reply.Encoding,
+	}, aliceSignReply))
+	require.False(aliceSignReply.Signed)
+	require.Equal(reply.Tx, aliceSignReply.Tx)
+
+	bobSignReply := &SignTxReply{}
+	require.NoError(env.service.SignTx(nil, &SignTxArgs{
+		UserPass: api.UserPass{
+			Username: "bob",
+			Password: password,
+		},
+		Tx:       reply.Tx,
+		Encoding: reply.Encoding,
+	}, bobSignReply))
+	require.True(bobSignReply.Signed)
+
+	issueReply := &api.JSONTxID{}
+	require.NoError(env.service.IssueTx(nil, &api.FormattedTx{
+		Tx:       bobSignReply.Tx,
+		Encoding: bobSignReply.Encoding,
+	}, issueReply))
+	buildAndAccept(require, env.vm, env.issuer, issueReply.TxID)
+}
+
 func TestSendMultiple(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1848,7 +2829,7 @@ func TestSendMultiple(t *testing.T) {
 					},
 				},
 			}
-			reply := &api.JSONTxIDChangeAddr{}
+			reply := &SpendReply{}
 			require.NoError(env.service.SendMultiple(nil, args, reply))
 			require.Equal(changeAddrStr, reply.ChangeAddr)
 
@@ -1857,6 +2838,57 @@ func TestSendMultiple(t *testing.T) {
 	}
 }
 
+func TestExportWithMemo(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{{
+			username:    username,
+			password:    password,
+			initialKeys: keys,
+		}},
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	changeAddrStr, err := env.vm.FormatLocalAddress(testChangeAddr)
+	require.NoError(err)
+	_, fromAddrsStr := sampleAddrs(t, env.vm, addrs)
+	oAddr, err := env.vm.FormatAddress(constants.OmegaChainID, keys[0].PublicKey().Address())
+	require.NoError(err)
+
+	args := &ExportArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass: api.UserPass{
+				Username: username,
+				Password: password,
+			},
+			JSONFromAddrs:  api.JSONFromAddrs{From: fromAddrsStr},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddrStr},
+		},
+		Amount:  500,
+		To:      oAddr,
+		AssetID: env.genesisTx.ID().String(),
+		Memo:    string(make([]byte, dione.MaxMemoSize+1)),
+	}
+	reply := &api.JSONTxIDChangeAddr{}
+	err = env.service.Export(nil, args, reply)
+	require.ErrorContains(err, "max memo length")
+
+	args.Memo = "deposit 1234"
+	require.NoError(env.service.Export(nil, args, reply))
+
+	buildAndAccept(require, env.vm, env.issuer, reply.TxID)
+
+	tx, err := env.vm.state.GetTx(reply.TxID)
+	require.NoError(err)
+	require.IsType(&txs.ExportTx{}, tx.Unsigned)
+	exportTx := tx.Unsigned.(*txs.ExportTx)
+	require.Equal([]byte("deposit 1234"), []byte(exportTx.Memo))
+}
+
 func TestCreateAndListAddresses(t *testing.T) {
 	require := require.New(t)
 
@@ -1955,6 +2987,65 @@ func TestImport(t *testing.T) {
 	}
 }
 
+func TestImportExportPeerChain(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		keystoreUsers: []*user{{
+			username:    username,
+			password:    password,
+			initialKeys: keys,
+		}},
+	})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	// A chain whose subnet is unknown
+	otherChainID := ids.GenerateTestID()
+	aliaser := env.vm.ctx.BCLookup.(ids.Aliaser)
+	require.NoError(aliaser.Alias(otherChainID, "Z"))
+
+	userPass := api.UserPass{
+		Username: username,
+		Password: password,
+	}
+	addrStr, err := env.vm.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+
+	importArgs := &ImportArgs{
+		UserPass:    userPass,
+		SourceChain: "A",
+		To:          addrStr,
+	}
+	importReply := &api.JSONTxID{}
+	err = env.service.Import(nil, importArgs, importReply)
+	require.ErrorIs(err, verify.ErrSameChainID)
+
+	importArgs.SourceChain = "Z"
+	err = env.service.Import(nil, importArgs, importReply)
+	require.ErrorIs(err, errMissing)
+
+	exportArgs := &ExportArgs{
+		JSONSpendHeader: api.JSONSpendHeader{UserPass: userPass},
+		Amount:          500,
+		TargetChain:     "A",
+		To:              keys[0].PublicKey().Address().String(),
+		AssetID:         env.genesisTx.ID().String(),
+	}
+	exportReply := &api.JSONTxIDChangeAddr{}
+	err = env.service.Export(nil, exportArgs, exportReply)
+	require.ErrorIs(err, verify.ErrSameChainID)
+
+	exportArgs.TargetChain = "Z"
+	err = env.service.Export(nil, exportArgs, exportReply)
+	require.ErrorIs(err, errMissing)
+
+	exportArgs.TargetChain = "O"
+	require.NoError(env.service.Export(nil, exportArgs, exportReply))
+}
+
 func TestServiceGetBlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -2008,9 +3099,13 @@ func TestServiceGetBlock(t *testing.T) {
 
 				manager := executor.NewMockManager(ctrl)
 				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil)
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().AcceptedAt(blockID).Return(time.Time{}, database.ErrNotFound)
 				return &Service{
 					vm: &VM{
 						chainManager: manager,
+						acceptTimes:  acceptTimes,
 						ctx: &snow.Context{
 							Log: logging.NoLog{},
 						},
@@ -2032,9 +3127,13 @@ func TestServiceGetBlock(t *testing.T) {
 
 				manager := executor.NewMockManager(ctrl)
 				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil)
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().AcceptedAt(blockID).Return(time.Time{}, database.ErrNotFound)
 				return &Service{
 					vm: &VM{
 						chainManager: manager,
+						acceptTimes:  acceptTimes,
 						ctx: &snow.Context{
 							Log: logging.NoLog{},
 						},
@@ -2056,9 +3155,13 @@ func TestServiceGetBlock(t *testing.T) {
 
 				manager := executor.NewMockManager(ctrl)
 				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil)
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().AcceptedAt(blockID).Return(time.Time{}, database.ErrNotFound)
 				return &Service{
 					vm: &VM{
 						chainManager: manager,
+						acceptTimes:  acceptTimes,
 						ctx: &snow.Context{
 							Log: logging.NoLog{},
 						},
@@ -2080,9 +3183,13 @@ func TestServiceGetBlock(t *testing.T) {
 
 				manager := executor.NewMockManager(ctrl)
 				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil)
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().AcceptedAt(blockID).Return(time.Time{}, database.ErrNotFound)
 				return &Service{
 					vm: &VM{
 						chainManager: manager,
+						acceptTimes:  acceptTimes,
 						ctx: &snow.Context{
 							Log: logging.NoLog{},
 						},
@@ -2197,10 +3304,14 @@ func TestServiceGetBlockByHeight(t *testing.T) {
 
 				manager := executor.NewMockManager(ctrl)
 				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil)
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().AcceptedAt(blockID).Return(time.Time{}, database.ErrNotFound)
 				return &Service{
 					vm: &VM{
 						state:        state,
 						chainManager: manager,
+						acceptTimes:  acceptTimes,
 						ctx: &snow.Context{
 							Log: logging.NoLog{},
 						},
@@ -2225,10 +3336,14 @@ func TestServiceGetBlockByHeight(t *testing.T) {
 
 				manager := executor.NewMockManager(ctrl)
 				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil)
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().AcceptedAt(blockID).Return(time.Time{}, database.ErrNotFound)
 				return &Service{
 					vm: &VM{
 						state:        state,
 						chainManager: manager,
+						acceptTimes:  acceptTimes,
 						ctx: &snow.Context{
 							Log: logging.NoLog{},
 						},
@@ -2253,10 +3368,14 @@ func TestServiceGetBlockByHeight(t *testing.T) {
 
 				manager := executor.NewMockManager(ctrl)
 				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil)
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().AcceptedAt(blockID).Return(time.Time{}, database.ErrNotFound)
 				return &Service{
 					vm: &VM{
 						state:        state,
 						chainManager: manager,
+						acceptTimes:  acceptTimes,
 						ctx: &snow.Context{
 							Log: logging.NoLog{},
 						},
@@ -2281,10 +3400,14 @@ func TestServiceGetBlockByHeight(t *testing.T) {
 
 				manager := executor.NewMockManager(ctrl)
 				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil)
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().AcceptedAt(blockID).Return(time.Time{}, database.ErrNotFound)
 				return &Service{
 					vm: &VM{
 						state:        state,
 						chainManager: manager,
+						acceptTimes:  acceptTimes,
 						ctx: &snow.Context{
 							Log: logging.NoLog{},
 						},
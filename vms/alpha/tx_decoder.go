@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package alpha
+
+import (
+	"context"
+
+	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
+	"github.com/DioneProtocol/odysseygo/vms/components/txdecoder"
+)
+
+var (
+	_ txdecoder.Decoder = (*VM)(nil)
+	_ txs.Visitor       = (*txDescriber)(nil)
+)
+
+// DecodeTx implements the txdecoder.Decoder interface
+func (vm *VM) DecodeTx(_ context.Context, txBytes []byte) (*txdecoder.Description, error) {
+	tx, err := vm.parser.ParseTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	describer := &txDescriber{
+		tx: tx,
+		builder: txdecoder.Builder{
+			Formatter: vm.AddressManager,
+			Description: txdecoder.Description{
+				TxID:    tx.ID(),
+				ChainID: vm.ctx.ChainID,
+			},
+		},
+	}
+	if err := tx.Unsigned.Visit(describer); err != nil {
+		return nil, err
+	}
+	return &describer.builder.Description, nil
+}
+
+// txDescriber populates a txdecoder.Description from an A-chain transaction.
+type txDescriber struct {
+	tx      *txs.Tx
+	builder txdecoder.Builder
+}
+
+func (d *txDescriber) BaseTx(tx *txs.BaseTx) error {
+	d.builder.Description.Type = "BaseTx"
+	return d.builder.AddBaseTx(&tx.BaseTx)
+}
+
+func (d *txDescriber) CreateAssetTx(tx *txs.CreateAssetTx) error {
+	if err := d.builder.AddBaseTx(&tx.BaseTx.BaseTx); err != nil {
+		return err
+	}
+	d.builder.Description.Type = "CreateAssetTx"
+
+	assetID := d.tx.ID()
+	for _, state := range tx.States {
+		for _, out := range state.Outs {
+			if err := d.builder.AddStateOutput(assetID, out); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (d *txDescriber) OperationTx(tx *txs.OperationTx) error {
+	if err := d.builder.AddBaseTx(&tx.BaseTx.BaseTx); err != nil {
+		return err
+	}
+	d.builder.Description.Type = "OperationTx"
+
+	for _, op := range tx.Ops {
+		assetID := op.AssetID()
+		d.builder.AddUTXOInputs(assetID, op.UTXOIDs)
+		for _, out := range op.Op.Outs() {
+			if err := d.builder.AddStateOutput(assetID, out); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (d *txDescriber) ImportTx(tx *txs.ImportTx) error {
+	if err := d.builder.AddBaseTx(&tx.BaseTx.BaseTx); err != nil {
+		return err
+	}
+	d.builder.Description.Type = "ImportTx"
+	d.builder.AddInputs(&tx.SourceChain, tx.ImportedIns)
+	return nil
+}
+
+func (d *txDescriber) ExportTx(tx *txs.ExportTx) error {
+	if err := d.builder.AddBaseTx(&tx.BaseTx.BaseTx); err != nil {
+		return err
+	}
+	d.builder.Description.Type = "ExportTx"
+	return d.builder.AddOutputs(&tx.DestinationChain, false, tx.ExportedOuts)
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package alpha
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+)
+
+func TestDecodeExportTx(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	tx := newDioneExportTxWithOutputs(t, env.genesisBytes, env.vm)
+	desc, err := env.vm.DecodeTx(context.Background(), tx.Bytes())
+	require.NoError(err)
+
+	require.Equal(tx.ID(), desc.TxID)
+	require.Equal(env.vm.ctx.ChainID, desc.ChainID)
+	require.Equal("ExportTx", desc.Type)
+
+	require.Len(desc.Inputs, 1)
+	require.Equal(json.Uint64(startBalance), desc.Inputs[0].Amount)
+	require.Nil(desc.Inputs[0].SourceChain)
+
+	require.Len(desc.Outputs, 1)
+	out := desc.Outputs[0]
+	require.Equal(json.Uint64(startBalance-env.vm.TxFee), out.Amount)
+	require.Equal(constants.OmegaChainID, *out.DestinationChain)
+	require.NotNil(out.Owner)
+	require.Equal(json.Uint32(1), out.Owner.Threshold)
+	require.Equal([]string{"A-testing1lnk637g0edwnqc2tn8tel39652fswa3xk4r65e"}, out.Owner.Addresses)
+}
+
+func TestDecodeInvalidTx(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	_, err := env.vm.DecodeTx(context.Background(), []byte{1, 2, 3})
+	require.Error(err) //nolint:forbidigo // error is codec-specific
+}
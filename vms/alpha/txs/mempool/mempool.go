@@ -6,15 +6,19 @@ package mempool
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"golang.org/x/exp/slices"
+
 	"github.com/DioneProtocol/odysseygo/cache"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
 	"github.com/DioneProtocol/odysseygo/utils/linkedhashmap"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/config"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 )
 
@@ -48,10 +52,19 @@ type Mempool interface {
 	Get(txID ids.ID) *txs.Tx
 	Remove(txs []*txs.Tx)
 
-	// Peek returns the next first tx that was added to the mempool whose size
-	// is less than or equal to maxTxSize.
+	// Peek returns the tx paying the highest tip whose size is less than or
+	// equal to maxTxSize. Txs paying the same tip are returned in the order
+	// they were added to the mempool.
 	Peek(maxTxSize int) *txs.Tx
 
+	// Tips returns the tips paid by the txs currently in the mempool, sorted
+	// in decreasing order.
+	Tips() []uint64
+
+	// List returns the txs currently in the mempool, in the order they were
+	// added.
+	List() []*txs.Tx
+
 	// RequestBuildBlock notifies the consensus engine that a block should be
 	// built if there is at least one transaction in the mempool.
 	RequestBuildBlock()
@@ -69,6 +82,18 @@ type mempool struct {
 	unissuedTxs linkedhashmap.LinkedHashmap[ids.ID, *txs.Tx]
 	numTxs      prometheus.Gauge
 
+	// Used to calculate the tip paid by a tx.
+	feeAssetID ids.ID
+	config     config.Config
+	// Key: Tx ID
+	// Value: Tip paid by the tx
+	txTips map[ids.ID]uint64
+	// Key: Tip
+	// Value: Txs paying the tip, in the order they were added
+	tipBuckets map[uint64]linkedhashmap.LinkedHashmap[ids.ID, *txs.Tx]
+	// Tips with a non-empty bucket, sorted in decreasing order
+	sortedTips []uint64
+
 	toEngine chan<- common.Message
 
 	// Key: Tx ID
@@ -82,6 +107,8 @@ func New(
 	namespace string,
 	registerer prometheus.Registerer,
 	toEngine chan<- common.Message,
+	feeAssetID ids.ID,
+	config config.Config,
 ) (Mempool, error) {
 	bytesAvailableMetric := prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace: namespace,
@@ -107,6 +134,10 @@ func New(
 		bytesAvailable:       maxMempoolSize,
 		unissuedTxs:          linkedhashmap.New[ids.ID, *txs.Tx](),
 		numTxs:               numTxsMetric,
+		feeAssetID:           feeAssetID,
+		config:               config,
+		txTips:               make(map[ids.ID]uint64),
+		tipBuckets:           make(map[uint64]linkedhashmap.LinkedHashmap[ids.ID, *txs.Tx]),
 		toEngine:             toEngine,
 		droppedTxIDs:         &cache.LRU[ids.ID, error]{Size: droppedTxIDsCacheSize},
 		consumedUTXOs:        set.NewSet[ids.ID](initialConsumedUTXOsSize),
@@ -148,6 +179,7 @@ func (m *mempool) Add(tx *txs.Tx) error {
 
 	m.unissuedTxs.Put(txID, tx)
 	m.numTxs.Inc()
+	m.addToTipBucket(txID, tx)
 
 	// Mark these UTXOs as consumed in the mempool
 	m.consumedUTXOs.Union(inputs)
@@ -180,6 +212,7 @@ func (m *mempool) Remove(txsToRemove []*txs.Tx) {
 
 		m.unissuedTxs.Delete(txID)
 		m.numTxs.Dec()
+		m.removeFromTipBucket(txID)
 
 		inputs := tx.Unsigned.InputIDs()
 		m.consumedUTXOs.Difference(inputs)
@@ -187,17 +220,38 @@ func (m *mempool) Remove(txsToRemove []*txs.Tx) {
 }
 
 func (m *mempool) Peek(maxTxSize int) *txs.Tx {
-	txIter := m.unissuedTxs.NewIterator()
-	for txIter.Next() {
-		tx := txIter.Value()
-		txSize := len(tx.Bytes())
-		if txSize <= maxTxSize {
-			return tx
+	for _, tip := range m.sortedTips {
+		txIter := m.tipBuckets[tip].NewIterator()
+		for txIter.Next() {
+			tx := txIter.Value()
+			txSize := len(tx.Bytes())
+			if txSize <= maxTxSize {
+				return tx
+			}
 		}
 	}
 	return nil
 }
 
+func (m *mempool) Tips() []uint64 {
+	tips := make([]uint64, 0, len(m.txTips))
+	for _, tip := range m.sortedTips {
+		for i := 0; i < m.tipBuckets[tip].Len(); i++ {
+			tips = append(tips, tip)
+		}
+	}
+	return tips
+}
+
+func (m *mempool) List() []*txs.Tx {
+	txs := make([]*txs.Tx, 0, m.unissuedTxs.Len())
+	txIter := m.unissuedTxs.NewIterator()
+	for txIter.Next() {
+		txs = append(txs, txIter.Value())
+	}
+	return txs
+}
+
 func (m *mempool) RequestBuildBlock() {
 	if m.unissuedTxs.Len() == 0 {
 		return
@@ -217,3 +271,47 @@ func (m *mempool) GetDropReason(txID ids.ID) error {
 	err, _ := m.droppedTxIDs.Get(txID)
 	return err
 }
+
+// tip returns the amount of the fee asset that [tx] burns in excess of its
+// required fee.
+func (m *mempool) tip(tx *txs.Tx) uint64 {
+	requiredFee := m.config.TxFee
+	if _, ok := tx.Unsigned.(*txs.CreateAssetTx); ok {
+		requiredFee = m.config.CreateAssetTxFee
+	}
+	return tx.Tip(m.feeAssetID, requiredFee)
+}
+
+func (m *mempool) addToTipBucket(txID ids.ID, tx *txs.Tx) {
+	tip := m.tip(tx)
+	m.txTips[txID] = tip
+
+	bucket, ok := m.tipBuckets[tip]
+	if !ok {
+		bucket = linkedhashmap.New[ids.ID, *txs.Tx]()
+		m.tipBuckets[tip] = bucket
+
+		i := sort.Search(len(m.sortedTips), func(i int) bool {
+			return m.sortedTips[i] < tip
+		})
+		m.sortedTips = slices.Insert(m.sortedTips, i, tip)
+	}
+	bucket.Put(txID, tx)
+}
+
+func (m *mempool) removeFromTipBucket(txID ids.ID) {
+	tip := m.txTips[txID]
+	delete(m.txTips, txID)
+
+	bucket := m.tipBuckets[tip]
+	bucket.Delete(txID)
+	if bucket.Len() > 0 {
+		return
+	}
+
+	delete(m.tipBuckets, tip)
+	i := sort.Search(len(m.sortedTips), func(i int) bool {
+		return m.sortedTips[i] <= tip
+	})
+	m.sortedTips = slices.Delete(m.sortedTips, i, i+1)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/vms/alpha/config"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
@@ -33,7 +34,7 @@ func TestBlockBuilderMaxMempoolSizeHandling(t *testing.T) {
 	require := require.New(t)
 
 	registerer := prometheus.NewRegistry()
-	mempoolIntf, err := New("mempool", registerer, nil)
+	mempoolIntf, err := New("mempool", registerer, nil, assetID, config.Config{})
 	require.NoError(err)
 
 	mempool := mempoolIntf.(*mempool)
@@ -58,7 +59,7 @@ func TestTxsInMempool(t *testing.T) {
 
 	registerer := prometheus.NewRegistry()
 	toEngine := make(chan common.Message, 100)
-	mempool, err := New("mempool", registerer, toEngine)
+	mempool, err := New("mempool", registerer, toEngine, assetID, config.Config{})
 	require.NoError(err)
 
 	testTxs := createTestTxs(2)
@@ -115,6 +116,70 @@ func TestTxsInMempool(t *testing.T) {
 	}
 }
 
+func TestPeekOrdersByTip(t *testing.T) {
+	require := require.New(t)
+
+	registerer := prometheus.NewRegistry()
+	mempool, err := New("mempool", registerer, nil, assetID, config.Config{
+		TxFee: 1000,
+	})
+	require.NoError(err)
+
+	var (
+		noTip      = createTestBaseTx(0, 1000)
+		smallTip   = createTestBaseTx(1, 1500)
+		largeTip   = createTestBaseTx(2, 3000)
+		otherNoTip = createTestBaseTx(3, 1000)
+	)
+	for _, tx := range []*txs.Tx{noTip, smallTip, otherNoTip, largeTip} {
+		require.NoError(mempool.Add(tx))
+	}
+	require.Equal([]uint64{2000, 500, 0, 0}, mempool.Tips())
+
+	// Txs should be returned in order of decreasing tip, with ties broken by
+	// insertion order.
+	for _, expectedTx := range []*txs.Tx{largeTip, smallTip, noTip, otherNoTip} {
+		tx := mempool.Peek(MaxTxSize)
+		require.Equal(expectedTx, tx)
+		mempool.Remove([]*txs.Tx{tx})
+	}
+	require.Nil(mempool.Peek(MaxTxSize))
+	require.Empty(mempool.Tips())
+}
+
+func createTestBaseTx(index uint32, burned uint64) *txs.Tx {
+	addr := keys[0].PublicKey().Address()
+	tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: dione.BaseTx{
+		NetworkID:    constants.UnitTestID,
+		BlockchainID: chainID,
+		Ins: []*dione.TransferableInput{{
+			UTXOID: dione.UTXOID{
+				TxID:        ids.ID{'t', 'x', 'I', 'D'},
+				OutputIndex: index,
+			},
+			Asset: dione.Asset{ID: assetID},
+			In: &secp256k1fx.TransferInput{
+				Amt: 10000 + burned,
+				Input: secp256k1fx.Input{
+					SigIndices: []uint32{0},
+				},
+			},
+		}},
+		Outs: []*dione.TransferableOutput{{
+			Asset: dione.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: 10000,
+				OutputOwners: secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{addr},
+				},
+			},
+		}},
+	}}}
+	tx.SetBytes(utils.RandomBytes(16), utils.RandomBytes(16))
+	return tx
+}
+
 func createTestTxs(count int) []*txs.Tx {
 	testTxs := make([]*txs.Tx, 0, count)
 	addr := keys[0].PublicKey().Address()
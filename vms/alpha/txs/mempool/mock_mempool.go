@@ -94,6 +94,20 @@ func (mr *MockMempoolMockRecorder) Has(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Has", reflect.TypeOf((*MockMempool)(nil).Has), arg0)
 }
 
+// List mocks base method.
+func (m *MockMempool) List() []*txs.Tx {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]*txs.Tx)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockMempoolMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMempool)(nil).List))
+}
+
 // MarkDropped mocks base method.
 func (m *MockMempool) MarkDropped(arg0 ids.ID, arg1 error) {
 	m.ctrl.T.Helper()
@@ -143,3 +157,17 @@ func (mr *MockMempoolMockRecorder) RequestBuildBlock() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestBuildBlock", reflect.TypeOf((*MockMempool)(nil).RequestBuildBlock))
 }
+
+// Tips mocks base method.
+func (m *MockMempool) Tips() []uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tips")
+	ret0, _ := ret[0].([]uint64)
+	return ret0
+}
+
+// Tips indicates an expected call of Tips.
+func (mr *MockMempoolMockRecorder) Tips() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tips", reflect.TypeOf((*MockMempool)(nil).Tips))
+}
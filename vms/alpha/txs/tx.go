@@ -98,6 +98,19 @@ func (t *Tx) Burned(assetId ids.ID) uint64 {
 	return b.burned
 }
 
+// Tip returns the amount of [feeAssetID] burned in excess of [requiredFee].
+// Burning more than the required fee allows a tx to be prioritized.
+func (t *Tx) Tip(feeAssetID ids.ID, requiredFee uint64) uint64 {
+	burned := t.Burned(feeAssetID)
+	if burned <= requiredFee {
+		return 0
+	}
+	return burned - requiredFee
+}
+
+// SignSECP256K1Fx adds a credential signed by [signers][i] for the i-th
+// input of the tx. A nil key leaves its signature empty so that it can be
+// provided later by another signer.
 func (t *Tx) SignSECP256K1Fx(c codec.Manager, signers [][]*secp256k1.PrivateKey) error {
 	unsignedBytes, err := c.Marshal(CodecVersion, &t.Unsigned)
 	if err != nil {
@@ -110,6 +123,9 @@ func (t *Tx) SignSECP256K1Fx(c codec.Manager, signers [][]*secp256k1.PrivateKey)
 			Sigs: make([][secp256k1.SignatureLen]byte, len(keys)),
 		}
 		for i, key := range keys {
+			if key == nil {
+				continue
+			}
 			sig, err := key.SignHash(hash)
 			if err != nil {
 				return fmt.Errorf("problem creating transaction: %w", err)
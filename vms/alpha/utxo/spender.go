@@ -15,6 +15,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/nftfx"
+	"github.com/DioneProtocol/odysseygo/vms/propertyfx"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 )
 
@@ -45,6 +46,21 @@ type Spender interface {
 		error,
 	)
 
+	// SpendPartial is like Spend, but also spends UTXOs that [kc] can only
+	// partially sign, which are used after the UTXOs [kc] can fully sign. The
+	// signers of the signatures that must be provided by the co-owners of the
+	// UTXOs are nil.
+	SpendPartial(
+		utxos []*dione.UTXO,
+		kc *secp256k1fx.Keychain,
+		amounts map[ids.ID]uint64,
+	) (
+		map[ids.ID]uint64, // amountsSpent
+		[]*dione.TransferableInput, // inputs
+		[][]*secp256k1.PrivateKey, // signers
+		error,
+	)
+
 	SpendNFT(
 		utxos []*dione.UTXO,
 		kc *secp256k1fx.Keychain,
@@ -78,19 +94,64 @@ type Spender interface {
 		error,
 	)
 
+	// MintPartial is like Mint, but also uses mint outputs that [kc] can only
+	// partially sign. The signers of the signatures that must be provided by
+	// the co-owners of the mint outputs are nil.
+	MintPartial(
+		utxos []*dione.UTXO,
+		kc *secp256k1fx.Keychain,
+		amounts map[ids.ID]uint64,
+		to ids.ShortID,
+	) (
+		[]*txs.Operation,
+		[][]*secp256k1.PrivateKey,
+		error,
+	)
+
+	// MintNFT mints an NFT of [assetID] with [payloads][i] to [to][i] for
+	// each i. Every NFT consumes a different mint output of the asset.
 	MintNFT(
 		utxos []*dione.UTXO,
 		kc *secp256k1fx.Keychain,
 		assetID ids.ID,
-		payload []byte,
+		payloads [][]byte,
+		to []ids.ShortID,
+	) (
+		[]*txs.Operation,
+		[][]*secp256k1.PrivateKey,
+		error,
+	)
+
+	// MintProperty mints the property [assetID] to [to]. The consumed mint
+	// output is recreated so that the property can be minted again.
+	MintProperty(
+		utxos []*dione.UTXO,
+		kc *secp256k1fx.Keychain,
+		assetID ids.ID,
 		to ids.ShortID,
 	) (
 		[]*txs.Operation,
 		[][]*secp256k1.PrivateKey,
 		error,
 	)
+
+	// SpendProperty burns every output of the property [assetID] that [kc]
+	// can spend.
+	SpendProperty(
+		utxos []*dione.UTXO,
+		kc *secp256k1fx.Keychain,
+		assetID ids.ID,
+	) (
+		[]*txs.Operation,
+		[][]*secp256k1.PrivateKey,
+		error,
+	)
 }
 
+// matcher returns the signature indices and keys used to spend an output
+// owned by [owners] at [time], and whether the output can be spent.
+type matcher func(owners *secp256k1fx.OutputOwners, time uint64) ([]uint32, []*secp256k1.PrivateKey, bool)
+
 func NewSpender(
 	clk *mockable.Clock,
 	codec codec.Manager,
@@ -115,6 +176,48 @@ func (s *spender) Spend(
 	[]*dione.TransferableInput, // inputs
 	[][]*secp256k1.PrivateKey, // signers
 	error,
+) {
+	return s.spend(utxos, kc.Match, amounts)
+}
+
+func (s *spender) SpendPartial(
+	utxos []*dione.UTXO,
+	kc *secp256k1fx.Keychain,
+	amounts map[ids.ID]uint64,
+) (
+	map[ids.ID]uint64, // amountsSpent
+	[]*dione.TransferableInput, // inputs
+	[][]*secp256k1.PrivateKey, // signers
+	error,
+) {
+	// Prefer the UTXOs that don't require any co-signers
+	time := s.clock.Unix()
+	sortedUTXOs := make([]*dione.UTXO, 0, len(utxos))
+	partialUTXOs := []*dione.UTXO{}
+	for _, utxo := range utxos {
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			continue
+		}
+		if _, _, ok := kc.Match(&out.OutputOwners, time); ok {
+			sortedUTXOs = append(sortedUTXOs, utxo)
+		} else {
+			partialUTXOs = append(partialUTXOs, utxo)
+		}
+	}
+	sortedUTXOs = append(sortedUTXOs, partialUTXOs...)
+	return s.spend(sortedUTXOs, kc.MatchPartial, amounts)
+}
+
+func (s *spender) spend(
+	utxos []*dione.UTXO,
+	match matcher,
+	amounts map[ids.ID]uint64,
+) (
+	map[ids.ID]uint64, // amountsSpent
+	[]*dione.TransferableInput, // inputs
+	[][]*secp256k1.PrivateKey, // signers
+	error,
 ) {
 	amountsSpent := make(map[ids.ID]uint64, len(amounts))
 	time := s.clock.Unix()
@@ -131,16 +234,22 @@ func (s *spender) Spend(
 			continue
 		}
 
-		inputIntf, signers, err := kc.Spend(utxo.Out, time)
-		if err != nil {
-			// this utxo can't be spent with the current keys right now
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			// this output doesn't have an amount, so I don't care about it here
 			continue
 		}
-		input, ok := inputIntf.(dione.TransferableIn)
+		sigIndices, signers, ok := match(&out.OutputOwners, time)
 		if !ok {
-			// this input doesn't have an amount, so I don't care about it here
+			// this utxo can't be spent with the current keys right now
 			continue
 		}
+		input := &secp256k1fx.TransferInput{
+			Amt: out.Amt,
+			Input: secp256k1fx.Input{
+				SigIndices: sigIndices,
+			},
+		}
 		newAmountSpent, err := math.Add64(amountSpent, input.Amount())
 		if err != nil {
 			// there was an error calculating the consumed amount, just error
@@ -304,6 +413,32 @@ func (s *spender) Mint(
 	[]*txs.Operation,
 	[][]*secp256k1.PrivateKey,
 	error,
+) {
+	return s.mint(utxos, kc.Match, amounts, to)
+}
+
+func (s *spender) MintPartial(
+	utxos []*dione.UTXO,
+	kc *secp256k1fx.Keychain,
+	amounts map[ids.ID]uint64,
+	to ids.ShortID,
+) (
+	[]*txs.Operation,
+	[][]*secp256k1.PrivateKey,
+	error,
+) {
+	return s.mint(utxos, kc.MatchPartial, amounts, to)
+}
+
+func (s *spender) mint(
+	utxos []*dione.UTXO,
+	match matcher,
+	amounts map[ids.ID]uint64,
+	to ids.ShortID,
+) (
+	[]*txs.Operation,
+	[][]*secp256k1.PrivateKey,
+	error,
 ) {
 	time := s.clock.Unix()
 
@@ -325,12 +460,7 @@ func (s *spender) Mint(
 			continue
 		}
 
-		inIntf, signers, err := kc.Spend(out, time)
-		if err != nil {
-			continue
-		}
-
-		in, ok := inIntf.(*secp256k1fx.Input)
+		sigIndices, signers, ok := match(&out.OutputOwners, time)
 		if !ok {
 			continue
 		}
@@ -340,7 +470,9 @@ func (s *spender) Mint(
 			Asset:   utxo.Asset,
 			UTXOIDs: []*dione.UTXOID{&utxo.UTXOID},
 			Op: &secp256k1fx.MintOperation{
-				MintInput:  *in,
+				MintInput: secp256k1fx.Input{
+					SigIndices: sigIndices,
+				},
 				MintOutput: *out,
 				TransferOutput: secp256k1fx.TransferOutput{
 					Amt: amount,
@@ -372,8 +504,8 @@ func (s *spender) MintNFT(
 	utxos []*dione.UTXO,
 	kc *secp256k1fx.Keychain,
 	assetID ids.ID,
-	payload []byte,
-	to ids.ShortID,
+	payloads [][]byte,
+	to []ids.ShortID,
 ) (
 	[]*txs.Operation,
 	[][]*secp256k1.PrivateKey,
@@ -388,8 +520,8 @@ func (s *spender) MintNFT(
 		// makes sure that the variable isn't overwritten with the next iteration
 		utxo := utxo
 
-		if len(ops) > 0 {
-			// we have already been able to create the operation needed
+		if len(ops) == len(payloads) {
+			// we have already been able to create the operations needed
 			break
 		}
 
@@ -410,6 +542,7 @@ func (s *spender) MintNFT(
 		}
 
 		// add the operation to the array
+		i := len(ops)
 		ops = append(ops, &txs.Operation{
 			Asset: dione.Asset{ID: assetID},
 			UTXOIDs: []*dione.UTXOID{
@@ -420,10 +553,10 @@ func (s *spender) MintNFT(
 					SigIndices: indices,
 				},
 				GroupID: out.GroupID,
-				Payload: payload,
+				Payload: payloads[i],
 				Outputs: []*secp256k1fx.OutputOwners{{
 					Threshold: 1,
-					Addrs:     []ids.ShortID{to},
+					Addrs:     []ids.ShortID{to[i]},
 				}},
 			},
 		})
@@ -431,10 +564,122 @@ func (s *spender) MintNFT(
 		keys = append(keys, signers)
 	}
 
-	if len(ops) == 0 {
+	if len(ops) == 0 || len(ops) < len(payloads) {
 		return nil, nil, errAddressesCantMintAsset
 	}
 
 	txs.SortOperationsWithSigners(ops, keys, s.codec)
 	return ops, keys, nil
 }
+
+func (s *spender) MintProperty(
+	utxos []*dione.UTXO,
+	kc *secp256k1fx.Keychain,
+	assetID ids.ID,
+	to ids.ShortID,
+) (
+	[]*txs.Operation,
+	[][]*secp256k1.PrivateKey,
+	error,
+) {
+	time := s.clock.Unix()
+
+	for _, utxo := range utxos {
+		if utxo.AssetID() != assetID {
+			// wrong asset id
+			continue
+		}
+		out, ok := utxo.Out.(*propertyfx.MintOutput)
+		if !ok {
+			// wrong output type
+			continue
+		}
+
+		indices, signers, ok := kc.Match(&out.OutputOwners, time)
+		if !ok {
+			// unable to spend the output
+			continue
+		}
+
+		ops := []*txs.Operation{{
+			Asset: dione.Asset{ID: assetID},
+			UTXOIDs: []*dione.UTXOID{
+				&utxo.UTXOID,
+			},
+			Op: &propertyfx.MintOperation{
+				MintInput: secp256k1fx.Input{
+					SigIndices: indices,
+				},
+				MintOutput: propertyfx.MintOutput{
+					OutputOwners: out.OutputOwners,
+				},
+				OwnedOutput: propertyfx.OwnedOutput{
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{to},
+					},
+				},
+			},
+		}}
+		return ops, [][]*secp256k1.PrivateKey{signers}, nil
+	}
+	return nil, nil, errAddressesCantMintAsset
+}
+
+func (s *spender) SpendProperty(
+	utxos []*dione.UTXO,
+	kc *secp256k1fx.Keychain,
+	assetID ids.ID,
+) (
+	[]*txs.Operation,
+	[][]*secp256k1.PrivateKey,
+	error,
+) {
+	time := s.clock.Unix()
+
+	ops := []*txs.Operation{}
+	keys := [][]*secp256k1.PrivateKey{}
+
+	for _, utxo := range utxos {
+		// makes sure that the variable isn't overwritten with the next iteration
+		utxo := utxo
+
+		if utxo.AssetID() != assetID {
+			// wrong asset id
+			continue
+		}
+		out, ok := utxo.Out.(*propertyfx.OwnedOutput)
+		if !ok {
+			// wrong output type
+			continue
+		}
+
+		indices, signers, ok := kc.Match(&out.OutputOwners, time)
+		if !ok {
+			// unable to spend the output
+			continue
+		}
+
+		// add the operation to the array
+		ops = append(ops, &txs.Operation{
+			Asset: dione.Asset{ID: assetID},
+			UTXOIDs: []*dione.UTXOID{
+				&utxo.UTXOID,
+			},
+			Op: &propertyfx.BurnOperation{
+				Input: secp256k1fx.Input{
+					SigIndices: indices,
+				},
+			},
+		})
+		// add the required keys to the array
+		keys = append(keys, signers)
+	}
+
+	if len(ops) == 0 {
+		return nil, nil, errInsufficientFunds
+	}
+
+	txs.SortOperationsWithSigners(ops, keys, s.codec)
+	return ops, keys, nil
+}
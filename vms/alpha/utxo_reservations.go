@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package alpha
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+)
+
+const (
+	// defaultReservationTTL is how long UTXOs are reserved for if no TTL is
+	// provided
+	defaultReservationTTL = time.Minute
+	maxReservationTTL     = 10 * time.Minute
+
+	// maxReservedUTXOs is the maximum number of UTXOs that a user can have
+	// reserved at once, across all of its sessions
+	maxReservedUTXOs = 16 * 1024
+)
+
+var (
+	errNoSession            = errors.New("no session provided")
+	errNoUTXOs              = errors.New("no UTXOs provided")
+	errUTXOReserved         = errors.New("UTXO is reserved by another session")
+	errUTXONotOwned         = errors.New("UTXO isn't spendable by the user")
+	errTooManyReservedUTXOs = fmt.Errorf("can't reserve more than %d UTXOs", maxReservedUTXOs)
+)
+
+type utxoReservation struct {
+	user    string
+	session string
+	expiry  time.Time
+}
+
+// utxoReservations tracks the UTXOs that the sessions of a user have reserved
+// for txs they are building. Reserved UTXOs aren't spent by the txs the node
+// builds for that user, and can't be reserved by other sessions, until the
+// reservation is released or expires.
+type utxoReservations struct {
+	// UTXO ID -> reservation
+	reservations map[ids.ID]utxoReservation
+	// user -> number of UTXOs reserved by the user
+	numReserved map[string]int
+}
+
+func newUTXOReservations() *utxoReservations {
+	return &utxoReservations{
+		reservations: make(map[ids.ID]utxoReservation),
+		numReserved:  make(map[string]int),
+	}
+}
+
+// Reserve reserves [utxoIDs] for [session] of [user] until [expiry]. UTXOs
+// that are already reserved by the session have their reservation extended.
+// If any of the UTXOs is reserved by another session, none of the UTXOs are
+// reserved.
+//
+// Invariant: Assumes [user] is able to spend [utxoIDs].
+func (r *utxoReservations) Reserve(user, session string, utxoIDs []ids.ID, expiry time.Time, now time.Time) error {
+	r.prune(now)
+
+	newUTXOIDs := set.Set[ids.ID]{}
+	for _, utxoID := range utxoIDs {
+		reservation, ok := r.reservations[utxoID]
+		if !ok {
+			newUTXOIDs.Add(utxoID)
+			continue
+		}
+		if reservation.user != user || reservation.session != session {
+			return fmt.Errorf("%w: %s", errUTXOReserved, utxoID)
+		}
+	}
+	if r.numReserved[user]+newUTXOIDs.Len() > maxReservedUTXOs {
+		return errTooManyReservedUTXOs
+	}
+
+	for _, utxoID := range utxoIDs {
+		r.reservations[utxoID] = utxoReservation{
+			user:    user,
+			session: session,
+			expiry:  expiry,
+		}
+	}
+	r.numReserved[user] += newUTXOIDs.Len()
+	return nil
+}
+
+// Release releases the reservations of [utxoIDs] that are held by [session] of
+// [user].
+func (r *utxoReservations) Release(user, session string, utxoIDs []ids.ID) {
+	for _, utxoID := range utxoIDs {
+		if reservation, ok := r.reservations[utxoID]; ok && reservation.user == user && reservation.session == session {
+			r.remove(utxoID, reservation)
+		}
+	}
+}
+
+// Unreserved returns the UTXOs in [utxos] that aren't reserved by any session
+// of [user].
+func (r *utxoReservations) Unreserved(user string, utxos []*dione.UTXO, now time.Time) []*dione.UTXO {
+	r.prune(now)
+	if r.numReserved[user] == 0 {
+		return utxos
+	}
+
+	unreserved := make([]*dione.UTXO, 0, len(utxos))
+	for _, utxo := range utxos {
+		if reservation, ok := r.reservations[utxo.InputID()]; !ok || reservation.user != user {
+			unreserved = append(unreserved, utxo)
+		}
+	}
+	return unreserved
+}
+
+// prune removes the reservations that expired before [now].
+func (r *utxoReservations) prune(now time.Time) {
+	for utxoID, reservation := range r.reservations {
+		if now.After(reservation.expiry) {
+			r.remove(utxoID, reservation)
+		}
+	}
+}
+
+func (r *utxoReservations) remove(utxoID ids.ID, reservation utxoReservation) {
+	delete(r.reservations, utxoID)
+	r.numReserved[reservation.user]--
+	if r.numReserved[reservation.user] == 0 {
+		delete(r.numReserved, reservation.user)
+	}
+}
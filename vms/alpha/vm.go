@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	stdjson "encoding/json"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/cache"
 	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
 	"github.com/DioneProtocol/odysseygo/database/versiondb"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/pubsub"
@@ -42,6 +44,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/txs/mempool"
 	"github.com/DioneProtocol/odysseygo/vms/alpha/utxo"
+	"github.com/DioneProtocol/odysseygo/vms/components/acceptance"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/index"
 	"github.com/DioneProtocol/odysseygo/vms/components/keystore"
@@ -61,6 +64,9 @@ var (
 	errGenesisAssetMustHaveState = errors.New("genesis asset must have non-empty state")
 	errBootstrapping             = errors.New("chain is currently bootstrapping")
 
+	acceptTimesPrefix = []byte("acceptTimes")
+	mempoolPrefix     = []byte("mempool")
+
 	_ vertex.LinearizableVMWithEngine = (*VM)(nil)
 )
 
@@ -110,14 +116,40 @@ type VM struct {
 
 	walletService WalletService
 
+	// UTXOs reserved by client sessions, which aren't spent by the keystore
+	// APIs
+	utxoReservations *utxoReservations
+
+	// Keys held in memory for client sessions, which are used in place of
+	// keystore users
+	keySessions *keySessions
+
 	addressTxsIndexer index.AddressTxsIndexer
+	analyticsEnabled  bool
+	adminAPIEnabled   bool
+
+	// Alias --> ID of the asset it was given to by the node operator
+	assetAliases database.Database
 
 	txBackend *txexecutor.Backend
 
+	// Persists the mempool across restarts
+	mempoolDB database.Database
+	// Txs read from [mempoolDB] that haven't been re-issued yet
+	persistedTxs []*txs.Tx
+	// How often the txs in the mempool are re-gossiped. A non-positive value
+	// disables re-gossiping.
+	mempoolRegossipFrequency time.Duration
+
+	// Cancels the background tasks started in Linearize
+	onShutdown func()
+
 	// These values are only initialized after the chain has been linearized.
 	blockbuilder.Builder
 	chainManager blockexecutor.Manager
 	network      network.Network
+	acceptTimes  acceptance.Tracker
+	mempool      mempool.Mempool
 }
 
 func (*VM) Connected(context.Context, ids.NodeID, *version.Application) error {
@@ -138,6 +170,15 @@ type Config struct {
 	IndexTransactions    bool `json:"index-transactions"`
 	IndexAllowIncomplete bool `json:"index-allow-incomplete"`
 	ChecksumsEnabled     bool `json:"checksums-enabled"`
+	// AnalyticsEnabled exposes the analytics API, which requires
+	// [IndexTransactions].
+	AnalyticsEnabled bool `json:"analytics-enabled"`
+	// AdminAPIEnabled exposes the APIs that modify the node's local view of
+	// the chain, such as asset aliases.
+	AdminAPIEnabled bool `json:"admin-api-enabled"`
+	// MempoolRegossipFrequency is how often the txs in the mempool are
+	// re-gossiped to the network. A non-positive value disables re-gossiping.
+	MempoolRegossipFrequency time.Duration `json:"mempool-regossip-frequency"`
 }
 
 func (vm *VM) Initialize(
@@ -154,7 +195,9 @@ func (vm *VM) Initialize(
 	noopMessageHandler := common.NewNoOpAppHandler(ctx.Log)
 	vm.Atomic = network.NewAtomic(noopMessageHandler)
 
-	alphaConfig := Config{}
+	alphaConfig := Config{
+		MempoolRegossipFrequency: defaultMempoolRegossipFrequency,
+	}
 	if len(configBytes) > 0 {
 		if err := stdjson.Unmarshal(configBytes, &alphaConfig); err != nil {
 			return err
@@ -220,6 +263,8 @@ func (vm *VM) Initialize(
 	codec := vm.parser.Codec()
 	vm.AtomicUTXOManager = dione.NewAtomicUTXOManager(ctx.SharedMemory, codec)
 	vm.Spender = utxo.NewSpender(&vm.clock, codec)
+	vm.utxoReservations = newUTXOReservations()
+	vm.keySessions = newKeySessions()
 
 	state, err := states.New(
 		vm.db,
@@ -236,10 +281,25 @@ func (vm *VM) Initialize(
 	if err := vm.initGenesis(genesisBytes); err != nil {
 		return err
 	}
+	if err := vm.initAssetAliases(); err != nil {
+		return fmt.Errorf("failed to initialize asset aliases: %w", err)
+	}
+	vm.adminAPIEnabled = alphaConfig.AdminAPIEnabled
+	vm.mempoolRegossipFrequency = alphaConfig.MempoolRegossipFrequency
+
+	vm.mempoolDB = prefixdb.New(mempoolPrefix, vm.baseDB)
+	if err := vm.loadPersistedMempool(); err != nil {
+		return fmt.Errorf("failed to load persisted mempool: %w", err)
+	}
 
 	vm.walletService.vm = vm
 	vm.walletService.pendingTxs = linkedhashmap.New[ids.ID, *txs.Tx]()
 
+	if alphaConfig.AnalyticsEnabled && !alphaConfig.IndexTransactions {
+		return errAnalyticsRequiresIndexing
+	}
+	vm.analyticsEnabled = alphaConfig.AnalyticsEnabled
+
 	// use no op impl when disabled in config
 	if alphaConfig.IndexTransactions {
 		vm.ctx.Log.Warn("deprecated address transaction indexing is enabled")
@@ -288,6 +348,9 @@ func (vm *VM) onNormalOperationsStarted() error {
 	}
 
 	vm.bootstrapped = true
+	if vm.network != nil {
+		vm.reissuePersistedMempool()
+	}
 	return nil
 }
 
@@ -307,6 +370,18 @@ func (vm *VM) Shutdown(context.Context) error {
 		return nil
 	}
 
+	if vm.onShutdown != nil {
+		vm.onShutdown()
+	}
+
+	// If the persisted txs were never re-issued, the mempool doesn't contain
+	// them, so they are left on disk for the next restart.
+	if vm.mempool != nil && len(vm.persistedTxs) == 0 {
+		if err := vm.persistMempool(); err != nil {
+			return err
+		}
+	}
+
 	errs := wrappers.Errs{}
 	errs.Add(
 		vm.state.Close(),
@@ -338,13 +413,28 @@ func (vm *VM) CreateHandlers(context.Context) (map[string]*common.HTTPHandler, e
 	walletServer.RegisterInterceptFunc(vm.metrics.InterceptRequest)
 	walletServer.RegisterAfterFunc(vm.metrics.AfterRequest)
 	// name this service "wallet"
-	err := walletServer.RegisterService(&vm.walletService, "wallet")
+	if err := walletServer.RegisterService(&vm.walletService, "wallet"); err != nil {
+		return nil, err
+	}
 
-	return map[string]*common.HTTPHandler{
+	handlers := map[string]*common.HTTPHandler{
 		"":        {Handler: rpcServer},
 		"/wallet": {Handler: walletServer},
 		"/events": {LockOptions: common.NoLock, Handler: vm.pubsub},
-	}, err
+	}
+	if !vm.analyticsEnabled {
+		return handlers, nil
+	}
+
+	analyticsServer := rpc.NewServer()
+	analyticsServer.RegisterCodec(codec, "application/json")
+	analyticsServer.RegisterCodec(codec, "application/json;charset=UTF-8")
+	analyticsServer.RegisterInterceptFunc(vm.metrics.InterceptRequest)
+	analyticsServer.RegisterAfterFunc(vm.metrics.AfterRequest)
+	// name this service "analytics"
+	err := analyticsServer.RegisterService(&AnalyticsService{vm: vm}, "analytics")
+	handlers["/analytics"] = &common.HTTPHandler{Handler: analyticsServer}
+	return handlers, err
 }
 
 func (*VM) CreateStaticHandlers(context.Context) (map[string]*common.HTTPHandler, error) {
@@ -408,10 +498,20 @@ func (vm *VM) Linearize(_ context.Context, stopVertexID ids.ID, toEngine chan<-
 		return err
 	}
 
-	mempool, err := mempool.New("mempool", vm.registerer, toEngine)
+	mempool, err := mempool.New("mempool", vm.registerer, toEngine, vm.feeAssetID, vm.Config)
 	if err != nil {
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
+	vm.mempool = mempool
+
+	vm.acceptTimes, err = acceptance.NewTracker(
+		prefixdb.New(acceptTimesPrefix, vm.db),
+		"",
+		vm.registerer,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create accept times tracker: %w", err)
+	}
 
 	vm.chainManager = blockexecutor.NewManager(
 		mempool,
@@ -420,6 +520,7 @@ func (vm *VM) Linearize(_ context.Context, stopVertexID ids.ID, toEngine chan<-
 		vm.txBackend,
 		&vm.clock,
 		vm.onAccept,
+		vm.acceptTimes,
 	)
 
 	vm.Builder = blockbuilder.New(
@@ -442,6 +543,16 @@ func (vm *VM) Linearize(_ context.Context, stopVertexID ids.ID, toEngine chan<-
 	// handled asynchronously.
 	vm.Atomic.Set(vm.network)
 
+	if vm.bootstrapped {
+		vm.reissuePersistedMempool()
+	}
+
+	backgroundCtx, cancel := context.WithCancel(context.Background())
+	vm.onShutdown = cancel
+	if vm.mempoolRegossipFrequency > 0 {
+		go vm.runMempoolRegossip(backgroundCtx, vm.mempoolRegossipFrequency)
+	}
+
 	go func() {
 		err := vm.state.Prune(&vm.ctx.Lock, vm.ctx.Log)
 		if err != nil {
@@ -497,15 +608,25 @@ func (vm *VM) IssueTx(b []byte) (ids.ID, error) {
 		return ids.ID{}, err
 	}
 
-	err = vm.network.IssueTx(context.TODO(), tx)
+	if err := vm.issueTx(tx); err != nil {
+		return ids.ID{}, err
+	}
+	return tx.ID(), nil
+}
+
+// issueTx attempts to send the parsed transaction [tx] to consensus.
+func (vm *VM) issueTx(tx *txs.Tx) error {
+	if !vm.bootstrapped || vm.Builder == nil {
+		return errBootstrapping
+	}
+
+	err := vm.network.IssueTx(context.TODO(), tx)
 	if err != nil {
 		vm.ctx.Log.Debug("failed to add tx to mempool",
 			zap.Error(err),
 		)
-		return ids.ID{}, err
 	}
-
-	return tx.ID(), nil
+	return err
 }
 
 /*
@@ -581,6 +702,8 @@ func (vm *VM) initState(tx *txs.Tx) {
 // 2) A keychain that contains this user's keys
 // If [addrsToUse] has positive length, returns UTXOs that reference one or more
 // addresses controlled by the given user that are also in [addrsToUse].
+// UTXOs that are reserved by a client session of the user aren't returned.
+// [username] and [password] may also be the credentials of a key session.
 func (vm *VM) LoadUser(
 	username string,
 	password string,
@@ -590,25 +713,45 @@ func (vm *VM) LoadUser(
 	*secp256k1fx.Keychain,
 	error,
 ) {
-	user, err := keystore.NewUserFromKeystore(vm.ctx.Keystore, username, password)
+	kc, err := vm.loadKeychain(username, password, addrsToUse)
 	if err != nil {
 		return nil, nil, err
 	}
-	// Drop any potential error closing the database to report the original
-	// error
-	defer user.Close()
 
-	kc, err := keystore.GetKeychain(user, addrsToUse)
+	utxos, err := dione.GetAllUTXOs(vm.state, kc.Addresses())
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, fmt.Errorf("problem retrieving user's UTXOs: %w", err)
 	}
+	utxos = vm.utxoReservations.Unreserved(username, utxos, vm.clock.Time())
 
-	utxos, err := dione.GetAllUTXOs(vm.state, kc.Addresses())
+	return utxos, kc, nil
+}
+
+// loadKeychain returns the keys of the given key session or keystore user
+// that control [addrsToUse], or all of its keys if [addrsToUse] is empty.
+func (vm *VM) loadKeychain(
+	username string,
+	password string,
+	addrsToUse set.Set[ids.ShortID],
+) (*secp256k1fx.Keychain, error) {
+	kc, ok, err := vm.keySessions.Keychain(username, password, addrsToUse, vm.clock.Time())
+	if ok {
+		return kc, err
+	}
+
+	user, err := keystore.NewUserFromKeystore(vm.ctx.Keystore, username, password)
 	if err != nil {
-		return nil, nil, fmt.Errorf("problem retrieving user's UTXOs: %w", err)
+		return nil, err
 	}
+	// Drop any potential error closing the database to report the original
+	// error
+	defer user.Close()
 
-	return utxos, kc, user.Close()
+	kc, err = keystore.GetKeychain(user, addrsToUse)
+	if err != nil {
+		return nil, err
+	}
+	return kc, user.Close()
 }
 
 // selectChangeAddr returns the change address to be used for [kc] when [changeAddr] is given
@@ -636,6 +779,16 @@ func (vm *VM) lookupAssetID(asset string) (ids.ID, error) {
 	return ids.ID{}, fmt.Errorf("asset '%s' not found", asset)
 }
 
+// getFxIndex returns the index of the fx [fxID] in this VM
+func (vm *VM) getFxIndex(fxID ids.ID) (uint32, error) {
+	for i, fx := range vm.fxs {
+		if fx.ID == fxID {
+			return uint32(i), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", errUnknownFx, fxID)
+}
+
 // Invariant: onAccept is called when [tx] is being marked as accepted, but
 // before its state changes are applied.
 // Invariant: any error returned by onAccept should be considered fatal.
@@ -674,7 +827,7 @@ func (vm *VM) onAccept(tx *txs.Tx) error {
 		return fmt.Errorf("error indexing tx: %w", err)
 	}
 
-	vm.pubsub.Publish(NewPubSubFilterer(tx))
+	vm.pubsub.Publish(NewPubSubFilterer(tx, inputUTXOs))
 	vm.walletService.decided(txID)
 	return nil
 }
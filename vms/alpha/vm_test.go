@@ -128,6 +128,41 @@ func TestIssueTx(t *testing.T) {
 	issueAndAccept(require, env.vm, env.issuer, tx)
 }
 
+// Ensure the mempool is persisted and re-issued across restarts
+func TestMempoolPersistence(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	tx := newTx(t, env.genesisBytes, env.vm, "DIONE")
+	txID, err := env.vm.IssueTx(tx.Bytes())
+	require.NoError(err)
+	require.NoError(env.vm.persistMempool())
+
+	// Simulate a restart by dropping the tx from the in-memory mempool
+	env.vm.mempool.Remove([]*txs.Tx{tx})
+	require.False(env.vm.mempool.Has(txID))
+
+	require.NoError(env.vm.loadPersistedMempool())
+	require.Len(env.vm.persistedTxs, 1)
+	require.Equal(txID, env.vm.persistedTxs[0].ID())
+
+	env.vm.reissuePersistedMempool()
+	require.Empty(env.vm.persistedTxs)
+	require.True(env.vm.mempool.Has(txID))
+
+	// Once the tx is accepted, it is no longer persisted
+	buildAndAccept(require, env.vm, env.issuer, txID)
+	require.NoError(env.vm.persistMempool())
+
+	require.NoError(env.vm.loadPersistedMempool())
+	require.Empty(env.vm.persistedTxs)
+}
+
 // Test issuing a transaction that creates an NFT family
 func TestIssueNFT(t *testing.T) {
 	require := require.New(t)
@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package acceptance
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/metric"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
+	"github.com/DioneProtocol/odysseygo/utils/wrappers"
+)
+
+var _ Tracker = (*tracker)(nil)
+
+// Tracker records the local wall-clock time that blocks were accepted at.
+type Tracker interface {
+	// Accept records that the block [blkID], with the timestamp [blkTime], was
+	// accepted at the current time.
+	Accept(blkID ids.ID, blkTime time.Time) error
+
+	// AcceptedAt returns the local time that [blkID] was accepted at.
+	// Returns database.ErrNotFound if the acceptance of [blkID] wasn't
+	// recorded.
+	AcceptedAt(blkID ids.ID) (time.Time, error)
+}
+
+type tracker struct {
+	clock mockable.Clock
+	db    database.KeyValueReaderWriter
+
+	// Rolling average of the delay between a block's timestamp and its local
+	// acceptance.
+	latency metric.Averager
+	// The delay between the timestamp of the last accepted block and its local
+	// acceptance.
+	lastLatency prometheus.Gauge
+}
+
+func NewTracker(
+	db database.KeyValueReaderWriter,
+	namespace string,
+	reg prometheus.Registerer,
+) (Tracker, error) {
+	t := &tracker{
+		db: db,
+		lastLatency: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "last_block_accept_latency",
+			Help:      "Time (in ns) between the timestamp of the last accepted block and its local acceptance",
+		}),
+	}
+
+	errs := wrappers.Errs{}
+	t.latency = metric.NewAveragerWithErrs(
+		namespace,
+		"block_accept_latency",
+		"time (in ns) between a block's timestamp and its local acceptance",
+		reg,
+		&errs,
+	)
+	errs.Add(reg.Register(t.lastLatency))
+	return t, errs.Err
+}
+
+func (t *tracker) Accept(blkID ids.ID, blkTime time.Time) error {
+	now := t.clock.Time()
+	latency := float64(now.Sub(blkTime))
+	t.latency.Observe(latency)
+	t.lastLatency.Set(latency)
+	return database.PutTimestamp(t.db, blkID[:], now)
+}
+
+func (t *tracker) AcceptedAt(blkID ids.ID) (time.Time, error) {
+	return database.GetTimestamp(t.db, blkID[:])
+}
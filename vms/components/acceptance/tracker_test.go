@@ -0,0 +1,46 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package acceptance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+)
+
+func TestTracker(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	intf, err := NewTracker(db, "", prometheus.NewRegistry())
+	require.NoError(err)
+	tr := intf.(*tracker)
+
+	now := time.Unix(1_000_000, 0)
+	tr.clock.Set(now)
+
+	blkID := ids.GenerateTestID()
+	_, err = tr.AcceptedAt(blkID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	require.NoError(tr.Accept(blkID, now.Add(-2*time.Second)))
+
+	acceptedAt, err := tr.AcceptedAt(blkID)
+	require.NoError(err)
+	require.Equal(now.Unix(), acceptedAt.Unix())
+
+	// The acceptance time should be persisted
+	reloaded, err := NewTracker(db, "", prometheus.NewRegistry())
+	require.NoError(err)
+	acceptedAt, err = reloaded.AcceptedAt(blkID)
+	require.NoError(err)
+	require.Equal(now.Unix(), acceptedAt.Unix())
+}
@@ -26,6 +26,9 @@ func init() {
 	errs := wrappers.Errs{}
 	errs.Add(
 		lc.RegisterType(&Tx{}),
+		lc.RegisterType(&TxStatusRequest{}),
+		lc.RegisterType(&TxStatusResponse{}),
+		lc.RegisterType(&Maintenance{}),
 		c.RegisterCodec(codecVersion, lc),
 	)
 	if errs.Errored() {
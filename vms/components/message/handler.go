@@ -14,6 +14,9 @@ var _ Handler = NoopHandler{}
 
 type Handler interface {
 	HandleTx(nodeID ids.NodeID, requestID uint32, msg *Tx) error
+	HandleTxStatusRequest(nodeID ids.NodeID, requestID uint32, msg *TxStatusRequest) error
+	HandleTxStatusResponse(nodeID ids.NodeID, requestID uint32, msg *TxStatusResponse) error
+	HandleMaintenance(nodeID ids.NodeID, requestID uint32, msg *Maintenance) error
 }
 
 type NoopHandler struct {
@@ -27,3 +30,27 @@ func (h NoopHandler) HandleTx(nodeID ids.NodeID, requestID uint32, _ *Tx) error
 	)
 	return nil
 }
+
+func (h NoopHandler) HandleTxStatusRequest(nodeID ids.NodeID, requestID uint32, _ *TxStatusRequest) error {
+	h.Log.Debug("dropping unexpected TxStatusRequest message",
+		zap.Stringer("nodeID", nodeID),
+		zap.Uint32("requestID", requestID),
+	)
+	return nil
+}
+
+func (h NoopHandler) HandleTxStatusResponse(nodeID ids.NodeID, requestID uint32, _ *TxStatusResponse) error {
+	h.Log.Debug("dropping unexpected TxStatusResponse message",
+		zap.Stringer("nodeID", nodeID),
+		zap.Uint32("requestID", requestID),
+	)
+	return nil
+}
+
+func (h NoopHandler) HandleMaintenance(nodeID ids.NodeID, requestID uint32, _ *Maintenance) error {
+	h.Log.Debug("dropping unexpected Maintenance message",
+		zap.Stringer("nodeID", nodeID),
+		zap.Uint32("requestID", requestID),
+	)
+	return nil
+}
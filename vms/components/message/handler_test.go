@@ -13,7 +13,10 @@ import (
 )
 
 type CounterHandler struct {
-	Tx int
+	Tx               int
+	TxStatusRequest  int
+	TxStatusResponse int
+	Maintenance      int
 }
 
 func (h *CounterHandler) HandleTx(ids.NodeID, uint32, *Tx) error {
@@ -21,6 +24,21 @@ func (h *CounterHandler) HandleTx(ids.NodeID, uint32, *Tx) error {
 	return nil
 }
 
+func (h *CounterHandler) HandleTxStatusRequest(ids.NodeID, uint32, *TxStatusRequest) error {
+	h.TxStatusRequest++
+	return nil
+}
+
+func (h *CounterHandler) HandleTxStatusResponse(ids.NodeID, uint32, *TxStatusResponse) error {
+	h.TxStatusResponse++
+	return nil
+}
+
+func (h *CounterHandler) HandleMaintenance(ids.NodeID, uint32, *Maintenance) error {
+	h.Maintenance++
+	return nil
+}
+
 func TestHandleTx(t *testing.T) {
 	require := require.New(t)
 
@@ -37,4 +55,7 @@ func TestNoopHandler(t *testing.T) {
 	}
 
 	require.NoError(t, handler.HandleTx(ids.EmptyNodeID, 0, nil))
+	require.NoError(t, handler.HandleTxStatusRequest(ids.EmptyNodeID, 0, nil))
+	require.NoError(t, handler.HandleTxStatusResponse(ids.EmptyNodeID, 0, nil))
+	require.NoError(t, handler.HandleMaintenance(ids.EmptyNodeID, 0, nil))
 }
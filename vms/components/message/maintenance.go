@@ -0,0 +1,46 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/wrappers"
+)
+
+var (
+	_ Message = (*Maintenance)(nil)
+
+	// maintenanceDomain separates the signatures of maintenance announcements
+	// from the signatures of any other warp payload.
+	maintenanceDomain = []byte("odyssey maintenance announcement")
+)
+
+// Maintenance announces that [NodeID] plans to be offline between [StartTime]
+// and [EndTime]. The announcement is signed by the validator's BLS key.
+type Maintenance struct {
+	message
+
+	NodeID    ids.NodeID `serialize:"true"`
+	StartTime uint64     `serialize:"true"`
+	EndTime   uint64     `serialize:"true"`
+	Signature []byte     `serialize:"true"`
+}
+
+// Payload returns the bytes that are signed by [Signature]. The payload is
+// prefixed by [maintenanceDomain], so that a signed announcement can't be
+// interpreted as another warp message signed by the validator.
+func (msg *Maintenance) Payload() []byte {
+	p := wrappers.Packer{
+		Bytes: make([]byte, len(maintenanceDomain)+ids.NodeIDLen+2*wrappers.LongLen),
+	}
+	p.PackFixedBytes(maintenanceDomain)
+	p.PackFixedBytes(msg.NodeID[:])
+	p.PackLong(msg.StartTime)
+	p.PackLong(msg.EndTime)
+	return p.Bytes
+}
+
+func (msg *Maintenance) Handle(handler Handler, nodeID ids.NodeID, requestID uint32) error {
+	return handler.HandleMaintenance(nodeID, requestID, msg)
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils"
+)
+
+func TestMaintenance(t *testing.T) {
+	require := require.New(t)
+
+	builtMsg := Maintenance{
+		NodeID:    ids.GenerateTestNodeID(),
+		StartTime: 1,
+		EndTime:   2,
+		Signature: utils.RandomBytes(96),
+	}
+	builtMsgBytes, err := Build(&builtMsg)
+	require.NoError(err)
+	require.Equal(builtMsgBytes, builtMsg.Bytes())
+
+	parsedMsgIntf, err := Parse(builtMsgBytes)
+	require.NoError(err)
+	require.Equal(builtMsgBytes, parsedMsgIntf.Bytes())
+
+	require.IsType(&Maintenance{}, parsedMsgIntf)
+	parsedMsg := parsedMsgIntf.(*Maintenance)
+
+	require.Equal(builtMsg.NodeID, parsedMsg.NodeID)
+	require.Equal(builtMsg.StartTime, parsedMsg.StartTime)
+	require.Equal(builtMsg.EndTime, parsedMsg.EndTime)
+	require.Equal(builtMsg.Signature, parsedMsg.Signature)
+	require.Equal(builtMsg.Payload(), parsedMsg.Payload())
+	require.True(bytes.HasPrefix(parsedMsg.Payload(), maintenanceDomain))
+}
@@ -0,0 +1,37 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/choices"
+)
+
+var (
+	_ Message = (*TxStatusRequest)(nil)
+	_ Message = (*TxStatusResponse)(nil)
+)
+
+// TxStatusRequest asks a peer for its view of the status of [TxID].
+type TxStatusRequest struct {
+	message
+
+	TxID ids.ID `serialize:"true"`
+}
+
+func (msg *TxStatusRequest) Handle(handler Handler, nodeID ids.NodeID, requestID uint32) error {
+	return handler.HandleTxStatusRequest(nodeID, requestID, msg)
+}
+
+// TxStatusResponse reports a peer's view of the status of [TxID].
+type TxStatusResponse struct {
+	message
+
+	TxID   ids.ID         `serialize:"true"`
+	Status choices.Status `serialize:"true"`
+}
+
+func (msg *TxStatusResponse) Handle(handler Handler, nodeID ids.NodeID, requestID uint32) error {
+	return handler.HandleTxStatusResponse(nodeID, requestID, msg)
+}
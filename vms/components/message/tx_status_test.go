@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/choices"
+)
+
+func TestTxStatusRequest(t *testing.T) {
+	require := require.New(t)
+
+	txID := ids.GenerateTestID()
+	builtMsg := TxStatusRequest{
+		TxID: txID,
+	}
+	builtMsgBytes, err := Build(&builtMsg)
+	require.NoError(err)
+	require.Equal(builtMsgBytes, builtMsg.Bytes())
+
+	parsedMsgIntf, err := Parse(builtMsgBytes)
+	require.NoError(err)
+	require.Equal(builtMsgBytes, parsedMsgIntf.Bytes())
+
+	require.IsType(&TxStatusRequest{}, parsedMsgIntf)
+	parsedMsg := parsedMsgIntf.(*TxStatusRequest)
+
+	require.Equal(txID, parsedMsg.TxID)
+}
+
+func TestTxStatusResponse(t *testing.T) {
+	require := require.New(t)
+
+	txID := ids.GenerateTestID()
+	builtMsg := TxStatusResponse{
+		TxID:   txID,
+		Status: choices.Accepted,
+	}
+	builtMsgBytes, err := Build(&builtMsg)
+	require.NoError(err)
+	require.Equal(builtMsgBytes, builtMsg.Bytes())
+
+	parsedMsgIntf, err := Parse(builtMsgBytes)
+	require.NoError(err)
+	require.Equal(builtMsgBytes, parsedMsgIntf.Bytes())
+
+	require.IsType(&TxStatusResponse{}, parsedMsgIntf)
+	parsedMsg := parsedMsgIntf.(*TxStatusResponse)
+
+	require.Equal(txID, parsedMsg.TxID)
+	require.Equal(choices.Accepted, parsedMsg.Status)
+}
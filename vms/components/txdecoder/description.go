@@ -0,0 +1,206 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txdecoder
+
+import (
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+// Description is a VM agnostic representation of a transaction. Every VM that
+// registers a Decoder describes its transactions using this schema.
+type Description struct {
+	TxID    ids.ID `json:"txID"`
+	ChainID ids.ID `json:"chainID"`
+	// Type is the name of the transaction type, e.g. "BaseTx" or "ExportTx"
+	Type    string   `json:"type"`
+	Inputs  []Input  `json:"inputs"`
+	Outputs []Output `json:"outputs"`
+	// Owners are the owners referenced by the transaction that don't control
+	// an output, such as reward owners or subnet owners.
+	Owners []NamedOwner `json:"owners,omitempty"`
+	Memo   string       `json:"memo"`
+}
+
+// Input is a UTXO consumed by a transaction.
+type Input struct {
+	TxID        ids.ID      `json:"txID"`
+	OutputIndex json.Uint32 `json:"outputIndex"`
+	AssetID     ids.ID      `json:"assetID"`
+	Amount      json.Uint64 `json:"amount"`
+	// SourceChain is only set for inputs imported from another chain.
+	SourceChain *ids.ID `json:"sourceChain,omitempty"`
+}
+
+// Output is a UTXO produced by a transaction.
+type Output struct {
+	AssetID ids.ID      `json:"assetID"`
+	Amount  json.Uint64 `json:"amount"`
+	Owner   *Owner      `json:"owner,omitempty"`
+	// DestinationChain is only set for outputs exported to another chain.
+	DestinationChain *ids.ID `json:"destinationChain,omitempty"`
+	// Staked is true if the output is locked for the duration of staking.
+	Staked bool `json:"staked,omitempty"`
+}
+
+// Owner is a set of addresses that control funds or a resource.
+type Owner struct {
+	Locktime  json.Uint64 `json:"locktime"`
+	Threshold json.Uint32 `json:"threshold"`
+	Addresses []string    `json:"addresses"`
+}
+
+// NamedOwner is an Owner that has a role in the transaction.
+type NamedOwner struct {
+	Owner
+	Role string `json:"role"`
+}
+
+// AddressFormatter formats addresses for inclusion in a Description.
+type AddressFormatter interface {
+	FormatLocalAddress(addr ids.ShortID) (string, error)
+}
+
+// Builder incrementally populates a Description.
+type Builder struct {
+	Formatter   AddressFormatter
+	Description Description
+}
+
+// AddBaseTx adds the inputs, outputs and memo of [tx].
+func (b *Builder) AddBaseTx(tx *dione.BaseTx) error {
+	memo, err := formatting.Encode(formatting.Hex, tx.Memo)
+	if err != nil {
+		return err
+	}
+	b.Description.Memo = memo
+	b.AddInputs(nil, tx.Ins)
+	return b.AddOutputs(nil, false, tx.Outs)
+}
+
+// AddInputs adds [ins]. If [sourceChain] is non-nil, the inputs are marked as
+// imported from [sourceChain].
+func (b *Builder) AddInputs(sourceChain *ids.ID, ins []*dione.TransferableInput) {
+	for _, in := range ins {
+		b.Description.Inputs = append(b.Description.Inputs, Input{
+			TxID:        in.TxID,
+			OutputIndex: json.Uint32(in.OutputIndex),
+			AssetID:     in.AssetID(),
+			Amount:      json.Uint64(in.In.Amount()),
+			SourceChain: sourceChain,
+		})
+	}
+}
+
+// AddOutputs adds [outs]. If [destinationChain] is non-nil, the outputs are
+// marked as exported to [destinationChain].
+func (b *Builder) AddOutputs(destinationChain *ids.ID, staked bool, outs []*dione.TransferableOutput) error {
+	for _, out := range outs {
+		owner, err := b.owner(out.Out)
+		if err != nil {
+			return err
+		}
+		b.Description.Outputs = append(b.Description.Outputs, Output{
+			AssetID:          out.AssetID(),
+			Amount:           json.Uint64(out.Out.Amount()),
+			Owner:            owner,
+			DestinationChain: destinationChain,
+			Staked:           staked,
+		})
+	}
+	return nil
+}
+
+// AddUTXOInputs adds inputs of [assetID] that are only referenced by their
+// UTXOIDs. The amount of these inputs is unknown and is reported as 0.
+func (b *Builder) AddUTXOInputs(assetID ids.ID, utxoIDs []*dione.UTXOID) {
+	for _, utxoID := range utxoIDs {
+		b.Description.Inputs = append(b.Description.Inputs, Input{
+			TxID:        utxoID.TxID,
+			OutputIndex: json.Uint32(utxoID.OutputIndex),
+			AssetID:     assetID,
+		})
+	}
+}
+
+// AddStateOutput adds an output of [assetID] that isn't a transferable output,
+// such as outputs created by operations or initial asset states.
+func (b *Builder) AddStateOutput(assetID ids.ID, out interface{}) error {
+	owner, err := b.owner(out)
+	if err != nil {
+		return err
+	}
+	var amount uint64
+	if transferable, ok := out.(dione.TransferableOut); ok {
+		amount = transferable.Amount()
+	}
+	b.Description.Outputs = append(b.Description.Outputs, Output{
+		AssetID: assetID,
+		Amount:  json.Uint64(amount),
+		Owner:   owner,
+	})
+	return nil
+}
+
+// AddOwner adds [owner] with the provided [role]. Owners that aren't
+// secp256k1fx owners are ignored.
+func (b *Builder) AddOwner(role string, owner interface{}) error {
+	apiOwner, err := b.owner(owner)
+	if err != nil || apiOwner == nil {
+		return err
+	}
+	b.Description.Owners = append(b.Description.Owners, NamedOwner{
+		Owner: *apiOwner,
+		Role:  role,
+	})
+	return nil
+}
+
+func (b *Builder) owner(intf interface{}) (*Owner, error) {
+	var owners *secp256k1fx.OutputOwners
+	switch o := intf.(type) {
+	case *secp256k1fx.OutputOwners:
+		owners = o
+	case *secp256k1fx.TransferOutput:
+		owners = &o.OutputOwners
+	case *secp256k1fx.MintOutput:
+		owners = &o.OutputOwners
+	case dione.Addressable:
+		addrs := o.Addresses()
+		owner := &Owner{
+			Addresses: make([]string, 0, len(addrs)),
+		}
+		for _, addrBytes := range addrs {
+			addr, err := ids.ToShortID(addrBytes)
+			if err != nil {
+				return nil, err
+			}
+			addrStr, err := b.Formatter.FormatLocalAddress(addr)
+			if err != nil {
+				return nil, err
+			}
+			owner.Addresses = append(owner.Addresses, addrStr)
+		}
+		return owner, nil
+	default:
+		return nil, nil
+	}
+
+	owner := &Owner{
+		Locktime:  json.Uint64(owners.Locktime),
+		Threshold: json.Uint32(owners.Threshold),
+		Addresses: make([]string, 0, len(owners.Addrs)),
+	}
+	for _, addr := range owners.Addrs {
+		addrStr, err := b.Formatter.FormatLocalAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		owner.Addresses = append(owner.Addresses, addrStr)
+	}
+	return owner, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txdecoder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+)
+
+var (
+	_ Registry = (*registry)(nil)
+
+	ErrNoDecoder         = errors.New("no tx decoder registered for chain")
+	errDuplicatedDecoder = errors.New("tx decoder already registered for chain")
+)
+
+// Decoder is implemented by VMs that are able to describe their transactions.
+type Decoder interface {
+	// DecodeTx parses [txBytes] and returns a description of the transaction.
+	//
+	// DecodeTx may be called concurrently and without the context lock held.
+	DecodeTx(ctx context.Context, txBytes []byte) (*Description, error)
+}
+
+// Registry maps chains to the Decoder of the VM they are running.
+type Registry interface {
+	// Register sets the decoder of [chainID] to [decoder].
+	Register(chainID ids.ID, decoder Decoder) error
+
+	// Deregister removes the decoder of [chainID], if any.
+	Deregister(chainID ids.ID)
+
+	// Decode describes [txBytes] using the decoder registered for [chainID].
+	Decode(ctx context.Context, chainID ids.ID, txBytes []byte) (*Description, error)
+}
+
+type registry struct {
+	lock     sync.RWMutex
+	decoders map[ids.ID]Decoder
+}
+
+func NewRegistry() Registry {
+	return &registry{
+		decoders: make(map[ids.ID]Decoder),
+	}
+}
+
+func (r *registry) Register(chainID ids.ID, decoder Decoder) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.decoders[chainID]; ok {
+		return fmt.Errorf("%w: %s", errDuplicatedDecoder, chainID)
+	}
+	r.decoders[chainID] = decoder
+	return nil
+}
+
+func (r *registry) Deregister(chainID ids.ID) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.decoders, chainID)
+}
+
+func (r *registry) Decode(ctx context.Context, chainID ids.ID, txBytes []byte) (*Description, error) {
+	r.lock.RLock()
+	decoder, ok := r.decoders[chainID]
+	r.lock.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoDecoder, chainID)
+	}
+	return decoder.DecodeTx(ctx, txBytes)
+}
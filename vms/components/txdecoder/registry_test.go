@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txdecoder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+)
+
+type testDecoder struct {
+	chainID ids.ID
+}
+
+func (d *testDecoder) DecodeTx(_ context.Context, txBytes []byte) (*Description, error) {
+	return &Description{
+		ChainID: d.chainID,
+		Type:    string(txBytes),
+	}, nil
+}
+
+func TestRegistry(t *testing.T) {
+	require := require.New(t)
+
+	chainID := ids.GenerateTestID()
+	r := NewRegistry()
+
+	_, err := r.Decode(context.Background(), chainID, []byte("BaseTx"))
+	require.ErrorIs(err, ErrNoDecoder)
+
+	require.NoError(r.Register(chainID, &testDecoder{chainID: chainID}))
+
+	err = r.Register(chainID, &testDecoder{chainID: chainID})
+	require.ErrorIs(err, errDuplicatedDecoder)
+
+	desc, err := r.Decode(context.Background(), chainID, []byte("BaseTx"))
+	require.NoError(err)
+	require.Equal(chainID, desc.ChainID)
+	require.Equal("BaseTx", desc.Type)
+
+	r.Deregister(chainID)
+
+	_, err = r.Decode(context.Background(), chainID, []byte("BaseTx"))
+	require.ErrorIs(err, ErrNoDecoder)
+
+	require.NoError(r.Register(chainID, &testDecoder{chainID: chainID}))
+}
@@ -103,6 +103,9 @@ type Owner struct {
 	Locktime  json.Uint64 `json:"locktime"`
 	Threshold json.Uint32 `json:"threshold"`
 	Addresses []string    `json:"addresses"`
+	// If set, rewards are locked until this time and can only be restaked
+	// before then.
+	RestakeLocktime *json.Uint64 `json:"restakeLocktime,omitempty"`
 }
 
 // PermissionlessValidator is the repr. of a permissionless validator sent over
@@ -116,15 +119,16 @@ type PermissionlessValidator struct {
 	ValidationRewardOwner *Owner `json:"validationRewardOwner,omitempty"`
 	// The owner of the rewards from delegations during the validation period,
 	// if applicable.
-	DelegationRewardOwner  *Owner                    `json:"delegationRewardOwner,omitempty"`
-	PotentialReward        *json.Uint64              `json:"potentialReward,omitempty"`
-	AccruedDelegateeReward *json.Uint64              `json:"accruedDelegateeReward,omitempty"`
-	DelegationFee          json.Float32              `json:"delegationFee"`
-	ExactDelegationFee     *json.Uint32              `json:"exactDelegationFee,omitempty"`
-	Uptime                 *json.Float32             `json:"uptime,omitempty"`
-	Connected              bool                      `json:"connected"`
-	Staked                 []UTXO                    `json:"staked,omitempty"`
-	Signer                 *signer.ProofOfPossession `json:"signer,omitempty"`
+	DelegationRewardOwner     *Owner                    `json:"delegationRewardOwner,omitempty"`
+	PotentialReward           *json.Uint64              `json:"potentialReward,omitempty"`
+	AccruedDelegateeReward    *json.Uint64              `json:"accruedDelegateeReward,omitempty"`
+	DelegationFee             json.Float32              `json:"delegationFee"`
+	ExactDelegationFee        *json.Uint32              `json:"exactDelegationFee,omitempty"`
+	Uptime                    *json.Float32             `json:"uptime,omitempty"`
+	MaintenanceAdjustedUptime *json.Float32             `json:"maintenanceAdjustedUptime,omitempty"`
+	Connected                 bool                      `json:"connected"`
+	Staked                    []UTXO                    `json:"staked,omitempty"`
+	Signer                    *signer.ProofOfPossession `json:"signer,omitempty"`
 
 	// The delegators delegating to this validator
 	DelegatorCount  *json.Uint64        `json:"delegatorCount,omitempty"`
@@ -136,8 +140,9 @@ type PermissionlessValidator struct {
 type PermissionedValidator struct {
 	Staker
 	// The owner the staking reward, if applicable, will go to
-	Connected bool          `json:"connected"`
-	Uptime    *json.Float32 `json:"uptime,omitempty"`
+	Connected                 bool          `json:"connected"`
+	Uptime                    *json.Float32 `json:"uptime,omitempty"`
+	MaintenanceAdjustedUptime *json.Float32 `json:"maintenanceAdjustedUptime,omitempty"`
 }
 
 // PrimaryDelegator is the repr. of a primary network delegator sent over APIs.
@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/pubsub"
+	"github.com/DioneProtocol/odysseygo/utils/rpc"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+)
+
+var (
+	errTxNotInBlock     = errors.New("tx isn't in its reported block")
+	errUnexpectedHeight = errors.New("block has an unexpected height")
+)
+
+// EventsURI returns the websocket URI of the O Chain events endpoint of the
+// node served at [uri].
+func EventsURI(uri string) string {
+	// http:// and https:// become ws:// and wss:// respectively.
+	return "ws" + strings.TrimPrefix(uri, "http") + "/ext/bc/O/events"
+}
+
+// AwaitTxDecided waits until [txID] is decided. [c] is polled every [freq]
+// for the status of the tx. If [eventsURI] is non-empty, the tx is also
+// subscribed to on the events endpoint so that its decision is learned of as
+// soon as it is accepted.
+//
+// If the tx was committed or aborted and the node reported the block that
+// contains it, the block is fetched and verified to contain the tx. The
+// returned response then proves the inclusion of the tx.
+func AwaitTxDecided(
+	ctx context.Context,
+	c Client,
+	eventsURI string,
+	txID ids.ID,
+	freq time.Duration,
+	options ...rpc.Option,
+) (*GetTxStatusResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var decisions <-chan TxDecided
+	if eventsURI != "" {
+		// If the node doesn't serve events, the tx is only polled for.
+		decisions, _ = subscribeTxDecided(ctx, eventsURI, txID)
+	}
+
+	ticker := time.NewTicker(freq)
+	defer ticker.Stop()
+
+	for {
+		res, err := c.GetTxStatus(ctx, txID, options...)
+		if err == nil {
+			switch res.Status {
+			case status.Committed, status.Aborted:
+				return res, verifyTxBlock(ctx, c, txID, res, options...)
+			case status.Dropped:
+				return res, nil
+			}
+		}
+
+		select {
+		case decision, ok := <-decisions:
+			if !ok {
+				decisions = nil
+				continue
+			}
+			res := &GetTxStatusResponse{
+				Status:  decision.Status,
+				BlockID: &decision.BlockID,
+				Height:  &decision.Height,
+			}
+			return res, verifyTxBlock(ctx, c, txID, res, options...)
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// subscribeTxDecided subscribes to the decision of [txID] on the events
+// endpoint at [eventsURI]. The returned channel receives the decision, or is
+// closed if the subscription fails. The subscription is closed once [ctx] is
+// cancelled.
+func subscribeTxDecided(ctx context.Context, eventsURI string, txID ids.ID) (<-chan TxDecided, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, eventsURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	cmds := []*pubsub.Command{
+		{NewSet: &pubsub.NewSet{}},
+		{AddTxIDs: &pubsub.AddTxIDs{TxIDs: []ids.ID{txID}}},
+	}
+	for _, cmd := range cmds {
+		if err := conn.WriteJSON(cmd); err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	decisions := make(chan TxDecided, 1)
+	go func() {
+		defer close(decisions)
+
+		for {
+			var decision TxDecided
+			if err := conn.ReadJSON(&decision); err != nil {
+				return
+			}
+			if decision.TxID == txID {
+				decisions <- decision
+				return
+			}
+		}
+	}()
+	return decisions, nil
+}
+
+// verifyTxBlock verifies that the block reported in [res] contains [txID].
+// Nodes that don't index txs by block don't report a block, in which case
+// there is nothing to verify.
+func verifyTxBlock(
+	ctx context.Context,
+	c Client,
+	txID ids.ID,
+	res *GetTxStatusResponse,
+	options ...rpc.Option,
+) error {
+	if res.BlockID == nil || res.Height == nil {
+		return nil
+	}
+
+	blkID := *res.BlockID
+	blkBytes, err := c.GetBlock(ctx, blkID, options...)
+	if err != nil {
+		return fmt.Errorf("couldn't get block %s: %w", blkID, err)
+	}
+	blk, err := blocks.Parse(blocks.Codec, blkBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't parse block %s: %w", blkID, err)
+	}
+	if blk.ID() != blkID {
+		return fmt.Errorf("%w: expected block %s but got %s", errTxNotInBlock, blkID, blk.ID())
+	}
+	if height := uint64(*res.Height); blk.Height() != height {
+		return fmt.Errorf("%w: expected %d but got %d", errUnexpectedHeight, height, blk.Height())
+	}
+	for _, tx := range blk.Txs() {
+		if tx.ID() == txID {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: tx %s, block %s", errTxNotInBlock, txID, blkID)
+}
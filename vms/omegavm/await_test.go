@@ -0,0 +1,162 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/pubsub"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/rpc"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+)
+
+type testAwaitClient struct {
+	Client
+
+	status *GetTxStatusResponse
+	blocks map[ids.ID][]byte
+}
+
+func (c *testAwaitClient) GetTxStatus(context.Context, ids.ID, ...rpc.Option) (*GetTxStatusResponse, error) {
+	return c.status, nil
+}
+
+func (c *testAwaitClient) GetBlock(_ context.Context, blkID ids.ID, _ ...rpc.Option) ([]byte, error) {
+	return c.blocks[blkID], nil
+}
+
+func newTestAwaitBlock(t *testing.T) (blocks.Block, ids.ID) {
+	blk, err := blocks.NewBanffStandardBlock(time.Now(), ids.GenerateTestID(), 5, []*txs.Tx{
+		{
+			Unsigned: &txs.RewardValidatorTx{
+				TxID: ids.GenerateTestID(),
+			},
+		},
+	})
+	require.NoError(t, err)
+	return blk, blk.Txs()[0].ID()
+}
+
+func TestAwaitTxDecidedPolling(t *testing.T) {
+	require := require.New(t)
+
+	blk, txID := newTestAwaitBlock(t)
+	blkID := blk.ID()
+	height := json.Uint64(blk.Height())
+	c := &testAwaitClient{
+		status: &GetTxStatusResponse{
+			Status:  status.Committed,
+			BlockID: &blkID,
+			Height:  &height,
+		},
+		blocks: map[ids.ID][]byte{
+			blkID: blk.Bytes(),
+		},
+	}
+
+	res, err := AwaitTxDecided(context.Background(), c, "", txID, time.Millisecond)
+	require.NoError(err)
+	require.Equal(c.status, res)
+
+	// The reported block must contain the tx
+	_, err = AwaitTxDecided(context.Background(), c, "", ids.GenerateTestID(), time.Millisecond)
+	require.ErrorIs(err, errTxNotInBlock)
+
+	// The reported height must match the block
+	wrongHeight := height + 1
+	c.status.Height = &wrongHeight
+	_, err = AwaitTxDecided(context.Background(), c, "", txID, time.Millisecond)
+	require.ErrorIs(err, errUnexpectedHeight)
+
+	// Nodes that don't report blocks aren't required to prove inclusion
+	c.status = &GetTxStatusResponse{
+		Status: status.Aborted,
+	}
+	res, err = AwaitTxDecided(context.Background(), c, "", txID, time.Millisecond)
+	require.NoError(err)
+	require.Equal(c.status, res)
+}
+
+func TestAwaitTxDecidedEvents(t *testing.T) {
+	require := require.New(t)
+
+	blk, txID := newTestAwaitBlock(t)
+	c := &testAwaitClient{
+		status: &GetTxStatusResponse{
+			Status: status.Processing,
+		},
+		blocks: map[ids.ID][]byte{
+			blk.ID(): blk.Bytes(),
+		},
+	}
+
+	events := pubsub.New(logging.NoLog{})
+	server := httptest.NewServer(events)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// The decision is published until the subscription has been registered.
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			events.Publish(&txDecidedFilterer{
+				event: TxDecided{
+					TxID:    txID,
+					Status:  status.Committed,
+					BlockID: blk.ID(),
+					Height:  json.Uint64(blk.Height()),
+				},
+			})
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	eventsURI := "ws" + server.URL[len("http"):]
+	// Polling alone would never observe the decision.
+	res, err := AwaitTxDecided(ctx, c, eventsURI, txID, time.Hour)
+	require.NoError(err)
+	require.Equal(status.Committed, res.Status)
+	require.Equal(blk.ID(), *res.BlockID)
+	require.Equal(json.Uint64(blk.Height()), *res.Height)
+}
+
+func TestAwaitTxDecidedCancelled(t *testing.T) {
+	c := &testAwaitClient{
+		status: &GetTxStatusResponse{
+			Status: status.Processing,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := AwaitTxDecided(ctx, c, "", ids.GenerateTestID(), time.Hour)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestEventsURI(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("ws://127.0.0.1:9650/ext/bc/O/events", EventsURI("http://127.0.0.1:9650"))
+	require.Equal("wss://node.example/ext/bc/O/events", EventsURI("https://node.example"))
+}
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/network/p2p"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
@@ -54,6 +55,10 @@ type Builder interface {
 	// AddUnverifiedTx verifier the tx before adding it to mempool
 	AddUnverifiedTx(tx *txs.Tx) error
 
+	// VerifyTx verifies the tx on top of the preferred block without adding
+	// it to the mempool
+	VerifyTx(tx *txs.Tx) error
+
 	// BuildBlock is called on timer clock to attempt to create
 	// next block
 	BuildBlock(context.Context) (snowman.Block, error)
@@ -90,6 +95,7 @@ func New(
 	blkManager blockexecutor.Manager,
 	toEngine chan<- common.Message,
 	appSender common.AppSender,
+	router *p2p.Router,
 ) Builder {
 	builder := &builder{
 		Mempool:           mempool,
@@ -105,6 +111,7 @@ func New(
 		txExecutorBackend.Ctx,
 		builder,
 		appSender,
+		router,
 	)
 
 	go txExecutorBackend.Ctx.Log.RecoverAndPanic(builder.timer.Dispatch)
@@ -137,13 +144,7 @@ func (b *builder) AddUnverifiedTx(tx *txs.Tx) error {
 		return nil
 	}
 
-	verifier := txexecutor.MempoolTxVerifier{
-		Backend:       b.txExecutorBackend,
-		ParentID:      b.preferredBlockID, // We want to build off of the preferred block
-		StateVersions: b.blkManager,
-		Tx:            tx,
-	}
-	if err := tx.Unsigned.Visit(&verifier); err != nil {
+	if err := b.verifyTx(tx); err != nil {
 		b.MarkDropped(txID, err)
 		return err
 	}
@@ -158,6 +159,23 @@ func (b *builder) AddUnverifiedTx(tx *txs.Tx) error {
 	return b.GossipTx(tx)
 }
 
+func (b *builder) VerifyTx(tx *txs.Tx) error {
+	if !b.txExecutorBackend.Bootstrapped.Get() {
+		return ErrChainNotSynced
+	}
+	return b.verifyTx(tx)
+}
+
+func (b *builder) verifyTx(tx *txs.Tx) error {
+	verifier := txexecutor.MempoolTxVerifier{
+		Backend:       b.txExecutorBackend,
+		ParentID:      b.preferredBlockID, // We want to build off of the preferred block
+		StateVersions: b.blkManager,
+		Tx:            tx,
+	}
+	return tx.Unsigned.Visit(&verifier)
+}
+
 // BuildBlock builds a block to be added to consensus.
 // This method removes the transactions from the returned
 // blocks from the mempool.
@@ -261,7 +279,8 @@ func (b *builder) dropExpiredStakerTxs(timestamp time.Time) {
 
 		txID := tx.ID()
 		err := fmt.Errorf(
-			"synchrony bound (%s) is later than staker start time (%s)",
+			"%w: synchrony bound (%s) is later than staker start time (%s)",
+			txexecutor.ErrTimestampNotBeforeStartTime,
 			minStartTime,
 			startTime,
 		)
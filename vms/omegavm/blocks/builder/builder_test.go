@@ -68,6 +68,32 @@ func TestBlockBuilderAddLocalTx(t *testing.T) {
 	require.False(env.mempool.Has(txID))
 }
 
+// shows that a TransferSubnetOwnershipTx isn't admitted to the mempool before
+// the Durango upgrade
+func TestBlockBuilderAddTransferSubnetOwnershipTxPreDurango(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+	env.config.DurangoTime = mockable.MaxTime
+
+	tx, err := env.txBuilder.NewTransferSubnetOwnershipTx(
+		testSubnet1.ID(),
+		1,
+		[]ids.ShortID{ids.GenerateTestShortID()},
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	require.NoError(err)
+
+	err = env.Builder.AddUnverifiedTx(tx)
+	require.ErrorIs(err, txexecutor.ErrDurangoUpgradeNotActive)
+	require.False(env.mempool.Has(tx.ID()))
+}
+
 func TestPreviouslyDroppedTxsCanBeReAddedToMempool(t *testing.T) {
 	require := require.New(t)
 
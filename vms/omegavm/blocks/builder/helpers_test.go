@@ -19,9 +19,11 @@ import (
 	"github.com/DioneProtocol/odysseygo/codec/linearcodec"
 	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
 	"github.com/DioneProtocol/odysseygo/database/prefixdb"
 	"github.com/DioneProtocol/odysseygo/database/versiondb"
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/network/p2p"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
 	"github.com/DioneProtocol/odysseygo/snow/uptime"
@@ -37,6 +39,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/units"
 	"github.com/DioneProtocol/odysseygo/utils/wrappers"
 	"github.com/DioneProtocol/odysseygo/version"
+	"github.com/DioneProtocol/odysseygo/vms/components/acceptance"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/api"
@@ -163,7 +166,10 @@ func newEnvironment(t *testing.T) *environment {
 	metrics, err := metrics.New("", registerer)
 	require.NoError(err)
 
-	res.mempool, err = mempool.NewMempool("mempool", registerer, res)
+	res.mempool, err = mempool.NewMempool("mempool", registerer, memdb.New(), res)
+	require.NoError(err)
+
+	acceptTimes, err := acceptance.NewTracker(memdb.New(), "", registerer)
 	require.NoError(err)
 
 	res.blkManager = blockexecutor.NewManager(
@@ -172,6 +178,7 @@ func newEnvironment(t *testing.T) *environment {
 		res.state,
 		&res.backend,
 		ovalidators.TestManager,
+		acceptTimes,
 	)
 
 	res.Builder = New(
@@ -181,6 +188,7 @@ func newEnvironment(t *testing.T) *environment {
 		res.blkManager,
 		nil, // toEngine,
 		res.sender,
+		p2p.NewRouter(logging.NoLog{}, res.sender, registerer, ""),
 	)
 
 	res.Builder.SetPreference(genesisID)
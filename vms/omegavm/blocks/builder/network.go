@@ -7,6 +7,7 @@ package builder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -14,25 +15,57 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/cache"
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/network/p2p"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/snow/uptime"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
 	"github.com/DioneProtocol/odysseygo/vms/components/message"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
 )
 
 const (
 	// We allow [recentCacheSize] to be fairly large because we only store hashes
 	// in the cache, not entire transactions.
 	recentCacheSize = 512
+
+	// MaxMaintenanceAnnouncementLead is how far in advance of its start a
+	// maintenance window may be announced.
+	MaxMaintenanceAnnouncementLead = 7 * 24 * time.Hour
+
+	// MaxMaintenanceClockSkew is how far in the past a maintenance window may
+	// start when it is announced, to tolerate clock differences between
+	// nodes. Announcing a window retroactively would erase past downtime.
+	MaxMaintenanceClockSkew = time.Minute
 )
 
-var _ Network = (*network)(nil)
+var (
+	_ Network = (*network)(nil)
+
+	errMissingState                = errors.New("missing state")
+	errMissingPublicKey            = errors.New("validator has no registered BLS public key")
+	errInvalidMaintenanceSignature = errors.New("invalid maintenance announcement signature")
+	errMaintenanceTooFarInFuture   = errors.New("maintenance window starts too far in the future")
+	errMaintenanceInPast           = errors.New("maintenance window starts in the past")
+)
 
 type Network interface {
 	common.AppHandler
 
 	// GossipTx gossips the transaction to some of the connected peers
 	GossipTx(tx *txs.Tx) error
+
+	// RegossipTx gossips the transaction to some of the connected peers, even
+	// if it was recently gossiped.
+	RegossipTx(tx *txs.Tx) error
+
+	// AnnounceMaintenance signs an announcement that this node will be offline
+	// during [window], records it locally and gossips it to the network.
+	//
+	// Invariant: Assumes the context lock is held.
+	AnnounceMaintenance(window uptime.MaintenanceWindow) error
 }
 
 type network struct {
@@ -42,55 +75,52 @@ type network struct {
 	// gossip related attributes
 	appSender common.AppSender
 	recentTxs *cache.LRU[ids.ID, struct{}]
+
+	// router handles the app requests and responses of the protocols
+	// registered on it
+	router *p2p.Router
 }
 
 func NewNetwork(
 	ctx *snow.Context,
 	blkBuilder *builder,
 	appSender common.AppSender,
+	router *p2p.Router,
 ) Network {
 	return &network{
 		ctx:        ctx,
 		blkBuilder: blkBuilder,
 		appSender:  appSender,
 		recentTxs:  &cache.LRU[ids.ID, struct{}]{Size: recentCacheSize},
+		router:     router,
 	}
 }
 
 func (*network) CrossChainAppRequestFailed(context.Context, ids.ID, uint32) error {
-	// This VM currently only supports gossiping of txs, so there are no
-	// requests.
+	// This VM currently doesn't issue cross-chain requests.
 	return nil
 }
 
 func (*network) CrossChainAppRequest(context.Context, ids.ID, uint32, time.Time, []byte) error {
-	// This VM currently only supports gossiping of txs, so there are no
-	// requests.
+	// This VM currently doesn't serve cross-chain requests.
 	return nil
 }
 
 func (*network) CrossChainAppResponse(context.Context, ids.ID, uint32, []byte) error {
-	// This VM currently only supports gossiping of txs, so there are no
-	// requests.
+	// This VM currently doesn't issue cross-chain requests.
 	return nil
 }
 
-func (*network) AppRequestFailed(context.Context, ids.NodeID, uint32) error {
-	// This VM currently only supports gossiping of txs, so there are no
-	// requests.
-	return nil
+func (n *network) AppRequestFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
+	return n.router.AppRequestFailed(ctx, nodeID, requestID)
 }
 
-func (*network) AppRequest(context.Context, ids.NodeID, uint32, time.Time, []byte) error {
-	// This VM currently only supports gossiping of txs, so there are no
-	// requests.
-	return nil
+func (n *network) AppRequest(ctx context.Context, nodeID ids.NodeID, requestID uint32, deadline time.Time, request []byte) error {
+	return n.router.AppRequest(ctx, nodeID, requestID, deadline, request)
 }
 
-func (*network) AppResponse(context.Context, ids.NodeID, uint32, []byte) error {
-	// This VM currently only supports gossiping of txs, so there are no
-	// requests.
-	return nil
+func (n *network) AppResponse(ctx context.Context, nodeID ids.NodeID, requestID uint32, response []byte) error {
+	return n.router.AppResponse(ctx, nodeID, requestID, response)
 }
 
 func (n *network) AppGossip(_ context.Context, nodeID ids.NodeID, msgBytes []byte) error {
@@ -114,14 +144,20 @@ func (n *network) AppGossip(_ context.Context, nodeID ids.NodeID, msgBytes []byt
 		return nil
 	}
 
-	msg, ok := msgIntf.(*message.Tx)
-	if !ok {
+	switch msg := msgIntf.(type) {
+	case *message.Tx:
+		n.handleTx(nodeID, msg)
+	case *message.Maintenance:
+		n.handleMaintenance(nodeID, msg)
+	default:
 		n.ctx.Log.Debug("dropping unexpected message",
 			zap.Stringer("nodeID", nodeID),
 		)
-		return nil
 	}
+	return nil
+}
 
+func (n *network) handleTx(nodeID ids.NodeID, msg *message.Tx) {
 	tx, err := txs.Parse(txs.Codec, msg.Tx)
 	if err != nil {
 		n.ctx.Log.Verbo("received invalid tx",
@@ -129,7 +165,7 @@ func (n *network) AppGossip(_ context.Context, nodeID ids.NodeID, msgBytes []byt
 			zap.Binary("tx", msg.Tx),
 			zap.Error(err),
 		)
-		return nil
+		return
 	}
 
 	txID := tx.ID()
@@ -141,7 +177,7 @@ func (n *network) AppGossip(_ context.Context, nodeID ids.NodeID, msgBytes []byt
 
 	if reason := n.blkBuilder.GetDropReason(txID); reason != nil {
 		// If the tx is being dropped - just ignore it
-		return nil
+		return
 	}
 
 	// add to mempool
@@ -151,7 +187,128 @@ func (n *network) AppGossip(_ context.Context, nodeID ids.NodeID, msgBytes []byt
 			zap.Error(err),
 		)
 	}
-	return nil
+}
+
+func (n *network) handleMaintenance(nodeID ids.NodeID, msg *message.Maintenance) {
+	unsignedMsg, err := warp.NewUnsignedMessage(n.ctx.NetworkID, n.ctx.ChainID, msg.Payload())
+	if err != nil {
+		n.ctx.Log.Debug("dropping maintenance announcement",
+			zap.Stringer("nodeID", nodeID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	// Don't re-process or re-gossip an announcement that was recently seen.
+	announcementID := unsignedMsg.ID()
+	if _, has := n.recentTxs.Get(announcementID); has {
+		return
+	}
+
+	n.ctx.Lock.Lock()
+	err = n.recordMaintenance(msg, unsignedMsg)
+	n.ctx.Lock.Unlock()
+	if err != nil {
+		n.ctx.Log.Debug("dropping maintenance announcement",
+			zap.Stringer("nodeID", nodeID),
+			zap.Stringer("validatorID", msg.NodeID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	n.recentTxs.Put(announcementID, struct{}{})
+	if err := n.appSender.SendAppGossip(context.TODO(), msg.Bytes()); err != nil {
+		n.ctx.Log.Error("failed to gossip maintenance announcement",
+			zap.Stringer("validatorID", msg.NodeID),
+			zap.Error(err),
+		)
+	}
+}
+
+// recordMaintenance verifies that [msg] was signed by a current primary
+// network validator and, if so, records the announced maintenance window.
+//
+// Invariant: Assumes the context lock is held.
+func (n *network) recordMaintenance(msg *message.Maintenance, unsignedMsg *warp.UnsignedMessage) error {
+	window := uptime.MaintenanceWindow{
+		Start: time.Unix(int64(msg.StartTime), 0),
+		End:   time.Unix(int64(msg.EndTime), 0),
+	}
+	if err := n.verifyMaintenanceWindow(window); err != nil {
+		return err
+	}
+
+	lastAccepted := n.blkBuilder.blkManager.LastAccepted()
+	chainState, ok := n.blkBuilder.blkManager.GetState(lastAccepted)
+	if !ok {
+		return fmt.Errorf("%w: %s", errMissingState, lastAccepted)
+	}
+	staker, err := chainState.GetCurrentValidator(constants.PrimaryNetworkID, msg.NodeID)
+	if err != nil {
+		return fmt.Errorf("couldn't find validator %s: %w", msg.NodeID, err)
+	}
+	if staker.PublicKey == nil {
+		return errMissingPublicKey
+	}
+
+	sig, err := bls.SignatureFromBytes(msg.Signature)
+	if err != nil {
+		return err
+	}
+	if !bls.Verify(staker.PublicKey, sig, unsignedMsg.Bytes()) {
+		return errInvalidMaintenanceSignature
+	}
+	return n.blkBuilder.txExecutorBackend.Uptimes.AddMaintenanceWindow(msg.NodeID, window)
+}
+
+// verifyMaintenanceWindow returns nil iff [window] is well formed and starts
+// neither in the past nor too far in the future.
+func (n *network) verifyMaintenanceWindow(window uptime.MaintenanceWindow) error {
+	if err := window.Verify(); err != nil {
+		return err
+	}
+
+	now := n.blkBuilder.txExecutorBackend.Clk.Time()
+	switch {
+	case window.Start.Before(now.Add(-MaxMaintenanceClockSkew)):
+		return errMaintenanceInPast
+	case window.Start.After(now.Add(MaxMaintenanceAnnouncementLead)):
+		return errMaintenanceTooFarInFuture
+	default:
+		return nil
+	}
+}
+
+func (n *network) AnnounceMaintenance(window uptime.MaintenanceWindow) error {
+	if err := n.verifyMaintenanceWindow(window); err != nil {
+		return err
+	}
+
+	msg := &message.Maintenance{
+		NodeID:    n.ctx.NodeID,
+		StartTime: uint64(window.Start.Unix()),
+		EndTime:   uint64(window.End.Unix()),
+	}
+	unsignedMsg, err := warp.NewUnsignedMessage(n.ctx.NetworkID, n.ctx.ChainID, msg.Payload())
+	if err != nil {
+		return err
+	}
+	msg.Signature, err = n.ctx.WarpSigner.Sign(unsignedMsg)
+	if err != nil {
+		return fmt.Errorf("couldn't sign maintenance announcement: %w", err)
+	}
+
+	if err := n.recordMaintenance(msg, unsignedMsg); err != nil {
+		return err
+	}
+
+	msgBytes, err := message.Build(msg)
+	if err != nil {
+		return fmt.Errorf("AnnounceMaintenance: failed to build Maintenance message: %w", err)
+	}
+	n.recentTxs.Put(unsignedMsg.ID(), struct{}{})
+	return n.appSender.SendAppGossip(context.TODO(), msgBytes)
 }
 
 func (n *network) GossipTx(tx *txs.Tx) error {
@@ -161,9 +318,12 @@ func (n *network) GossipTx(tx *txs.Tx) error {
 		return nil
 	}
 	n.recentTxs.Put(txID, struct{}{})
+	return n.RegossipTx(tx)
+}
 
+func (n *network) RegossipTx(tx *txs.Tx) error {
 	n.ctx.Log.Debug("gossiping tx",
-		zap.Stringer("txID", txID),
+		zap.Stringer("txID", tx.ID()),
 	)
 
 	msg := &message.Tx{Tx: tx.Bytes()}
@@ -6,14 +6,18 @@ package builder
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/uptime"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/vms/components/message"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
 
 	txbuilder "github.com/DioneProtocol/odysseygo/vms/omegavm/txs/builder"
 )
@@ -145,3 +149,78 @@ func TestMempoolNewLocaTxIsGossiped(t *testing.T) {
 
 	require.Nil(gossipedBytes)
 }
+
+// show that a maintenance announcement from a non-validator is dropped
+func TestMaintenanceFromNonValidatorIsDropped(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	gossiped := false
+	env.sender.SendAppGossipF = func(context.Context, []byte) error {
+		gossiped = true
+		return nil
+	}
+
+	now := env.clk.Time()
+	msg := message.Maintenance{
+		NodeID:    ids.GenerateTestNodeID(),
+		StartTime: uint64(now.Unix()),
+		EndTime:   uint64(now.Add(time.Hour).Unix()),
+		Signature: make([]byte, bls.SignatureLen),
+	}
+	msgBytes, err := message.Build(&msg)
+	require.NoError(err)
+
+	// Free lock because [AppGossip] waits for the context lock
+	env.ctx.Lock.Unlock()
+	require.NoError(env.AppGossip(context.Background(), ids.GenerateTestNodeID(), msgBytes))
+	env.ctx.Lock.Lock()
+
+	require.False(gossiped)
+	windows, err := env.uptimes.GetMaintenanceWindows(msg.NodeID)
+	require.NoError(err)
+	require.Empty(windows)
+}
+
+// show that a maintenance window can't be announced retroactively
+func TestRetroactiveMaintenanceIsRejected(t *testing.T) {
+	require := require.New(t)
+
+	env := newEnvironment(t)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	gossiped := false
+	env.sender.SendAppGossipF = func(context.Context, []byte) error {
+		gossiped = true
+		return nil
+	}
+
+	now := env.clk.Time()
+	window := uptime.MaintenanceWindow{
+		Start: now.Add(-time.Hour),
+		End:   now.Add(time.Hour),
+	}
+	err := env.AnnounceMaintenance(window)
+	require.ErrorIs(err, errMaintenanceInPast)
+	require.False(gossiped)
+
+	msg := &message.Maintenance{
+		NodeID:    ids.GenerateTestNodeID(),
+		StartTime: uint64(window.Start.Unix()),
+		EndTime:   uint64(window.End.Unix()),
+		Signature: make([]byte, bls.SignatureLen),
+	}
+	unsignedMsg, err := warp.NewUnsignedMessage(env.ctx.NetworkID, env.ctx.ChainID, msg.Payload())
+	require.NoError(err)
+	n := env.Builder.(*builder).Network.(*network)
+	err = n.recordMaintenance(msg, unsignedMsg)
+	require.ErrorIs(err, errMaintenanceInPast)
+}
@@ -36,6 +36,8 @@ func init() {
 			RegisterApricotBlockTypes(c),
 			txs.RegisterUnsignedTxsTypes(c),
 			RegisterBanffBlockTypes(c),
+			txs.RegisterSubnetOwnershipTxsTypes(c),
+			txs.RegisterRestakeTypes(c),
 		)
 	}
 	errs.Add(
@@ -11,7 +11,9 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
@@ -32,6 +34,13 @@ type acceptor struct {
 	metrics      metrics.Metrics
 	validators   validators.Manager
 	bootstrapped *utils.Atomic[bool]
+
+	// If true, the subnets that this node becomes a validator of are tracked
+	// as their stakers are accepted.
+	trackValidatedSubnets bool
+	config                *config.Config
+	// subnets that are being tracked asynchronously
+	trackingSubnets set.Set[ids.ID]
 }
 
 func (a *acceptor) BanffAbortBlock(b *blocks.BanffAbortBlock) error {
@@ -85,6 +94,7 @@ func (a *acceptor) ApricotAtomicBlock(b *blocks.ApricotAtomicBlock) error {
 	if err := blkState.onAcceptState.Apply(a.state); err != nil {
 		return err
 	}
+	a.addConsumedAtomicUTXOs(b.Tx)
 
 	defer a.state.Abort()
 	batch, err := a.state.CommitBatch()
@@ -196,6 +206,8 @@ func (a *acceptor) optionBlock(b, parent blocks.Block, blockType string) error {
 		return err
 	}
 
+	a.trackNewValidatedSubnets()
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
@@ -256,6 +268,17 @@ func (a *acceptor) standardBlock(b blocks.Block, blockType string) error {
 		return err
 	}
 
+	// Record the receipts of the decision txs so that their outcome can be
+	// served without re-executing the block.
+	for _, tx := range b.Txs() {
+		a.state.AddTxReceipt(tx.ID(), &state.TxReceipt{
+			Fee:         tx.Burned(a.ctx.DIONEAssetID),
+			NumConsumed: uint32(tx.Unsigned.InputIDs().Len()),
+			NumProduced: uint32(len(tx.Unsigned.Outputs())),
+		})
+		a.addConsumedAtomicUTXOs(tx)
+	}
+
 	defer a.state.Abort()
 	batch, err := a.state.CommitBatch()
 	if err != nil {
@@ -275,6 +298,8 @@ func (a *acceptor) standardBlock(b blocks.Block, blockType string) error {
 		onAcceptFunc()
 	}
 
+	a.trackNewValidatedSubnets()
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
@@ -287,6 +312,52 @@ func (a *acceptor) standardBlock(b blocks.Block, blockType string) error {
 	return nil
 }
 
+// trackNewValidatedSubnets starts tracking the subnets that this node became a
+// current or pending validator of. The chain manager tracks the subnet through
+// the O-chain, which grabs the context lock that is held while accepting, so
+// it is called asynchronously.
+func (a *acceptor) trackNewValidatedSubnets() {
+	if !a.trackValidatedSubnets {
+		return
+	}
+
+	for subnetID := range a.state.ValidatedSubnets() {
+		if a.config.TrackedSubnets.Contains(subnetID) || a.trackingSubnets.Contains(subnetID) {
+			continue
+		}
+		a.trackingSubnets.Add(subnetID)
+
+		a.ctx.Log.Info("tracking validated subnet",
+			zap.Stringer("subnetID", subnetID),
+		)
+
+		subnetID := subnetID
+		go func() {
+			if err := a.config.Chains.TrackSubnet(subnetID); err != nil {
+				a.ctx.Log.Error("failed to track validated subnet",
+					zap.Stringer("subnetID", subnetID),
+					zap.Error(err),
+				)
+			}
+		}()
+	}
+}
+
+// addConsumedAtomicUTXOs records the atomic UTXOs imported by [tx] so that
+// shared memory entries that weren't removed when [tx] was accepted can be
+// detected.
+func (a *acceptor) addConsumedAtomicUTXOs(tx *txs.Tx) {
+	importTx, ok := tx.Unsigned.(*txs.ImportTx)
+	if !ok {
+		return
+	}
+
+	txID := tx.ID()
+	for _, in := range importTx.ImportedInputs {
+		a.state.AddConsumedAtomicUTXO(in.InputID(), txID)
+	}
+}
+
 func (a *acceptor) updateOrionFee(b *blocks.ApricotProposalBlock) error {
 	rewardValidatorTx, ok := b.Tx.Unsigned.(*txs.RewardValidatorTx)
 	if !ok {
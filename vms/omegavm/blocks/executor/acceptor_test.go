@@ -10,15 +10,18 @@ import (
 
 	"go.uber.org/mock/gomock"
 
+	"github.com/DioneProtocol/odysseygo/chains"
 	"github.com/DioneProtocol/odysseygo/chains/atomic"
 	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
@@ -239,6 +242,7 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 	s.EXPECT().CommitBatch().Return(batch, nil).Times(1)
 	s.EXPECT().Abort().Times(1)
 	onAcceptState.EXPECT().Apply(s).Times(1)
+	s.EXPECT().AddTxReceipt(blk.Transactions[0].ID(), &state.TxReceipt{}).Times(1)
 	sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1)
 	s.EXPECT().Checksum().Return(ids.Empty).Times(1)
 
@@ -441,3 +445,51 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 	require.NoError(acceptor.ApricotAbortBlock(blk))
 	require.Equal(blk.ID(), acceptor.backend.lastAccepted)
 }
+
+type subnetTrackerManager struct {
+	chains.Manager
+
+	trackedSubnets chan ids.ID
+}
+
+func (m *subnetTrackerManager) TrackSubnet(subnetID ids.ID) error {
+	m.trackedSubnets <- subnetID
+	return nil
+}
+
+func TestAcceptorTrackNewValidatedSubnets(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	var (
+		trackedSubnetID   = ids.GenerateTestID()
+		validatedSubnetID = ids.GenerateTestID()
+	)
+	chainManager := &subnetTrackerManager{
+		Manager:        chains.TestManager,
+		trackedSubnets: make(chan ids.ID, 2),
+	}
+
+	s := state.NewMockState(ctrl)
+	s.EXPECT().ValidatedSubnets().Return(set.Of(trackedSubnetID, validatedSubnetID)).Times(2)
+
+	acceptor := &acceptor{
+		backend: &backend{
+			ctx: &snow.Context{
+				Log: logging.NoLog{},
+			},
+			state: s,
+		},
+		trackValidatedSubnets: true,
+		config: &config.Config{
+			Chains:         chainManager,
+			TrackedSubnets: set.Of(trackedSubnetID),
+		},
+	}
+
+	// Only the subnet that isn't already tracked is tracked, and only once
+	acceptor.trackNewValidatedSubnets()
+	acceptor.trackNewValidatedSubnets()
+	require.Equal(validatedSubnetID, <-chainManager.trackedSubnets)
+	require.Empty(chainManager.trackedSubnets)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/vms/components/acceptance"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/mempool"
@@ -29,6 +30,9 @@ type backend struct {
 	blkIDToState map[ids.ID]*blockState
 	state        state.State
 
+	// acceptTimes records the local time that blocks are accepted at.
+	acceptTimes acceptance.Tracker
+
 	ctx *snow.Context
 }
 
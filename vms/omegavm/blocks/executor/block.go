@@ -38,7 +38,14 @@ func (b *Block) Verify(context.Context) error {
 }
 
 func (b *Block) Accept(context.Context) error {
-	return b.Visit(b.manager.acceptor)
+	blkID := b.ID()
+	// The timestamp must be fetched prior to accepting the block, as the
+	// block's state may be removed during acceptance.
+	blkTime := b.Timestamp()
+	if err := b.Visit(b.manager.acceptor); err != nil {
+		return err
+	}
+	return b.manager.acceptTimes.Accept(blkID, blkTime)
 }
 
 func (b *Block) Reject(context.Context) error {
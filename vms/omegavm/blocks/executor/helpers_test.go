@@ -19,6 +19,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/codec"
 	"github.com/DioneProtocol/odysseygo/codec/linearcodec"
 	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
 	"github.com/DioneProtocol/odysseygo/database/prefixdb"
 	"github.com/DioneProtocol/odysseygo/database/versiondb"
 	"github.com/DioneProtocol/odysseygo/ids"
@@ -37,6 +38,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/units"
 	"github.com/DioneProtocol/odysseygo/utils/wrappers"
 	"github.com/DioneProtocol/odysseygo/version"
+	"github.com/DioneProtocol/odysseygo/vms/components/acceptance"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/api"
@@ -195,11 +197,16 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller) *environment {
 	metrics := metrics.Noop
 
 	var err error
-	res.mempool, err = mempool.NewMempool("mempool", registerer, res)
+	res.mempool, err = mempool.NewMempool("mempool", registerer, memdb.New(), res)
 	if err != nil {
 		panic(fmt.Errorf("failed to create mempool: %w", err))
 	}
 
+	acceptTimes, err := acceptance.NewTracker(memdb.New(), "", registerer)
+	if err != nil {
+		panic(fmt.Errorf("failed to create accept times tracker: %w", err))
+	}
+
 	if ctrl == nil {
 		res.blkManager = NewManager(
 			res.mempool,
@@ -207,6 +214,7 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller) *environment {
 			res.state,
 			res.backend,
 			ovalidators.TestManager,
+			acceptTimes,
 		)
 		addSubnet(res)
 	} else {
@@ -216,6 +224,7 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller) *environment {
 			res.mockedState,
 			res.backend,
 			ovalidators.TestManager,
+			acceptTimes,
 		)
 		// we do not add any subnet to state, since we can mock
 		// whatever we need
@@ -6,6 +6,7 @@ package executor
 import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
+	"github.com/DioneProtocol/odysseygo/vms/components/acceptance"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
@@ -32,11 +33,13 @@ func NewManager(
 	s state.State,
 	txExecutorBackend *executor.Backend,
 	validatorManager validators.Manager,
+	acceptTimes acceptance.Tracker,
 ) Manager {
 	backend := &backend{
 		Mempool:      mempool,
 		lastAccepted: s.GetLastAccepted(),
 		state:        s,
+		acceptTimes:  acceptTimes,
 		ctx:          txExecutorBackend.Ctx,
 		blkIDToState: map[ids.ID]*blockState{},
 	}
@@ -52,6 +55,9 @@ func NewManager(
 			metrics:      metrics,
 			validators:   validatorManager,
 			bootstrapped: txExecutorBackend.Bootstrapped,
+
+			trackValidatedSubnets: txExecutorBackend.Config.TrackValidatedSubnets,
+			config:                txExecutorBackend.Config,
 		},
 		rejector: &rejector{
 			backend:         backend,
@@ -122,6 +122,7 @@ func TestBanffStandardBlockTimeVerification(t *testing.T) {
 	env.mockedState.EXPECT().GetLastAccepted().Return(parentID).AnyTimes()
 	env.mockedState.EXPECT().GetTimestamp().Return(chainTime).AnyTimes()
 	env.mockedState.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(uint64(0), nil).AnyTimes()
+	env.mockedState.EXPECT().GetSupplyChanges(constants.PrimaryNetworkID).Return(state.SupplyChanges{Base: 0}, nil).AnyTimes()
 
 	nextStakerTime := chainTime.Add(executor.SyncBound).Add(-1 * time.Second)
 
@@ -141,6 +142,7 @@ func TestBanffStandardBlockTimeVerification(t *testing.T) {
 	onParentAccept.EXPECT().GetStakeSyncTimestamp().Return(time.Time{}, nil).AnyTimes()
 	onParentAccept.EXPECT().GetStakerAccumulatedMintRate().Return(new(big.Int).SetUint64(1), nil).AnyTimes()
 	onParentAccept.EXPECT().GetCurrentSupply(constants.PrimaryNetworkID).Return(uint64(0), nil).AnyTimes()
+	onParentAccept.EXPECT().GetSupplyChanges(constants.PrimaryNetworkID).Return(state.SupplyChanges{Base: 0}, nil).AnyTimes()
 	onParentAccept.EXPECT().GetCurrentStakersLen().Return(uint64(0), nil).AnyTimes()
 	onParentAccept.EXPECT().GetLastAccumulatedFee().Return(uint64(0), nil).AnyTimes()
 	onParentAccept.EXPECT().GetCurrentAccumulatedFee().Return(uint64(0), nil).AnyTimes()
@@ -0,0 +1,472 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/api"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/executor"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+
+	omegaapi "github.com/DioneProtocol/odysseygo/vms/omegavm/api"
+)
+
+var (
+	errNoFromAddrs         = errors.New("no from addresses provided")
+	errWrongNumCredentials = errors.New("wrong number of credentials")
+	errWrongSignatureLen   = errors.New("wrong signature length")
+)
+
+// BuildSpendHeader is the set of arguments shared by the methods that build
+// unsigned transactions. Only the addresses that fund the tx are required, so
+// the keys never need to be imported into the keystore.
+type BuildSpendHeader struct {
+	// Addresses whose UTXOs fund the tx
+	api.JSONFromAddrs
+
+	// Address to send change to. Defaults to the first from address.
+	api.JSONChangeAddr
+
+	// Encoding of the returned unsigned tx
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// BuildTxReply is the response from the methods that build unsigned
+// transactions
+type BuildTxReply struct {
+	// The unsigned tx
+	Tx       string              `json:"tx"`
+	Encoding formatting.Encoding `json:"encoding"`
+
+	// Signers[i] are the addresses that must sign the unsigned tx, in order,
+	// to create the i-th credential of the tx.
+	Signers [][]string `json:"signers"`
+}
+
+// BuildAddValidatorArgs are the arguments to BuildAddValidator
+type BuildAddValidatorArgs struct {
+	// From addrs, change addr, encoding
+	BuildSpendHeader
+	omegaapi.Staker
+	// The address the staking reward, if applicable, will go to
+	RewardAddress     string       `json:"rewardAddress"`
+	DelegationFeeRate json.Float32 `json:"delegationFeeRate"`
+}
+
+// BuildAddValidator returns an unsigned tx that adds a validator to the
+// primary network along with the addresses that must sign it.
+func (s *Service) BuildAddValidator(_ *http.Request, args *BuildAddValidatorArgs, reply *BuildTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "buildAddValidator"),
+		logging.UserStrings("from", args.From),
+	)
+
+	if err := s.verifyStakerStartTime(&args.Staker); err != nil {
+		return err
+	}
+	switch {
+	case args.RewardAddress == "":
+		return errNoRewardAddress
+	case args.DelegationFeeRate < 0 || args.DelegationFeeRate > 100:
+		return errInvalidDelegationRate
+	}
+
+	rewardAddress, err := dione.ParseServiceAddress(s.addrManager, args.RewardAddress)
+	if err != nil {
+		return fmt.Errorf("problem while parsing reward address: %w", err)
+	}
+
+	ins, unstakedOuts, stakedOuts, signers, err := s.buildSpend(
+		&args.BuildSpendHeader,
+		uint64(args.Weight),
+		s.vm.AddPrimaryNetworkValidatorFee,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.buildTxReply(&txs.AddValidatorTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    s.vm.ctx.NetworkID,
+			BlockchainID: s.vm.ctx.ChainID,
+			Ins:          ins,
+			Outs:         unstakedOuts,
+		}},
+		Validator: txs.Validator{
+			NodeID: s.stakerNodeID(&args.Staker),
+			Start:  uint64(args.StartTime),
+			End:    uint64(args.EndTime),
+			Wght:   uint64(args.Weight),
+		},
+		StakeOuts: stakedOuts,
+		RewardsOwner: &secp256k1fx.OutputOwners{
+			Locktime:  0,
+			Threshold: 1,
+			Addrs:     []ids.ShortID{rewardAddress},
+		},
+		DelegationShares: uint32(10000 * args.DelegationFeeRate),
+	}, signers, args.Encoding, reply)
+}
+
+// BuildAddDelegatorArgs are the arguments to BuildAddDelegator
+type BuildAddDelegatorArgs struct {
+	// From addrs, change addr, encoding
+	BuildSpendHeader
+	omegaapi.Staker
+	RewardAddress string `json:"rewardAddress"`
+}
+
+// BuildAddDelegator returns an unsigned tx that adds a delegator to the
+// primary network along with the addresses that must sign it.
+func (s *Service) BuildAddDelegator(_ *http.Request, args *BuildAddDelegatorArgs, reply *BuildTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "buildAddDelegator"),
+		logging.UserStrings("from", args.From),
+	)
+
+	if err := s.verifyStakerStartTime(&args.Staker); err != nil {
+		return err
+	}
+	if args.RewardAddress == "" {
+		return errNoRewardAddress
+	}
+
+	rewardAddress, err := dione.ParseServiceAddress(s.addrManager, args.RewardAddress)
+	if err != nil {
+		return fmt.Errorf("problem parsing 'rewardAddress': %w", err)
+	}
+
+	ins, unlockedOuts, lockedOuts, signers, err := s.buildSpend(
+		&args.BuildSpendHeader,
+		uint64(args.Weight),
+		s.vm.AddPrimaryNetworkDelegatorFee,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.buildTxReply(&txs.AddDelegatorTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    s.vm.ctx.NetworkID,
+			BlockchainID: s.vm.ctx.ChainID,
+			Ins:          ins,
+			Outs:         unlockedOuts,
+		}},
+		Validator: txs.Validator{
+			NodeID: s.stakerNodeID(&args.Staker),
+			Start:  uint64(args.StartTime),
+			End:    uint64(args.EndTime),
+			Wght:   uint64(args.Weight),
+		},
+		StakeOuts: lockedOuts,
+		DelegationRewardsOwner: &secp256k1fx.OutputOwners{
+			Locktime:  0,
+			Threshold: 1,
+			Addrs:     []ids.ShortID{rewardAddress},
+		},
+	}, signers, args.Encoding, reply)
+}
+
+// BuildCreateSubnetArgs are the arguments to BuildCreateSubnet
+type BuildCreateSubnetArgs struct {
+	// From addrs, change addr, encoding
+	BuildSpendHeader
+	// The ID member of APISubnet is ignored
+	APISubnet
+}
+
+// BuildCreateSubnet returns an unsigned tx that creates a new subnet along
+// with the addresses that must sign it.
+func (s *Service) BuildCreateSubnet(_ *http.Request, args *BuildCreateSubnetArgs, reply *BuildTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "buildCreateSubnet"),
+		logging.UserStrings("from", args.From),
+	)
+
+	controlKeys, err := dione.ParseServiceAddresses(s.addrManager, args.ControlKeys)
+	if err != nil {
+		return err
+	}
+	ownerAddrs := controlKeys.List()
+	utils.Sort(ownerAddrs)
+
+	fee := s.vm.Config.GetCreateSubnetTxFee(s.vm.state.GetTimestamp())
+	ins, outs, _, signers, err := s.buildSpend(&args.BuildSpendHeader, 0, fee)
+	if err != nil {
+		return err
+	}
+
+	return s.buildTxReply(&txs.CreateSubnetTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    s.vm.ctx.NetworkID,
+			BlockchainID: s.vm.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Owner: &secp256k1fx.OutputOwners{
+			Threshold: uint32(args.Threshold),
+			Addrs:     ownerAddrs,
+		},
+	}, signers, args.Encoding, reply)
+}
+
+// BuildExportDIONEArgs are the arguments to BuildExportDIONE
+type BuildExportDIONEArgs struct {
+	// From addrs, change addr, encoding
+	BuildSpendHeader
+
+	// Amount of DIONE to send
+	Amount json.Uint64 `json:"amount"`
+
+	// Chain the funds are going to. Optional. Used if To address does not include the chainID.
+	TargetChain string `json:"targetChain"`
+
+	// ID of the address that will receive the DIONE. This address may include the
+	// chainID, which is used to determine what the destination chain is.
+	To string `json:"to"`
+}
+
+// BuildExportDIONE returns an unsigned tx that exports DIONE from the O-Chain
+// along with the addresses that must sign it.
+func (s *Service) BuildExportDIONE(_ *http.Request, args *BuildExportDIONEArgs, reply *BuildTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "buildExportDIONE"),
+		logging.UserStrings("from", args.From),
+	)
+
+	if args.Amount == 0 {
+		return errNoAmount
+	}
+
+	// Get the chainID and parse the to address
+	chainID, to, err := s.addrManager.ParseAddress(args.To)
+	if err != nil {
+		chainID, err = s.vm.ctx.BCLookup.Lookup(args.TargetChain)
+		if err != nil {
+			return err
+		}
+		to, err = ids.ShortFromString(args.To)
+		if err != nil {
+			return err
+		}
+	}
+
+	toBurn, err := math.Add64(uint64(args.Amount), s.vm.TxFee)
+	if err != nil {
+		return fmt.Errorf("amount (%d) + tx fee(%d) overflows", args.Amount, s.vm.TxFee)
+	}
+	ins, outs, _, signers, err := s.buildSpend(&args.BuildSpendHeader, 0, toBurn)
+	if err != nil {
+		return err
+	}
+
+	return s.buildTxReply(&txs.ExportTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    s.vm.ctx.NetworkID,
+			BlockchainID: s.vm.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		DestinationChain: chainID,
+		ExportedOutputs: []*dione.TransferableOutput{{
+			Asset: dione.Asset{ID: s.vm.ctx.DIONEAssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt: uint64(args.Amount),
+				OutputOwners: secp256k1fx.OutputOwners{
+					Locktime:  0,
+					Threshold: 1,
+					Addrs:     []ids.ShortID{to},
+				},
+			},
+		}},
+	}, signers, args.Encoding, reply)
+}
+
+// IssueSignedTxArgs are the arguments to IssueSignedTx
+type IssueSignedTxArgs struct {
+	// The unsigned tx, as returned by one of the build methods
+	Tx string `json:"tx"`
+
+	// Signatures[i] are the signatures, in order, of the i-th credential of
+	// the tx.
+	Signatures [][]string `json:"signatures"`
+
+	// Encoding of the unsigned tx and of the signatures
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// IssueSignedTx attaches the provided signatures to an unsigned tx and issues
+// the signed tx.
+func (s *Service) IssueSignedTx(_ *http.Request, args *IssueSignedTxArgs, reply *api.JSONTxID) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "issueSignedTx"),
+	)
+
+	unsignedBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+
+	tx := &txs.Tx{}
+	if _, err := txs.Codec.Unmarshal(unsignedBytes, &tx.Unsigned); err != nil {
+		return fmt.Errorf("problem parsing transaction: %w", err)
+	}
+
+	tx.Creds = make([]verify.Verifiable, len(args.Signatures))
+	for i, sigStrs := range args.Signatures {
+		cred := &secp256k1fx.Credential{
+			Sigs: make([][secp256k1.SignatureLen]byte, len(sigStrs)),
+		}
+		for j, sigStr := range sigStrs {
+			sig, err := formatting.Decode(args.Encoding, sigStr)
+			if err != nil {
+				return fmt.Errorf("problem decoding signature %d of credential %d: %w", j, i, err)
+			}
+			if len(sig) != secp256k1.SignatureLen {
+				return fmt.Errorf("%w: expected %d bytes but got %d", errWrongSignatureLen, secp256k1.SignatureLen, len(sig))
+			}
+			copy(cred.Sigs[j][:], sig)
+		}
+		tx.Creds[i] = cred
+	}
+	if numInputs := tx.Unsigned.InputIDs().Len(); numInputs != len(tx.Creds) {
+		return fmt.Errorf("%w: expected %d but got %d", errWrongNumCredentials, numInputs, len(tx.Creds))
+	}
+
+	if err := tx.Initialize(txs.Codec); err != nil {
+		return err
+	}
+	if err := s.vm.Builder.AddUnverifiedTx(tx); err != nil {
+		return fmt.Errorf("couldn't issue tx: %w", err)
+	}
+
+	reply.TxID = tx.ID()
+	return nil
+}
+
+// verifyStakerStartTime defaults the start time of [staker] to the earliest
+// time a staker can be added and verifies that it is within the allowed
+// range. Additionally, the deprecated StakeAmount field is copied to Weight.
+func (s *Service) verifyStakerStartTime(staker *omegaapi.Staker) error {
+	now := s.vm.clock.Time()
+	minAddStakerUnix := json.Uint64(now.Add(minAddStakerDelay).Unix())
+	maxAddStakerUnix := json.Uint64(now.Add(executor.MaxFutureStartTime).Unix())
+
+	if staker.StartTime == 0 {
+		staker.StartTime = minAddStakerUnix
+	}
+
+	switch {
+	case staker.StartTime < minAddStakerUnix:
+		return errStartTimeTooSoon
+	case staker.StartTime > maxAddStakerUnix:
+		return errStartTimeTooLate
+	}
+
+	// TODO: Remove after StakeAmount is removed from [omegaapi.Staker].
+	if staker.StakeAmount != nil {
+		staker.Weight = *staker.StakeAmount
+	}
+	return nil
+}
+
+// stakerNodeID returns the node ID of [staker], defaulting to this node's ID.
+func (s *Service) stakerNodeID(staker *omegaapi.Staker) ids.NodeID {
+	if staker.NodeID == ids.EmptyNodeID {
+		return s.vm.ctx.NodeID
+	}
+	return staker.NodeID
+}
+
+// buildSpend selects the UTXOs of the from addresses of [header] that stake
+// [amount] and burn [fee]. Returns the inputs that consume them, the outputs
+// that return the change, the outputs that are staked and the addresses that
+// must sign each input.
+func (s *Service) buildSpend(header *BuildSpendHeader, amount uint64, fee uint64) (
+	[]*dione.TransferableInput,
+	[]*dione.TransferableOutput,
+	[]*dione.TransferableOutput,
+	[][]ids.ShortID,
+	error,
+) {
+	if len(header.From) == 0 {
+		return nil, nil, nil, nil, errNoFromAddrs
+	}
+	fromAddrs, err := dione.ParseServiceAddresses(s.addrManager, header.From)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	changeAddr, err := dione.ParseServiceAddress(s.addrManager, header.From[0])
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if header.ChangeAddr != "" {
+		changeAddr, err = dione.ParseServiceAddress(s.addrManager, header.ChangeAddr)
+		if err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	ins, outs, stakedOuts, signers, err := s.vm.utxoHandler.SpendFromAddrs(s.vm.state, fromAddrs, amount, fee, changeAddr)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+	return ins, outs, stakedOuts, signers, nil
+}
+
+// buildTxReply populates [reply] with [utx] and the addresses that must sign
+// it.
+func (s *Service) buildTxReply(
+	utx txs.UnsignedTx,
+	signers [][]ids.ShortID,
+	encoding formatting.Encoding,
+	reply *BuildTxReply,
+) error {
+	tx := &txs.Tx{Unsigned: utx}
+	if err := tx.Initialize(txs.Codec); err != nil {
+		return fmt.Errorf("problem creating transaction: %w", err)
+	}
+	if err := tx.SyntacticVerify(s.vm.ctx); err != nil {
+		return err
+	}
+
+	var err error
+	reply.Tx, err = formatting.Encode(encoding, utx.Bytes())
+	if err != nil {
+		return fmt.Errorf("couldn't encode tx as string: %w", err)
+	}
+	reply.Encoding = encoding
+
+	reply.Signers = make([][]string, len(signers))
+	for i, addrs := range signers {
+		reply.Signers[i] = make([]string, len(addrs))
+		for j, addr := range addrs {
+			reply.Signers[i][j], err = s.addrManager.FormatLocalAddress(addr)
+			if err != nil {
+				return fmt.Errorf("problem formatting address: %w", err)
+			}
+		}
+	}
+	return nil
+}
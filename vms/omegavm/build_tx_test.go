@@ -0,0 +1,169 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/api"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/hashing"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+
+	omegaapi "github.com/DioneProtocol/odysseygo/vms/omegavm/api"
+)
+
+// signBuiltTx signs the unsigned tx in [reply] with the keys of its signers
+func signBuiltTx(t *testing.T, service *Service, reply *BuildTxReply) [][]string {
+	require := require.New(t)
+
+	unsignedBytes, err := formatting.Decode(reply.Encoding, reply.Tx)
+	require.NoError(err)
+	hash := hashing.ComputeHash256(unsignedBytes)
+
+	keysByAddr := make(map[string]*secp256k1.PrivateKey, len(keys))
+	for _, key := range keys {
+		addrStr, err := service.addrManager.FormatLocalAddress(key.PublicKey().Address())
+		require.NoError(err)
+		keysByAddr[addrStr] = key
+	}
+
+	sigs := make([][]string, len(reply.Signers))
+	for i, signers := range reply.Signers {
+		sigs[i] = make([]string, len(signers))
+		for j, signer := range signers {
+			key, ok := keysByAddr[signer]
+			require.True(ok)
+			sig, err := key.SignHash(hash)
+			require.NoError(err)
+			sigs[i][j], err = formatting.Encode(reply.Encoding, sig)
+			require.NoError(err)
+		}
+	}
+	return sigs
+}
+
+func TestBuildAddValidatorAndIssueSignedTx(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	fromAddrStr, err := service.addrManager.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+	rewardAddrStr, err := service.addrManager.FormatLocalAddress(ids.GenerateTestShortID())
+	require.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	startTime := service.vm.clock.Time().Add(minAddStakerDelay).Add(time.Second)
+	args := &BuildAddValidatorArgs{
+		BuildSpendHeader: BuildSpendHeader{
+			Encoding: formatting.Hex,
+		},
+		Staker: omegaapi.Staker{
+			NodeID:    nodeID,
+			StartTime: json.Uint64(startTime.Unix()),
+			EndTime:   json.Uint64(startTime.Add(defaultMinValidatorStakingDuration).Unix()),
+			Weight:    json.Uint64(service.vm.MinValidatorStake),
+		},
+		RewardAddress:     rewardAddrStr,
+		DelegationFeeRate: 10,
+	}
+	reply := &BuildTxReply{}
+	err = service.BuildAddValidator(nil, args, reply)
+	require.ErrorIs(err, errNoFromAddrs)
+
+	args.From = []string{fromAddrStr}
+	require.NoError(service.BuildAddValidator(nil, args, reply))
+	require.Equal(formatting.Hex, reply.Encoding)
+	require.NotEmpty(reply.Signers)
+	for _, signers := range reply.Signers {
+		require.Equal([]string{fromAddrStr}, signers)
+	}
+
+	issueArgs := &IssueSignedTxArgs{
+		Tx:       reply.Tx,
+		Encoding: reply.Encoding,
+	}
+	issueReply := &api.JSONTxID{}
+	err = service.IssueSignedTx(nil, issueArgs, issueReply)
+	require.ErrorIs(err, errWrongNumCredentials)
+
+	issueArgs.Signatures = signBuiltTx(t, service, reply)
+	require.NoError(service.IssueSignedTx(nil, issueArgs, issueReply))
+
+	blk, err := service.vm.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+
+	tx, _, err := service.vm.state.GetTx(issueReply.TxID)
+	require.NoError(err)
+	require.IsType(&txs.AddValidatorTx{}, tx.Unsigned)
+
+	staker, err := service.vm.state.GetPendingValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(err)
+	require.Equal(issueReply.TxID, staker.TxID)
+}
+
+func TestBuildExportDIONE(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	fromAddrStr, err := service.addrManager.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+	changeAddr := ids.GenerateTestShortID()
+	changeAddrStr, err := service.addrManager.FormatLocalAddress(changeAddr)
+	require.NoError(err)
+
+	args := &BuildExportDIONEArgs{
+		BuildSpendHeader: BuildSpendHeader{
+			JSONFromAddrs:  api.JSONFromAddrs{From: []string{fromAddrStr}},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddrStr},
+			Encoding:       formatting.Hex,
+		},
+		TargetChain: "A",
+		To:          ids.GenerateTestShortID().String(),
+	}
+	reply := &BuildTxReply{}
+	err = service.BuildExportDIONE(nil, args, reply)
+	require.ErrorIs(err, errNoAmount)
+
+	args.Amount = 1000
+	require.NoError(service.BuildExportDIONE(nil, args, reply))
+
+	unsignedBytes, err := formatting.Decode(reply.Encoding, reply.Tx)
+	require.NoError(err)
+	var utx txs.UnsignedTx
+	_, err = txs.Codec.Unmarshal(unsignedBytes, &utx)
+	require.NoError(err)
+	require.IsType(&txs.ExportTx{}, utx)
+
+	exportTx := utx.(*txs.ExportTx)
+	require.Equal(service.vm.ctx.AChainID, exportTx.DestinationChain)
+	require.Len(exportTx.Ins, len(reply.Signers))
+	require.Len(exportTx.ExportedOutputs, 1)
+	require.Equal(uint64(1000), exportTx.ExportedOutputs[0].Out.Amount())
+	for _, out := range exportTx.Outs {
+		require.IsType(&secp256k1fx.TransferOutput{}, out.Out)
+		require.Equal([]ids.ShortID{changeAddr}, out.Out.(*secp256k1fx.TransferOutput).Addrs)
+	}
+}
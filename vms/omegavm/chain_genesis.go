@@ -0,0 +1,162 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/api/metrics"
+	"github.com/DioneProtocol/odysseygo/chains/atomic"
+	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/version"
+	"github.com/DioneProtocol/odysseygo/vms/components/feecollector"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+)
+
+var (
+	_ common.AppSender = noopAppSender{}
+
+	errUnexpectedVMType = errors.New("unexpected VM type")
+)
+
+// verifyChainGenesis returns an error if the VM [vmID], running the FXs
+// [fxIDs], fails to initialize a blockchain of [subnetID] from
+// [genesisBytes]. The VM is initialized in a throwaway context, with an
+// in-memory database and without any network access, and shut down again.
+//
+// Invariant: The context lock must be held, as the O-chain's validator state
+// is passed to the VM without grabbing it.
+func (vm *VM) verifyChainGenesis(
+	ctx context.Context,
+	subnetID ids.ID,
+	vmID ids.ID,
+	fxIDs []ids.ID,
+	genesisBytes []byte,
+) error {
+	log := logging.NoLog{}
+	vmFactory, err := vm.VMManager.GetFactory(vmID)
+	if err != nil {
+		return fmt.Errorf("couldn't get factory of VM %s: %w", vmID, err)
+	}
+	chainVMIntf, err := vmFactory.New(log)
+	if err != nil {
+		return fmt.Errorf("couldn't create VM %s: %w", vmID, err)
+	}
+	chainVM, ok := chainVMIntf.(common.VM)
+	if !ok {
+		return fmt.Errorf("%w: %T", errUnexpectedVMType, chainVMIntf)
+	}
+
+	fxs := make([]*common.Fx, len(fxIDs))
+	for i, fxID := range fxIDs {
+		fxFactory, err := vm.VMManager.GetFactory(fxID)
+		if err != nil {
+			return fmt.Errorf("couldn't get factory of FX %s: %w", fxID, err)
+		}
+		fx, err := fxFactory.New(log)
+		if err != nil {
+			return fmt.Errorf("couldn't create FX %s: %w", fxID, err)
+		}
+		fxs[i] = &common.Fx{
+			ID: fxID,
+			Fx: fx,
+		}
+	}
+
+	chainDataDir, err := os.MkdirTemp("", "genesis")
+	if err != nil {
+		return fmt.Errorf("couldn't create chain data dir: %w", err)
+	}
+	defer os.RemoveAll(chainDataDir)
+
+	sk, err := bls.NewSecretKey()
+	if err != nil {
+		return fmt.Errorf("couldn't create signing key: %w", err)
+	}
+
+	// The ID of the blockchain isn't known until the tx creating it is
+	// issued.
+	chainID := ids.Empty
+	chainCtx := &snow.Context{
+		NetworkID: vm.ctx.NetworkID,
+		SubnetID:  subnetID,
+		ChainID:   chainID,
+		NodeID:    vm.ctx.NodeID,
+		PublicKey: bls.PublicFromSecretKey(sk),
+
+		AChainID:     vm.ctx.AChainID,
+		DChainID:     vm.ctx.DChainID,
+		DIONEAssetID: vm.ctx.DIONEAssetID,
+
+		Log:          log,
+		SharedMemory: atomic.NewMemory(memdb.New()).NewSharedMemory(chainID),
+		FeeCollector: feecollector.NewDummyCollector(),
+		BCLookup:     vm.ctx.BCLookup,
+		Metrics:      metrics.NewOptionalGatherer(),
+
+		WarpSigner: warp.NewSigner(sk, vm.ctx.NetworkID, chainID),
+
+		ValidatorState: vm.State,
+		ChainDataDir:   chainDataDir,
+	}
+
+	err = chainVM.Initialize(
+		ctx,
+		chainCtx,
+		manager.NewMemDB(version.CurrentDatabase),
+		genesisBytes,
+		nil,
+		nil,
+		make(chan common.Message, 1),
+		fxs,
+		noopAppSender{},
+	)
+	if err != nil {
+		return err
+	}
+	return chainVM.Shutdown(ctx)
+}
+
+// noopAppSender drops the messages of a VM that isn't connected to the
+// network.
+type noopAppSender struct{}
+
+func (noopAppSender) SendAppRequest(context.Context, set.Set[ids.NodeID], uint32, []byte) error {
+	return nil
+}
+
+func (noopAppSender) SendAppResponse(context.Context, ids.NodeID, uint32, []byte) error {
+	return nil
+}
+
+func (noopAppSender) SendAppGossip(context.Context, []byte) error {
+	return nil
+}
+
+func (noopAppSender) SendAppGossipSpecific(context.Context, set.Set[ids.NodeID], []byte) error {
+	return nil
+}
+
+func (noopAppSender) SendAppRequestWithDeadline(context.Context, set.Set[ids.NodeID], uint32, time.Duration, []byte) error {
+	return nil
+}
+
+func (noopAppSender) SendCrossChainAppRequest(context.Context, ids.ID, uint32, []byte) error {
+	return nil
+}
+
+func (noopAppSender) SendCrossChainAppResponse(context.Context, ids.ID, uint32, []byte) error {
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
 	"github.com/DioneProtocol/odysseygo/utils/json"
 	"github.com/DioneProtocol/odysseygo/utils/rpc"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 
 	omegaapi "github.com/DioneProtocol/odysseygo/vms/omegavm/api"
@@ -67,21 +68,64 @@ type Client interface {
 		startUTXOID ids.ID,
 		options ...rpc.Option,
 	) ([][]byte, ids.ShortID, ids.ID, error)
+	// InspectAtomicUTXOs returns the atomic UTXOs controlled by [addrs] from
+	// [sourceChain] along with the accepted txs that already imported them.
+	InspectAtomicUTXOs(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		sourceChain string,
+		limit uint32,
+		startAddress ids.ShortID,
+		startUTXOID ids.ID,
+		options ...rpc.Option,
+	) (*InspectAtomicUTXOsReply, error)
 	// GetSubnets returns information about the specified subnets
 	//
 	// Deprecated: Subnets should be fetched from a dedicated indexer.
 	GetSubnets(ctx context.Context, subnetIDs []ids.ID, options ...rpc.Option) ([]ClientSubnet, error)
+	// GetSubnet returns the owner, the staking parameters and the blockchains
+	// of [subnetID]
+	GetSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (*GetSubnetResponse, error)
 	// GetStakingAssetID returns the assetID of the asset used for staking on
 	// subnet corresponding to [subnetID]
 	GetStakingAssetID(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (ids.ID, error)
+	// GetSubnetTransformation returns the staking parameters of the
+	// permissionless subnet [subnetID]
+	GetSubnetTransformation(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (*APISubnetTransformation, error)
 	// GetCurrentValidators returns the list of current validators for subnet with ID [subnetID]
 	GetCurrentValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]ClientPermissionlessValidator, error)
+	// GetCurrentValidatorsPage returns up to [limit] current validators of
+	// [subnetID], sorted by [sortBy] and skipping the first [offset]
+	// validators, along with the total number of validators.
+	GetCurrentValidatorsPage(
+		ctx context.Context,
+		subnetID ids.ID,
+		sortBy string,
+		descending bool,
+		offset uint64,
+		limit uint64,
+		options ...rpc.Option,
+	) ([]ClientPermissionlessValidator, uint64, error)
 	// GetPendingValidators returns the list of pending validators for subnet with ID [subnetID]
 	GetPendingValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]interface{}, []interface{}, error)
+	// GetStakerSchedule returns up to [limit] upcoming staker set changes of
+	// [subnetID], ordered by the time they are scheduled to occur
+	GetStakerSchedule(ctx context.Context, subnetID ids.ID, limit uint64, options ...rpc.Option) ([]StakerEvent, error)
+	// GetRewardOwners returns the reward owners of the current or pending
+	// permissionless validators of [subnetID] in [nodeIDs]
+	GetRewardOwners(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]ClientRewardOwners, error)
 	// GetCurrentSupply returns an upper bound on the supply of DIONE in the system along with the O-chain height
 	GetCurrentSupply(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, uint64, error)
+	// EstimateReward returns the potential reward of staking [stakeAmount] on
+	// [subnetID] from [startTime] to [endTime], calculated against the current
+	// supply
+	EstimateReward(ctx context.Context, subnetID ids.ID, stakeAmount uint64, startTime uint64, endTime uint64, options ...rpc.Option) (uint64, error)
 	// SampleValidators returns the nodeIDs of a sample of [sampleSize] validators from the current validator set for subnet with ID [subnetID]
 	SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error)
+	// GetValidatorUptime returns the locally observed uptime of the current validator [nodeID] of [subnetID]
+	GetValidatorUptime(ctx context.Context, nodeID ids.NodeID, subnetID ids.ID, options ...rpc.Option) (*APIValidatorUptime, error)
+	// GetValidatorUptimes returns the locally observed uptimes of all the current validators of [subnetID]
+	GetValidatorUptimes(ctx context.Context, subnetID ids.ID, options ...rpc.Option) ([]APIValidatorUptime, error)
 	// AddValidator issues a transaction to add a validator to the primary network
 	// and returns the txID
 	//
@@ -117,6 +161,48 @@ type Client interface {
 		endTime uint64,
 		options ...rpc.Option,
 	) (ids.ID, error)
+	// AddPermissionlessValidator issues a transaction to add validator
+	// [nodeID] to the permissionless subnet with ID [subnetID], staking the
+	// subnet's staking asset, and returns the txID. [pop] may be nil for
+	// validators that don't register a BLS key.
+	//
+	// Deprecated: Transactions should be issued using the
+	// `odysseygo/wallet/chain/o.Wallet` utility.
+	AddPermissionlessValidator(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		subnetID ids.ID,
+		nodeID ids.NodeID,
+		pop *signer.ProofOfPossession,
+		rewardAddress ids.ShortID,
+		delegationRewardAddress ids.ShortID,
+		stakeAmount,
+		startTime,
+		endTime uint64,
+		delegationFeeRate float32,
+		options ...rpc.Option,
+	) (ids.ID, error)
+	// AddPermissionlessDelegator issues a transaction to delegate to validator
+	// [nodeID] of the permissionless subnet with ID [subnetID], staking the
+	// subnet's staking asset, and returns the txID
+	//
+	// Deprecated: Transactions should be issued using the
+	// `odysseygo/wallet/chain/o.Wallet` utility.
+	AddPermissionlessDelegator(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		subnetID ids.ID,
+		nodeID ids.NodeID,
+		rewardAddress ids.ShortID,
+		stakeAmount,
+		startTime,
+		endTime uint64,
+		options ...rpc.Option,
+	) (ids.ID, error)
 	// AddSubnetValidator issues a transaction to add validator [nodeID] to subnet
 	// with ID [subnetID] and returns the txID
 	//
@@ -147,6 +233,46 @@ type Client interface {
 		threshold uint32,
 		options ...rpc.Option,
 	) (ids.ID, error)
+	// TransferSubnetOwnership issues a transaction that replaces the control
+	// keys of [subnetID] and returns the txID
+	TransferSubnetOwnership(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		subnetID ids.ID,
+		controlKeys []ids.ShortID,
+		threshold uint32,
+		options ...rpc.Option,
+	) (ids.ID, error)
+	// TransformSubnet issues a transaction that transforms [subnetID] into a
+	// permissionless subnet staking [assetID] and returns the txID
+	//
+	// Deprecated: Transactions should be issued using the
+	// `odysseygo/wallet/chain/o.Wallet` utility.
+	TransformSubnet(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		subnetID ids.ID,
+		assetID ids.ID,
+		initialSupply uint64,
+		maxSupply uint64,
+		minConsumptionRate uint64,
+		maxConsumptionRate uint64,
+		minValidatorStake uint64,
+		maxValidatorStake uint64,
+		minValidatorStakeDuration time.Duration,
+		maxValidatorStakeDuration time.Duration,
+		minDelegatorStakeDuration time.Duration,
+		maxDelegatorStakeDuration time.Duration,
+		minDelegationFee uint32,
+		minDelegatorStake uint64,
+		maxValidatorWeightFactor byte,
+		uptimeRequirement uint32,
+		options ...rpc.Option,
+	) (ids.ID, error)
 	// ExportDIONE issues an ExportTx transaction and returns the txID
 	//
 	// Deprecated: Transactions should be issued using the
@@ -190,32 +316,102 @@ type Client interface {
 		genesisData []byte,
 		options ...rpc.Option,
 	) (ids.ID, error)
+	// ValidateCreateBlockchain checks the arguments of CreateBlockchain without
+	// issuing a transaction and returns the creation fee along with the reasons
+	// the creation would fail
+	ValidateCreateBlockchain(
+		ctx context.Context,
+		user api.UserPass,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		subnetID ids.ID,
+		vmID string,
+		fxIDs []string,
+		name string,
+		genesisData []byte,
+		options ...rpc.Option,
+	) (*ValidateCreateBlockchainReply, error)
 	// GetBlockchainStatus returns the current status of blockchain with ID: [blockchainID]
 	GetBlockchainStatus(ctx context.Context, blockchainID string, options ...rpc.Option) (status.BlockchainStatus, error)
 	// ValidatedBy returns the ID of the Subnet that validates [blockchainID]
 	ValidatedBy(ctx context.Context, blockchainID ids.ID, options ...rpc.Option) (ids.ID, error)
 	// Validates returns the list of blockchains that are validated by the subnet with ID [subnetID]
 	Validates(ctx context.Context, subnetID ids.ID, options ...rpc.Option) ([]ids.ID, error)
-	// GetBlockchains returns the list of blockchains on the omega
+	// GetBlockchains returns the list of blockchains on the omega. If
+	// [verbose] is true, the status of each blockchain on the queried node is
+	// included.
 	//
 	// Deprecated: Blockchains should be fetched from a dedicated indexer.
-	GetBlockchains(ctx context.Context, options ...rpc.Option) ([]APIBlockchain, error)
+	GetBlockchains(ctx context.Context, verbose bool, options ...rpc.Option) ([]APIBlockchain, error)
 	// IssueTx issues the transaction and returns its txID
 	IssueTx(ctx context.Context, tx []byte, options ...rpc.Option) (ids.ID, error)
+	// IssueTxs issues a batch of txs and returns the outcome of each of them
+	IssueTxs(ctx context.Context, txs [][]byte, options ...rpc.Option) ([]api.IssueTxResult, error)
+	// BuildAddValidator returns an unsigned tx that adds a validator to the
+	// primary network and is funded by [from] along with the addresses that
+	// must sign it
+	BuildAddValidator(
+		ctx context.Context,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		rewardAddress ids.ShortID,
+		nodeID ids.NodeID,
+		stakeAmount,
+		startTime,
+		endTime uint64,
+		delegationFeeRate float32,
+		options ...rpc.Option,
+	) (*BuildTxReply, error)
+	// BuildAddDelegator returns an unsigned tx that adds a delegator to the
+	// primary network and is funded by [from] along with the addresses that
+	// must sign it
+	BuildAddDelegator(
+		ctx context.Context,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		rewardAddress ids.ShortID,
+		nodeID ids.NodeID,
+		stakeAmount,
+		startTime,
+		endTime uint64,
+		options ...rpc.Option,
+	) (*BuildTxReply, error)
+	// BuildCreateSubnet returns an unsigned tx that creates a subnet and is
+	// funded by [from] along with the addresses that must sign it
+	BuildCreateSubnet(
+		ctx context.Context,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		controlKeys []ids.ShortID,
+		threshold uint32,
+		options ...rpc.Option,
+	) (*BuildTxReply, error)
+	// BuildExportDIONE returns an unsigned tx that exports [amount] of DIONE
+	// from [from] to [to] on [targetChain] along with the addresses that must
+	// sign it
+	BuildExportDIONE(
+		ctx context.Context,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		targetChain string,
+		to ids.ShortID,
+		amount uint64,
+		options ...rpc.Option,
+	) (*BuildTxReply, error)
+	// IssueSignedTx attaches [sigs] to [unsignedTxBytes] and issues the signed
+	// tx. [sigs][i] are the signatures of the i-th credential of the tx.
+	IssueSignedTx(
+		ctx context.Context,
+		unsignedTxBytes []byte,
+		sigs [][][secp256k1.SignatureLen]byte,
+		options ...rpc.Option,
+	) (ids.ID, error)
 	// GetTx returns the byte representation of the transaction corresponding to [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetTxStatus returns the status of the transaction corresponding to [txID]
 	GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetTxStatusResponse, error)
-	// AwaitTxDecided polls [GetTxStatus] until a status is returned that
-	// implies the tx may be decided.
-	// TODO: Move this function off of the Client interface into a utility
-	// function.
-	AwaitTxDecided(
-		ctx context.Context,
-		txID ids.ID,
-		freq time.Duration,
-		options ...rpc.Option,
-	) (*GetTxStatusResponse, error)
+	// GetPendingTxs returns the transactions currently in the mempool
+	GetPendingTxs(ctx context.Context, options ...rpc.Option) ([]ClientPendingTx, error)
 	// GetStake returns the amount of nDIONE that [addrs] have cumulatively
 	// staked on the Primary Network.
 	//
@@ -228,8 +424,12 @@ type Client interface {
 		options ...rpc.Option,
 	) (map[ids.ID]uint64, [][]byte, error)
 	// GetMinStake returns the minimum staking amount in nDIONE for validators
-	// and delegators respectively
-	GetMinStake(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, uint64, error)
+	// and delegators respectively, and the maximum weight of a validator,
+	// including delegations, as a multiple of its own stake
+	GetMinStake(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, uint64, byte, error)
+	// GetTxConfig returns the fees, staking limits and supply parameters of
+	// the chain
+	GetTxConfig(ctx context.Context, options ...rpc.Option) (*GetTxConfigReply, error)
 	// GetTotalStake returns the total amount (in nDIONE) staked on the network
 	GetTotalStake(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, error)
 	// GetMaxStakeAmount returns the maximum amount of nDIONE staking to the named
@@ -251,6 +451,12 @@ type Client interface {
 	GetRewardUTXOs(context.Context, *api.GetTxArgs, ...rpc.Option) ([][]byte, error)
 	// GetTimestamp returns the current chain timestamp
 	GetTimestamp(ctx context.Context, options ...rpc.Option) (time.Time, error)
+	// AnnounceMaintenance announces that the node will be offline between
+	// [startTime] and [endTime]
+	AnnounceMaintenance(ctx context.Context, startTime time.Time, endTime time.Time, options ...rpc.Option) error
+	// GetMaintenanceWindows returns the maintenance windows announced by
+	// [nodeID] that the node has recorded
+	GetMaintenanceWindows(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) ([]APIMaintenanceWindow, error)
 	// GetValidatorsAt returns the weights of the validator set of a provided
 	// subnet at the specified height.
 	GetValidatorsAt(
@@ -259,10 +465,27 @@ type Client interface {
 		height uint64,
 		options ...rpc.Option,
 	) (map[ids.NodeID]*validators.GetValidatorOutput, error)
+	// GetValidatorSetDiffs returns the validator set of a provided subnet at
+	// [startHeight] along with the changes to that set at every height up to
+	// and including [endHeight].
+	GetValidatorSetDiffs(
+		ctx context.Context,
+		subnetID ids.ID,
+		startHeight uint64,
+		endHeight uint64,
+		options ...rpc.Option,
+	) (*GetValidatorSetDiffsReply, error)
 	// GetBlock returns the block with the given id.
 	GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetBlockByHeight returns the block at the given [height].
 	GetBlockByHeight(ctx context.Context, height uint64, options ...rpc.Option) ([]byte, error)
+	// GetBlockRange returns up to [numBlocks] consecutive accepted blocks,
+	// starting at [startHeight]. Fewer blocks are returned if the range
+	// extends past the last accepted block.
+	GetBlockRange(ctx context.Context, startHeight uint64, numBlocks uint64, options ...rpc.Option) ([][]byte, error)
+	// GetBlockReceipts returns the receipts of the txs of the accepted
+	// standard block with the given id.
+	GetBlockReceipts(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]*TxReceipt, error)
 }
 
 // Client implementation for interacting with the O Chain endpoint
@@ -381,6 +604,29 @@ func (c *client) GetAtomicUTXOs(
 	return utxos, endAddr, endUTXOID, err
 }
 
+func (c *client) InspectAtomicUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	sourceChain string,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) (*InspectAtomicUTXOsReply, error) {
+	res := &InspectAtomicUTXOsReply{}
+	err := c.requester.SendRequest(ctx, "omega.inspectAtomicUTXOs", &api.GetUTXOsArgs{
+		Addresses:   ids.ShortIDsToStrings(addrs),
+		SourceChain: sourceChain,
+		Limit:       json.Uint32(limit),
+		StartIndex: api.Index{
+			Address: startAddress.String(),
+			UTXO:    startUTXOID.String(),
+		},
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
 // ClientSubnet is a representation of a subnet used in client methods
 type ClientSubnet struct {
 	// ID of the subnet
@@ -416,6 +662,14 @@ func (c *client) GetSubnets(ctx context.Context, ids []ids.ID, options ...rpc.Op
 	return subnets, nil
 }
 
+func (c *client) GetSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (*GetSubnetResponse, error) {
+	res := &GetSubnetResponse{}
+	err := c.requester.SendRequest(ctx, "omega.getSubnet", &GetSubnetArgs{
+		SubnetID: subnetID,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetStakingAssetID(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (ids.ID, error) {
 	res := &GetStakingAssetIDResponse{}
 	err := c.requester.SendRequest(ctx, "omega.getStakingAssetID", &GetStakingAssetIDArgs{
@@ -424,6 +678,14 @@ func (c *client) GetStakingAssetID(ctx context.Context, subnetID ids.ID, options
 	return res.AssetID, err
 }
 
+func (c *client) GetSubnetTransformation(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (*APISubnetTransformation, error) {
+	res := &APISubnetTransformation{}
+	err := c.requester.SendRequest(ctx, "omega.getSubnetTransformation", &GetSubnetTransformationArgs{
+		SubnetID: subnetID,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetCurrentValidators(
 	ctx context.Context,
 	subnetID ids.ID,
@@ -441,6 +703,37 @@ func (c *client) GetCurrentValidators(
 	return getClientPermissionlessValidators(res.Validators)
 }
 
+func (c *client) GetCurrentValidatorsPage(
+	ctx context.Context,
+	subnetID ids.ID,
+	sortBy string,
+	descending bool,
+	offset uint64,
+	limit uint64,
+	options ...rpc.Option,
+) ([]ClientPermissionlessValidator, uint64, error) {
+	res := &GetCurrentValidatorsReply{}
+	err := c.requester.SendRequest(ctx, "omega.getCurrentValidators", &GetCurrentValidatorsArgs{
+		SubnetID:   subnetID,
+		SortBy:     sortBy,
+		Descending: descending,
+		Offset:     json.Uint64(offset),
+		Limit:      json.Uint64(limit),
+	}, res, options...)
+	if err != nil {
+		return nil, 0, err
+	}
+	vdrs, err := getClientPermissionlessValidators(res.Validators)
+	if err != nil {
+		return nil, 0, err
+	}
+	numValidators := uint64(len(vdrs))
+	if res.NumValidators != nil {
+		numValidators = uint64(*res.NumValidators)
+	}
+	return vdrs, numValidators, nil
+}
+
 func (c *client) GetPendingValidators(
 	ctx context.Context,
 	subnetID ids.ID,
@@ -455,6 +748,50 @@ func (c *client) GetPendingValidators(
 	return res.Validators, res.Delegators, err
 }
 
+func (c *client) GetStakerSchedule(
+	ctx context.Context,
+	subnetID ids.ID,
+	limit uint64,
+	options ...rpc.Option,
+) ([]StakerEvent, error) {
+	res := &GetStakerScheduleReply{}
+	err := c.requester.SendRequest(ctx, "omega.getStakerSchedule", &GetStakerScheduleArgs{
+		SubnetID: subnetID,
+		Limit:    json.Uint64(limit),
+	}, res, options...)
+	return res.Events, err
+}
+
+func (c *client) GetRewardOwners(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]ClientRewardOwners, error) {
+	res := &GetRewardOwnersReply{}
+	err := c.requester.SendRequest(ctx, "omega.getRewardOwners", &GetRewardOwnersArgs{
+		SubnetID: subnetID,
+		NodeIDs:  nodeIDs,
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardOwners := make([]ClientRewardOwners, len(res.RewardOwners))
+	for i, apiRewardOwners := range res.RewardOwners {
+		validationRewardOwner, err := apiOwnerToClientOwner(apiRewardOwners.ValidationRewardOwner)
+		if err != nil {
+			return nil, err
+		}
+		delegationRewardOwner, err := apiOwnerToClientOwner(apiRewardOwners.DelegationRewardOwner)
+		if err != nil {
+			return nil, err
+		}
+		rewardOwners[i] = ClientRewardOwners{
+			NodeID:                apiRewardOwners.NodeID,
+			TxID:                  apiRewardOwners.TxID,
+			ValidationRewardOwner: validationRewardOwner,
+			DelegationRewardOwner: delegationRewardOwner,
+		}
+	}
+	return rewardOwners, nil
+}
+
 func (c *client) GetCurrentSupply(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, uint64, error) {
 	res := &GetCurrentSupplyReply{}
 	err := c.requester.SendRequest(ctx, "omega.getCurrentSupply", &GetCurrentSupplyArgs{
@@ -463,6 +800,24 @@ func (c *client) GetCurrentSupply(ctx context.Context, subnetID ids.ID, options
 	return uint64(res.Supply), uint64(res.Height), err
 }
 
+func (c *client) EstimateReward(
+	ctx context.Context,
+	subnetID ids.ID,
+	stakeAmount uint64,
+	startTime uint64,
+	endTime uint64,
+	options ...rpc.Option,
+) (uint64, error) {
+	res := &EstimateRewardReply{}
+	err := c.requester.SendRequest(ctx, "omega.estimateReward", &EstimateRewardArgs{
+		StakeAmount: json.Uint64(stakeAmount),
+		StartTime:   json.Uint64(startTime),
+		EndTime:     json.Uint64(endTime),
+		SubnetID:    subnetID,
+	}, res, options...)
+	return uint64(res.PotentialReward), err
+}
+
 func (c *client) SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error) {
 	res := &SampleValidatorsReply{}
 	err := c.requester.SendRequest(ctx, "omega.sampleValidators", &SampleValidatorsArgs{
@@ -472,6 +827,23 @@ func (c *client) SampleValidators(ctx context.Context, subnetID ids.ID, sampleSi
 	return res.Validators, err
 }
 
+func (c *client) GetValidatorUptime(ctx context.Context, nodeID ids.NodeID, subnetID ids.ID, options ...rpc.Option) (*APIValidatorUptime, error) {
+	res := &APIValidatorUptime{}
+	err := c.requester.SendRequest(ctx, "omega.getValidatorUptime", &GetValidatorUptimeArgs{
+		NodeID:   nodeID,
+		SubnetID: subnetID,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetValidatorUptimes(ctx context.Context, subnetID ids.ID, options ...rpc.Option) ([]APIValidatorUptime, error) {
+	res := &GetValidatorUptimesReply{}
+	err := c.requester.SendRequest(ctx, "omega.getValidatorUptimes", &GetValidatorUptimesArgs{
+		SubnetID: subnetID,
+	}, res, options...)
+	return res.Validators, err
+}
+
 func (c *client) AddValidator(
 	ctx context.Context,
 	user api.UserPass,
@@ -538,6 +910,80 @@ func (c *client) AddDelegator(
 	return res.TxID, err
 }
 
+func (c *client) AddPermissionlessValidator(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	subnetID ids.ID,
+	nodeID ids.NodeID,
+	pop *signer.ProofOfPossession,
+	rewardAddress ids.ShortID,
+	delegationRewardAddress ids.ShortID,
+	stakeAmount,
+	startTime,
+	endTime uint64,
+	delegationFeeRate float32,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	res := &api.JSONTxID{}
+	jsonStakeAmount := json.Uint64(stakeAmount)
+	err := c.requester.SendRequest(ctx, "omega.addPermissionlessValidator", &AddPermissionlessValidatorArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		Staker: omegaapi.Staker{
+			NodeID:      nodeID,
+			Weight:      jsonStakeAmount,
+			StakeAmount: &jsonStakeAmount,
+			StartTime:   json.Uint64(startTime),
+			EndTime:     json.Uint64(endTime),
+		},
+		SubnetID:                subnetID.String(),
+		Signer:                  pop,
+		RewardAddress:           rewardAddress.String(),
+		DelegationRewardAddress: delegationRewardAddress.String(),
+		DelegationFeeRate:       json.Float32(delegationFeeRate),
+	}, res, options...)
+	return res.TxID, err
+}
+
+func (c *client) AddPermissionlessDelegator(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	subnetID ids.ID,
+	nodeID ids.NodeID,
+	rewardAddress ids.ShortID,
+	stakeAmount,
+	startTime,
+	endTime uint64,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	res := &api.JSONTxID{}
+	jsonStakeAmount := json.Uint64(stakeAmount)
+	err := c.requester.SendRequest(ctx, "omega.addPermissionlessDelegator", &AddPermissionlessDelegatorArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		Staker: omegaapi.Staker{
+			NodeID:      nodeID,
+			Weight:      jsonStakeAmount,
+			StakeAmount: &jsonStakeAmount,
+			StartTime:   json.Uint64(startTime),
+			EndTime:     json.Uint64(endTime),
+		},
+		SubnetID:      subnetID.String(),
+		RewardAddress: rewardAddress.String(),
+	}, res, options...)
+	return res.TxID, err
+}
+
 func (c *client) AddSubnetValidator(
 	ctx context.Context,
 	user api.UserPass,
@@ -594,6 +1040,80 @@ func (c *client) CreateSubnet(
 	return res.TxID, err
 }
 
+func (c *client) TransferSubnetOwnership(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	subnetID ids.ID,
+	controlKeys []ids.ShortID,
+	threshold uint32,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	res := &api.JSONTxID{}
+	err := c.requester.SendRequest(ctx, "omega.transferSubnetOwnership", &TransferSubnetOwnershipArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		SubnetID:    subnetID.String(),
+		ControlKeys: ids.ShortIDsToStrings(controlKeys),
+		Threshold:   json.Uint32(threshold),
+	}, res, options...)
+	return res.TxID, err
+}
+
+func (c *client) TransformSubnet(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	subnetID ids.ID,
+	assetID ids.ID,
+	initialSupply uint64,
+	maxSupply uint64,
+	minConsumptionRate uint64,
+	maxConsumptionRate uint64,
+	minValidatorStake uint64,
+	maxValidatorStake uint64,
+	minValidatorStakeDuration time.Duration,
+	maxValidatorStakeDuration time.Duration,
+	minDelegatorStakeDuration time.Duration,
+	maxDelegatorStakeDuration time.Duration,
+	minDelegationFee uint32,
+	minDelegatorStake uint64,
+	maxValidatorWeightFactor byte,
+	uptimeRequirement uint32,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	res := &api.JSONTxID{}
+	err := c.requester.SendRequest(ctx, "omega.transformSubnet", &TransformSubnetArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		SubnetID:                  subnetID.String(),
+		AssetID:                   assetID,
+		InitialSupply:             json.Uint64(initialSupply),
+		MaximumSupply:             json.Uint64(maxSupply),
+		MinConsumptionRate:        json.Uint64(minConsumptionRate),
+		MaxConsumptionRate:        json.Uint64(maxConsumptionRate),
+		MinValidatorStake:         json.Uint64(minValidatorStake),
+		MaxValidatorStake:         json.Uint64(maxValidatorStake),
+		MinValidatorStakeDuration: json.Uint32(minValidatorStakeDuration / time.Second),
+		MaxValidatorStakeDuration: json.Uint32(maxValidatorStakeDuration / time.Second),
+		MinDelegatorStakeDuration: json.Uint32(minDelegatorStakeDuration / time.Second),
+		MaxDelegatorStakeDuration: json.Uint32(maxDelegatorStakeDuration / time.Second),
+		MinDelegationFee:          json.Uint32(minDelegationFee),
+		MinDelegatorStake:         json.Uint64(minDelegatorStake),
+		MaxValidatorWeightFactor:  json.Uint8(maxValidatorWeightFactor),
+		UptimeRequirement:         json.Uint32(uptimeRequirement),
+	}, res, options...)
+	return res.TxID, err
+}
+
 func (c *client) ExportDIONE(
 	ctx context.Context,
 	user api.UserPass,
@@ -674,6 +1194,40 @@ func (c *client) CreateBlockchain(
 	return res.TxID, err
 }
 
+func (c *client) ValidateCreateBlockchain(
+	ctx context.Context,
+	user api.UserPass,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	subnetID ids.ID,
+	vmID string,
+	fxIDs []string,
+	name string,
+	genesisData []byte,
+	options ...rpc.Option,
+) (*ValidateCreateBlockchainReply, error) {
+	genesisDataStr, err := formatting.Encode(formatting.Hex, genesisData)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ValidateCreateBlockchainReply{}
+	err = c.requester.SendRequest(ctx, "omega.validateCreateBlockchain", &CreateBlockchainArgs{
+		JSONSpendHeader: api.JSONSpendHeader{
+			UserPass:       user,
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+		},
+		SubnetID:    subnetID,
+		VMID:        vmID,
+		FxIDs:       fxIDs,
+		Name:        name,
+		GenesisData: genesisDataStr,
+		Encoding:    formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetBlockchainStatus(ctx context.Context, blockchainID string, options ...rpc.Option) (status.BlockchainStatus, error) {
 	res := &GetBlockchainStatusReply{}
 	err := c.requester.SendRequest(ctx, "omega.getBlockchainStatus", &GetBlockchainStatusArgs{
@@ -698,9 +1252,11 @@ func (c *client) Validates(ctx context.Context, subnetID ids.ID, options ...rpc.
 	return res.BlockchainIDs, err
 }
 
-func (c *client) GetBlockchains(ctx context.Context, options ...rpc.Option) ([]APIBlockchain, error) {
+func (c *client) GetBlockchains(ctx context.Context, verbose bool, options ...rpc.Option) ([]APIBlockchain, error) {
 	res := &GetBlockchainsResponse{}
-	err := c.requester.SendRequest(ctx, "omega.getBlockchains", struct{}{}, res, options...)
+	err := c.requester.SendRequest(ctx, "omega.getBlockchains", &GetBlockchainsArgs{
+		Verbose: verbose,
+	}, res, options...)
 	return res.Blockchains, err
 }
 
@@ -718,6 +1274,159 @@ func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Opt
 	return res.TxID, err
 }
 
+func (c *client) IssueTxs(ctx context.Context, txs [][]byte, options ...rpc.Option) ([]api.IssueTxResult, error) {
+	txStrs := make([]string, len(txs))
+	for i, txBytes := range txs {
+		txStr, err := formatting.Encode(formatting.Hex, txBytes)
+		if err != nil {
+			return nil, err
+		}
+		txStrs[i] = txStr
+	}
+
+	res := &api.IssueTxsReply{}
+	err := c.requester.SendRequest(ctx, "omega.issueTxs", &api.FormattedTxs{
+		Txs:      txStrs,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res.Results, err
+}
+
+func (c *client) BuildAddValidator(
+	ctx context.Context,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	rewardAddress ids.ShortID,
+	nodeID ids.NodeID,
+	stakeAmount,
+	startTime,
+	endTime uint64,
+	delegationFeeRate float32,
+	options ...rpc.Option,
+) (*BuildTxReply, error) {
+	res := &BuildTxReply{}
+	err := c.requester.SendRequest(ctx, "omega.buildAddValidator", &BuildAddValidatorArgs{
+		BuildSpendHeader: BuildSpendHeader{
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+			Encoding:       formatting.Hex,
+		},
+		Staker: omegaapi.Staker{
+			NodeID:    nodeID,
+			Weight:    json.Uint64(stakeAmount),
+			StartTime: json.Uint64(startTime),
+			EndTime:   json.Uint64(endTime),
+		},
+		RewardAddress:     rewardAddress.String(),
+		DelegationFeeRate: json.Float32(delegationFeeRate),
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) BuildAddDelegator(
+	ctx context.Context,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	rewardAddress ids.ShortID,
+	nodeID ids.NodeID,
+	stakeAmount,
+	startTime,
+	endTime uint64,
+	options ...rpc.Option,
+) (*BuildTxReply, error) {
+	res := &BuildTxReply{}
+	err := c.requester.SendRequest(ctx, "omega.buildAddDelegator", &BuildAddDelegatorArgs{
+		BuildSpendHeader: BuildSpendHeader{
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+			Encoding:       formatting.Hex,
+		},
+		Staker: omegaapi.Staker{
+			NodeID:    nodeID,
+			Weight:    json.Uint64(stakeAmount),
+			StartTime: json.Uint64(startTime),
+			EndTime:   json.Uint64(endTime),
+		},
+		RewardAddress: rewardAddress.String(),
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) BuildCreateSubnet(
+	ctx context.Context,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	controlKeys []ids.ShortID,
+	threshold uint32,
+	options ...rpc.Option,
+) (*BuildTxReply, error) {
+	res := &BuildTxReply{}
+	err := c.requester.SendRequest(ctx, "omega.buildCreateSubnet", &BuildCreateSubnetArgs{
+		BuildSpendHeader: BuildSpendHeader{
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+			Encoding:       formatting.Hex,
+		},
+		APISubnet: APISubnet{
+			ControlKeys: ids.ShortIDsToStrings(controlKeys),
+			Threshold:   json.Uint32(threshold),
+		},
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) BuildExportDIONE(
+	ctx context.Context,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	targetChain string,
+	to ids.ShortID,
+	amount uint64,
+	options ...rpc.Option,
+) (*BuildTxReply, error) {
+	res := &BuildTxReply{}
+	err := c.requester.SendRequest(ctx, "omega.buildExportDIONE", &BuildExportDIONEArgs{
+		BuildSpendHeader: BuildSpendHeader{
+			JSONFromAddrs:  api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+			JSONChangeAddr: api.JSONChangeAddr{ChangeAddr: changeAddr.String()},
+			Encoding:       formatting.Hex,
+		},
+		TargetChain: targetChain,
+		To:          to.String(),
+		Amount:      json.Uint64(amount),
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) IssueSignedTx(
+	ctx context.Context,
+	unsignedTxBytes []byte,
+	sigs [][][secp256k1.SignatureLen]byte,
+	options ...rpc.Option,
+) (ids.ID, error) {
+	txStr, err := formatting.Encode(formatting.Hex, unsignedTxBytes)
+	if err != nil {
+		return ids.ID{}, err
+	}
+	sigStrs := make([][]string, len(sigs))
+	for i, credSigs := range sigs {
+		sigStrs[i] = make([]string, len(credSigs))
+		for j, sig := range credSigs {
+			sigStrs[i][j], err = formatting.Encode(formatting.Hex, sig[:])
+			if err != nil {
+				return ids.ID{}, err
+			}
+		}
+	}
+	res := &api.JSONTxID{}
+	err = c.requester.SendRequest(ctx, "omega.issueSignedTx", &IssueSignedTxArgs{
+		Tx:         txStr,
+		Signatures: sigStrs,
+		Encoding:   formatting.Hex,
+	}, res, options...)
+	return res.TxID, err
+}
+
 func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedTx{}
 	err := c.requester.SendRequest(ctx, "omega.getTx", &api.GetTxArgs{
@@ -744,25 +1453,39 @@ func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Op
 	return res, err
 }
 
-func (c *client) AwaitTxDecided(ctx context.Context, txID ids.ID, freq time.Duration, options ...rpc.Option) (*GetTxStatusResponse, error) {
-	ticker := time.NewTicker(freq)
-	defer ticker.Stop()
+// ClientPendingTx is a representation of a mempool tx used in client methods
+type ClientPendingTx struct {
+	TxID ids.ID
+	Tx   []byte
+	// Size of the tx in bytes
+	Size uint64
+	// Time elapsed since the tx was added to the mempool
+	Age time.Duration
+}
 
-	for {
-		res, err := c.GetTxStatus(ctx, txID, options...)
-		if err == nil {
-			switch res.Status {
-			case status.Committed, status.Aborted, status.Dropped:
-				return res, nil
-			}
-		}
+func (c *client) GetPendingTxs(ctx context.Context, options ...rpc.Option) ([]ClientPendingTx, error) {
+	res := &GetPendingTxsReply{}
+	err := c.requester.SendRequest(ctx, "omega.getPendingTxs", &GetPendingTxsArgs{
+		Encoding: formatting.Hex,
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
 
-		select {
-		case <-ticker.C:
-		case <-ctx.Done():
-			return nil, ctx.Err()
+	pendingTxs := make([]ClientPendingTx, len(res.Txs))
+	for i, tx := range res.Txs {
+		txBytes, err := formatting.Decode(res.Encoding, tx.Tx)
+		if err != nil {
+			return nil, err
+		}
+		pendingTxs[i] = ClientPendingTx{
+			TxID: tx.TxID,
+			Tx:   txBytes,
+			Size: uint64(tx.Size),
+			Age:  time.Duration(tx.Age) * time.Second,
 		}
 	}
+	return pendingTxs, nil
 }
 
 func (c *client) GetStake(
@@ -799,12 +1522,18 @@ func (c *client) GetStake(
 	return staked, outputs, err
 }
 
-func (c *client) GetMinStake(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, uint64, error) {
+func (c *client) GetMinStake(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, uint64, byte, error) {
 	res := &GetMinStakeReply{}
 	err := c.requester.SendRequest(ctx, "omega.getMinStake", &GetMinStakeArgs{
 		SubnetID: subnetID,
 	}, res, options...)
-	return uint64(res.MinValidatorStake), uint64(res.MinDelegatorStake), err
+	return uint64(res.MinValidatorStake), uint64(res.MinDelegatorStake), byte(res.MaxValidatorWeightFactor), err
+}
+
+func (c *client) GetTxConfig(ctx context.Context, options ...rpc.Option) (*GetTxConfigReply, error) {
+	res := &GetTxConfigReply{}
+	err := c.requester.SendRequest(ctx, "omega.getTxConfig", struct{}{}, res, options...)
+	return res, err
 }
 
 func (c *client) GetTotalStake(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, error) {
@@ -855,6 +1584,21 @@ func (c *client) GetTimestamp(ctx context.Context, options ...rpc.Option) (time.
 	return res.Timestamp, err
 }
 
+func (c *client) AnnounceMaintenance(ctx context.Context, startTime time.Time, endTime time.Time, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "omega.announceMaintenance", &AnnounceMaintenanceArgs{
+		StartTime: json.Uint64(startTime.Unix()),
+		EndTime:   json.Uint64(endTime.Unix()),
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) GetMaintenanceWindows(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) ([]APIMaintenanceWindow, error) {
+	res := &GetMaintenanceWindowsReply{}
+	err := c.requester.SendRequest(ctx, "omega.getMaintenanceWindows", &GetMaintenanceWindowsArgs{
+		NodeID: nodeID,
+	}, res, options...)
+	return res.Windows, err
+}
+
 func (c *client) GetValidatorsAt(
 	ctx context.Context,
 	subnetID ids.ID,
@@ -869,6 +1613,22 @@ func (c *client) GetValidatorsAt(
 	return res.Validators, err
 }
 
+func (c *client) GetValidatorSetDiffs(
+	ctx context.Context,
+	subnetID ids.ID,
+	startHeight uint64,
+	endHeight uint64,
+	options ...rpc.Option,
+) (*GetValidatorSetDiffsReply, error) {
+	res := &GetValidatorSetDiffsReply{}
+	err := c.requester.SendRequest(ctx, "omega.getValidatorSetDiffs", &GetValidatorSetDiffsArgs{
+		StartHeight: json.Uint64(startHeight),
+		EndHeight:   json.Uint64(endHeight),
+		SubnetID:    subnetID,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedBlock{}
 	if err := c.requester.SendRequest(ctx, "omega.getBlock", &api.GetBlockArgs{
@@ -891,3 +1651,33 @@ func (c *client) GetBlockByHeight(ctx context.Context, height uint64, options ..
 	}
 	return formatting.Decode(res.Encoding, res.Block)
 }
+
+func (c *client) GetBlockRange(ctx context.Context, startHeight uint64, numBlocks uint64, options ...rpc.Option) ([][]byte, error) {
+	res := &struct {
+		Blocks []api.FormattedBlock `json:"blocks"`
+	}{}
+	err := c.requester.SendRequest(ctx, "omega.getBlockRange", &GetBlockRangeArgs{
+		StartHeight: json.Uint64(startHeight),
+		NumBlocks:   json.Uint64(numBlocks),
+		Encoding:    formatting.HexNC,
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([][]byte, len(res.Blocks))
+	for i, block := range res.Blocks {
+		blocks[i], err = formatting.Decode(block.Encoding, block.Block)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
+
+func (c *client) GetBlockReceipts(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]*TxReceipt, error) {
+	res := &GetBlockReceiptsReply{}
+	err := c.requester.SendRequest(ctx, "omega.getBlockReceipts", &GetBlockReceiptsArgs{
+		BlockID: blockID,
+	}, res, options...)
+	return res.Receipts, err
+}
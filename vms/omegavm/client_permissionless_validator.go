@@ -35,6 +35,15 @@ type ClientOwner struct {
 	Addresses []ids.ShortID
 }
 
+// ClientRewardOwners is the repr. of a validator's reward owners sent over
+// client
+type ClientRewardOwners struct {
+	NodeID                ids.NodeID
+	TxID                  ids.ID
+	ValidationRewardOwner *ClientOwner
+	DelegationRewardOwner *ClientOwner
+}
+
 // ClientPermissionlessValidator is the repr. of a permissionless validator sent
 // over client
 type ClientPermissionlessValidator struct {
@@ -12,6 +12,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow/validators"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 )
@@ -21,6 +22,10 @@ type Config struct {
 	// The node's chain manager
 	Chains chains.Manager
 
+	// The node's VM manager, used to report the versions of the VMs that
+	// chains run on this node
+	VMManager vms.Manager
+
 	// Node's validator set maps subnetID -> validators of the subnet
 	//
 	// Invariant: The primary network's validator set should have been added to
@@ -41,6 +46,16 @@ type Config struct {
 	// Set of subnets that this node is validating
 	TrackedSubnets set.Set[ids.ID]
 
+	// If true, the subnets that this node is a current or pending validator of
+	// are added to [TrackedSubnets] when the state is loaded. Subnets that this
+	// node becomes a validator of afterwards are tracked once its staker is
+	// accepted.
+	//
+	// Invariant: [TrackedSubnets] is shared with the rest of the node until it
+	// starts networking, so it may only be modified before then or, once the
+	// node is running, with the context lock held.
+	TrackValidatedSubnets bool
+
 	// Fee that is burned by every non-state creating transaction
 	TxFee uint64
 
@@ -113,6 +128,9 @@ type Config struct {
 	// Time of the Cortina network upgrade
 	CortinaTime time.Time
 
+	// Time of the Durango network upgrade
+	DurangoTime time.Time
+
 	// UseCurrentHeight forces [GetMinimumHeight] to return the current height
 	// of the O-Chain instead of the oldest block in the [recentlyAccepted]
 	// window.
@@ -139,6 +157,10 @@ func (c *Config) IsCortinaActivated(timestamp time.Time) bool {
 	return !timestamp.Before(c.CortinaTime)
 }
 
+func (c *Config) IsDurangoActivated(timestamp time.Time) bool {
+	return !timestamp.Before(c.DurangoTime)
+}
+
 func (c *Config) GetCreateBlockchainTxFee(timestamp time.Time) uint64 {
 	if c.IsApricotPhase3Activated(timestamp) {
 		return c.CreateBlockchainTxFee
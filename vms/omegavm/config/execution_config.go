@@ -5,6 +5,7 @@ package config
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/DioneProtocol/odysseygo/utils/units"
 )
@@ -13,11 +14,14 @@ var DefaultExecutionConfig = ExecutionConfig{
 	BlockCacheSize:               64 * units.MiB,
 	TxCacheSize:                  128 * units.MiB,
 	TransformedSubnetTxCacheSize: 4 * units.MiB,
+	SubnetOwnerCacheSize:         2048,
 	RewardUTXOsCacheSize:         2048,
 	ChainCacheSize:               2048,
 	ChainDBCacheSize:             2048,
 	BlockIDCacheSize:             8192,
 	ChecksumsEnabled:             false,
+	StateAuditFrequency:          time.Hour,
+	MempoolRegossipFrequency:     time.Minute,
 }
 
 // ExecutionConfig provides execution parameters of OmegaVM
@@ -25,11 +29,22 @@ type ExecutionConfig struct {
 	BlockCacheSize               int  `json:"block-cache-size"`
 	TxCacheSize                  int  `json:"tx-cache-size"`
 	TransformedSubnetTxCacheSize int  `json:"transformed-subnet-tx-cache-size"`
+	SubnetOwnerCacheSize         int  `json:"subnet-owner-cache-size"`
 	RewardUTXOsCacheSize         int  `json:"reward-utxos-cache-size"`
 	ChainCacheSize               int  `json:"chain-cache-size"`
 	ChainDBCacheSize             int  `json:"chain-db-cache-size"`
 	BlockIDCacheSize             int  `json:"block-id-cache-size"`
 	ChecksumsEnabled             bool `json:"checksums-enabled"`
+	// StateAuditFrequency is how often the state's aggregate invariants are
+	// re-derived and verified. A non-positive value disables the audit.
+	StateAuditFrequency time.Duration `json:"state-audit-frequency"`
+	// StakeEndingLeadTime is how long before a staker's end time a
+	// notification is published to the staker's subscribers. A non-positive
+	// value disables the notifications.
+	StakeEndingLeadTime time.Duration `json:"stake-ending-lead-time"`
+	// MempoolRegossipFrequency is how often the txs in the mempool are
+	// re-gossiped to the network. A non-positive value disables re-gossiping.
+	MempoolRegossipFrequency time.Duration `json:"mempool-regossip-frequency"`
 }
 
 // GetExecutionConfig returns an ExecutionConfig
@@ -42,11 +42,14 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			"block-cache-size": 1,
 			"tx-cache-size": 2,
 			"transformed-subnet-tx-cache-size": 3,
+			"subnet-owner-cache-size": 4,
 			"reward-utxos-cache-size": 5,
 			"chain-cache-size": 6,
 			"chain-db-cache-size": 7,
 			"block-id-cache-size": 8,
-			"checksums-enabled": true
+			"checksums-enabled": true,
+			"state-audit-frequency": 9,
+			"mempool-regossip-frequency": 10
 		}`)
 		ec, err := GetExecutionConfig(b)
 		require.NoError(err)
@@ -54,11 +57,14 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			BlockCacheSize:               1,
 			TxCacheSize:                  2,
 			TransformedSubnetTxCacheSize: 3,
+			SubnetOwnerCacheSize:         4,
 			RewardUTXOsCacheSize:         5,
 			ChainCacheSize:               6,
 			ChainDBCacheSize:             7,
 			BlockIDCacheSize:             8,
 			ChecksumsEnabled:             true,
+			StateAuditFrequency:          9,
+			MempoolRegossipFrequency:     10,
 		}
 		require.Equal(expected, ec)
 	})
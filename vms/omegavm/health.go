@@ -40,5 +40,7 @@ func (vm *VM) HealthCheck(context.Context) (interface{}, error) {
 			return nil, fmt.Errorf("couldn't get current subnet validator of %q: %w", subnetID, err)
 		}
 	}
-	return nil, nil
+	return map[string]interface{}{
+		"mempoolSize": len(vm.Builder.List()),
+	}, nil
 }
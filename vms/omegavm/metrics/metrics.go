@@ -42,6 +42,8 @@ type Metrics interface {
 	SetTimeUntilUnstake(time.Duration)
 	// Mark when this node will unstake from a subnet.
 	SetTimeUntilSubnetUnstake(subnetID ids.ID, timeUntilUnstake time.Duration)
+	// Mark that a state audit found the given number of inconsistencies.
+	MarkStateAudit(numMismatches int)
 }
 
 func New(
@@ -106,6 +108,22 @@ func New(
 			Name:      "validator_sets_duration_sum",
 			Help:      "Total amount of time generating validator sets in nanoseconds",
 		}),
+
+		stateAudits: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "state_audits",
+			Help:      "Total number of state audits performed",
+		}),
+		stateAuditMismatches: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "state_audit_mismatches",
+			Help:      "Total number of inconsistencies found by state audits",
+		}),
+		stateAuditFailing: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "state_audit_failing",
+			Help:      "1 if the most recent state audit found an inconsistency, 0 otherwise",
+		}),
 	}
 
 	errs := wrappers.Errs{Err: err}
@@ -125,6 +143,10 @@ func New(
 		registerer.Register(m.validatorSetsCached),
 		registerer.Register(m.validatorSetsHeightDiff),
 		registerer.Register(m.validatorSetsDuration),
+
+		registerer.Register(m.stateAudits),
+		registerer.Register(m.stateAuditMismatches),
+		registerer.Register(m.stateAuditFailing),
 	)
 
 	return m, errs.Err
@@ -146,6 +168,10 @@ type metrics struct {
 	validatorSetsCreated    prometheus.Counter
 	validatorSetsHeightDiff prometheus.Gauge
 	validatorSetsDuration   prometheus.Gauge
+
+	stateAudits          prometheus.Counter
+	stateAuditMismatches prometheus.Counter
+	stateAuditFailing    prometheus.Gauge
 }
 
 func (m *metrics) MarkOptionVoteWon() {
@@ -191,3 +217,13 @@ func (m *metrics) SetTimeUntilUnstake(timeUntilUnstake time.Duration) {
 func (m *metrics) SetTimeUntilSubnetUnstake(subnetID ids.ID, timeUntilUnstake time.Duration) {
 	m.timeUntilSubnetUnstake.WithLabelValues(subnetID.String()).Set(float64(timeUntilUnstake))
 }
+
+func (m *metrics) MarkStateAudit(numMismatches int) {
+	m.stateAudits.Inc()
+	m.stateAuditMismatches.Add(float64(numMismatches))
+	if numMismatches > 0 {
+		m.stateAuditFailing.Set(1)
+	} else {
+		m.stateAuditFailing.Set(0)
+	}
+}
@@ -4,10 +4,12 @@
 package omegavm
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"path"
 	"time"
 
 	stdjson "encoding/json"
@@ -16,11 +18,14 @@ import (
 	"go.uber.org/zap"
 
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
 	"github.com/DioneProtocol/odysseygo/api"
 	"github.com/DioneProtocol/odysseygo/cache"
 	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/engine/snowman/block"
+	"github.com/DioneProtocol/odysseygo/snow/uptime"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
 	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
@@ -34,6 +39,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/wrappers"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/keystore"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
@@ -48,6 +54,19 @@ import (
 	omegaapi "github.com/DioneProtocol/odysseygo/vms/omegavm/api"
 )
 
+// Supported values of StakerEvent.Event
+const (
+	StakerEventStart = "start"
+	StakerEventEnd   = "end"
+)
+
+// Supported values of GetCurrentValidatorsArgs.SortBy
+const (
+	ValidatorSortByEndTime = "endTime"
+	ValidatorSortByStake   = "stake"
+	ValidatorSortByUptime  = "uptime"
+)
+
 const (
 	// Max number of addresses that can be passed in as argument to GetUTXOs
 	maxGetUTXOsAddrs = 1024
@@ -55,6 +74,18 @@ const (
 	// Max number of addresses that can be passed in as argument to GetStake
 	maxGetStakeAddrs = 256
 
+	// Max number of blocks that can be returned by GetBlockRange
+	maxGetBlockRange = 1024
+
+	// Max number of heights that can be spanned by GetValidatorSetDiffs
+	maxGetValidatorSetDiffsRange = 1024
+
+	// Max number of events that can be returned by GetStakerSchedule
+	maxGetStakerScheduleLimit = 1024
+
+	// Max number of txs that can be passed in as argument to IssueTxs
+	maxIssueTxs = 1024
+
 	// Minimum amount of delay to allow a transaction to be issued through the
 	// API
 	minAddStakerDelay = 2 * executor.SyncBound
@@ -81,6 +112,17 @@ var (
 	errMissingPrivateKey        = errors.New("argument 'privateKey' not given")
 	errStartAfterEndTime        = errors.New("start time must be before end time")
 	errStartTimeInThePast       = errors.New("start time in the past")
+	errNotStandardBlock         = errors.New("block is not a standard block")
+	errStartAfterEndHeight      = errors.New("start height must not be after end height")
+	errUnknownSortBy            = errors.New("unknown sortBy")
+	errPrimaryNetworkReward     = errors.New("primary network rewards are paid from fees and minting and can't be estimated")
+	errPrimaryNotASubnet        = errors.New("the primary network isn't a subnet")
+	errUptimeNotTracked         = errors.New("uptimes of the subnet's validators aren't tracked")
+	errTooManyTxs               = errors.New("too many txs")
+	errNoSourceChain            = errors.New("argument 'sourceChain' must be another chain")
+	errSubnetNotPermissionless  = errors.New("subnet isn't permissionless")
+	errSubnetNotFound           = errors.New("subnet not found")
+	errGenesisTooLong           = fmt.Errorf("genesis data must be at most %d bytes", txs.MaxGenesisLen)
 )
 
 // Service defines the API calls that can be made to the omega chain
@@ -491,6 +533,129 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *ap
 	return nil
 }
 
+// AtomicUTXO is a UTXO that was exported to this chain and is present in
+// shared memory
+type AtomicUTXO struct {
+	UTXO string `json:"utxo"`
+	// ID of the accepted tx that imported the UTXO. If set, the UTXO is
+	// orphaned, as it should have been removed from shared memory when the tx
+	// was accepted.
+	ConsumedBy *ids.ID `json:"consumedBy,omitempty"`
+}
+
+// InspectAtomicUTXOsReply is the response from calling InspectAtomicUTXOs
+type InspectAtomicUTXOsReply struct {
+	// Number of UTXOs returned
+	NumFetched json.Uint64 `json:"numFetched"`
+	// Number of returned UTXOs that are orphaned
+	NumOrphaned json.Uint64  `json:"numOrphaned"`
+	UTXOs       []AtomicUTXO `json:"utxos"`
+	// The last UTXO that was returned, and the address it corresponds to.
+	// Used for pagination.
+	EndIndex api.Index           `json:"endIndex"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// InspectAtomicUTXOs returns the UTXOs controlled by the given addresses that were
+// exported to this chain from [SourceChain] and are present in shared memory.
+// UTXOs that were imported by an accepted tx but weren't removed from shared
+// memory are reported as orphaned. They can be removed with
+// admin.repairAtomicUTXOs.
+//
+// Only imports accepted after the node started indexing consumed atomic UTXOs
+// are detected.
+func (s *Service) InspectAtomicUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *InspectAtomicUTXOsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "inspectAtomicUTXOs"),
+		logging.UserString("sourceChain", args.SourceChain),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetUTXOsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	}
+
+	sourceChain, err := s.vm.ctx.BCLookup.Lookup(args.SourceChain)
+	if err != nil {
+		return fmt.Errorf("problem parsing source chainID %q: %w", args.SourceChain, err)
+	}
+	if sourceChain == s.vm.ctx.ChainID {
+		return errNoSourceChain
+	}
+
+	addrSet, err := dione.ParseServiceAddresses(s.addrManager, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	startAddr := ids.ShortEmpty
+	startUTXO := ids.Empty
+	if args.StartIndex.Address != "" || args.StartIndex.UTXO != "" {
+		startAddr, err = dione.ParseServiceAddress(s.addrManager, args.StartIndex.Address)
+		if err != nil {
+			return fmt.Errorf("couldn't parse start index address %q: %w", args.StartIndex.Address, err)
+		}
+		startUTXO, err = ids.FromString(args.StartIndex.UTXO)
+		if err != nil {
+			return fmt.Errorf("couldn't parse start index utxo: %w", err)
+		}
+	}
+
+	limit := int(args.Limit)
+	if limit <= 0 || builder.MaxPageSize < limit {
+		limit = builder.MaxPageSize
+	}
+	utxos, endAddr, endUTXOID, err := s.vm.atomicUtxosManager.GetAtomicUTXOs(
+		sourceChain,
+		addrSet,
+		startAddr,
+		startUTXO,
+		limit,
+	)
+	if err != nil {
+		return fmt.Errorf("problem retrieving UTXOs: %w", err)
+	}
+
+	numOrphaned := 0
+	response.UTXOs = make([]AtomicUTXO, len(utxos))
+	for i, utxo := range utxos {
+		utxoID := utxo.InputID()
+		bytes, err := txs.Codec.Marshal(txs.Version, utxo)
+		if err != nil {
+			return fmt.Errorf("couldn't serialize UTXO %q: %w", utxoID, err)
+		}
+		response.UTXOs[i].UTXO, err = formatting.Encode(args.Encoding, bytes)
+		if err != nil {
+			return fmt.Errorf("couldn't encode UTXO %s as %s: %w", utxoID, args.Encoding, err)
+		}
+
+		txID, err := s.vm.state.GetConsumedAtomicUTXO(utxoID)
+		switch err {
+		case nil:
+			response.UTXOs[i].ConsumedBy = &txID
+			numOrphaned++
+		case database.ErrNotFound:
+		default:
+			return fmt.Errorf("couldn't check if UTXO %s was consumed: %w", utxoID, err)
+		}
+	}
+
+	endAddress, err := s.addrManager.FormatLocalAddress(endAddr)
+	if err != nil {
+		return fmt.Errorf("problem formatting address: %w", err)
+	}
+
+	response.NumFetched = json.Uint64(len(utxos))
+	response.NumOrphaned = json.Uint64(numOrphaned)
+	response.EndIndex.Address = endAddress
+	response.EndIndex.UTXO = endUTXOID.String()
+	response.Encoding = args.Encoding
+	return nil
+}
+
 /*
  ******************************************************
  ******************* Get Subnets **********************
@@ -550,8 +715,14 @@ func (s *Service) GetSubnets(_ *http.Request, args *GetSubnetsArgs, response *Ge
 				continue
 			}
 
-			unsignedTx := subnet.Unsigned.(*txs.CreateSubnetTx)
-			owner := unsignedTx.Owner.(*secp256k1fx.OutputOwners)
+			subnetOwner, err := s.vm.state.GetSubnetOwner(subnetID)
+			if err != nil {
+				return err
+			}
+			owner, ok := subnetOwner.(*secp256k1fx.OutputOwners)
+			if !ok {
+				return fmt.Errorf("expected *secp256k1fx.OutputOwners but got %T", subnetOwner)
+			}
 			controlAddrs := []string{}
 			for _, controlKeyID := range owner.Addrs {
 				addr, err := s.addrManager.FormatLocalAddress(controlKeyID)
@@ -633,6 +804,196 @@ func (s *Service) GetSubnets(_ *http.Request, args *GetSubnetsArgs, response *Ge
 	return nil
 }
 
+// GetSubnetArgs are the arguments to GetSubnet
+type GetSubnetArgs struct {
+	// ID of the subnet to retrieve information about
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// APISubnetTransformation describes the staking parameters a subnet was
+// transformed with
+type APISubnetTransformation struct {
+	// ID of the tx that transformed the subnet
+	TxID ids.ID `json:"txID"`
+	// Asset that is staked to validate the subnet
+	AssetID                   ids.ID      `json:"assetID"`
+	InitialSupply             json.Uint64 `json:"initialSupply"`
+	MaximumSupply             json.Uint64 `json:"maximumSupply"`
+	MinConsumptionRate        json.Uint64 `json:"minConsumptionRate"`
+	MaxConsumptionRate        json.Uint64 `json:"maxConsumptionRate"`
+	MinValidatorStake         json.Uint64 `json:"minValidatorStake"`
+	MaxValidatorStake         json.Uint64 `json:"maxValidatorStake"`
+	MinValidatorStakeDuration json.Uint32 `json:"minValidatorStakeDuration"`
+	MaxValidatorStakeDuration json.Uint32 `json:"maxValidatorStakeDuration"`
+	MinDelegatorStakeDuration json.Uint32 `json:"minDelegatorStakeDuration"`
+	MaxDelegatorStakeDuration json.Uint32 `json:"maxDelegatorStakeDuration"`
+	MinDelegationFee          json.Uint32 `json:"minDelegationFee"`
+	MinDelegatorStake         json.Uint64 `json:"minDelegatorStake"`
+	MaxValidatorWeightFactor  json.Uint8  `json:"maxValidatorWeightFactor"`
+	UptimeRequirement         json.Uint32 `json:"uptimeRequirement"`
+}
+
+// GetSubnetResponse is the response from calling GetSubnet
+type GetSubnetResponse struct {
+	// True if the subnet hasn't been transformed into a permissionless subnet
+	IsPermissioned bool `json:"isPermissioned"`
+	// Owner of the subnet. Once the subnet is transformed, the owner can no
+	// longer modify it.
+	ControlKeys []string    `json:"controlKeys"`
+	Threshold   json.Uint32 `json:"threshold"`
+	Locktime    json.Uint64 `json:"locktime"`
+	// Staking parameters of the subnet. Nil if the subnet is permissioned.
+	Transformation *APISubnetTransformation `json:"transformation,omitempty"`
+	// Blockchains validated by the subnet
+	Blockchains []APIBlockchain `json:"blockchains"`
+}
+
+// GetSubnet returns the owner, the staking parameters and the blockchains of
+// a subnet
+func (s *Service) GetSubnet(_ *http.Request, args *GetSubnetArgs, response *GetSubnetResponse) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getSubnet"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	if args.SubnetID == constants.PrimaryNetworkID {
+		return errPrimaryNotASubnet
+	}
+
+	subnetOwner, err := s.vm.state.GetSubnetOwner(args.SubnetID)
+	if err != nil {
+		return err
+	}
+	owner, ok := subnetOwner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return fmt.Errorf("expected *secp256k1fx.OutputOwners but got %T", subnetOwner)
+	}
+
+	response.ControlKeys = make([]string, len(owner.Addrs))
+	for i, controlKeyID := range owner.Addrs {
+		addr, err := s.addrManager.FormatLocalAddress(controlKeyID)
+		if err != nil {
+			return fmt.Errorf("problem formatting address: %w", err)
+		}
+		response.ControlKeys[i] = addr
+	}
+	response.Threshold = json.Uint32(owner.Threshold)
+	response.Locktime = json.Uint64(owner.Locktime)
+
+	transformSubnetIntf, err := s.vm.state.GetSubnetTransformation(args.SubnetID)
+	switch err {
+	case nil:
+		response.Transformation, err = newAPISubnetTransformation(transformSubnetIntf)
+		if err != nil {
+			return err
+		}
+	case database.ErrNotFound:
+		response.IsPermissioned = true
+	default:
+		return fmt.Errorf(
+			"failed fetching subnet transformation for %s: %w",
+			args.SubnetID,
+			err,
+		)
+	}
+
+	chains, err := s.vm.state.GetChains(args.SubnetID)
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't retrieve chains for subnet %q: %w",
+			args.SubnetID,
+			err,
+		)
+	}
+
+	response.Blockchains = make([]APIBlockchain, len(chains))
+	for i, chainTx := range chains {
+		chain, ok := chainTx.Unsigned.(*txs.CreateChainTx)
+		if !ok {
+			return fmt.Errorf("expected tx type *txs.CreateChainTx but got %T", chainTx.Unsigned)
+		}
+		response.Blockchains[i] = APIBlockchain{
+			ID:       chainTx.ID(),
+			Name:     chain.ChainName,
+			SubnetID: args.SubnetID,
+			VMID:     chain.VMID,
+		}
+	}
+	return nil
+}
+
+// newAPISubnetTransformation returns the staking parameters set by
+// [transformSubnetIntf]
+func newAPISubnetTransformation(transformSubnetIntf *txs.Tx) (*APISubnetTransformation, error) {
+	transformSubnet, ok := transformSubnetIntf.Unsigned.(*txs.TransformSubnetTx)
+	if !ok {
+		return nil, fmt.Errorf(
+			"unexpected subnet transformation tx type fetched %T",
+			transformSubnetIntf.Unsigned,
+		)
+	}
+	return &APISubnetTransformation{
+		TxID:                      transformSubnetIntf.ID(),
+		AssetID:                   transformSubnet.AssetID,
+		InitialSupply:             json.Uint64(transformSubnet.InitialSupply),
+		MaximumSupply:             json.Uint64(transformSubnet.MaximumSupply),
+		MinConsumptionRate:        json.Uint64(transformSubnet.MinConsumptionRate),
+		MaxConsumptionRate:        json.Uint64(transformSubnet.MaxConsumptionRate),
+		MinValidatorStake:         json.Uint64(transformSubnet.MinValidatorStake),
+		MaxValidatorStake:         json.Uint64(transformSubnet.MaxValidatorStake),
+		MinValidatorStakeDuration: json.Uint32(transformSubnet.MinValidatorStakeDuration),
+		MaxValidatorStakeDuration: json.Uint32(transformSubnet.MaxValidatorStakeDuration),
+		MinDelegatorStakeDuration: json.Uint32(transformSubnet.MinDelegatorStakeDuration),
+		MaxDelegatorStakeDuration: json.Uint32(transformSubnet.MaxDelegatorStakeDuration),
+		MinDelegationFee:          json.Uint32(transformSubnet.MinDelegationFee),
+		MinDelegatorStake:         json.Uint64(transformSubnet.MinDelegatorStake),
+		MaxValidatorWeightFactor:  json.Uint8(transformSubnet.MaxValidatorWeightFactor),
+		UptimeRequirement:         json.Uint32(transformSubnet.UptimeRequirement),
+	}, nil
+}
+
+// GetSubnetTransformationArgs are the arguments to GetSubnetTransformation
+type GetSubnetTransformationArgs struct {
+	// ID of the permissionless subnet
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// GetSubnetTransformation returns the staking parameters of a permissionless
+// subnet: the staked asset, the stake and duration bounds, the reward rates
+// and the minimum delegation fee
+func (s *Service) GetSubnetTransformation(_ *http.Request, args *GetSubnetTransformationArgs, response *APISubnetTransformation) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getSubnetTransformation"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	if args.SubnetID == constants.PrimaryNetworkID {
+		return errPrimaryNotASubnet
+	}
+
+	transformSubnetIntf, err := s.vm.state.GetSubnetTransformation(args.SubnetID)
+	switch err {
+	case nil:
+	case database.ErrNotFound:
+		return fmt.Errorf("%w: %s", errSubnetNotPermissionless, args.SubnetID)
+	default:
+		return fmt.Errorf(
+			"failed fetching subnet transformation for %s: %w",
+			args.SubnetID,
+			err,
+		)
+	}
+
+	transformation, err := newAPISubnetTransformation(transformSubnetIntf)
+	if err != nil {
+		return err
+	}
+	*response = *transformation
+	return nil
+}
+
 // GetStakingAssetIDArgs are the arguments to GetStakingAssetID
 type GetStakingAssetIDArgs struct {
 	SubnetID ids.ID `json:"subnetID"`
@@ -692,12 +1053,25 @@ type GetCurrentValidatorsArgs struct {
 	// some nodeIDs are not currently validators, they
 	// will be omitted from the response.
 	NodeIDs []ids.NodeID `json:"nodeIDs"`
+	// SortBy orders the returned validators by one of "endTime", "stake" or
+	// "uptime". If omitted, validators are returned in staker order.
+	SortBy string `json:"sortBy"`
+	// Descending reverses the order specified by [SortBy].
+	Descending bool `json:"descending"`
+	// Offset is the number of validators to skip.
+	Offset json.Uint64 `json:"offset"`
+	// Limit is the maximum number of validators to return. If 0, all
+	// remaining validators are returned.
+	Limit json.Uint64 `json:"limit"`
 }
 
 // GetCurrentValidatorsReply are the results from calling GetCurrentValidators.
 // Each validator contains a list of delegators to itself.
 type GetCurrentValidatorsReply struct {
 	Validators []interface{} `json:"validators"`
+	// NumValidators is the number of validators before pagination was
+	// applied. It is only populated if [Offset] or [Limit] was provided.
+	NumValidators *json.Uint64 `json:"numValidators,omitempty"`
 }
 
 func (s *Service) loadStakerTxAttributes(txID ids.ID) (*stakerAttributes, error) {
@@ -835,38 +1209,37 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 			if err != nil {
 				return err
 			}
+			maintenanceUptime, err := s.getAPIMaintenanceAdjustedUptime(currentStaker)
+			if err != nil {
+				return err
+			}
 
 			connected := s.vm.uptimeManager.IsConnected(nodeID, args.SubnetID)
 			var (
 				validationRewardOwner *omegaapi.Owner
 				delegationRewardOwner *omegaapi.Owner
 			)
-			validationOwner, ok := attr.validationRewardsOwner.(*secp256k1fx.OutputOwners)
-			if ok {
-				validationRewardOwner, err = s.getAPIOwner(validationOwner)
-				if err != nil {
-					return err
-				}
+			validationRewardOwner, err = s.getAPIRewardOwner(attr.validationRewardsOwner)
+			if err != nil {
+				return err
 			}
-			delegationOwner, ok := attr.delegationRewardsOwner.(*secp256k1fx.OutputOwners)
-			if ok {
-				delegationRewardOwner, err = s.getAPIOwner(delegationOwner)
-				if err != nil {
-					return err
-				}
+			delegationRewardOwner, err = s.getAPIRewardOwner(attr.delegationRewardsOwner)
+			if err != nil {
+				return err
 			}
 
 			vdr := omegaapi.PermissionlessValidator{
-				Staker:                 apiStaker,
-				Uptime:                 uptime,
-				Connected:              connected,
-				PotentialReward:        &potentialReward,
-				AccruedDelegateeReward: &jsonDelegateeReward,
-				RewardOwner:            validationRewardOwner,
-				ValidationRewardOwner:  validationRewardOwner,
-				DelegationRewardOwner:  delegationRewardOwner,
-				DelegationFee:          delegationFee,
-				Signer:                 attr.proofOfPossession,
+				Staker:                    apiStaker,
+				Uptime:                    uptime,
+				MaintenanceAdjustedUptime: maintenanceUptime,
+				Connected:                 connected,
+				PotentialReward:           &potentialReward,
+				AccruedDelegateeReward:    &jsonDelegateeReward,
+				RewardOwner:               validationRewardOwner,
+				ValidationRewardOwner:     validationRewardOwner,
+				DelegationRewardOwner:     delegationRewardOwner,
+				DelegationFee:             delegationFee,
+				Signer:                    attr.proofOfPossession,
 			}
 			reply.Validators = append(reply.Validators, vdr)
 
@@ -879,12 +1252,9 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 				if err != nil {
 					return err
 				}
-				owner, ok := attr.rewardsOwner.(*secp256k1fx.OutputOwners)
-				if ok {
-					rewardOwner, err = s.getAPIOwner(owner)
-					if err != nil {
-						return err
-					}
+				rewardOwner, err = s.getAPIRewardOwner(attr.rewardsOwner)
+				if err != nil {
+					return err
 				}
 			}
 
@@ -900,11 +1270,16 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 			if err != nil {
 				return err
 			}
+			maintenanceUptime, err := s.getAPIMaintenanceAdjustedUptime(currentStaker)
+			if err != nil {
+				return err
+			}
 			connected := s.vm.uptimeManager.IsConnected(nodeID, args.SubnetID)
 			reply.Validators = append(reply.Validators, omegaapi.PermissionedValidator{
-				Staker:    apiStaker,
-				Connected: connected,
-				Uptime:    uptime,
+				Staker:                    apiStaker,
+				Connected:                 connected,
+				Uptime:                    uptime,
+				MaintenanceAdjustedUptime: maintenanceUptime,
 			})
 
 		default:
@@ -940,9 +1315,95 @@ func (s *Service) GetCurrentValidators(_ *http.Request, args *GetCurrentValidato
 		reply.Validators[i] = vdr
 	}
 
+	if err := sortAPIValidators(reply.Validators, args.SortBy, args.Descending); err != nil {
+		return err
+	}
+
+	if args.Offset != 0 || args.Limit != 0 {
+		numValidators := json.Uint64(len(reply.Validators))
+		reply.NumValidators = &numValidators
+
+		start := uint64(args.Offset)
+		if start > uint64(numValidators) {
+			start = uint64(numValidators)
+		}
+		end := uint64(numValidators)
+		if args.Limit != 0 && uint64(args.Limit) < end-start {
+			end = start + uint64(args.Limit)
+		}
+		reply.Validators = reply.Validators[start:end]
+	}
+	return nil
+}
+
+// sortAPIValidators sorts [vdrs], which must contain
+// omegaapi.PermissionlessValidator and omegaapi.PermissionedValidator values,
+// by [sortBy]. Ties are broken by nodeID so that paginated results are
+// consistent.
+func sortAPIValidators(vdrs []interface{}, sortBy string, descending bool) error {
+	var less func(a, b *apiValidatorSortKey) bool
+	switch sortBy {
+	case "":
+		return nil
+	case ValidatorSortByEndTime:
+		less = func(a, b *apiValidatorSortKey) bool {
+			return a.staker.EndTime < b.staker.EndTime
+		}
+	case ValidatorSortByStake:
+		less = func(a, b *apiValidatorSortKey) bool {
+			return a.staker.Weight < b.staker.Weight
+		}
+	case ValidatorSortByUptime:
+		less = func(a, b *apiValidatorSortKey) bool {
+			return a.uptime < b.uptime
+		}
+	default:
+		return fmt.Errorf("%w: %q", errUnknownSortBy, sortBy)
+	}
+
+	keys := make([]apiValidatorSortKey, len(vdrs))
+	for i, vdrIntf := range vdrs {
+		keys[i].vdr = vdrIntf
+		switch vdr := vdrIntf.(type) {
+		case omegaapi.PermissionlessValidator:
+			keys[i].staker = vdr.Staker
+			if vdr.Uptime != nil {
+				keys[i].uptime = float32(*vdr.Uptime)
+			}
+		case omegaapi.PermissionedValidator:
+			keys[i].staker = vdr.Staker
+			if vdr.Uptime != nil {
+				keys[i].uptime = float32(*vdr.Uptime)
+			}
+		default:
+			return fmt.Errorf("unexpected validator type %T", vdrIntf)
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b apiValidatorSortKey) bool {
+		if descending {
+			a, b = b, a
+		}
+		if less(&a, &b) {
+			return true
+		}
+		if less(&b, &a) {
+			return false
+		}
+		return a.staker.NodeID.Less(b.staker.NodeID)
+	})
+	for i, key := range keys {
+		vdrs[i] = key.vdr
+	}
 	return nil
 }
 
+type apiValidatorSortKey struct {
+	vdr    interface{}
+	staker omegaapi.Staker
+	uptime float32
+}
+
 // GetPendingValidatorsArgs are the arguments for calling GetPendingValidators
 type GetPendingValidatorsArgs struct {
 	// Subnet we're getting the pending validators of
@@ -1063,40 +1524,287 @@ func (s *Service) GetPendingValidators(_ *http.Request, args *GetPendingValidato
 	return nil
 }
 
-// GetCurrentSupplyArgs are the arguments for calling GetCurrentSupply
-type GetCurrentSupplyArgs struct {
+// GetStakerScheduleArgs are the arguments for calling GetStakerSchedule
+type GetStakerScheduleArgs struct {
+	// Subnet whose stakers are scheduled
+	// If omitted, defaults to primary network
 	SubnetID ids.ID `json:"subnetID"`
+	// Maximum number of events to return. If 0, up to
+	// [maxGetStakerScheduleLimit] events are returned.
+	Limit json.Uint64 `json:"limit"`
 }
 
-// GetCurrentSupplyReply are the results from calling GetCurrentSupply
-type GetCurrentSupplyReply struct {
-	Supply json.Uint64 `json:"supply"`
-	Height json.Uint64 `json:"height"`
+// StakerEvent is a scheduled change to the staker set
+type StakerEvent struct {
+	// Either "start" or "end"
+	Event       string      `json:"event"`
+	Time        json.Uint64 `json:"time"`
+	TxID        ids.ID      `json:"txID"`
+	NodeID      ids.NodeID  `json:"nodeID"`
+	Weight      json.Uint64 `json:"weight"`
+	IsDelegator bool        `json:"isDelegator"`
 }
 
-// GetCurrentSupply returns an upper bound on the supply of DIONE in the system
-func (s *Service) GetCurrentSupply(r *http.Request, args *GetCurrentSupplyArgs, reply *GetCurrentSupplyReply) error {
+// GetStakerScheduleReply are the results from calling GetStakerSchedule
+type GetStakerScheduleReply struct {
+	// Events ordered by the time they are scheduled to occur
+	Events []StakerEvent `json:"events"`
+}
+
+// GetStakerSchedule returns the next changes to the staker set of a subnet:
+// pending stakers starting and pending or current stakers ending.
+func (s *Service) GetStakerSchedule(_ *http.Request, args *GetStakerScheduleArgs, reply *GetStakerScheduleReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "omega"),
-		zap.String("method", "getCurrentSupply"),
+		zap.String("method", "getStakerSchedule"),
+		zap.Stringer("subnetID", args.SubnetID),
+		zap.Uint64("limit", uint64(args.Limit)),
 	)
 
-	supply, err := s.vm.state.GetCurrentSupply(args.SubnetID)
-	if err != nil {
-		return fmt.Errorf("fetching current supply failed: %w", err)
+	limit := int(maxGetStakerScheduleLimit)
+	if args.Limit != 0 && args.Limit < maxGetStakerScheduleLimit {
+		limit = int(args.Limit)
 	}
-	reply.Supply = json.Uint64(supply)
 
-	ctx := r.Context()
-	height, err := s.vm.GetCurrentHeight(ctx)
+	// The [limit] earliest events must be among the starts of the first
+	// [limit] pending stakers, the ends of those same stakers, and the ends of
+	// the first [limit] current stakers. Any other pending staker starts, and
+	// therefore ends, after all of the collected starts.
+	events := make([]StakerEvent, 0, 3*limit)
+
+	pendingStakerIterator, err := s.vm.state.GetPendingStakerIterator()
 	if err != nil {
-		return fmt.Errorf("fetching current height failed: %w", err)
+		return err
+	}
+	for numPending := 0; numPending < limit && pendingStakerIterator.Next(); {
+		staker := pendingStakerIterator.Value()
+		if staker.SubnetID != args.SubnetID {
+			continue
+		}
+		numPending++
+		events = append(events,
+			newStakerEvent(StakerEventStart, staker.StartTime, staker),
+			newStakerEvent(StakerEventEnd, staker.EndTime, staker),
+		)
+	}
+	pendingStakerIterator.Release()
+
+	currentStakerIterator, err := s.vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return err
+	}
+	for numCurrent := 0; numCurrent < limit && currentStakerIterator.Next(); {
+		staker := currentStakerIterator.Value()
+		if staker.SubnetID != args.SubnetID {
+			continue
+		}
+		numCurrent++
+		events = append(events, newStakerEvent(StakerEventEnd, staker.EndTime, staker))
+	}
+	currentStakerIterator.Release()
+
+	slices.SortStableFunc(events, func(a, b StakerEvent) bool {
+		return a.Time < b.Time
+	})
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	reply.Events = events
+	return nil
+}
+
+func newStakerEvent(event string, eventTime time.Time, staker *state.Staker) StakerEvent {
+	return StakerEvent{
+		Event:       event,
+		Time:        json.Uint64(eventTime.Unix()),
+		TxID:        staker.TxID,
+		NodeID:      staker.NodeID,
+		Weight:      json.Uint64(staker.Weight),
+		IsDelegator: staker.Priority.IsDelegator(),
+	}
+}
+
+// GetRewardOwnersArgs are the arguments for calling GetRewardOwners
+type GetRewardOwnersArgs struct {
+	// Subnet the validators are validating
+	// If omitted, defaults to primary network
+	SubnetID ids.ID `json:"subnetID"`
+	// NodeIDs of the validators to get the reward owners of
+	NodeIDs []ids.NodeID `json:"nodeIDs"`
+}
+
+// APIRewardOwners are the owners of the rewards of a validator
+type APIRewardOwners struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// ID of the transaction that added the validator
+	TxID                  ids.ID          `json:"txID"`
+	ValidationRewardOwner *omegaapi.Owner `json:"validationRewardOwner,omitempty"`
+	DelegationRewardOwner *omegaapi.Owner `json:"delegationRewardOwner,omitempty"`
+}
+
+// GetRewardOwnersReply are the results from calling GetRewardOwners
+type GetRewardOwnersReply struct {
+	RewardOwners []APIRewardOwners `json:"rewardOwners"`
+}
+
+// GetRewardOwners returns the reward owners of the current or pending
+// permissionless validators in [args.NodeIDs]. Node IDs that aren't such a
+// validator of [args.SubnetID] are omitted from the reply.
+func (s *Service) GetRewardOwners(_ *http.Request, args *GetRewardOwnersArgs, reply *GetRewardOwnersReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getRewardOwners"),
+		zap.Int("numNodeIDs", len(args.NodeIDs)),
+	)
+
+	reply.RewardOwners = make([]APIRewardOwners, 0, len(args.NodeIDs))
+	seen := set.NewSet[ids.NodeID](len(args.NodeIDs))
+	for _, nodeID := range args.NodeIDs {
+		// Only report each validator once
+		if seen.Contains(nodeID) {
+			continue
+		}
+		seen.Add(nodeID)
+
+		staker, err := s.vm.state.GetCurrentValidator(args.SubnetID, nodeID)
+		if err == database.ErrNotFound {
+			staker, err = s.vm.state.GetPendingValidator(args.SubnetID, nodeID)
+		}
+		switch err {
+		case nil:
+		case database.ErrNotFound:
+			continue
+		default:
+			return fmt.Errorf("couldn't get validator %s: %w", nodeID, err)
+		}
+
+		switch staker.Priority {
+		case txs.PrimaryNetworkValidatorCurrentPriority,
+			txs.PrimaryNetworkValidatorPendingPriority,
+			txs.SubnetPermissionlessValidatorCurrentPriority,
+			txs.SubnetPermissionlessValidatorPendingPriority:
+		default:
+			// Permissioned validators aren't rewarded
+			continue
+		}
+
+		attr, err := s.loadStakerTxAttributes(staker.TxID)
+		if err != nil {
+			return err
+		}
+
+		rewardOwners := APIRewardOwners{
+			NodeID: nodeID,
+			TxID:   staker.TxID,
+		}
+		rewardOwners.ValidationRewardOwner, err = s.getAPIRewardOwner(attr.validationRewardsOwner)
+		if err != nil {
+			return err
+		}
+		rewardOwners.DelegationRewardOwner, err = s.getAPIRewardOwner(attr.delegationRewardsOwner)
+		if err != nil {
+			return err
+		}
+		reply.RewardOwners = append(reply.RewardOwners, rewardOwners)
+	}
+	return nil
+}
+
+// GetCurrentSupplyArgs are the arguments for calling GetCurrentSupply
+type GetCurrentSupplyArgs struct {
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// GetCurrentSupplyReply are the results from calling GetCurrentSupply
+type GetCurrentSupplyReply struct {
+	Supply json.Uint64 `json:"supply"`
+	Height json.Uint64 `json:"height"`
+}
+
+// GetCurrentSupply returns an upper bound on the supply of DIONE in the system
+func (s *Service) GetCurrentSupply(r *http.Request, args *GetCurrentSupplyArgs, reply *GetCurrentSupplyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getCurrentSupply"),
+	)
+
+	supply, err := s.vm.state.GetCurrentSupply(args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("fetching current supply failed: %w", err)
+	}
+	reply.Supply = json.Uint64(supply)
+
+	ctx := r.Context()
+	height, err := s.vm.GetCurrentHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching current height failed: %w", err)
 	}
 	reply.Height = json.Uint64(height)
 
 	return nil
 }
 
+// EstimateRewardArgs are the arguments for calling EstimateReward
+type EstimateRewardArgs struct {
+	// Amount that would be staked
+	StakeAmount json.Uint64 `json:"stakeAmount"`
+	// Unix time the staking period would start
+	StartTime json.Uint64 `json:"startTime"`
+	// Unix time the staking period would end
+	EndTime json.Uint64 `json:"endTime"`
+	// Subnet that would be staked on
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// EstimateRewardReply are the results from calling EstimateReward
+type EstimateRewardReply struct {
+	PotentialReward json.Uint64 `json:"potentialReward"`
+	// Supply the estimate was calculated against
+	Supply json.Uint64 `json:"supply"`
+}
+
+// EstimateReward returns the potential reward of a staker of [args.SubnetID]
+// with the provided stake and staking period, calculated against the current
+// supply of the subnet. The actual reward is calculated against the supply
+// when the staker starts staking, so it may differ from this estimate.
+func (s *Service) EstimateReward(_ *http.Request, args *EstimateRewardArgs, reply *EstimateRewardReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "estimateReward"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	switch {
+	case args.SubnetID == constants.PrimaryNetworkID:
+		return errPrimaryNetworkReward
+	case args.StakeAmount == 0:
+		return errNoAmount
+	case args.EndTime <= args.StartTime:
+		return errStartAfterEndTime
+	}
+
+	rewards, err := executor.GetRewardsCalculator(
+		&executor.Backend{
+			Config: &s.vm.Config,
+		},
+		s.vm.state,
+		args.SubnetID,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't get rewards calculator for subnet %s: %w", args.SubnetID, err)
+	}
+
+	supply, err := s.vm.state.GetCurrentSupply(args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("fetching current supply failed: %w", err)
+	}
+
+	stakedDuration := time.Duration(args.EndTime-args.StartTime) * time.Second
+	reply.PotentialReward = json.Uint64(rewards.Calculate(stakedDuration, uint64(args.StakeAmount), supply))
+	reply.Supply = json.Uint64(supply)
+	return nil
+}
+
 // SampleValidatorsArgs are the arguments for calling SampleValidators
 type SampleValidatorsArgs struct {
 	// Number of validators in the sample
@@ -1142,6 +1850,150 @@ func (s *Service) SampleValidators(_ *http.Request, args *SampleValidatorsArgs,
 	return nil
 }
 
+// GetValidatorUptimeArgs are the arguments for calling GetValidatorUptime
+type GetValidatorUptimeArgs struct {
+	// Validator to report the uptime of
+	NodeID ids.NodeID `json:"nodeID"`
+	// Subnet the node validates
+	// If omitted, defaults to the primary network
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// APIValidatorUptime is the uptime of a current validator as observed by this
+// node
+type APIValidatorUptime struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Percentage (0-100) of the validator's staking period that it was
+	// observed to be online
+	Uptime json.Float32 `json:"uptime"`
+	// Number of seconds the validator was observed to be online
+	UpDuration json.Uint64 `json:"upDuration"`
+	Connected  bool        `json:"connected"`
+	// Unix time the current connection to the validator was established. Nil
+	// if the validator isn't connected.
+	ConnectedSince *json.Uint64 `json:"connectedSince,omitempty"`
+	// Unix time the validator was last observed to be connected. Nil if it
+	// hasn't been connected since this node started.
+	LastConnected *json.Uint64 `json:"lastConnected,omitempty"`
+}
+
+// GetValidatorUptime returns the locally observed uptime of a current
+// validator
+func (s *Service) GetValidatorUptime(_ *http.Request, args *GetValidatorUptimeArgs, reply *APIValidatorUptime) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getValidatorUptime"),
+		zap.Stringer("nodeID", args.NodeID),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	if err := s.verifyUptimeTracked(args.SubnetID); err != nil {
+		return err
+	}
+
+	staker, err := s.vm.state.GetCurrentValidator(args.SubnetID, args.NodeID)
+	if err != nil {
+		return fmt.Errorf(
+			"couldn't get current validator %s of subnet %s: %w",
+			args.NodeID,
+			args.SubnetID,
+			err,
+		)
+	}
+
+	uptime, err := s.getAPIValidatorUptime(staker)
+	if err != nil {
+		return err
+	}
+	*reply = *uptime
+	return nil
+}
+
+// GetValidatorUptimesArgs are the arguments for calling GetValidatorUptimes
+type GetValidatorUptimesArgs struct {
+	// Subnet whose current validators are reported
+	// If omitted, defaults to the primary network
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// GetValidatorUptimesReply are the results from calling GetValidatorUptimes
+type GetValidatorUptimesReply struct {
+	Validators []APIValidatorUptime `json:"validators"`
+}
+
+// GetValidatorUptimes returns the locally observed uptime of every current
+// validator of a subnet
+func (s *Service) GetValidatorUptimes(_ *http.Request, args *GetValidatorUptimesArgs, reply *GetValidatorUptimesReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getValidatorUptimes"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	if err := s.verifyUptimeTracked(args.SubnetID); err != nil {
+		return err
+	}
+
+	currentStakerIterator, err := s.vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return err
+	}
+	defer currentStakerIterator.Release()
+
+	reply.Validators = []APIValidatorUptime{}
+	for currentStakerIterator.Next() {
+		staker := currentStakerIterator.Value()
+		if staker.SubnetID != args.SubnetID || !staker.Priority.IsCurrentValidator() {
+			continue
+		}
+
+		uptime, err := s.getAPIValidatorUptime(staker)
+		if err != nil {
+			return err
+		}
+		reply.Validators = append(reply.Validators, *uptime)
+	}
+	return nil
+}
+
+// verifyUptimeTracked returns an error if this node doesn't track the uptimes
+// of the validators of [subnetID].
+func (s *Service) verifyUptimeTracked(subnetID ids.ID) error {
+	if subnetID != constants.PrimaryNetworkID && !s.vm.TrackedSubnets.Contains(subnetID) {
+		return fmt.Errorf("%w: %s", errUptimeNotTracked, subnetID)
+	}
+	return nil
+}
+
+func (s *Service) getAPIValidatorUptime(staker *state.Staker) (*APIValidatorUptime, error) {
+	upDuration, _, err := s.vm.uptimeManager.CalculateUptime(staker.NodeID, staker.SubnetID)
+	if err != nil {
+		return nil, err
+	}
+	rawUptime, err := s.vm.uptimeManager.CalculateUptimePercentFrom(staker.NodeID, staker.SubnetID, staker.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	uptime := &APIValidatorUptime{
+		NodeID: staker.NodeID,
+		// Transform this to a percentage (0-100) to make it consistent with
+		// getCurrentValidators
+		Uptime:     json.Float32(rawUptime * 100),
+		UpDuration: json.Uint64(upDuration / time.Second),
+		Connected:  s.vm.uptimeManager.IsConnected(staker.NodeID, staker.SubnetID),
+	}
+	if connectedSince, ok := s.vm.uptimeManager.ConnectedSince(staker.NodeID, staker.SubnetID); ok {
+		connectedSinceUnix := json.Uint64(connectedSince.Unix())
+		uptime.ConnectedSince = &connectedSinceUnix
+	}
+	if lastConnected, ok := s.vm.uptimeManager.LastConnected(staker.NodeID, staker.SubnetID); ok {
+		lastConnectedUnix := json.Uint64(lastConnected.Unix())
+		uptime.LastConnected = &lastConnectedUnix
+	}
+	return uptime, nil
+}
+
 /*
  ******************************************************
  ************ Add Validators to Subnets ***************
@@ -1372,40 +2224,500 @@ func (s *Service) AddDelegator(_ *http.Request, args *AddDelegatorArgs, reply *a
 	return errs.Err
 }
 
-// AddSubnetValidatorArgs are the arguments to AddSubnetValidator
-type AddSubnetValidatorArgs struct {
+// AddPermissionlessValidatorArgs are the arguments to
+// AddPermissionlessValidator
+type AddPermissionlessValidatorArgs struct {
 	// User, password, from addrs, change addr
 	api.JSONSpendHeader
 	omegaapi.Staker
-	// ID of subnet to validate
+	// ID of the subnet to validate. Defaults to the primary network.
 	SubnetID string `json:"subnetID"`
+	// BLS key and proof of possession of the validator. Only primary network
+	// validators register a BLS key.
+	Signer *signer.ProofOfPossession `json:"signer"`
+	// The address the validation rewards, if applicable, will go to
+	RewardAddress string `json:"rewardAddress"`
+	// The address the delegation fees will go to. Defaults to
+	// [RewardAddress].
+	DelegationRewardAddress string       `json:"delegationRewardAddress"`
+	DelegationFeeRate       json.Float32 `json:"delegationFeeRate"`
 }
 
-// AddSubnetValidator creates and signs and issues a transaction to add a
-// validator to a subnet other than the primary network
-func (s *Service) AddSubnetValidator(_ *http.Request, args *AddSubnetValidatorArgs, response *api.JSONTxIDChangeAddr) error {
-	s.vm.ctx.Log.Warn("deprecated API called",
+// AddPermissionlessValidator creates and signs and issues a transaction to add
+// a validator to a permissionless subnet, staking the subnet's staking asset
+func (s *Service) AddPermissionlessValidator(_ *http.Request, args *AddPermissionlessValidatorArgs, reply *api.JSONTxIDChangeAddr) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "addPermissionlessValidator"),
+	)
+
+	now := s.vm.clock.Time()
+	minAddStakerTime := now.Add(minAddStakerDelay)
+	minAddStakerUnix := json.Uint64(minAddStakerTime.Unix())
+	maxAddStakerTime := now.Add(executor.MaxFutureStartTime)
+	maxAddStakerUnix := json.Uint64(maxAddStakerTime.Unix())
+
+	if args.StartTime == 0 {
+		args.StartTime = minAddStakerUnix
+	}
+
+	switch {
+	case args.RewardAddress == "":
+		return errNoRewardAddress
+	case args.StartTime < minAddStakerUnix:
+		return errStartTimeTooSoon
+	case args.StartTime > maxAddStakerUnix:
+		return errStartTimeTooLate
+	case args.DelegationFeeRate < 0 || args.DelegationFeeRate > 100:
+		return errInvalidDelegationRate
+	}
+
+	subnetID, err := s.parsePermissionlessSubnetID(args.SubnetID)
+	if err != nil {
+		return err
+	}
+
+	// Parse the node ID
+	var nodeID ids.NodeID
+	if args.NodeID == ids.EmptyNodeID { // If ID unspecified, use this node's ID
+		nodeID = s.vm.ctx.NodeID
+	} else {
+		nodeID = args.NodeID
+	}
+
+	var pop signer.Signer = &signer.Empty{}
+	if args.Signer != nil {
+		pop = args.Signer
+	}
+
+	// Parse the from addresses
+	fromAddrs, err := dione.ParseServiceAddresses(s.addrManager, args.From)
+	if err != nil {
+		return err
+	}
+
+	// Parse the reward addresses
+	rewardAddress, err := dione.ParseServiceAddress(s.addrManager, args.RewardAddress)
+	if err != nil {
+		return fmt.Errorf("problem while parsing reward address: %w", err)
+	}
+	delegationRewardAddress := rewardAddress
+	if args.DelegationRewardAddress != "" {
+		delegationRewardAddress, err = dione.ParseServiceAddress(s.addrManager, args.DelegationRewardAddress)
+		if err != nil {
+			return fmt.Errorf("problem while parsing delegation reward address: %w", err)
+		}
+	}
+
+	user, err := keystore.NewUserFromKeystore(s.vm.ctx.Keystore, args.Username, args.Password)
+	if err != nil {
+		return err
+	}
+	defer user.Close()
+
+	// Get the user's keys
+	privKeys, err := keystore.GetKeychain(user, fromAddrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
+	}
+
+	// Parse the change address.
+	if len(privKeys.Keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr := privKeys.Keys[0].PublicKey().Address() // By default, use a key controlled by the user
+	if args.ChangeAddr != "" {
+		changeAddr, err = dione.ParseServiceAddress(s.addrManager, args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	// TODO: Remove after StakeAmount is removed from [args].
+	if args.StakeAmount != nil {
+		args.Weight = *args.StakeAmount
+	}
+
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewAddPermissionlessValidatorTx(
+		uint64(args.Weight),                  // Stake amount
+		uint64(args.StartTime),               // Start time
+		uint64(args.EndTime),                 // End time
+		nodeID,                               // Node ID
+		subnetID,                             // Subnet ID
+		pop,                                  // BLS proof of possession
+		rewardAddress,                        // Validation reward address
+		delegationRewardAddress,              // Delegation reward address
+		uint32(10000*args.DelegationFeeRate), // Shares
+		privKeys.Keys,                        // Keys providing the staked tokens
+		changeAddr,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	reply.TxID = tx.ID()
+	reply.ChangeAddr, err = s.addrManager.FormatLocalAddress(changeAddr)
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		err,
+		s.vm.Builder.AddUnverifiedTx(tx),
+		user.Close(),
+	)
+	return errs.Err
+}
+
+// AddPermissionlessDelegatorArgs are the arguments to
+// AddPermissionlessDelegator
+type AddPermissionlessDelegatorArgs struct {
+	// User, password, from addrs, change addr
+	api.JSONSpendHeader
+	omegaapi.Staker
+	// ID of the subnet the delegatee validates. Defaults to the primary
+	// network.
+	SubnetID      string `json:"subnetID"`
+	RewardAddress string `json:"rewardAddress"`
+}
+
+// AddPermissionlessDelegator creates and signs and issues a transaction to add
+// a delegator to a validator of a permissionless subnet, staking the subnet's
+// staking asset
+func (s *Service) AddPermissionlessDelegator(_ *http.Request, args *AddPermissionlessDelegatorArgs, reply *api.JSONTxIDChangeAddr) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "addPermissionlessDelegator"),
+	)
+
+	now := s.vm.clock.Time()
+	minAddStakerTime := now.Add(minAddStakerDelay)
+	minAddStakerUnix := json.Uint64(minAddStakerTime.Unix())
+	maxAddStakerTime := now.Add(executor.MaxFutureStartTime)
+	maxAddStakerUnix := json.Uint64(maxAddStakerTime.Unix())
+
+	if args.StartTime == 0 {
+		args.StartTime = minAddStakerUnix
+	}
+
+	switch {
+	case args.RewardAddress == "":
+		return errNoRewardAddress
+	case args.StartTime < minAddStakerUnix:
+		return errStartTimeTooSoon
+	case args.StartTime > maxAddStakerUnix:
+		return errStartTimeTooLate
+	}
+
+	subnetID, err := s.parsePermissionlessSubnetID(args.SubnetID)
+	if err != nil {
+		return err
+	}
+
+	// Parse the node ID
+	var nodeID ids.NodeID
+	if args.NodeID == ids.EmptyNodeID { // If ID unspecified, use this node's ID
+		nodeID = s.vm.ctx.NodeID
+	} else {
+		nodeID = args.NodeID
+	}
+
+	// Parse the from addresses
+	fromAddrs, err := dione.ParseServiceAddresses(s.addrManager, args.From)
+	if err != nil {
+		return err
+	}
+
+	// Parse the reward address
+	rewardAddress, err := dione.ParseServiceAddress(s.addrManager, args.RewardAddress)
+	if err != nil {
+		return fmt.Errorf("problem parsing 'rewardAddress': %w", err)
+	}
+
+	user, err := keystore.NewUserFromKeystore(s.vm.ctx.Keystore, args.Username, args.Password)
+	if err != nil {
+		return err
+	}
+	defer user.Close()
+
+	// Get the user's keys
+	privKeys, err := keystore.GetKeychain(user, fromAddrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
+	}
+
+	// Parse the change address. Assumes that if the user has no keys,
+	// this operation will fail so the change address can be anything.
+	if len(privKeys.Keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr := privKeys.Keys[0].PublicKey().Address() // By default, use a key controlled by the user
+	if args.ChangeAddr != "" {
+		changeAddr, err = dione.ParseServiceAddress(s.addrManager, args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	// TODO: Remove after StakeAmount is removed from [args].
+	if args.StakeAmount != nil {
+		args.Weight = *args.StakeAmount
+	}
+
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewAddPermissionlessDelegatorTx(
+		uint64(args.Weight),    // Stake amount
+		uint64(args.StartTime), // Start time
+		uint64(args.EndTime),   // End time
+		nodeID,                 // Node ID
+		subnetID,               // Subnet ID
+		rewardAddress,          // Reward Address
+		privKeys.Keys,          // Private keys
+		changeAddr,             // Change address
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	reply.TxID = tx.ID()
+	reply.ChangeAddr, err = s.addrManager.FormatLocalAddress(changeAddr)
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		err,
+		s.vm.Builder.AddUnverifiedTx(tx),
+		user.Close(),
+	)
+	return errs.Err
+}
+
+// parsePermissionlessSubnetID parses the ID of a subnet that can be staked on
+// permissionlessly. An empty [subnetID] is the primary network.
+func (s *Service) parsePermissionlessSubnetID(subnetIDStr string) (ids.ID, error) {
+	if subnetIDStr == "" {
+		return constants.PrimaryNetworkID, nil
+	}
+
+	subnetID, err := ids.FromString(subnetIDStr)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("problem parsing subnetID %q: %w", subnetIDStr, err)
+	}
+	if subnetID == constants.PrimaryNetworkID {
+		return subnetID, nil
+	}
+
+	if _, err := s.vm.state.GetSubnetTransformation(subnetID); err != nil {
+		if err == database.ErrNotFound {
+			return ids.Empty, fmt.Errorf("%w: %s", errSubnetNotPermissionless, subnetID)
+		}
+		return ids.Empty, fmt.Errorf("couldn't get transformation of subnet %s: %w", subnetID, err)
+	}
+	return subnetID, nil
+}
+
+// AddSubnetValidatorArgs are the arguments to AddSubnetValidator
+type AddSubnetValidatorArgs struct {
+	// User, password, from addrs, change addr
+	api.JSONSpendHeader
+	omegaapi.Staker
+	// ID of subnet to validate
+	SubnetID string `json:"subnetID"`
+}
+
+// AddSubnetValidator creates and signs and issues a transaction to add a
+// validator to a subnet other than the primary network
+func (s *Service) AddSubnetValidator(_ *http.Request, args *AddSubnetValidatorArgs, response *api.JSONTxIDChangeAddr) error {
+	s.vm.ctx.Log.Warn("deprecated API called",
+		zap.String("service", "omega"),
+		zap.String("method", "addSubnetValidator"),
+	)
+
+	now := s.vm.clock.Time()
+	minAddStakerTime := now.Add(minAddStakerDelay)
+	minAddStakerUnix := json.Uint64(minAddStakerTime.Unix())
+	maxAddStakerTime := now.Add(executor.MaxFutureStartTime)
+	maxAddStakerUnix := json.Uint64(maxAddStakerTime.Unix())
+
+	if args.StartTime == 0 {
+		args.StartTime = minAddStakerUnix
+	}
+
+	switch {
+	case args.SubnetID == "":
+		return errNoSubnetID
+	case args.StartTime < minAddStakerUnix:
+		return errStartTimeTooSoon
+	case args.StartTime > maxAddStakerUnix:
+		return errStartTimeTooLate
+	}
+
+	// Parse the subnet ID
+	subnetID, err := ids.FromString(args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("problem parsing subnetID %q: %w", args.SubnetID, err)
+	}
+	if subnetID == constants.PrimaryNetworkID {
+		return errNamedSubnetCantBePrimary
+	}
+
+	// Parse the from addresses
+	fromAddrs, err := dione.ParseServiceAddresses(s.addrManager, args.From)
+	if err != nil {
+		return err
+	}
+
+	user, err := keystore.NewUserFromKeystore(s.vm.ctx.Keystore, args.Username, args.Password)
+	if err != nil {
+		return err
+	}
+	defer user.Close()
+
+	keys, err := keystore.GetKeychain(user, fromAddrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
+	}
+
+	// Parse the change address.
+	if len(keys.Keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr := keys.Keys[0].PublicKey().Address() // By default, use a key controlled by the user
+	if args.ChangeAddr != "" {
+		changeAddr, err = dione.ParseServiceAddress(s.addrManager, args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	// TODO: Remove after StakeAmount is removed from [args].
+	if args.StakeAmount != nil {
+		args.Weight = *args.StakeAmount
+	}
+
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewAddSubnetValidatorTx(
+		uint64(args.Weight),    // Stake amount
+		uint64(args.StartTime), // Start time
+		uint64(args.EndTime),   // End time
+		args.NodeID,            // Node ID
+		subnetID,               // Subnet ID
+		keys.Keys,
+		changeAddr,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	response.TxID = tx.ID()
+	response.ChangeAddr, err = s.addrManager.FormatLocalAddress(changeAddr)
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		err,
+		s.vm.Builder.AddUnverifiedTx(tx),
+		user.Close(),
+	)
+	return errs.Err
+}
+
+// CreateSubnetArgs are the arguments to CreateSubnet
+type CreateSubnetArgs struct {
+	// User, password, from addrs, change addr
+	api.JSONSpendHeader
+	// The ID member of APISubnet is ignored
+	APISubnet
+}
+
+// CreateSubnet creates and signs and issues a transaction to create a new
+// subnet
+func (s *Service) CreateSubnet(_ *http.Request, args *CreateSubnetArgs, response *api.JSONTxIDChangeAddr) error {
+	s.vm.ctx.Log.Warn("deprecated API called",
+		zap.String("service", "omega"),
+		zap.String("method", "createSubnet"),
+	)
+
+	// Parse the control keys
+	controlKeys, err := dione.ParseServiceAddresses(s.addrManager, args.ControlKeys)
+	if err != nil {
+		return err
+	}
+
+	// Parse the from addresses
+	fromAddrs, err := dione.ParseServiceAddresses(s.addrManager, args.From)
+	if err != nil {
+		return err
+	}
+
+	user, err := keystore.NewUserFromKeystore(s.vm.ctx.Keystore, args.Username, args.Password)
+	if err != nil {
+		return err
+	}
+	defer user.Close()
+
+	privKeys, err := keystore.GetKeychain(user, fromAddrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
+	}
+
+	// Parse the change address. Assumes that if the user has no keys,
+	// this operation will fail so the change address can be anything.
+	if len(privKeys.Keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr := privKeys.Keys[0].PublicKey().Address() // By default, use a key controlled by the user
+	if args.ChangeAddr != "" {
+		changeAddr, err = dione.ParseServiceAddress(s.addrManager, args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	// Create the transaction
+	tx, err := s.vm.txBuilder.NewCreateSubnetTx(
+		uint32(args.Threshold), // Threshold
+		controlKeys.List(),     // Control Addresses
+		privKeys.Keys,          // Private keys
+		changeAddr,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't create tx: %w", err)
+	}
+
+	response.TxID = tx.ID()
+	response.ChangeAddr, err = s.addrManager.FormatLocalAddress(changeAddr)
+
+	errs := wrappers.Errs{}
+	errs.Add(
+		err,
+		s.vm.Builder.AddUnverifiedTx(tx),
+		user.Close(),
+	)
+	return errs.Err
+}
+
+// TransferSubnetOwnershipArgs are the arguments to TransferSubnetOwnership
+type TransferSubnetOwnershipArgs struct {
+	// User, password, from addrs, change addr
+	api.JSONSpendHeader
+	// ID of the subnet whose ownership is transferred
+	SubnetID string `json:"subnetID"`
+	// Each element of [ControlKeys] is the address of a public key that is a
+	// new control key of the subnet. Managing the subnet requires signatures
+	// from [Threshold] of these keys.
+	ControlKeys []string    `json:"controlKeys"`
+	Threshold   json.Uint32 `json:"threshold"`
+}
+
+// TransferSubnetOwnership creates and signs and issues a transaction to
+// replace the control keys of a subnet. The user must control enough of the
+// subnet's current control keys to authorize the transfer.
+func (s *Service) TransferSubnetOwnership(_ *http.Request, args *TransferSubnetOwnershipArgs, response *api.JSONTxIDChangeAddr) error {
+	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "omega"),
-		zap.String("method", "addSubnetValidator"),
+		zap.String("method", "transferSubnetOwnership"),
 	)
 
-	now := s.vm.clock.Time()
-	minAddStakerTime := now.Add(minAddStakerDelay)
-	minAddStakerUnix := json.Uint64(minAddStakerTime.Unix())
-	maxAddStakerTime := now.Add(executor.MaxFutureStartTime)
-	maxAddStakerUnix := json.Uint64(maxAddStakerTime.Unix())
-
-	if args.StartTime == 0 {
-		args.StartTime = minAddStakerUnix
-	}
-
-	switch {
-	case args.SubnetID == "":
+	if args.SubnetID == "" {
 		return errNoSubnetID
-	case args.StartTime < minAddStakerUnix:
-		return errStartTimeTooSoon
-	case args.StartTime > maxAddStakerUnix:
-		return errStartTimeTooLate
 	}
 
 	// Parse the subnet ID
@@ -1414,7 +2726,13 @@ func (s *Service) AddSubnetValidator(_ *http.Request, args *AddSubnetValidatorAr
 		return fmt.Errorf("problem parsing subnetID %q: %w", args.SubnetID, err)
 	}
 	if subnetID == constants.PrimaryNetworkID {
-		return errNamedSubnetCantBePrimary
+		return txs.ErrTransferPrimaryNetworkOwnership
+	}
+
+	// Parse the control keys
+	controlKeys, err := dione.ParseServiceAddresses(s.addrManager, args.ControlKeys)
+	if err != nil {
+		return err
 	}
 
 	// Parse the from addresses
@@ -1429,16 +2747,16 @@ func (s *Service) AddSubnetValidator(_ *http.Request, args *AddSubnetValidatorAr
 	}
 	defer user.Close()
 
-	keys, err := keystore.GetKeychain(user, fromAddrs)
+	privKeys, err := keystore.GetKeychain(user, fromAddrs)
 	if err != nil {
 		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
 	}
 
 	// Parse the change address.
-	if len(keys.Keys) == 0 {
+	if len(privKeys.Keys) == 0 {
 		return errNoKeys
 	}
-	changeAddr := keys.Keys[0].PublicKey().Address() // By default, use a key controlled by the user
+	changeAddr := privKeys.Keys[0].PublicKey().Address() // By default, use a key controlled by the user
 	if args.ChangeAddr != "" {
 		changeAddr, err = dione.ParseServiceAddress(s.addrManager, args.ChangeAddr)
 		if err != nil {
@@ -1446,19 +2764,12 @@ func (s *Service) AddSubnetValidator(_ *http.Request, args *AddSubnetValidatorAr
 		}
 	}
 
-	// TODO: Remove after StakeAmount is removed from [args].
-	if args.StakeAmount != nil {
-		args.Weight = *args.StakeAmount
-	}
-
 	// Create the transaction
-	tx, err := s.vm.txBuilder.NewAddSubnetValidatorTx(
-		uint64(args.Weight),    // Stake amount
-		uint64(args.StartTime), // Start time
-		uint64(args.EndTime),   // End time
-		args.NodeID,            // Node ID
-		subnetID,               // Subnet ID
-		keys.Keys,
+	tx, err := s.vm.txBuilder.NewTransferSubnetOwnershipTx(
+		subnetID,
+		uint32(args.Threshold),
+		controlKeys.List(),
+		privKeys.Keys,
 		changeAddr,
 	)
 	if err != nil {
@@ -1477,26 +2788,52 @@ func (s *Service) AddSubnetValidator(_ *http.Request, args *AddSubnetValidatorAr
 	return errs.Err
 }
 
-// CreateSubnetArgs are the arguments to CreateSubnet
-type CreateSubnetArgs struct {
+// TransformSubnetArgs are the arguments to TransformSubnet
+type TransformSubnetArgs struct {
 	// User, password, from addrs, change addr
 	api.JSONSpendHeader
-	// The ID member of APISubnet is ignored
-	APISubnet
+	// ID of the subnet to transform into a permissionless subnet
+	SubnetID string `json:"subnetID"`
+	// Asset that is staked to validate the subnet. The difference between
+	// [MaximumSupply] and [InitialSupply] is burned to fund the rewards.
+	AssetID            ids.ID      `json:"assetID"`
+	InitialSupply      json.Uint64 `json:"initialSupply"`
+	MaximumSupply      json.Uint64 `json:"maximumSupply"`
+	MinConsumptionRate json.Uint64 `json:"minConsumptionRate"`
+	MaxConsumptionRate json.Uint64 `json:"maxConsumptionRate"`
+	MinValidatorStake  json.Uint64 `json:"minValidatorStake"`
+	MaxValidatorStake  json.Uint64 `json:"maxValidatorStake"`
+	// Stake durations, in seconds
+	MinValidatorStakeDuration json.Uint32 `json:"minValidatorStakeDuration"`
+	MaxValidatorStakeDuration json.Uint32 `json:"maxValidatorStakeDuration"`
+	MinDelegatorStakeDuration json.Uint32 `json:"minDelegatorStakeDuration"`
+	MaxDelegatorStakeDuration json.Uint32 `json:"maxDelegatorStakeDuration"`
+	MinDelegationFee          json.Uint32 `json:"minDelegationFee"`
+	MinDelegatorStake         json.Uint64 `json:"minDelegatorStake"`
+	MaxValidatorWeightFactor  json.Uint8  `json:"maxValidatorWeightFactor"`
+	UptimeRequirement         json.Uint32 `json:"uptimeRequirement"`
 }
 
-// CreateSubnet creates and signs and issues a transaction to create a new
-// subnet
-func (s *Service) CreateSubnet(_ *http.Request, args *CreateSubnetArgs, response *api.JSONTxIDChangeAddr) error {
-	s.vm.ctx.Log.Warn("deprecated API called",
+// TransformSubnet creates and signs and issues a transaction to transform a
+// permissioned subnet into a permissionless subnet. The user must control
+// enough of the subnet's control keys to authorize the transformation.
+func (s *Service) TransformSubnet(_ *http.Request, args *TransformSubnetArgs, response *api.JSONTxIDChangeAddr) error {
+	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "omega"),
-		zap.String("method", "createSubnet"),
+		zap.String("method", "transformSubnet"),
 	)
 
-	// Parse the control keys
-	controlKeys, err := dione.ParseServiceAddresses(s.addrManager, args.ControlKeys)
+	if args.SubnetID == "" {
+		return errNoSubnetID
+	}
+
+	// Parse the subnet ID
+	subnetID, err := ids.FromString(args.SubnetID)
 	if err != nil {
-		return err
+		return fmt.Errorf("problem parsing subnetID %q: %w", args.SubnetID, err)
+	}
+	if subnetID == constants.PrimaryNetworkID {
+		return errPrimaryNotASubnet
 	}
 
 	// Parse the from addresses
@@ -1516,8 +2853,7 @@ func (s *Service) CreateSubnet(_ *http.Request, args *CreateSubnetArgs, response
 		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
 	}
 
-	// Parse the change address. Assumes that if the user has no keys,
-	// this operation will fail so the change address can be anything.
+	// Parse the change address.
 	if len(privKeys.Keys) == 0 {
 		return errNoKeys
 	}
@@ -1530,10 +2866,24 @@ func (s *Service) CreateSubnet(_ *http.Request, args *CreateSubnetArgs, response
 	}
 
 	// Create the transaction
-	tx, err := s.vm.txBuilder.NewCreateSubnetTx(
-		uint32(args.Threshold), // Threshold
-		controlKeys.List(),     // Control Addresses
-		privKeys.Keys,          // Private keys
+	tx, err := s.vm.txBuilder.NewTransformSubnetTx(
+		subnetID,
+		args.AssetID,
+		uint64(args.InitialSupply),
+		uint64(args.MaximumSupply),
+		uint64(args.MinConsumptionRate),
+		uint64(args.MaxConsumptionRate),
+		uint64(args.MinValidatorStake),
+		uint64(args.MaxValidatorStake),
+		time.Duration(args.MinValidatorStakeDuration)*time.Second,
+		time.Duration(args.MaxValidatorStakeDuration)*time.Second,
+		time.Duration(args.MinDelegatorStakeDuration)*time.Second,
+		time.Duration(args.MaxDelegatorStakeDuration)*time.Second,
+		uint32(args.MinDelegationFee),
+		uint64(args.MinDelegatorStake),
+		byte(args.MaxValidatorWeightFactor),
+		uint32(args.UptimeRequirement),
+		privKeys.Keys,
 		changeAddr,
 	)
 	if err != nil {
@@ -1787,13 +3137,7 @@ func (s *Service) CreateBlockchain(_ *http.Request, args *CreateBlockchainArgs,
 		}
 		fxIDs = append(fxIDs, fxID)
 	}
-	// If creating ALPHA instance, use secp256k1fx
-	// TODO: Document FXs and have user specify them in API call
-	fxIDsSet := set.Set[ids.ID]{}
-	fxIDsSet.Add(fxIDs...)
-	if vmID == constants.AlphaID && !fxIDsSet.Contains(secp256k1fx.ID) {
-		fxIDs = append(fxIDs, secp256k1fx.ID)
-	}
+	fxIDs = withDefaultFxIDs(vmID, fxIDs)
 
 	if args.SubnetID == constants.PrimaryNetworkID {
 		return txs.ErrCantValidatePrimaryNetwork
@@ -1855,6 +3199,163 @@ func (s *Service) CreateBlockchain(_ *http.Request, args *CreateBlockchainArgs,
 	return errs.Err
 }
 
+// withDefaultFxIDs adds the feature extensions that [vmID] always runs to
+// [fxIDs]
+func withDefaultFxIDs(vmID ids.ID, fxIDs []ids.ID) []ids.ID {
+	// If creating ALPHA instance, use secp256k1fx
+	// TODO: Document FXs and have user specify them in API call
+	fxIDsSet := set.Set[ids.ID]{}
+	fxIDsSet.Add(fxIDs...)
+	if vmID == constants.AlphaID && !fxIDsSet.Contains(secp256k1fx.ID) {
+		fxIDs = append(fxIDs, secp256k1fx.ID)
+	}
+	return fxIDs
+}
+
+// APICreateBlockchainError is a reason a call to CreateBlockchain would fail
+type APICreateBlockchainError struct {
+	// Name of the argument that caused the failure. "tx" if the failure comes
+	// from funding or authorizing the transaction.
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidateCreateBlockchainReply is the response from ValidateCreateBlockchain
+type ValidateCreateBlockchainReply struct {
+	// Fee, in nDIONE, that creating the blockchain burns
+	Fee json.Uint64 `json:"fee"`
+	// Reasons creating the blockchain would fail. Empty if the blockchain can
+	// be created.
+	Errors []APICreateBlockchainError `json:"errors"`
+}
+
+// ValidateCreateBlockchain checks the arguments of CreateBlockchain without
+// issuing a transaction, so that a misconfigured blockchain is caught before
+// the creation fee is burned.
+//
+// The genesis data is decoded and its size checked, the VM and FXs must be
+// installed on this node and the subnet must exist. The VM must then
+// initialize from the genesis data, which is done in a throwaway context. If a
+// keystore user is provided, the transaction is also built and verified
+// against the preferred state, which checks that the user can pay the fee and
+// authorize the creation on the subnet.
+func (s *Service) ValidateCreateBlockchain(_ *http.Request, args *CreateBlockchainArgs, reply *ValidateCreateBlockchainReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "validateCreateBlockchain"),
+	)
+
+	reply.Fee = json.Uint64(s.vm.GetCreateBlockchainTxFee(s.vm.state.GetTimestamp()))
+	reply.Errors = []APICreateBlockchainError{}
+	addError := func(field string, err error) {
+		reply.Errors = append(reply.Errors, APICreateBlockchainError{
+			Field:   field,
+			Message: err.Error(),
+		})
+	}
+
+	if args.Name == "" {
+		addError("name", errMissingName)
+	} else if err := txs.VerifyChainName(args.Name); err != nil {
+		addError("name", err)
+	}
+
+	genesisBytes, err := formatting.Decode(args.Encoding, args.GenesisData)
+	validGenesis := false
+	switch {
+	case err != nil:
+		addError("genesisData", fmt.Errorf("problem parsing genesis data: %w", err))
+	case len(genesisBytes) > txs.MaxGenesisLen:
+		addError("genesisData", errGenesisTooLong)
+	default:
+		validGenesis = true
+	}
+
+	vmID := ids.Empty
+	if args.VMID == "" {
+		addError("vmID", errMissingVMID)
+	} else if vmID, err = s.vm.Chains.LookupVM(args.VMID); err != nil {
+		addError("vmID", fmt.Errorf("no VM with ID '%s' found", args.VMID))
+	}
+
+	fxIDs := []ids.ID(nil)
+	for _, fxIDStr := range args.FxIDs {
+		fxID, err := s.vm.Chains.LookupVM(fxIDStr)
+		if err != nil {
+			addError("fxIDs", fmt.Errorf("no FX with ID '%s' found", fxIDStr))
+			continue
+		}
+		fxIDs = append(fxIDs, fxID)
+	}
+	fxIDs = withDefaultFxIDs(vmID, fxIDs)
+
+	// The genesis data can only be interpreted by the VM it was written for.
+	if validGenesis && len(reply.Errors) == 0 {
+		err := s.vm.verifyChainGenesis(context.TODO(), args.SubnetID, vmID, fxIDs, genesisBytes)
+		if err != nil {
+			addError("genesisData", fmt.Errorf("VM failed to initialize from genesis data: %w", err))
+		}
+	}
+
+	if args.SubnetID == constants.PrimaryNetworkID {
+		addError("subnetID", txs.ErrCantValidatePrimaryNetwork)
+	} else if _, err := s.vm.state.GetSubnetOwner(args.SubnetID); errors.Is(err, database.ErrNotFound) {
+		addError("subnetID", fmt.Errorf("%w: %s", errSubnetNotFound, args.SubnetID))
+	} else if err != nil {
+		return fmt.Errorf("couldn't get owner of subnet %s: %w", args.SubnetID, err)
+	}
+
+	// Funding and authorizing the transaction can only be checked with the
+	// user's keys, and only once the arguments are valid.
+	if args.Username == "" || len(reply.Errors) != 0 {
+		return nil
+	}
+
+	// Parse the from addresses
+	fromAddrs, err := dione.ParseServiceAddresses(s.addrManager, args.From)
+	if err != nil {
+		return err
+	}
+
+	user, err := keystore.NewUserFromKeystore(s.vm.ctx.Keystore, args.Username, args.Password)
+	if err != nil {
+		return err
+	}
+	defer user.Close()
+
+	keys, err := keystore.GetKeychain(user, fromAddrs)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses controlled by the user: %w", err)
+	}
+	if len(keys.Keys) == 0 {
+		return errNoKeys
+	}
+	changeAddr := keys.Keys[0].PublicKey().Address() // By default, use a key controlled by the user
+	if args.ChangeAddr != "" {
+		changeAddr, err = dione.ParseServiceAddress(s.addrManager, args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	tx, err := s.vm.txBuilder.NewCreateChainTx(
+		args.SubnetID,
+		genesisBytes,
+		vmID,
+		fxIDs,
+		args.Name,
+		keys.Keys,
+		changeAddr,
+	)
+	if err == nil {
+		err = s.vm.Builder.VerifyTx(tx)
+	}
+	if err != nil {
+		addError("tx", err)
+	}
+	return user.Close()
+}
+
 // GetBlockchainStatusArgs is the arguments for calling GetBlockchainStatus
 // [BlockchainID] is the ID of or an alias of the blockchain to get the status of.
 type GetBlockchainStatusArgs struct {
@@ -2051,6 +3552,31 @@ type APIBlockchain struct {
 
 	// Virtual Machine the blockchain runs
 	VMID ids.ID `json:"vmID"`
+
+	// The following fields are only populated if verbose was requested.
+
+	// Status of the blockchain on this node
+	Status *status.BlockchainStatus `json:"status,omitempty"`
+
+	// Version of the Virtual Machine this node runs the blockchain with
+	VMVersion string `json:"vmVersion,omitempty"`
+
+	// True if this node tracks the subnet that validates the blockchain
+	Tracked *bool `json:"tracked,omitempty"`
+
+	// True if this node runs the blockchain and finished bootstrapping it
+	Bootstrapped *bool `json:"bootstrapped,omitempty"`
+
+	// Path of the blockchain's API on this node. Empty if this node doesn't
+	// run the blockchain.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// GetBlockchainsArgs are the arguments for calling GetBlockchains
+type GetBlockchainsArgs struct {
+	// If true, the status, VM version, bootstrap state and API endpoint of
+	// each blockchain on this node are included
+	Verbose bool `json:"verbose"`
 }
 
 // GetBlockchainsResponse is the response from a call to GetBlockchains
@@ -2060,7 +3586,7 @@ type GetBlockchainsResponse struct {
 }
 
 // GetBlockchains returns all of the blockchains that exist
-func (s *Service) GetBlockchains(_ *http.Request, _ *struct{}, response *GetBlockchainsResponse) error {
+func (s *Service) GetBlockchains(_ *http.Request, args *GetBlockchainsArgs, response *GetBlockchainsResponse) error {
 	s.vm.ctx.Log.Debug("deprecated API called",
 		zap.String("service", "omega"),
 		zap.String("method", "getBlockchains"),
@@ -2116,6 +3642,38 @@ func (s *Service) GetBlockchains(_ *http.Request, _ *struct{}, response *GetBloc
 		})
 	}
 
+	if !args.Verbose {
+		return nil
+	}
+
+	vmVersions, err := s.vm.VMManager.Versions()
+	if err != nil {
+		return fmt.Errorf("couldn't retrieve VM versions: %w", err)
+	}
+	for i := range response.Blockchains {
+		blockchain := &response.Blockchains[i]
+
+		chainStatus := status.Created
+		if _, err := s.vm.Chains.Lookup(blockchain.ID.String()); err == nil {
+			if s.nodeValidates(blockchain.ID) {
+				chainStatus = status.Validating
+			} else {
+				chainStatus = status.Syncing
+			}
+			blockchain.Endpoint = path.Join("/ext", constants.ChainAliasPrefix, blockchain.ID.String())
+		}
+		blockchain.Status = &chainStatus
+
+		bootstrapped := s.vm.Chains.IsBootstrapped(blockchain.ID)
+		blockchain.Bootstrapped = &bootstrapped
+
+		if vmAlias, err := s.vm.VMManager.PrimaryAlias(blockchain.VMID); err == nil {
+			blockchain.VMVersion = vmVersions[vmAlias]
+		}
+
+		tracked := blockchain.SubnetID == constants.PrimaryNetworkID || s.vm.TrackedSubnets.Contains(blockchain.SubnetID)
+		blockchain.Tracked = &tracked
+	}
 	return nil
 }
 
@@ -2126,45 +3684,156 @@ func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, response *api.
 		zap.String("method", "issueTx"),
 	)
 
-	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	txID, err := s.issueTx(args.Encoding, args.Tx)
+	if err != nil {
+		return err
+	}
+
+	response.TxID = txID
+	return nil
+}
+
+// IssueTxs issues a batch of txs into the mempool. A tx that fails to be
+// issued doesn't prevent the remaining txs from being issued.
+func (s *Service) IssueTxs(_ *http.Request, args *api.FormattedTxs, response *api.IssueTxsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "issueTxs"),
+		zap.Int("numTxs", len(args.Txs)),
+	)
+
+	if len(args.Txs) > maxIssueTxs {
+		return fmt.Errorf("%w: %d > %d", errTooManyTxs, len(args.Txs), maxIssueTxs)
+	}
+
+	response.Results = make([]api.IssueTxResult, len(args.Txs))
+	for i, txStr := range args.Txs {
+		txID, err := s.issueTx(args.Encoding, txStr)
+		response.Results[i].TxID = txID
+		if err != nil {
+			response.Results[i].Error = err.Error()
+		}
+	}
+	return nil
+}
+
+// issueTx decodes, parses and issues [txStr] into the mempool. The returned
+// txID is empty if the tx couldn't be parsed.
+func (s *Service) issueTx(encoding formatting.Encoding, txStr string) (ids.ID, error) {
+	txBytes, err := formatting.Decode(encoding, txStr)
 	if err != nil {
-		return fmt.Errorf("problem decoding transaction: %w", err)
+		return ids.Empty, fmt.Errorf("problem decoding transaction: %w", err)
 	}
 	tx, err := txs.Parse(txs.Codec, txBytes)
 	if err != nil {
-		return fmt.Errorf("couldn't parse tx: %w", err)
+		return ids.Empty, fmt.Errorf("couldn't parse tx: %w", err)
 	}
 	if err := s.vm.Builder.AddUnverifiedTx(tx); err != nil {
-		return fmt.Errorf("couldn't issue tx: %w", err)
+		return tx.ID(), fmt.Errorf("couldn't issue tx: %w", err)
 	}
+	return tx.ID(), nil
+}
 
-	response.TxID = tx.ID()
-	return nil
+// TxReceipt is the outcome of executing a tx accepted in a standard block.
+type TxReceipt struct {
+	TxID        ids.ID      `json:"txID"`
+	Fee         json.Uint64 `json:"fee"`
+	NumConsumed json.Uint32 `json:"numConsumed"`
+	NumProduced json.Uint32 `json:"numProduced"`
+}
+
+func newTxReceipt(txID ids.ID, receipt *state.TxReceipt) *TxReceipt {
+	return &TxReceipt{
+		TxID:        txID,
+		Fee:         json.Uint64(receipt.Fee),
+		NumConsumed: json.Uint32(receipt.NumConsumed),
+		NumProduced: json.Uint32(receipt.NumProduced),
+	}
+}
+
+// GetTxReply is the response from GetTx
+type GetTxReply struct {
+	api.GetTxReply
+	// Receipt is only populated for txs accepted in a standard block
+	Receipt *TxReceipt `json:"receipt,omitempty"`
 }
 
 // GetTx gets a tx
-func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetTxReply) error {
+func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *GetTxReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "omega"),
 		zap.String("method", "getTx"),
 	)
 
-	tx, _, err := s.vm.state.GetTx(args.TxID)
+	tx, _, err := s.vm.state.GetTx(args.TxID)
+	if err != nil {
+		return fmt.Errorf("couldn't get tx: %w", err)
+	}
+
+	receipt, err := s.vm.state.GetTxReceipt(args.TxID)
+	switch err {
+	case nil:
+		response.Receipt = newTxReceipt(args.TxID, receipt)
+	case database.ErrNotFound:
+	default:
+		return fmt.Errorf("couldn't get tx receipt: %w", err)
+	}
+
+	txBytes := tx.Bytes()
+	response.Encoding = args.Encoding
+
+	if args.Encoding == formatting.JSON {
+		tx.Unsigned.InitCtx(s.vm.ctx)
+		response.Tx = tx
+		return nil
+	}
+
+	response.Tx, err = formatting.Encode(args.Encoding, txBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't encode tx as %s: %w", args.Encoding, err)
+	}
+	return nil
+}
+
+// GetBlockReceiptsArgs are the arguments for GetBlockReceipts
+type GetBlockReceiptsArgs struct {
+	BlockID ids.ID `json:"blockID"`
+}
+
+// GetBlockReceiptsReply is the response from GetBlockReceipts
+type GetBlockReceiptsReply struct {
+	// Receipts of the txs of the block, in the order they were executed
+	Receipts []*TxReceipt `json:"receipts"`
+}
+
+// GetBlockReceipts returns the receipts of the txs of an accepted standard
+// block.
+func (s *Service) GetBlockReceipts(_ *http.Request, args *GetBlockReceiptsArgs, response *GetBlockReceiptsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getBlockReceipts"),
+		zap.Stringer("blkID", args.BlockID),
+	)
+
+	block, err := s.vm.manager.GetStatelessBlock(args.BlockID)
 	if err != nil {
-		return fmt.Errorf("couldn't get tx: %w", err)
+		return fmt.Errorf("couldn't get block with id %s: %w", args.BlockID, err)
 	}
-	txBytes := tx.Bytes()
-	response.Encoding = args.Encoding
-
-	if args.Encoding == formatting.JSON {
-		tx.Unsigned.InitCtx(s.vm.ctx)
-		response.Tx = tx
-		return nil
+	switch block.(type) {
+	case *blocks.BanffStandardBlock, *blocks.ApricotStandardBlock:
+	default:
+		return fmt.Errorf("%w: %s", errNotStandardBlock, args.BlockID)
 	}
 
-	response.Tx, err = formatting.Encode(args.Encoding, txBytes)
-	if err != nil {
-		return fmt.Errorf("couldn't encode tx as %s: %w", args.Encoding, err)
+	blockTxs := block.Txs()
+	response.Receipts = make([]*TxReceipt, len(blockTxs))
+	for i, tx := range blockTxs {
+		txID := tx.ID()
+		receipt, err := s.vm.state.GetTxReceipt(txID)
+		if err != nil {
+			return fmt.Errorf("couldn't get receipt of tx %s: %w", txID, err)
+		}
+		response.Receipts[i] = newTxReceipt(txID, receipt)
 	}
 	return nil
 }
@@ -2178,6 +3847,36 @@ type GetTxStatusResponse struct {
 	// Reason this tx was dropped.
 	// Only non-empty if Status is dropped
 	Reason string `json:"reason,omitempty"`
+	// Machine-readable classification of [Reason].
+	// Only populated if Status is dropped
+	ReasonCode status.DropReason `json:"reasonCode,omitempty"`
+	// ID and height of the accepted block that contains this tx.
+	// Only populated if Status is committed or aborted
+	BlockID *ids.ID      `json:"blockID,omitempty"`
+	Height  *json.Uint64 `json:"height,omitempty"`
+	// True if this tx is currently in the mempool waiting to be included in
+	// a block
+	InMempool bool `json:"inMempool"`
+}
+
+// getTxBlock populates [response] with the block that contains the decided tx
+// [txID]. Txs accepted before the tx index existed aren't populated.
+func (s *Service) getTxBlock(txID ids.ID, response *GetTxStatusResponse) error {
+	blkID, err := s.vm.state.GetTxBlockID(txID)
+	if err == database.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't get block of tx %s: %w", txID, err)
+	}
+	blk, err := s.vm.state.GetStatelessBlock(blkID)
+	if err != nil {
+		return fmt.Errorf("couldn't get block %s: %w", blkID, err)
+	}
+	height := json.Uint64(blk.Height())
+	response.BlockID = &blkID
+	response.Height = &height
+	return nil
 }
 
 // GetTxStatus gets a tx's status
@@ -2187,10 +3886,12 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 		zap.String("method", "getTxStatus"),
 	)
 
+	response.InMempool = s.vm.Builder.Has(args.TxID)
+
 	_, txStatus, err := s.vm.state.GetTx(args.TxID)
 	if err == nil { // Found the status. Report it.
 		response.Status = txStatus
-		return nil
+		return s.getTxBlock(args.TxID, response)
 	}
 	if err != database.ErrNotFound {
 		return err
@@ -2219,7 +3920,7 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 		return err
 	}
 
-	if s.vm.Builder.Has(args.TxID) {
+	if response.InMempool {
 		// Found the tx in the mempool. Report tx is processing.
 		response.Status = status.Processing
 		return nil
@@ -2237,6 +3938,59 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 	// The tx was recently dropped because it was invalid.
 	response.Status = status.Dropped
 	response.Reason = reason.Error()
+	response.ReasonCode = s.vm.Builder.GetDropReasonCode(args.TxID)
+	return nil
+}
+
+type GetPendingTxsArgs struct {
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// PendingTx is a tx in the mempool that hasn't been included in a block yet
+type PendingTx struct {
+	TxID ids.ID `json:"txID"`
+	Tx   string `json:"tx"`
+	// Size of the tx in bytes
+	Size json.Uint64 `json:"size"`
+	// Number of seconds since the tx was added to the mempool
+	Age json.Uint64 `json:"age"`
+}
+
+type GetPendingTxsReply struct {
+	Txs      []PendingTx         `json:"txs"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetPendingTxs returns the txs currently in the mempool. Decision txs are
+// listed before staker txs.
+func (s *Service) GetPendingTxs(_ *http.Request, args *GetPendingTxsArgs, reply *GetPendingTxsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getPendingTxs"),
+	)
+
+	pendingTxs := s.vm.Builder.List()
+	reply.Txs = make([]PendingTx, len(pendingTxs))
+	reply.Encoding = args.Encoding
+	for i, tx := range pendingTxs {
+		txID := tx.ID()
+		txBytes := tx.Bytes()
+		txStr, err := formatting.Encode(args.Encoding, txBytes)
+		if err != nil {
+			return fmt.Errorf("couldn't encode tx as %s: %w", args.Encoding, err)
+		}
+
+		var age time.Duration
+		if addTime, ok := s.vm.Builder.GetAddTime(txID); ok {
+			age = time.Since(addTime)
+		}
+		reply.Txs[i] = PendingTx{
+			TxID: txID,
+			Tx:   txStr,
+			Size: json.Uint64(len(txBytes)),
+			Age:  json.Uint64(age / time.Second),
+		}
+	}
 	return nil
 }
 
@@ -2263,8 +4017,6 @@ type GetStakeReply struct {
 // This method assumes that each stake output has only owner
 // This method assumes only DIONE can be staked
 // This method only concerns itself with the Primary Network, not subnets
-// TODO: Improve the performance of this method by maintaining this data
-// in a data structure rather than re-calculating it by iterating over stakers
 func (s *Service) GetStake(_ *http.Request, args *GetStakeArgs, response *GetStakeReply) error {
 	s.vm.ctx.Log.Debug("deprecated API called",
 		zap.String("service", "omega"),
@@ -2280,47 +4032,34 @@ func (s *Service) GetStake(_ *http.Request, args *GetStakeArgs, response *GetSta
 		return err
 	}
 
-	currentStakerIterator, err := s.vm.state.GetCurrentStakerIterator()
-	if err != nil {
-		return err
+	// Sort the stakers so that the outputs are returned in a deterministic
+	// order.
+	txIDs := set.Set[ids.ID]{}
+	for addr := range addrs {
+		addrTxIDs, err := s.vm.state.GetStakerTxIDs(addr)
+		if err != nil {
+			return err
+		}
+		txIDs.Add(addrTxIDs...)
 	}
-	defer currentStakerIterator.Release()
+	sortedTxIDs := txIDs.List()
+	utils.Sort(sortedTxIDs)
 
 	var (
 		totalAmountStaked = make(map[ids.ID]uint64)
 		stakedOuts        []dione.TransferableOutput
 	)
-	for currentStakerIterator.Next() { // Iterates over current stakers
-		staker := currentStakerIterator.Value()
-
-		if args.ValidatorsOnly && !staker.Priority.IsValidator() {
-			continue
-		}
-
-		tx, _, err := s.vm.state.GetTx(staker.TxID)
+	for _, txID := range sortedTxIDs {
+		tx, _, err := s.vm.state.GetTx(txID)
 		if err != nil {
 			return err
 		}
 
-		stakedOuts = append(stakedOuts, getStakeHelper(tx, addrs, totalAmountStaked)...)
-	}
-
-	pendingStakerIterator, err := s.vm.state.GetPendingStakerIterator()
-	if err != nil {
-		return err
-	}
-	defer pendingStakerIterator.Release()
-
-	for pendingStakerIterator.Next() { // Iterates over pending stakers
-		staker := pendingStakerIterator.Value()
-
-		if args.ValidatorsOnly && !staker.Priority.IsValidator() {
-			continue
-		}
-
-		tx, _, err := s.vm.state.GetTx(staker.TxID)
-		if err != nil {
-			return err
+		if args.ValidatorsOnly {
+			staker, ok := tx.Unsigned.(txs.Staker)
+			if ok && !staker.CurrentPriority().IsValidator() {
+				continue
+			}
 		}
 
 		stakedOuts = append(stakedOuts, getStakeHelper(tx, addrs, totalAmountStaked)...)
@@ -2355,6 +4094,9 @@ type GetMinStakeReply struct {
 	MinValidatorStake json.Uint64 `json:"minValidatorStake"`
 	// Minimum stake, in nDIONE, that can be delegated on the primary network
 	MinDelegatorStake json.Uint64 `json:"minDelegatorStake"`
+	// The maximum weight of a validator, including delegations, as a multiple
+	// of the validator's own stake
+	MaxValidatorWeightFactor json.Uint8 `json:"maxValidatorWeightFactor"`
 }
 
 // GetMinStake returns the minimum staking amount in nDIONE.
@@ -2367,6 +4109,7 @@ func (s *Service) GetMinStake(_ *http.Request, args *GetMinStakeArgs, reply *Get
 	if args.SubnetID == constants.PrimaryNetworkID {
 		reply.MinValidatorStake = json.Uint64(s.vm.MinValidatorStake)
 		reply.MinDelegatorStake = json.Uint64(s.vm.MinDelegatorStake)
+		reply.MaxValidatorWeightFactor = executor.MaxValidatorWeightFactor
 		return nil
 	}
 
@@ -2388,7 +4131,63 @@ func (s *Service) GetMinStake(_ *http.Request, args *GetMinStakeArgs, reply *Get
 
 	reply.MinValidatorStake = json.Uint64(transformSubnet.MinValidatorStake)
 	reply.MinDelegatorStake = json.Uint64(transformSubnet.MinDelegatorStake)
+	reply.MaxValidatorWeightFactor = json.Uint8(transformSubnet.MaxValidatorWeightFactor)
+
+	return nil
+}
+
+// GetTxConfigReply is the response from GetTxConfig
+type GetTxConfigReply struct {
+	TxFee                         json.Uint64  `json:"txFee"`
+	CreateSubnetTxFee             json.Uint64  `json:"createSubnetTxFee"`
+	TransformSubnetTxFee          json.Uint64  `json:"transformSubnetTxFee"`
+	CreateBlockchainTxFee         json.Uint64  `json:"createBlockchainTxFee"`
+	AddPrimaryNetworkValidatorFee json.Uint64  `json:"addPrimaryNetworkValidatorFee"`
+	AddPrimaryNetworkDelegatorFee json.Uint64  `json:"addPrimaryNetworkDelegatorFee"`
+	AddSubnetValidatorFee         json.Uint64  `json:"addSubnetValidatorFee"`
+	AddSubnetDelegatorFee         json.Uint64  `json:"addSubnetDelegatorFee"`
+	MinValidatorStake             json.Uint64  `json:"minValidatorStake"`
+	MaxValidatorStake             json.Uint64  `json:"maxValidatorStake"`
+	MinDelegatorStake             json.Uint64  `json:"minDelegatorStake"`
+	MinDelegationFee              json.Uint32  `json:"minDelegationFee"`
+	MaxValidatorWeightFactor      json.Uint8   `json:"maxValidatorWeightFactor"`
+	MinValidatorStakeDuration     json.Uint64  `json:"minValidatorStakeDuration"`
+	MaxValidatorStakeDuration     json.Uint64  `json:"maxValidatorStakeDuration"`
+	MinDelegatorStakeDuration     json.Uint64  `json:"minDelegatorStakeDuration"`
+	MaxDelegatorStakeDuration     json.Uint64  `json:"maxDelegatorStakeDuration"`
+	UptimeRequirement             json.Float64 `json:"uptimeRequirement"`
+	MaxSupply                     json.Uint64  `json:"maxSupply"`
+}
+
+// GetTxConfig returns the economic parameters of the O-Chain at the current
+// chain time. Fees and amounts are denominated in nDIONE and durations in
+// seconds.
+func (s *Service) GetTxConfig(_ *http.Request, _ *struct{}, reply *GetTxConfigReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getTxConfig"),
+	)
 
+	timestamp := s.vm.state.GetTimestamp()
+	reply.TxFee = json.Uint64(s.vm.TxFee)
+	reply.CreateSubnetTxFee = json.Uint64(s.vm.GetCreateSubnetTxFee(timestamp))
+	reply.TransformSubnetTxFee = json.Uint64(s.vm.TransformSubnetTxFee)
+	reply.CreateBlockchainTxFee = json.Uint64(s.vm.GetCreateBlockchainTxFee(timestamp))
+	reply.AddPrimaryNetworkValidatorFee = json.Uint64(s.vm.AddPrimaryNetworkValidatorFee)
+	reply.AddPrimaryNetworkDelegatorFee = json.Uint64(s.vm.AddPrimaryNetworkDelegatorFee)
+	reply.AddSubnetValidatorFee = json.Uint64(s.vm.AddSubnetValidatorFee)
+	reply.AddSubnetDelegatorFee = json.Uint64(s.vm.AddSubnetDelegatorFee)
+	reply.MinValidatorStake = json.Uint64(s.vm.MinValidatorStake)
+	reply.MaxValidatorStake = json.Uint64(s.vm.MaxValidatorStake)
+	reply.MinDelegatorStake = json.Uint64(s.vm.MinDelegatorStake)
+	reply.MinDelegationFee = json.Uint32(s.vm.MinDelegationFee)
+	reply.MaxValidatorWeightFactor = executor.MaxValidatorWeightFactor
+	reply.MinValidatorStakeDuration = json.Uint64(s.vm.MinValidatorStakeDuration / time.Second)
+	reply.MaxValidatorStakeDuration = json.Uint64(s.vm.MaxValidatorStakeDuration / time.Second)
+	reply.MinDelegatorStakeDuration = json.Uint64(s.vm.MinDelegatorStakeDuration / time.Second)
+	reply.MaxDelegatorStakeDuration = json.Uint64(s.vm.MaxDelegatorStakeDuration / time.Second)
+	reply.UptimeRequirement = json.Float64(s.vm.UptimePercentage)
+	reply.MaxSupply = json.Uint64(s.vm.RewardConfig.SupplyCap)
 	return nil
 }
 
@@ -2534,6 +4333,71 @@ func (s *Service) GetTimestamp(_ *http.Request, _ *struct{}, reply *GetTimestamp
 	return nil
 }
 
+// AnnounceMaintenanceArgs are the arguments for AnnounceMaintenance
+type AnnounceMaintenanceArgs struct {
+	// Unix time at which the maintenance window starts
+	StartTime json.Uint64 `json:"startTime"`
+	// Unix time at which the maintenance window ends
+	EndTime json.Uint64 `json:"endTime"`
+}
+
+// AnnounceMaintenance signs and gossips an announcement that this node will be
+// offline during the provided window. Time spent offline inside an announced
+// window is excluded from the maintenance adjusted uptime reported by peers.
+func (s *Service) AnnounceMaintenance(_ *http.Request, args *AnnounceMaintenanceArgs, _ *api.EmptyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "announceMaintenance"),
+		zap.Uint64("startTime", uint64(args.StartTime)),
+		zap.Uint64("endTime", uint64(args.EndTime)),
+	)
+
+	return s.vm.Builder.AnnounceMaintenance(uptime.MaintenanceWindow{
+		Start: time.Unix(int64(args.StartTime), 0),
+		End:   time.Unix(int64(args.EndTime), 0),
+	})
+}
+
+// GetMaintenanceWindowsArgs are the arguments for GetMaintenanceWindows
+type GetMaintenanceWindowsArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+}
+
+// APIMaintenanceWindow is the representation of a maintenance window sent over
+// APIs.
+type APIMaintenanceWindow struct {
+	StartTime json.Uint64 `json:"startTime"`
+	EndTime   json.Uint64 `json:"endTime"`
+}
+
+// GetMaintenanceWindowsReply is the response from GetMaintenanceWindows
+type GetMaintenanceWindowsReply struct {
+	Windows []APIMaintenanceWindow `json:"windows"`
+}
+
+// GetMaintenanceWindows returns the maintenance windows this node has recorded
+// for the provided validator.
+func (s *Service) GetMaintenanceWindows(_ *http.Request, args *GetMaintenanceWindowsArgs, reply *GetMaintenanceWindowsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getMaintenanceWindows"),
+		zap.Stringer("nodeID", args.NodeID),
+	)
+
+	windows, err := s.vm.uptimeManager.GetMaintenanceWindows(args.NodeID)
+	if err != nil {
+		return fmt.Errorf("couldn't get maintenance windows: %w", err)
+	}
+	reply.Windows = make([]APIMaintenanceWindow, len(windows))
+	for i, window := range windows {
+		reply.Windows[i] = APIMaintenanceWindow{
+			StartTime: json.Uint64(window.Start.Unix()),
+			EndTime:   json.Uint64(window.End.Unix()),
+		}
+	}
+	return nil
+}
+
 // GetValidatorsAtArgs is the response from GetValidatorsAt
 type GetValidatorsAtArgs struct {
 	Height   json.Uint64 `json:"height"`
@@ -2624,6 +4488,157 @@ func (s *Service) GetValidatorsAt(r *http.Request, args *GetValidatorsAtArgs, re
 	return nil
 }
 
+// GetValidatorSetDiffsArgs are the arguments for GetValidatorSetDiffs
+type GetValidatorSetDiffsArgs struct {
+	StartHeight json.Uint64 `json:"startHeight"`
+	EndHeight   json.Uint64 `json:"endHeight"`
+	SubnetID    ids.ID      `json:"subnetID"`
+}
+
+// ValidatorSetDiff contains the validators whose weight or public key changed
+// at [Height]. A validator that was removed at [Height] is reported with a
+// weight of 0.
+type ValidatorSetDiff struct {
+	Height     json.Uint64          `json:"height"`
+	Validators GetValidatorsAtReply `json:"validators"`
+}
+
+// GetValidatorSetDiffsReply is the response from GetValidatorSetDiffs
+type GetValidatorSetDiffsReply struct {
+	// Validators is the validator set at the start height
+	Validators GetValidatorsAtReply `json:"validators"`
+	// Diffs[i] is the diff applied at height StartHeight+i+1
+	Diffs []ValidatorSetDiff `json:"diffs"`
+}
+
+// GetValidatorSetDiffs returns the validator set of a provided subnet at
+// [args.StartHeight] along with the changes to that set at every height up to
+// and including [args.EndHeight]. Applying the diffs in order reconstructs the
+// validator set at every height in the range.
+func (s *Service) GetValidatorSetDiffs(r *http.Request, args *GetValidatorSetDiffsArgs, reply *GetValidatorSetDiffsReply) error {
+	startHeight := uint64(args.StartHeight)
+	endHeight := uint64(args.EndHeight)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getValidatorSetDiffs"),
+		zap.Uint64("startHeight", startHeight),
+		zap.Uint64("endHeight", endHeight),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	if startHeight > endHeight {
+		return errStartAfterEndHeight
+	}
+	if endHeight-startHeight > maxGetValidatorSetDiffsRange {
+		return fmt.Errorf("height range %d exceeds the maximum of %d", endHeight-startHeight, maxGetValidatorSetDiffsRange)
+	}
+
+	// The validator sets are rebuilt from [endHeight] towards [startHeight],
+	// one height at a time. Subnet validators take their public keys from the
+	// primary network, so the primary network set is always tracked.
+	ctx := r.Context()
+	primaryVdrs, err := s.vm.GetValidatorSet(ctx, endHeight, constants.PrimaryNetworkID)
+	if err != nil {
+		return fmt.Errorf("failed to get validator set: %w", err)
+	}
+	primaryVdrs = copyValidatorSet(primaryVdrs)
+
+	isPrimaryNetwork := args.SubnetID == constants.PrimaryNetworkID
+	vdrs := primaryVdrs
+	if !isPrimaryNetwork {
+		vdrs, err = s.vm.GetValidatorSet(ctx, endHeight, args.SubnetID)
+		if err != nil {
+			return fmt.Errorf("failed to get validator set: %w", err)
+		}
+		vdrs = copyValidatorSet(vdrs)
+	}
+
+	reply.Diffs = make([]ValidatorSetDiff, endHeight-startHeight)
+	for height := endHeight; height > startHeight; height-- {
+		prevPrimaryVdrs := copyValidatorSet(primaryVdrs)
+		err := s.vm.state.ApplyValidatorWeightDiffs(ctx, prevPrimaryVdrs, height, height, constants.PrimaryNetworkID)
+		if err != nil {
+			return fmt.Errorf("failed to apply validator weight diffs at height %d: %w", height, err)
+		}
+		err = s.vm.state.ApplyValidatorPublicKeyDiffs(ctx, prevPrimaryVdrs, height, height)
+		if err != nil {
+			return fmt.Errorf("failed to apply validator public key diffs at height %d: %w", height, err)
+		}
+
+		prevVdrs := prevPrimaryVdrs
+		if !isPrimaryNetwork {
+			prevVdrs = copyValidatorSet(vdrs)
+			err := s.vm.state.ApplyValidatorWeightDiffs(ctx, prevVdrs, height, height, args.SubnetID)
+			if err != nil {
+				return fmt.Errorf("failed to apply validator weight diffs at height %d: %w", height, err)
+			}
+			for nodeID, vdr := range prevVdrs {
+				if primaryVdr, ok := prevPrimaryVdrs[nodeID]; ok {
+					vdr.PublicKey = primaryVdr.PublicKey
+				} else {
+					vdr.PublicKey = nil
+				}
+			}
+		}
+
+		reply.Diffs[height-startHeight-1] = ValidatorSetDiff{
+			Height: json.Uint64(height),
+			Validators: GetValidatorsAtReply{
+				Validators: diffValidatorSets(prevVdrs, vdrs),
+			},
+		}
+		primaryVdrs = prevPrimaryVdrs
+		vdrs = prevVdrs
+	}
+	reply.Validators.Validators = vdrs
+	return nil
+}
+
+// copyValidatorSet returns a deep copy of [vdrs] so that it can be modified
+// without affecting cached validator sets.
+func copyValidatorSet(vdrs map[ids.NodeID]*validators.GetValidatorOutput) map[ids.NodeID]*validators.GetValidatorOutput {
+	vdrsCopy := make(map[ids.NodeID]*validators.GetValidatorOutput, len(vdrs))
+	for nodeID, vdr := range vdrs {
+		vdrCopy := *vdr
+		vdrsCopy[nodeID] = &vdrCopy
+	}
+	return vdrsCopy
+}
+
+// diffValidatorSets returns the validators in [after] whose weight or public
+// key differs from [before]. Validators that are only in [before] are returned
+// with a weight of 0.
+func diffValidatorSets(before, after map[ids.NodeID]*validators.GetValidatorOutput) map[ids.NodeID]*validators.GetValidatorOutput {
+	diff := make(map[ids.NodeID]*validators.GetValidatorOutput)
+	for nodeID, vdr := range after {
+		prevVdr, ok := before[nodeID]
+		if ok && prevVdr.Weight == vdr.Weight && publicKeysEqual(prevVdr.PublicKey, vdr.PublicKey) {
+			continue
+		}
+		vdrCopy := *vdr
+		diff[nodeID] = &vdrCopy
+	}
+	for nodeID := range before {
+		if _, ok := after[nodeID]; !ok {
+			diff[nodeID] = &validators.GetValidatorOutput{
+				NodeID: nodeID,
+			}
+		}
+	}
+	return diff
+}
+
+func publicKeysEqual(a, b *bls.PublicKey) bool {
+	switch {
+	case a == b:
+		return true
+	case a == nil || b == nil:
+		return false
+	default:
+		return bytes.Equal(bls.PublicKeyToBytes(a), bls.PublicKeyToBytes(b))
+	}
+}
+
 func (s *Service) GetBlock(_ *http.Request, args *api.GetBlockArgs, response *api.GetBlockResponse) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "omega"),
@@ -2636,12 +4651,16 @@ func (s *Service) GetBlock(_ *http.Request, args *api.GetBlockArgs, response *ap
 	if err != nil {
 		return fmt.Errorf("couldn't get block with id %s: %w", args.BlockID, err)
 	}
+	response.AcceptedAt, err = s.getAcceptedAt(args.BlockID)
+	if err != nil {
+		return err
+	}
 	response.Encoding = args.Encoding
 
 	if args.Encoding == formatting.JSON {
 		block.InitCtx(s.vm.ctx)
 		response.Block = block
-		return nil
+		return s.setBlockProposer(context.TODO(), args.BlockID, block.Height(), response)
 	}
 
 	response.Block, err = formatting.Encode(args.Encoding, block.Bytes())
@@ -2661,9 +4680,71 @@ func (s *Service) GetBlockByHeight(_ *http.Request, args *api.GetBlockByHeightAr
 		zap.Stringer("encoding", args.Encoding),
 	)
 
-	blockID, err := s.vm.state.GetBlockIDAtHeight(uint64(args.Height))
+	return s.getBlockByHeight(context.TODO(), uint64(args.Height), args.Encoding, response)
+}
+
+// GetBlockRangeArgs are the arguments for GetBlockRange
+type GetBlockRangeArgs struct {
+	// Height of the first block to return
+	StartHeight json.Uint64 `json:"startHeight"`
+	// Maximum number of blocks to return
+	NumBlocks json.Uint64         `json:"numBlocks"`
+	Encoding  formatting.Encoding `json:"encoding"`
+}
+
+// GetBlockRangeReply is the response from GetBlockRange
+type GetBlockRangeReply struct {
+	// Blocks[i] is the block at height StartHeight+i
+	Blocks []api.GetBlockResponse `json:"blocks"`
+}
+
+// GetBlockRange returns up to [args.NumBlocks] consecutive accepted blocks,
+// starting at [args.StartHeight]. Fewer blocks are returned if the range
+// extends past the last accepted block. This allows the chain to be walked by
+// height without looking up the ID of every block.
+func (s *Service) GetBlockRange(r *http.Request, args *GetBlockRangeArgs, reply *GetBlockRangeReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "omega"),
+		zap.String("method", "getBlockRange"),
+		zap.Uint64("startHeight", uint64(args.StartHeight)),
+		zap.Uint64("numBlocks", uint64(args.NumBlocks)),
+		zap.Stringer("encoding", args.Encoding),
+	)
+
+	if args.NumBlocks > maxGetBlockRange {
+		return fmt.Errorf("numBlocks %d exceeds the maximum of %d", args.NumBlocks, maxGetBlockRange)
+	}
+
+	lastAcceptedHeight, err := s.vm.GetCurrentHeight(r.Context())
+	if err != nil {
+		return err
+	}
+
+	startHeight := uint64(args.StartHeight)
+	if startHeight > lastAcceptedHeight {
+		reply.Blocks = []api.GetBlockResponse{}
+		return nil
+	}
+	numBlocks := uint64(args.NumBlocks)
+	if remaining := lastAcceptedHeight - startHeight + 1; numBlocks > remaining {
+		numBlocks = remaining
+	}
+
+	reply.Blocks = make([]api.GetBlockResponse, numBlocks)
+	for i := range reply.Blocks {
+		height := startHeight + uint64(i)
+		if err := s.getBlockByHeight(r.Context(), height, args.Encoding, &reply.Blocks[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getBlockByHeight populates [response] with the accepted block at [height]
+func (s *Service) getBlockByHeight(ctx context.Context, height uint64, encoding formatting.Encoding, response *api.GetBlockResponse) error {
+	blockID, err := s.vm.state.GetBlockIDAtHeight(height)
 	if err != nil {
-		return fmt.Errorf("couldn't get block at height %d: %w", args.Height, err)
+		return fmt.Errorf("couldn't get block at height %d: %w", height, err)
 	}
 
 	block, err := s.vm.manager.GetStatelessBlock(blockID)
@@ -2674,19 +4755,65 @@ func (s *Service) GetBlockByHeight(_ *http.Request, args *api.GetBlockByHeightAr
 		)
 		return fmt.Errorf("couldn't get block with id %s: %w", blockID, err)
 	}
-	response.Encoding = args.Encoding
+	response.AcceptedAt, err = s.getAcceptedAt(blockID)
+	if err != nil {
+		return err
+	}
+	response.Encoding = encoding
 
-	if args.Encoding == formatting.JSON {
+	if encoding == formatting.JSON {
 		block.InitCtx(s.vm.ctx)
 		response.Block = block
-		return nil
+		return s.setBlockProposer(ctx, blockID, height, response)
 	}
 
-	response.Block, err = formatting.Encode(args.Encoding, block.Bytes())
+	response.Block, err = formatting.Encode(encoding, block.Bytes())
 	if err != nil {
-		return fmt.Errorf("couldn't encode block %s as %s: %w", blockID, args.Encoding, err)
+		return fmt.Errorf("couldn't encode block %s as %s: %w", blockID, encoding, err)
+	}
+
+	return nil
+}
+
+// getAcceptedAt returns the local time that [blkID] was accepted at, or nil if
+// it wasn't recorded.
+func (s *Service) getAcceptedAt(blkID ids.ID) (*time.Time, error) {
+	acceptedAt, err := s.vm.acceptTimes.AcceptedAt(blkID)
+	switch err {
+	case nil:
+		return &acceptedAt, nil
+	case database.ErrNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("couldn't get acceptance time of block %s: %w", blkID, err)
+	}
+}
+
+// setBlockProposer populates the proposer and the timestamp of [response] from
+// the proposervm block that wrapped [blkID], if [blkID] was accepted and its
+// wrapping block can be resolved.
+func (s *Service) setBlockProposer(ctx context.Context, blkID ids.ID, height uint64, response *api.GetBlockResponse) error {
+	if s.vm.blockProposers == nil {
+		return nil
+	}
+	acceptedBlkID, err := s.vm.state.GetBlockIDAtHeight(height)
+	if err != nil || acceptedBlkID != blkID {
+		// Only accepted blocks are indexed by the proposervm.
+		return nil
+	}
+
+	proposer, err := s.vm.blockProposers.GetBlockProposer(ctx, blkID, height)
+	switch {
+	case err == database.ErrNotFound, errors.Is(err, block.ErrIndexIncomplete):
+		return nil
+	case err != nil:
+		return fmt.Errorf("couldn't get proposer of block %s: %w", blkID, err)
 	}
 
+	if proposer.NodeID != ids.EmptyNodeID {
+		response.ProposerNodeID = &proposer.NodeID
+	}
+	response.Timestamp = &proposer.Timestamp
 	return nil
 }
 
@@ -2706,6 +4833,20 @@ func (s *Service) getAPIUptime(staker *state.Staker) (*json.Float32, error) {
 	return &uptime, nil
 }
 
+func (s *Service) getAPIMaintenanceAdjustedUptime(staker *state.Staker) (*json.Float32, error) {
+	// Only report uptimes that we have been actively tracking.
+	if constants.PrimaryNetworkID != staker.SubnetID && !s.vm.TrackedSubnets.Contains(staker.SubnetID) {
+		return nil, nil
+	}
+
+	rawUptime, err := s.vm.uptimeManager.CalculateUptimePercentExcludingMaintenance(staker.NodeID, staker.SubnetID, staker.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	uptime := json.Float32(rawUptime * 100)
+	return &uptime, nil
+}
+
 func (s *Service) getAPIOwner(owner *secp256k1fx.OutputOwners) (*omegaapi.Owner, error) {
 	apiOwner := &omegaapi.Owner{
 		Locktime:  json.Uint64(owner.Locktime),
@@ -2722,6 +4863,29 @@ func (s *Service) getAPIOwner(owner *secp256k1fx.OutputOwners) (*omegaapi.Owner,
 	return apiOwner, nil
 }
 
+// getAPIRewardOwner returns the API representation of a staker's rewards
+// owner, or nil if the owner isn't an [secp256k1fx.OutputOwners].
+func (s *Service) getAPIRewardOwner(owner fx.Owner) (*omegaapi.Owner, error) {
+	lockOwner, locked := owner.(*stakeable.LockOwner)
+	if locked {
+		owner = lockOwner.Owner
+	}
+	outputOwners, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil, nil
+	}
+
+	apiOwner, err := s.getAPIOwner(outputOwners)
+	if err != nil {
+		return nil, err
+	}
+	if locked {
+		restakeLocktime := json.Uint64(lockOwner.Locktime)
+		apiOwner.RestakeLocktime = &restakeLocktime
+	}
+	return apiOwner, nil
+}
+
 // Takes in a staker and a set of addresses
 // Returns:
 // 1) The total amount staked by addresses in [addrs]
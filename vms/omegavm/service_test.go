@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"net/http"
 	"testing"
 	"time"
 
@@ -28,6 +29,8 @@ import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
+	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/snow/engine/snowman/block"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
@@ -36,8 +39,11 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/json"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/version"
+	"github.com/DioneProtocol/odysseygo/vms"
+	"github.com/DioneProtocol/odysseygo/vms/components/acceptance"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
@@ -115,6 +121,45 @@ func TestAddValidator(t *testing.T) {
 	require.Equal(expectedJSONString, string(bytes))
 }
 
+func TestAddPermissionlessStakerInvalidSubnet(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	defaultAddress(t, service)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	subnetID := ids.GenerateTestID()
+	spendHeader := api.JSONSpendHeader{
+		UserPass: api.UserPass{
+			Username: testUsername,
+			Password: testPassword,
+		},
+	}
+
+	err := service.AddPermissionlessValidator(nil, &AddPermissionlessValidatorArgs{
+		JSONSpendHeader: spendHeader,
+		SubnetID:        subnetID.String(),
+	}, &api.JSONTxIDChangeAddr{})
+	require.ErrorIs(err, errNoRewardAddress)
+
+	err = service.AddPermissionlessValidator(nil, &AddPermissionlessValidatorArgs{
+		JSONSpendHeader: spendHeader,
+		SubnetID:        subnetID.String(),
+		RewardAddress:   testAddress,
+	}, &api.JSONTxIDChangeAddr{})
+	require.ErrorIs(err, errSubnetNotPermissionless)
+
+	err = service.AddPermissionlessDelegator(nil, &AddPermissionlessDelegatorArgs{
+		JSONSpendHeader: spendHeader,
+		SubnetID:        subnetID.String(),
+		RewardAddress:   testAddress,
+	}, &api.JSONTxIDChangeAddr{})
+	require.ErrorIs(err, errSubnetNotPermissionless)
+}
+
 func TestCreateBlockchainArgsParsing(t *testing.T) {
 	require := require.New(t)
 
@@ -126,6 +171,121 @@ func TestCreateBlockchainArgsParsing(t *testing.T) {
 	require.NoError(err)
 }
 
+type testVMFactory struct {
+	vm interface{}
+}
+
+func (f testVMFactory) New(logging.Logger) (interface{}, error) {
+	return f.vm, nil
+}
+
+func TestValidateCreateBlockchain(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	service, _ := defaultService(t)
+	defaultAddress(t, service)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	expectedFee := json.Uint64(service.vm.GetCreateBlockchainTxFee(service.vm.state.GetTimestamp()))
+
+	reply := ValidateCreateBlockchainReply{}
+	require.NoError(service.ValidateCreateBlockchain(nil, &CreateBlockchainArgs{
+		SubnetID:    ids.GenerateTestID(),
+		VMID:        "not an ID",
+		Name:        "⌘",
+		GenesisData: "not hex",
+		Encoding:    formatting.Hex,
+	}, &reply))
+	require.Equal(expectedFee, reply.Fee)
+	fields := make([]string, len(reply.Errors))
+	for i, err := range reply.Errors {
+		fields[i] = err.Field
+	}
+	require.Equal([]string{"name", "genesisData", "vmID", "subnetID"}, fields)
+
+	// The VM only initializes from its own genesis
+	errInvalidGenesis := errors.New("invalid genesis")
+	chainVM := &block.TestVM{
+		TestVM: common.TestVM{
+			T: t,
+			InitializeF: func(
+				_ context.Context,
+				_ *snow.Context,
+				_ manager.Manager,
+				genesisBytes []byte,
+				_ []byte,
+				_ []byte,
+				_ chan<- common.Message,
+				_ []*common.Fx,
+				_ common.AppSender,
+			) error {
+				if string(genesisBytes) != "genesis" {
+					return errInvalidGenesis
+				}
+				return nil
+			},
+			ShutdownF: func(context.Context) error {
+				return nil
+			},
+		},
+	}
+	vmID := ids.GenerateTestID()
+	vmManager := vms.NewMockManager(ctrl)
+	vmManager.EXPECT().GetFactory(vmID).Return(testVMFactory{vm: chainVM}, nil).AnyTimes()
+	service.vm.VMManager = vmManager
+
+	invalidGenesisData, err := formatting.Encode(formatting.Hex, []byte("not the genesis"))
+	require.NoError(err)
+	args := &CreateBlockchainArgs{
+		SubnetID:    testSubnet1.ID(),
+		VMID:        vmID.String(),
+		Name:        "chain",
+		GenesisData: invalidGenesisData,
+		Encoding:    formatting.Hex,
+	}
+	reply = ValidateCreateBlockchainReply{}
+	require.NoError(service.ValidateCreateBlockchain(nil, args, &reply))
+	require.Len(reply.Errors, 1)
+	require.Equal("genesisData", reply.Errors[0].Field)
+	require.Contains(reply.Errors[0].Message, errInvalidGenesis.Error())
+
+	genesisData, err := formatting.Encode(formatting.Hex, []byte("genesis"))
+	require.NoError(err)
+	args.GenesisData = genesisData
+	reply = ValidateCreateBlockchainReply{}
+	require.NoError(service.ValidateCreateBlockchain(nil, args, &reply))
+	require.Equal(expectedFee, reply.Fee)
+	require.Empty(reply.Errors)
+
+	// The user only controls one of the two control keys needed to authorize
+	// the creation
+	args.UserPass = api.UserPass{
+		Username: testUsername,
+		Password: testPassword,
+	}
+	reply = ValidateCreateBlockchainReply{}
+	require.NoError(service.ValidateCreateBlockchain(nil, args, &reply))
+	require.Len(reply.Errors, 1)
+	require.Equal("tx", reply.Errors[0].Field)
+
+	user, err := vmkeystore.NewUserFromKeystore(service.vm.ctx.Keystore, testUsername, testPassword)
+	require.NoError(err)
+	require.NoError(user.PutKeys(keys[1]))
+	require.NoError(user.Close())
+
+	reply = ValidateCreateBlockchainReply{}
+	require.NoError(service.ValidateCreateBlockchain(nil, args, &reply))
+	require.Empty(reply.Errors)
+
+	// Validating the creation shouldn't issue a tx
+	require.False(service.vm.Builder.HasTxs())
+}
+
 func TestExportKey(t *testing.T) {
 	require := require.New(t)
 	jsonString := `{"username":"ScoobyUser","password":"ShaggyPassword1Zoinks!","address":"` + testAddress + `"}`
@@ -233,6 +393,7 @@ func TestGetTxStatus(t *testing.T) {
 	require.NoError(service.GetTxStatus(nil, arg, &resp))
 	require.Equal(status.Unknown, resp.Status)
 	require.Zero(resp.Reason)
+	require.False(resp.InMempool)
 
 	// put the chain in existing chain list
 	err = service.vm.Builder.AddUnverifiedTx(tx)
@@ -242,6 +403,11 @@ func TestGetTxStatus(t *testing.T) {
 
 	require.NoError(service.vm.Builder.AddUnverifiedTx(tx))
 
+	resp = GetTxStatusResponse{} // reset
+	require.NoError(service.GetTxStatus(nil, arg, &resp))
+	require.Equal(status.Processing, resp.Status)
+	require.True(resp.InMempool)
+
 	block, err := service.vm.BuildBlock(context.Background())
 	require.NoError(err)
 
@@ -254,6 +420,176 @@ func TestGetTxStatus(t *testing.T) {
 	require.NoError(service.GetTxStatus(nil, arg, &resp))
 	require.Equal(status.Committed, resp.Status)
 	require.Zero(resp.Reason)
+	require.False(resp.InMempool)
+
+	blkID := blk.ID()
+	height := json.Uint64(blk.Height())
+	require.Equal(&blkID, resp.BlockID)
+	require.Equal(&height, resp.Height)
+}
+
+func TestInspectAtomicUTXOs(t *testing.T) {
+	require := require.New(t)
+	service, mutableSharedMemory := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	factory := secp256k1.Factory{}
+	recipientKey, err := factory.NewPrivateKey()
+	require.NoError(err)
+	recipientAddr := recipientKey.PublicKey().Address()
+
+	m := atomic.NewMemory(prefixdb.New([]byte{}, service.vm.dbManager.Current().Database))
+	mutableSharedMemory.SharedMemory = m.NewSharedMemory(service.vm.ctx.ChainID)
+	peerSharedMemory := m.NewSharedMemory(aChainID)
+
+	utxo := &dione.UTXO{
+		UTXOID: dione.UTXOID{
+			TxID: ids.GenerateTestID(),
+		},
+		Asset: dione.Asset{ID: dioneAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1234567,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Addrs:     []ids.ShortID{recipientAddr},
+				Threshold: 1,
+			},
+		},
+	}
+	utxoBytes, err := txs.Codec.Marshal(txs.Version, utxo)
+	require.NoError(err)
+
+	inputID := utxo.InputID()
+	exportRequests := map[ids.ID]*atomic.Requests{
+		service.vm.ctx.ChainID: {
+			PutRequests: []*atomic.Element{
+				{
+					Key:   inputID[:],
+					Value: utxoBytes,
+					Traits: [][]byte{
+						recipientAddr.Bytes(),
+					},
+				},
+			},
+		},
+	}
+	require.NoError(peerSharedMemory.Apply(exportRequests))
+
+	addr, err := service.addrManager.FormatLocalAddress(recipientAddr)
+	require.NoError(err)
+	args := &api.GetUTXOsArgs{
+		Addresses:   []string{addr},
+		SourceChain: "A",
+		Encoding:    formatting.Hex,
+	}
+
+	reply := InspectAtomicUTXOsReply{}
+	require.NoError(service.InspectAtomicUTXOs(nil, args, &reply))
+	require.Equal(json.Uint64(1), reply.NumFetched)
+	require.Zero(reply.NumOrphaned)
+	require.Nil(reply.UTXOs[0].ConsumedBy)
+
+	// A UTXO that wasn't imported isn't removed
+	service.vm.ctx.Lock.Unlock()
+	removed, err := service.vm.RepairAtomicUTXOs(aChainID, []ids.ID{inputID})
+	service.vm.ctx.Lock.Lock()
+	require.NoError(err)
+	require.Empty(removed)
+
+	tx, err := service.vm.txBuilder.NewImportTx(aChainID, ids.ShortEmpty, []*secp256k1.PrivateKey{recipientKey}, ids.ShortEmpty)
+	require.NoError(err)
+	require.NoError(service.vm.Builder.AddUnverifiedTx(tx))
+
+	block, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(block.Verify(context.Background()))
+	require.NoError(block.Accept(context.Background()))
+
+	reply = InspectAtomicUTXOsReply{}
+	require.NoError(service.InspectAtomicUTXOs(nil, args, &reply))
+	require.Zero(reply.NumFetched)
+
+	// Re-add the imported UTXO to shared memory to orphan it.
+	require.NoError(peerSharedMemory.Apply(exportRequests))
+
+	// Inspecting the UTXOs doesn't remove the orphaned UTXO
+	for i := 0; i < 2; i++ {
+		reply = InspectAtomicUTXOsReply{}
+		require.NoError(service.InspectAtomicUTXOs(nil, args, &reply))
+		require.Equal(json.Uint64(1), reply.NumFetched)
+		require.Equal(json.Uint64(1), reply.NumOrphaned)
+		txID := tx.ID()
+		require.Equal(&txID, reply.UTXOs[0].ConsumedBy)
+	}
+
+	service.vm.ctx.Lock.Unlock()
+	removed, err = service.vm.RepairAtomicUTXOs(aChainID, []ids.ID{inputID})
+	service.vm.ctx.Lock.Lock()
+	require.NoError(err)
+	require.Equal([]ids.ID{inputID}, removed)
+
+	reply = InspectAtomicUTXOsReply{}
+	require.NoError(service.InspectAtomicUTXOs(nil, args, &reply))
+	require.Zero(reply.NumFetched)
+
+	// A UTXO that was already removed isn't removed again
+	service.vm.ctx.Lock.Unlock()
+	removed, err = service.vm.RepairAtomicUTXOs(aChainID, []ids.ID{inputID})
+	service.vm.ctx.Lock.Lock()
+	require.NoError(err)
+	require.Empty(removed)
+
+	args.SourceChain = "O"
+	err = service.InspectAtomicUTXOs(nil, args, &reply)
+	require.ErrorIs(err, errNoSourceChain)
+}
+
+func TestGetPendingTxs(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	args := &GetPendingTxsArgs{Encoding: formatting.Hex}
+	reply := &GetPendingTxsReply{}
+	require.NoError(service.GetPendingTxs(nil, args, reply))
+	require.Empty(reply.Txs)
+
+	tx, err := service.vm.txBuilder.NewCreateSubnetTx(
+		1,
+		[]ids.ShortID{keys[0].PublicKey().Address()},
+		[]*secp256k1.PrivateKey{keys[0]},
+		keys[0].PublicKey().Address(),
+	)
+	require.NoError(err)
+	require.NoError(service.vm.Builder.AddUnverifiedTx(tx))
+
+	reply = &GetPendingTxsReply{}
+	require.NoError(service.GetPendingTxs(nil, args, reply))
+	require.Equal(formatting.Hex, reply.Encoding)
+	require.Len(reply.Txs, 1)
+
+	pendingTx := reply.Txs[0]
+	require.Equal(tx.ID(), pendingTx.TxID)
+	require.Equal(json.Uint64(len(tx.Bytes())), pendingTx.Size)
+	txBytes, err := formatting.Decode(reply.Encoding, pendingTx.Tx)
+	require.NoError(err)
+	require.Equal(tx.Bytes(), txBytes)
+
+	blk, err := service.vm.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+
+	reply = &GetPendingTxsReply{}
+	require.NoError(service.GetPendingTxs(nil, args, reply))
+	require.Empty(reply.Txs)
 }
 
 // Test issuing and then retrieving a transaction
@@ -326,7 +662,7 @@ func TestGetTx(t *testing.T) {
 					TxID:     tx.ID(),
 					Encoding: encoding,
 				}
-				var response api.GetTxReply
+				var response GetTxReply
 				err = service.GetTx(nil, arg, &response)
 				require.ErrorIs(err, database.ErrNotFound) // We haven't issued the tx yet
 
@@ -373,6 +709,60 @@ func TestGetTx(t *testing.T) {
 	}
 }
 
+func TestGetBlockReceipts(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	defaultAddress(t, service)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	tx, err := service.vm.txBuilder.NewCreateChainTx(
+		testSubnet1.ID(),
+		nil,
+		constants.AlphaID,
+		nil,
+		"chain name",
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		keys[0].PublicKey().Address(), // change addr
+	)
+	require.NoError(err)
+	require.NoError(service.vm.Builder.AddUnverifiedTx(tx))
+
+	block, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(block.Verify(context.Background()))
+
+	// Receipts are only recorded once the block is accepted
+	receiptsArgs := &GetBlockReceiptsArgs{
+		BlockID: block.ID(),
+	}
+	receiptsReply := GetBlockReceiptsReply{}
+	err = service.GetBlockReceipts(nil, receiptsArgs, &receiptsReply)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	require.NoError(block.Accept(context.Background()))
+
+	expectedReceipt := &TxReceipt{
+		TxID:        tx.ID(),
+		Fee:         json.Uint64(tx.Burned(service.vm.ctx.DIONEAssetID)),
+		NumConsumed: json.Uint32(tx.Unsigned.InputIDs().Len()),
+		NumProduced: json.Uint32(len(tx.Unsigned.Outputs())),
+	}
+
+	require.NoError(service.GetBlockReceipts(nil, receiptsArgs, &receiptsReply))
+	require.Equal([]*TxReceipt{expectedReceipt}, receiptsReply.Receipts)
+
+	txReply := GetTxReply{}
+	require.NoError(service.GetTx(nil, &api.GetTxArgs{
+		TxID:     tx.ID(),
+		Encoding: formatting.Hex,
+	}, &txReply))
+	require.Equal(expectedReceipt, txReply.Receipt)
+}
+
 // Test method GetBalance
 func TestGetBalance(t *testing.T) {
 	require := require.New(t)
@@ -690,6 +1080,466 @@ func TestGetCurrentValidators(t *testing.T) {
 	}
 }
 
+func TestGetStakerSchedule(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	genesis, _ := defaultGenesis(t)
+
+	startTime := service.vm.clock.Time().Add(txexecutor.SyncBound).Add(time.Second)
+	endTime := startTime.Add(defaultMinValidatorStakingDuration)
+	tx, err := service.vm.txBuilder.NewAddValidatorTx(
+		service.vm.MinValidatorStake,
+		uint64(startTime.Unix()),
+		uint64(endTime.Unix()),
+		ids.GenerateTestNodeID(),
+		ids.GenerateTestShortID(),
+		reward.PercentDenominator,
+		[]*secp256k1.PrivateKey{keys[0]},
+		keys[0].PublicKey().Address(), // change addr
+	)
+	require.NoError(err)
+
+	staker, err := state.NewPendingStaker(tx.ID(), tx.Unsigned.(*txs.AddValidatorTx))
+	require.NoError(err)
+	service.vm.state.PutPendingValidator(staker)
+	service.vm.state.AddTx(tx, status.Committed)
+	require.NoError(service.vm.state.Commit())
+
+	args := GetStakerScheduleArgs{SubnetID: constants.PrimaryNetworkID}
+	reply := GetStakerScheduleReply{}
+	require.NoError(service.GetStakerSchedule(nil, &args, &reply))
+	require.Len(reply.Events, len(genesis.Validators)+2)
+	for i := 1; i < len(reply.Events); i++ {
+		require.LessOrEqual(reply.Events[i-1].Time, reply.Events[i].Time)
+	}
+	require.Equal(StakerEvent{
+		Event:  StakerEventStart,
+		Time:   json.Uint64(startTime.Unix()),
+		TxID:   tx.ID(),
+		NodeID: staker.NodeID,
+		Weight: json.Uint64(staker.Weight),
+	}, reply.Events[0])
+
+	args.Limit = 2
+	limited := GetStakerScheduleReply{}
+	require.NoError(service.GetStakerSchedule(nil, &args, &limited))
+	require.Equal(reply.Events[:2], limited.Events)
+
+	args.SubnetID = ids.GenerateTestID()
+	require.NoError(service.GetStakerSchedule(nil, &args, &reply))
+	require.Empty(reply.Events)
+}
+
+func TestGetCurrentValidatorsPagination(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	args := GetCurrentValidatorsArgs{
+		SubnetID:   constants.PrimaryNetworkID,
+		SortBy:     ValidatorSortByEndTime,
+		Descending: true,
+	}
+	sorted := GetCurrentValidatorsReply{}
+	require.NoError(service.GetCurrentValidators(nil, &args, &sorted))
+	require.Nil(sorted.NumValidators)
+	require.Greater(len(sorted.Validators), 3)
+
+	for i := 1; i < len(sorted.Validators); i++ {
+		prev := sorted.Validators[i-1].(pchainapi.PermissionlessValidator)
+		vdr := sorted.Validators[i].(pchainapi.PermissionlessValidator)
+		require.GreaterOrEqual(prev.EndTime, vdr.EndTime)
+		if prev.EndTime == vdr.EndTime {
+			require.True(vdr.NodeID.Less(prev.NodeID))
+		}
+	}
+
+	args.Offset = 1
+	args.Limit = 2
+	page := GetCurrentValidatorsReply{}
+	require.NoError(service.GetCurrentValidators(nil, &args, &page))
+	require.NotNil(page.NumValidators)
+	require.Equal(json.Uint64(len(sorted.Validators)), *page.NumValidators)
+	require.Equal(sorted.Validators[1:3], page.Validators)
+
+	args.Offset = json.Uint64(len(sorted.Validators))
+	require.NoError(service.GetCurrentValidators(nil, &args, &page))
+	require.Empty(page.Validators)
+
+	args.SortBy = "name"
+	err := service.GetCurrentValidators(nil, &args, &page)
+	require.ErrorIs(err, errUnknownSortBy)
+}
+
+func TestGetRewardOwners(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	nodeID := ids.NodeID(keys[0].PublicKey().Address())
+	staker, err := service.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(err)
+
+	expectedAddr, err := service.addrManager.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+	expectedOwner := &pchainapi.Owner{
+		Threshold: 1,
+		Addresses: []string{expectedAddr},
+	}
+
+	args := GetRewardOwnersArgs{
+		SubnetID: constants.PrimaryNetworkID,
+		NodeIDs: []ids.NodeID{
+			nodeID,
+			ids.GenerateTestNodeID(), // not a validator
+			nodeID,                   // duplicates are only reported once
+		},
+	}
+	reply := GetRewardOwnersReply{}
+	require.NoError(service.GetRewardOwners(nil, &args, &reply))
+	require.Equal([]APIRewardOwners{{
+		NodeID:                nodeID,
+		TxID:                  staker.TxID,
+		ValidationRewardOwner: expectedOwner,
+		DelegationRewardOwner: expectedOwner,
+	}}, reply.RewardOwners)
+}
+
+func TestGetBlockchains(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	vmID := ids.GenerateTestID()
+	tx, err := service.vm.txBuilder.NewCreateChainTx(
+		testSubnet1.ID(),
+		nil,
+		vmID,
+		nil,
+		"name",
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	require.NoError(err)
+	require.NoError(service.vm.Builder.AddUnverifiedTx(tx))
+	blk, err := service.vm.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+
+	expectedBlockchain := APIBlockchain{
+		ID:       tx.ID(),
+		Name:     "name",
+		SubnetID: testSubnet1.ID(),
+		VMID:     vmID,
+	}
+
+	reply := GetBlockchainsResponse{}
+	require.NoError(service.GetBlockchains(nil, &GetBlockchainsArgs{}, &reply))
+	require.Equal([]APIBlockchain{expectedBlockchain}, reply.Blockchains)
+
+	vmManager := vms.NewMockManager(ctrl)
+	vmManager.EXPECT().Versions().Return(map[string]string{"testvm": "v1.2.3"}, nil)
+	vmManager.EXPECT().PrimaryAlias(vmID).Return("testvm", nil)
+	service.vm.VMManager = vmManager
+
+	chainStatus := status.Syncing
+	tracked := false
+	bootstrapped := false
+	expectedBlockchain.Status = &chainStatus
+	expectedBlockchain.VMVersion = "v1.2.3"
+	expectedBlockchain.Tracked = &tracked
+	expectedBlockchain.Bootstrapped = &bootstrapped
+	expectedBlockchain.Endpoint = "/ext/bc/" + tx.ID().String()
+
+	reply = GetBlockchainsResponse{}
+	require.NoError(service.GetBlockchains(nil, &GetBlockchainsArgs{Verbose: true}, &reply))
+	require.Equal([]APIBlockchain{expectedBlockchain}, reply.Blockchains)
+}
+
+func TestIssueTxs(t *testing.T) {
+	require := require.New(t)
+
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	tx, err := service.vm.txBuilder.NewCreateChainTx(
+		testSubnet1.ID(),
+		nil,
+		ids.GenerateTestID(),
+		nil,
+		"name",
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	require.NoError(err)
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+
+	args := &api.FormattedTxs{
+		Txs: []string{
+			"",
+			txStr,
+		},
+		Encoding: formatting.Hex,
+	}
+	reply := &api.IssueTxsReply{}
+	require.NoError(service.IssueTxs(nil, args, reply))
+	require.Len(reply.Results, 2)
+
+	require.Equal(ids.Empty, reply.Results[0].TxID)
+	require.NotEmpty(reply.Results[0].Error)
+
+	require.Equal(tx.ID(), reply.Results[1].TxID)
+	require.Empty(reply.Results[1].Error)
+	require.True(service.vm.Builder.Has(tx.ID()))
+
+	args.Txs = make([]string, maxIssueTxs+1)
+	err = service.IssueTxs(nil, args, reply)
+	require.ErrorIs(err, errTooManyTxs)
+}
+
+func TestGetSubnet(t *testing.T) {
+	require := require.New(t)
+
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	subnetID := testSubnet1.ID()
+	vmID := ids.GenerateTestID()
+	chainTx, err := service.vm.txBuilder.NewCreateChainTx(
+		subnetID,
+		nil,
+		vmID,
+		nil,
+		"name",
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	require.NoError(err)
+	require.NoError(service.vm.Builder.AddUnverifiedTx(chainTx))
+	blk, err := service.vm.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+
+	expectedControlKeys := make([]string, len(testSubnet1ControlKeys))
+	for i, key := range testSubnet1ControlKeys {
+		expectedControlKeys[i], err = service.addrManager.FormatLocalAddress(key.PublicKey().Address())
+		require.NoError(err)
+	}
+	expectedBlockchains := []APIBlockchain{{
+		ID:       chainTx.ID(),
+		Name:     "name",
+		SubnetID: subnetID,
+		VMID:     vmID,
+	}}
+
+	reply := GetSubnetResponse{}
+	require.NoError(service.GetSubnet(nil, &GetSubnetArgs{SubnetID: subnetID}, &reply))
+	require.True(reply.IsPermissioned)
+	require.ElementsMatch(expectedControlKeys, reply.ControlKeys)
+	require.Equal(json.Uint32(2), reply.Threshold)
+	require.Nil(reply.Transformation)
+	require.Equal(expectedBlockchains, reply.Blockchains)
+
+	assetID := ids.GenerateTestID()
+	transformSubnetTx, err := txs.NewSigned(&txs.TransformSubnetTx{
+		Subnet:                    subnetID,
+		AssetID:                   assetID,
+		InitialSupply:             1_000,
+		MaximumSupply:             2_000,
+		MinValidatorStake:         10,
+		MaxValidatorStake:         100,
+		MinValidatorStakeDuration: 60,
+		MaxValidatorStakeDuration: 120,
+		MinDelegatorStakeDuration: 30,
+		MaxDelegatorStakeDuration: 90,
+		MinDelegationFee:          20_000,
+		MinDelegatorStake:         5,
+		MaxValidatorWeightFactor:  5,
+		UptimeRequirement:         800_000,
+		SubnetAuth:                &secp256k1fx.Input{},
+	}, txs.Codec, nil)
+	require.NoError(err)
+	service.vm.state.AddSubnetTransformation(transformSubnetTx)
+
+	reply = GetSubnetResponse{}
+	require.NoError(service.GetSubnet(nil, &GetSubnetArgs{SubnetID: subnetID}, &reply))
+	require.False(reply.IsPermissioned)
+	require.Equal(&APISubnetTransformation{
+		TxID:                      transformSubnetTx.ID(),
+		AssetID:                   assetID,
+		InitialSupply:             1_000,
+		MaximumSupply:             2_000,
+		MinValidatorStake:         10,
+		MaxValidatorStake:         100,
+		MinValidatorStakeDuration: 60,
+		MaxValidatorStakeDuration: 120,
+		MinDelegatorStakeDuration: 30,
+		MaxDelegatorStakeDuration: 90,
+		MinDelegationFee:          20_000,
+		MinDelegatorStake:         5,
+		MaxValidatorWeightFactor:  5,
+		UptimeRequirement:         800_000,
+	}, reply.Transformation)
+	require.Equal(expectedBlockchains, reply.Blockchains)
+
+	err = service.GetSubnet(nil, &GetSubnetArgs{SubnetID: constants.PrimaryNetworkID}, &reply)
+	require.ErrorIs(err, errPrimaryNotASubnet)
+}
+
+func TestGetSubnetTransformation(t *testing.T) {
+	require := require.New(t)
+
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	subnetID := testSubnet1.ID()
+	reply := APISubnetTransformation{}
+	err := service.GetSubnetTransformation(nil, &GetSubnetTransformationArgs{SubnetID: subnetID}, &reply)
+	require.ErrorIs(err, errSubnetNotPermissionless)
+
+	err = service.GetSubnetTransformation(nil, &GetSubnetTransformationArgs{SubnetID: constants.PrimaryNetworkID}, &reply)
+	require.ErrorIs(err, errPrimaryNotASubnet)
+
+	assetID := ids.GenerateTestID()
+	tx, err := service.vm.txBuilder.NewTransformSubnetTx(
+		subnetID,
+		assetID,
+		1_000, // initial supply
+		1_000, // max supply
+		reward.PercentDenominator/10,
+		reward.PercentDenominator/5,
+		10,  // min validator stake
+		100, // max validator stake
+		defaultMinValidatorStakingDuration,
+		defaultMaxValidatorStakingDuration,
+		defaultMinDelegatorStakingDuration,
+		defaultMaxDelegatorStakingDuration,
+		20_000,  // min delegation fee
+		5,       // min delegator stake
+		5,       // max validator weight factor
+		800_000, // uptime requirement
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	require.NoError(err)
+	require.NoError(service.vm.Builder.AddUnverifiedTx(tx))
+	blk, err := service.vm.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+
+	require.NoError(service.GetSubnetTransformation(nil, &GetSubnetTransformationArgs{SubnetID: subnetID}, &reply))
+	require.Equal(APISubnetTransformation{
+		TxID:                      tx.ID(),
+		AssetID:                   assetID,
+		InitialSupply:             1_000,
+		MaximumSupply:             1_000,
+		MinConsumptionRate:        reward.PercentDenominator / 10,
+		MaxConsumptionRate:        reward.PercentDenominator / 5,
+		MinValidatorStake:         10,
+		MaxValidatorStake:         100,
+		MinValidatorStakeDuration: json.Uint32(defaultMinValidatorStakingDuration / time.Second),
+		MaxValidatorStakeDuration: json.Uint32(defaultMaxValidatorStakingDuration / time.Second),
+		MinDelegatorStakeDuration: json.Uint32(defaultMinDelegatorStakingDuration / time.Second),
+		MaxDelegatorStakeDuration: json.Uint32(defaultMaxDelegatorStakingDuration / time.Second),
+		MinDelegationFee:          20_000,
+		MinDelegatorStake:         5,
+		MaxValidatorWeightFactor:  5,
+		UptimeRequirement:         800_000,
+	}, reply)
+}
+
+func TestGetValidatorUptime(t *testing.T) {
+	require := require.New(t)
+
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	connectedNodeID := ids.NodeID(keys[0].PublicKey().Address())
+	require.NoError(service.vm.uptimeManager.Connect(connectedNodeID, constants.PrimaryNetworkID))
+
+	reply := APIValidatorUptime{}
+	require.NoError(service.GetValidatorUptime(nil, &GetValidatorUptimeArgs{
+		NodeID:   connectedNodeID,
+		SubnetID: constants.PrimaryNetworkID,
+	}, &reply))
+	require.Equal(connectedNodeID, reply.NodeID)
+	require.True(reply.Connected)
+	require.NotNil(reply.ConnectedSince)
+	require.NotNil(reply.LastConnected)
+
+	disconnectedNodeID := ids.NodeID(keys[1].PublicKey().Address())
+	reply = APIValidatorUptime{}
+	require.NoError(service.GetValidatorUptime(nil, &GetValidatorUptimeArgs{
+		NodeID:   disconnectedNodeID,
+		SubnetID: constants.PrimaryNetworkID,
+	}, &reply))
+	require.Equal(disconnectedNodeID, reply.NodeID)
+	require.False(reply.Connected)
+	require.Nil(reply.ConnectedSince)
+	require.Nil(reply.LastConnected)
+
+	err := service.GetValidatorUptime(nil, &GetValidatorUptimeArgs{
+		NodeID:   ids.GenerateTestNodeID(),
+		SubnetID: constants.PrimaryNetworkID,
+	}, &reply)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	err = service.GetValidatorUptime(nil, &GetValidatorUptimeArgs{
+		NodeID:   connectedNodeID,
+		SubnetID: ids.GenerateTestID(),
+	}, &reply)
+	require.ErrorIs(err, errUptimeNotTracked)
+
+	uptimesReply := GetValidatorUptimesReply{}
+	require.NoError(service.GetValidatorUptimes(nil, &GetValidatorUptimesArgs{
+		SubnetID: constants.PrimaryNetworkID,
+	}, &uptimesReply))
+	require.Len(uptimesReply.Validators, len(keys))
+	for _, uptime := range uptimesReply.Validators {
+		require.Equal(uptime.NodeID == connectedNodeID, uptime.Connected)
+	}
+}
+
 func TestGetTimestamp(t *testing.T) {
 	require := require.New(t)
 	service, _ := defaultService(t)
@@ -710,6 +1560,123 @@ func TestGetTimestamp(t *testing.T) {
 	require.Equal(newTimestamp, reply.Timestamp)
 }
 
+func TestGetTxConfig(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	reply := GetTxConfigReply{}
+	require.NoError(service.GetTxConfig(nil, nil, &reply))
+
+	timestamp := service.vm.state.GetTimestamp()
+	require.Equal(json.Uint64(service.vm.TxFee), reply.TxFee)
+	require.Equal(json.Uint64(service.vm.GetCreateSubnetTxFee(timestamp)), reply.CreateSubnetTxFee)
+	require.Equal(json.Uint64(service.vm.GetCreateBlockchainTxFee(timestamp)), reply.CreateBlockchainTxFee)
+	require.Equal(json.Uint64(service.vm.MinValidatorStake), reply.MinValidatorStake)
+	require.Equal(json.Uint64(service.vm.MaxValidatorStake), reply.MaxValidatorStake)
+	require.Equal(json.Uint64(service.vm.MinDelegatorStake), reply.MinDelegatorStake)
+	require.Equal(json.Uint64(service.vm.MinValidatorStakeDuration/time.Second), reply.MinValidatorStakeDuration)
+	require.Equal(json.Uint8(txexecutor.MaxValidatorWeightFactor), reply.MaxValidatorWeightFactor)
+	require.Equal(json.Uint64(service.vm.RewardConfig.SupplyCap), reply.MaxSupply)
+}
+
+func TestGetMinStake(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	reply := GetMinStakeReply{}
+	require.NoError(service.GetMinStake(nil, &GetMinStakeArgs{
+		SubnetID: constants.PrimaryNetworkID,
+	}, &reply))
+	require.Equal(json.Uint64(service.vm.MinValidatorStake), reply.MinValidatorStake)
+	require.Equal(json.Uint64(service.vm.MinDelegatorStake), reply.MinDelegatorStake)
+	require.Equal(json.Uint8(txexecutor.MaxValidatorWeightFactor), reply.MaxValidatorWeightFactor)
+
+	subnetID := ids.GenerateTestID()
+	service.vm.state.AddSubnetTransformation(&txs.Tx{
+		Unsigned: &txs.TransformSubnetTx{
+			Subnet:                   subnetID,
+			MinValidatorStake:        2000,
+			MinDelegatorStake:        100,
+			MaxValidatorWeightFactor: 3,
+		},
+	})
+
+	reply = GetMinStakeReply{}
+	require.NoError(service.GetMinStake(nil, &GetMinStakeArgs{
+		SubnetID: subnetID,
+	}, &reply))
+	require.Equal(GetMinStakeReply{
+		MinValidatorStake:        2000,
+		MinDelegatorStake:        100,
+		MaxValidatorWeightFactor: 3,
+	}, reply)
+}
+
+func TestEstimateReward(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	args := EstimateRewardArgs{
+		StakeAmount: 1_000_000,
+		StartTime:   json.Uint64(defaultValidateStartTime.Unix()),
+		EndTime:     json.Uint64(defaultValidateEndTime.Unix()),
+		SubnetID:    constants.PrimaryNetworkID,
+	}
+	reply := EstimateRewardReply{}
+	err := service.EstimateReward(nil, &args, &reply)
+	require.ErrorIs(err, errPrimaryNetworkReward)
+
+	subnetID := ids.GenerateTestID()
+	args.SubnetID = subnetID
+	err = service.EstimateReward(nil, &args, &reply)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	transformTx := &txs.TransformSubnetTx{
+		Subnet:             subnetID,
+		InitialSupply:      100_000_000,
+		MaximumSupply:      1_000_000_000,
+		MinConsumptionRate: reward.PercentDenominator / 10,
+		MaxConsumptionRate: reward.PercentDenominator / 5,
+	}
+	service.vm.state.AddSubnetTransformation(&txs.Tx{Unsigned: transformTx})
+	service.vm.state.SetCurrentSupply(subnetID, transformTx.InitialSupply)
+
+	require.NoError(service.EstimateReward(nil, &args, &reply))
+	rewards := reward.NewCalculator(reward.Config{
+		MaxConsumptionRate: transformTx.MaxConsumptionRate,
+		MinConsumptionRate: transformTx.MinConsumptionRate,
+		MintingPeriod:      service.vm.RewardConfig.MintingPeriod,
+		SupplyCap:          transformTx.MaximumSupply,
+	})
+	expectedReward := rewards.Calculate(
+		defaultValidateEndTime.Sub(defaultValidateStartTime),
+		uint64(args.StakeAmount),
+		transformTx.InitialSupply,
+	)
+	require.NotZero(expectedReward)
+	require.Equal(json.Uint64(expectedReward), reply.PotentialReward)
+	require.Equal(json.Uint64(transformTx.InitialSupply), reply.Supply)
+
+	args.EndTime = args.StartTime
+	err = service.EstimateReward(nil, &args, &reply)
+	require.ErrorIs(err, errStartAfterEndTime)
+}
+
 func TestGetBlock(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -783,10 +1750,222 @@ func TestGetBlock(t *testing.T) {
 			}
 
 			require.Equal(test.encoding, response.Encoding)
+			require.NotNil(response.AcceptedAt)
 		})
 	}
 }
 
+type testBlockProposerGetter map[ids.ID]*block.BlockProposer
+
+func (g testBlockProposerGetter) GetBlockProposer(_ context.Context, blkID ids.ID, _ uint64) (*block.BlockProposer, error) {
+	proposer, ok := g[blkID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return proposer, nil
+}
+
+func TestGetBlockProposer(t *testing.T) {
+	require := require.New(t)
+
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(service.vm.Shutdown(context.Background()))
+		service.vm.ctx.Lock.Unlock()
+	}()
+
+	lastAcceptedID, err := service.vm.LastAccepted(context.Background())
+	require.NoError(err)
+	lastAccepted, err := service.vm.GetBlock(context.Background(), lastAcceptedID)
+	require.NoError(err)
+
+	args := api.GetBlockArgs{
+		BlockID:  lastAcceptedID,
+		Encoding: formatting.JSON,
+	}
+
+	// Not wrapped by the proposervm
+	response := api.GetBlockResponse{}
+	require.NoError(service.GetBlock(nil, &args, &response))
+	require.Nil(response.ProposerNodeID)
+	require.Nil(response.Timestamp)
+
+	// Wrapping block not found
+	blockProposers := testBlockProposerGetter{}
+	service.vm.SetBlockProposerGetter(blockProposers)
+
+	response = api.GetBlockResponse{}
+	require.NoError(service.GetBlock(nil, &args, &response))
+	require.Nil(response.ProposerNodeID)
+	require.Nil(response.Timestamp)
+
+	// Wrapping block found
+	proposer := &block.BlockProposer{
+		NodeID:    ids.GenerateTestNodeID(),
+		Timestamp: time.Unix(1_000, 0),
+	}
+	blockProposers[lastAcceptedID] = proposer
+
+	response = api.GetBlockResponse{}
+	require.NoError(service.GetBlock(nil, &args, &response))
+	require.NotNil(response.ProposerNodeID)
+	require.Equal(proposer.NodeID, *response.ProposerNodeID)
+	require.NotNil(response.Timestamp)
+	require.Equal(proposer.Timestamp, *response.Timestamp)
+
+	response = api.GetBlockResponse{}
+	require.NoError(service.GetBlockByHeight(nil, &api.GetBlockByHeightArgs{
+		Height:   json.Uint64(lastAccepted.Height()),
+		Encoding: formatting.JSON,
+	}, &response))
+	require.Equal(proposer.NodeID, *response.ProposerNodeID)
+	require.Equal(proposer.Timestamp, *response.Timestamp)
+
+	// Unsigned wrapping block
+	proposer.NodeID = ids.EmptyNodeID
+
+	response = api.GetBlockResponse{}
+	require.NoError(service.GetBlock(nil, &args, &response))
+	require.Nil(response.ProposerNodeID)
+	require.Equal(proposer.Timestamp, *response.Timestamp)
+}
+
+func TestGetBlockRange(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer service.vm.ctx.Lock.Unlock()
+
+	service.vm.Config.CreateAssetTxFee = 100 * defaultTxFee
+
+	tx, err := service.vm.txBuilder.NewCreateChainTx(
+		testSubnet1.ID(),
+		nil,
+		constants.AlphaID,
+		nil,
+		"chain name",
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		keys[0].PublicKey().Address(), // change addr
+	)
+	require.NoError(err)
+
+	preferred, err := service.vm.Builder.Preferred()
+	require.NoError(err)
+
+	statelessBlock, err := blocks.NewBanffStandardBlock(
+		preferred.Timestamp(),
+		preferred.ID(),
+		preferred.Height()+1,
+		[]*txs.Tx{tx},
+	)
+	require.NoError(err)
+
+	block := service.vm.manager.NewBlock(statelessBlock)
+	require.NoError(block.Verify(context.Background()))
+	require.NoError(block.Accept(context.Background()))
+
+	// The range is truncated at the last accepted block
+	args := GetBlockRangeArgs{
+		StartHeight: json.Uint64(preferred.Height()),
+		NumBlocks:   10,
+		Encoding:    formatting.Hex,
+	}
+	reply := GetBlockRangeReply{}
+	require.NoError(service.GetBlockRange(&http.Request{}, &args, &reply))
+	require.Len(reply.Blocks, 2)
+	for i, expectedID := range []ids.ID{preferred.ID(), block.ID()} {
+		decoded, err := formatting.Decode(reply.Blocks[i].Encoding, reply.Blocks[i].Block.(string))
+		require.NoError(err)
+		parsed, err := blocks.Parse(blocks.GenesisCodec, decoded)
+		require.NoError(err)
+		require.Equal(expectedID, parsed.ID())
+	}
+
+	// Starting past the last accepted block returns no blocks
+	args.StartHeight = json.Uint64(block.Height() + 1)
+	require.NoError(service.GetBlockRange(&http.Request{}, &args, &reply))
+	require.Empty(reply.Blocks)
+
+	args.NumBlocks = maxGetBlockRange + 1
+	require.Error(service.GetBlockRange(&http.Request{}, &args, &reply))
+}
+
+func TestGetValidatorSetDiffs(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+	defer service.vm.ctx.Lock.Unlock()
+
+	vm := service.vm
+	startTime := vm.clock.Time().Add(txexecutor.SyncBound).Add(1 * time.Second)
+	endTime := startTime.Add(defaultMaxValidatorStakingDuration)
+	nodeID := ids.GenerateTestNodeID()
+
+	tx, err := vm.txBuilder.NewAddValidatorTx(
+		vm.MaxValidatorStake,
+		uint64(startTime.Unix()),
+		uint64(endTime.Unix()),
+		nodeID,
+		ids.GenerateTestShortID(),
+		reward.PercentDenominator,
+		[]*secp256k1.PrivateKey{keys[0]},
+		ids.GenerateTestShortID(),
+	)
+	require.NoError(err)
+
+	// Add the validator to the pending set at height 2 and move it into the
+	// current set at height 3.
+	for _, blkArgs := range []struct {
+		timestamp time.Time
+		txs       []*txs.Tx
+	}{
+		{timestamp: vm.state.GetTimestamp(), txs: []*txs.Tx{tx}},
+		{timestamp: startTime},
+	} {
+		vm.clock.Set(blkArgs.timestamp)
+		preferred, err := vm.Builder.Preferred()
+		require.NoError(err)
+
+		statelessBlk, err := blocks.NewBanffStandardBlock(
+			blkArgs.timestamp,
+			preferred.ID(),
+			preferred.Height()+1,
+			blkArgs.txs,
+		)
+		require.NoError(err)
+		blk := vm.manager.NewBlock(statelessBlk)
+		require.NoError(blk.Verify(context.Background()))
+		require.NoError(blk.Accept(context.Background()))
+		require.NoError(vm.SetPreference(context.Background(), vm.manager.LastAccepted()))
+	}
+
+	args := GetValidatorSetDiffsArgs{
+		StartHeight: 1,
+		EndHeight:   3,
+		SubnetID:    constants.PrimaryNetworkID,
+	}
+	reply := GetValidatorSetDiffsReply{}
+	require.NoError(service.GetValidatorSetDiffs(&http.Request{}, &args, &reply))
+
+	expectedValidators, err := vm.GetValidatorSet(context.Background(), 1, constants.PrimaryNetworkID)
+	require.NoError(err)
+	require.Equal(expectedValidators, reply.Validators.Validators)
+
+	require.Len(reply.Diffs, 2)
+	require.Equal(json.Uint64(2), reply.Diffs[0].Height)
+	require.Empty(reply.Diffs[0].Validators.Validators)
+	require.Equal(json.Uint64(3), reply.Diffs[1].Height)
+	require.Len(reply.Diffs[1].Validators.Validators, 1)
+	require.Equal(vm.MaxValidatorStake, reply.Diffs[1].Validators.Validators[nodeID].Weight)
+
+	_, err = stdjson.Marshal(&reply)
+	require.NoError(err)
+
+	args.StartHeight = 4
+	require.ErrorIs(service.GetValidatorSetDiffs(&http.Request{}, &args, &reply), errStartAfterEndHeight)
+}
+
 func TestGetValidatorsAtReplyMarshalling(t *testing.T) {
 	require := require.New(t)
 
@@ -886,10 +2065,14 @@ func TestServiceGetBlockByHeight(t *testing.T) {
 
 				manager := blockexecutor.NewMockManager(ctrl)
 				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil)
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().AcceptedAt(blockID).Return(time.Time{}, database.ErrNotFound)
 				return &Service{
 					vm: &VM{
-						state:   state,
-						manager: manager,
+						state:       state,
+						manager:     manager,
+						acceptTimes: acceptTimes,
 						ctx: &snow.Context{
 							Log: logging.NoLog{},
 						},
@@ -914,10 +2097,14 @@ func TestServiceGetBlockByHeight(t *testing.T) {
 
 				manager := blockexecutor.NewMockManager(ctrl)
 				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil)
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().AcceptedAt(blockID).Return(time.Time{}, database.ErrNotFound)
 				return &Service{
 					vm: &VM{
-						state:   state,
-						manager: manager,
+						state:       state,
+						manager:     manager,
+						acceptTimes: acceptTimes,
 						ctx: &snow.Context{
 							Log: logging.NoLog{},
 						},
@@ -942,10 +2129,14 @@ func TestServiceGetBlockByHeight(t *testing.T) {
 
 				manager := blockexecutor.NewMockManager(ctrl)
 				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil)
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().AcceptedAt(blockID).Return(time.Time{}, database.ErrNotFound)
 				return &Service{
 					vm: &VM{
-						state:   state,
-						manager: manager,
+						state:       state,
+						manager:     manager,
+						acceptTimes: acceptTimes,
 						ctx: &snow.Context{
 							Log: logging.NoLog{},
 						},
@@ -970,10 +2161,14 @@ func TestServiceGetBlockByHeight(t *testing.T) {
 
 				manager := blockexecutor.NewMockManager(ctrl)
 				manager.EXPECT().GetStatelessBlock(blockID).Return(block, nil)
+
+				acceptTimes := acceptance.NewMockTracker(ctrl)
+				acceptTimes.EXPECT().AcceptedAt(blockID).Return(time.Time{}, database.ErrNotFound)
 				return &Service{
 					vm: &VM{
-						state:   state,
-						manager: manager,
+						state:       state,
+						manager:     manager,
+						acceptTimes: acceptTimes,
 						ctx: &snow.Context{
 							Log: logging.NoLog{},
 						},
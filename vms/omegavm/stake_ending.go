@@ -0,0 +1,173 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/pubsub"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+// stakeEndingCheckFrequency is how often chain time is checked for stakers
+// that are about to stop staking.
+const stakeEndingCheckFrequency = time.Second
+
+var _ pubsub.Filterer = (*stakeEndingFilterer)(nil)
+
+// StakeEnding is published to the subscribers of a staker's node ID or reward
+// owner addresses once chain time is within the configured lead time of the
+// staker's end time.
+type StakeEnding struct {
+	TxID     ids.ID      `json:"txID"`
+	NodeID   ids.NodeID  `json:"nodeID"`
+	SubnetID ids.ID      `json:"subnetID"`
+	Weight   json.Uint64 `json:"weight"`
+	EndTime  json.Uint64 `json:"endTime"`
+}
+
+type stakeEndingFilterer struct {
+	event StakeEnding
+	// node ID and reward owner addresses of the staker
+	keys [][]byte
+}
+
+func (f *stakeEndingFilterer) Filter(filters []pubsub.Filter) ([]bool, interface{}) {
+	resp := make([]bool, len(filters))
+	for i, filter := range filters {
+		for _, key := range f.keys {
+			if filter.Check(key) {
+				resp[i] = true
+				break
+			}
+		}
+	}
+	return resp, f.event
+}
+
+// runStakeEndingNotifications publishes a StakeEnding event for every current
+// staker once chain time is within [leadTime] of its end time, until [ctx] is
+// cancelled.
+func (vm *VM) runStakeEndingNotifications(ctx context.Context, leadTime time.Duration) {
+	ticker := time.NewTicker(stakeEndingCheckFrequency)
+	defer ticker.Stop()
+
+	var notified set.Set[ids.ID]
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		vm.ctx.Lock.Lock()
+		// Chain time moves quickly while bootstrapping, so stakers are only
+		// notified once the chain is up to date.
+		if !vm.bootstrapped.Get() {
+			vm.ctx.Lock.Unlock()
+			continue
+		}
+
+		var err error
+		notified, err = vm.notifyStakesEnding(notified, leadTime)
+		vm.ctx.Lock.Unlock()
+
+		if err != nil {
+			vm.ctx.Log.Warn("failed to publish stake ending notifications",
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// notifyStakesEnding publishes a StakeEnding event for every current staker
+// whose end time is within [leadTime] of chain time, unless the staker is in
+// [notified]. Returns the stakers that have now been notified.
+//
+// Invariant: assumes the context lock is held.
+func (vm *VM) notifyStakesEnding(notified set.Set[ids.ID], leadTime time.Duration) (set.Set[ids.ID], error) {
+	notifyBefore := vm.state.GetTimestamp().Add(leadTime)
+
+	stakerIterator, err := vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return notified, err
+	}
+	defer stakerIterator.Release()
+
+	// Current stakers are iterated in order of their end times.
+	var nowNotified set.Set[ids.ID]
+	for stakerIterator.Next() {
+		staker := stakerIterator.Value()
+		if staker.EndTime.After(notifyBefore) {
+			break
+		}
+
+		nowNotified.Add(staker.TxID)
+		if notified.Contains(staker.TxID) {
+			continue
+		}
+
+		filterer, err := vm.newStakeEndingFilterer(staker)
+		if err != nil {
+			return notified, err
+		}
+		vm.events.Publish(filterer)
+	}
+	return nowNotified, nil
+}
+
+func (vm *VM) newStakeEndingFilterer(staker *state.Staker) (*stakeEndingFilterer, error) {
+	tx, _, err := vm.state.GetTx(staker.TxID)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get staker tx %s: %w", staker.TxID, err)
+	}
+
+	var owners []fx.Owner
+	switch stakerTx := tx.Unsigned.(type) {
+	case txs.ValidatorTx:
+		owners = []fx.Owner{
+			stakerTx.ValidationRewardsOwner(),
+			stakerTx.DelegationRewardsOwner(),
+		}
+	case txs.DelegatorTx:
+		owners = []fx.Owner{
+			stakerTx.RewardsOwner(),
+		}
+	}
+
+	keys := [][]byte{staker.NodeID.Bytes()}
+	for _, owner := range owners {
+		if lockOwner, ok := owner.(*stakeable.LockOwner); ok {
+			owner = lockOwner.Owner
+		}
+		outputOwners, ok := owner.(*secp256k1fx.OutputOwners)
+		if !ok {
+			continue
+		}
+		for _, addr := range outputOwners.Addrs {
+			keys = append(keys, addr.Bytes())
+		}
+	}
+	return &stakeEndingFilterer{
+		event: StakeEnding{
+			TxID:     staker.TxID,
+			NodeID:   staker.NodeID,
+			SubnetID: staker.SubnetID,
+			Weight:   json.Uint64(staker.Weight),
+			EndTime:  json.Uint64(staker.EndTime.Unix()),
+		},
+		keys: keys,
+	}, nil
+}
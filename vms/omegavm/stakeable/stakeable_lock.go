@@ -7,9 +7,12 @@ import (
 	"errors"
 
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 )
 
 var (
+	_ fx.Owner = (*LockOwner)(nil)
+
 	errInvalidLocktime      = errors.New("invalid locktime")
 	errNestedStakeableLocks = errors.New("shouldn't nest stakeable locks")
 )
@@ -50,3 +53,20 @@ func (s *LockIn) Verify() error {
 	}
 	return s.TransferableIn.Verify()
 }
+
+// LockOwner is a rewards owner whose rewards are paid as a [LockOut] locked
+// until [Locktime]. Before then, the rewards can only be restaked.
+type LockOwner struct {
+	Locktime uint64 `serialize:"true" json:"locktime"`
+	fx.Owner `serialize:"true" json:"owner"`
+}
+
+func (s *LockOwner) Verify() error {
+	if s.Locktime == 0 {
+		return errInvalidLocktime
+	}
+	if _, nested := s.Owner.(*LockOwner); nested {
+		return errNestedStakeableLocks
+	}
+	return s.Owner.Verify()
+}
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 )
 
 var errTest = errors.New("hi mom")
@@ -131,3 +132,61 @@ func TestLockInVerify(t *testing.T) {
 		})
 	}
 }
+
+func TestLockOwnerVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		locktime    uint64
+		ownerF      func(*gomock.Controller) fx.Owner
+		expectedErr error
+	}{
+		{
+			name:     "happy path",
+			locktime: 1,
+			ownerF: func(ctrl *gomock.Controller) fx.Owner {
+				o := fx.NewMockOwner(ctrl)
+				o.EXPECT().Verify().Return(nil)
+				return o
+			},
+			expectedErr: nil,
+		},
+		{
+			name:     "invalid locktime",
+			locktime: 0,
+			ownerF: func(ctrl *gomock.Controller) fx.Owner {
+				return nil
+			},
+			expectedErr: errInvalidLocktime,
+		},
+		{
+			name:     "nested",
+			locktime: 1,
+			ownerF: func(ctrl *gomock.Controller) fx.Owner {
+				return &LockOwner{}
+			},
+			expectedErr: errNestedStakeableLocks,
+		},
+		{
+			name:     "inner owner fails verification",
+			locktime: 1,
+			ownerF: func(ctrl *gomock.Controller) fx.Owner {
+				o := fx.NewMockOwner(ctrl)
+				o.EXPECT().Verify().Return(errTest)
+				return o
+			},
+			expectedErr: errTest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			lockOwner := &LockOwner{
+				Locktime: tt.locktime,
+				Owner:    tt.ownerF(ctrl),
+			}
+			require.Equal(t, tt.expectedErr, lockOwner.Verify())
+		})
+	}
+}
@@ -38,6 +38,8 @@ type diff struct {
 
 	// Subnet ID --> supply of native asset of the subnet
 	currentSupply map[ids.ID]uint64
+	// Subnet ID --> changes made to the supply of the subnet
+	supplyChanges map[ids.ID]SupplyChanges
 
 	currentStakerDiffs diffStakers
 	// map of subnetID -> nodeID -> total accrued delegatee rewards
@@ -158,6 +160,27 @@ func (d *diff) SetCurrentSupply(subnetID ids.ID, currentSupply uint64) {
 	}
 }
 
+func (d *diff) GetSupplyChanges(subnetID ids.ID) (SupplyChanges, error) {
+	if changes, ok := d.supplyChanges[subnetID]; ok {
+		return changes, nil
+	}
+
+	// If the supply changes weren't modified in this diff, ask the parent
+	// state.
+	parentState, ok := d.stateVersions.GetState(d.parentID)
+	if !ok {
+		return SupplyChanges{}, fmt.Errorf("%w: %s", ErrMissingParentState, d.parentID)
+	}
+	return parentState.GetSupplyChanges(subnetID)
+}
+
+func (d *diff) SetSupplyChanges(subnetID ids.ID, changes SupplyChanges) {
+	if d.supplyChanges == nil {
+		d.supplyChanges = make(map[ids.ID]SupplyChanges)
+	}
+	d.supplyChanges[subnetID] = changes
+}
+
 func (d *diff) GetCurrentValidator(subnetID ids.ID, nodeID ids.NodeID) (*Staker, error) {
 	// If the validator was modified in this diff, return the modified
 	// validator.
@@ -386,6 +409,16 @@ func (d *diff) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
 	return parentState.GetSubnetOwner(subnetID)
 }
 
+func (d *diff) SetSubnetOwner(subnetID ids.ID, owner fx.Owner) {
+	if d.subnetOwners == nil {
+		d.subnetOwners = map[ids.ID]fx.Owner{
+			subnetID: owner,
+		}
+	} else {
+		d.subnetOwners[subnetID] = owner
+	}
+}
+
 func (d *diff) GetSubnetTransformation(subnetID ids.ID) (*txs.Tx, error) {
 	tx, exists := d.transformedSubnets[subnetID]
 	if exists {
@@ -634,6 +667,9 @@ func (d *diff) Apply(baseState State) error {
 	for subnetID, supply := range d.currentSupply {
 		baseState.SetCurrentSupply(subnetID, supply)
 	}
+	for subnetID, changes := range d.supplyChanges {
+		baseState.SetSupplyChanges(subnetID, changes)
+	}
 	for _, subnetValidatorDiffs := range d.currentStakerDiffs.validatorDiffs {
 		for _, validatorDiff := range subnetValidatorDiffs {
 			switch validatorDiff.validatorStatus {
@@ -684,6 +720,9 @@ func (d *diff) Apply(baseState State) error {
 	for _, subnet := range d.addedSubnets {
 		baseState.AddSubnet(subnet)
 	}
+	for subnetID, owner := range d.subnetOwners {
+		baseState.SetSubnetOwner(subnetID, owner)
+	}
 	for _, tx := range d.transformedSubnets {
 		baseState.AddSubnetTransformation(tx)
 	}
@@ -74,6 +74,46 @@ func TestDiffCurrentSupply(t *testing.T) {
 	require.Equal(initialCurrentSupply, returnedBaseCurrentSupply)
 }
 
+func TestDiffSupplyChanges(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	lastAcceptedID := ids.GenerateTestID()
+	state, _ := newInitializedState(require)
+	versions := NewMockVersions(ctrl)
+	versions.EXPECT().GetState(lastAcceptedID).AnyTimes().Return(state, true)
+
+	d, err := NewDiff(lastAcceptedID, versions)
+	require.NoError(err)
+
+	initialSupplyChanges, err := d.GetSupplyChanges(constants.PrimaryNetworkID)
+	require.NoError(err)
+	initialCurrentSupply, err := d.GetCurrentSupply(constants.PrimaryNetworkID)
+	require.NoError(err)
+	require.Equal(SupplyChanges{Base: initialCurrentSupply}, initialSupplyChanges)
+
+	newSupplyChanges := SupplyChanges{
+		Base:   initialSupplyChanges.Base,
+		Minted: 2,
+		Burned: 1,
+	}
+	d.SetSupplyChanges(constants.PrimaryNetworkID, newSupplyChanges)
+
+	returnedNewSupplyChanges, err := d.GetSupplyChanges(constants.PrimaryNetworkID)
+	require.NoError(err)
+	require.Equal(newSupplyChanges, returnedNewSupplyChanges)
+
+	returnedBaseSupplyChanges, err := state.GetSupplyChanges(constants.PrimaryNetworkID)
+	require.NoError(err)
+	require.Equal(initialSupplyChanges, returnedBaseSupplyChanges)
+
+	require.NoError(d.Apply(state))
+
+	returnedBaseSupplyChanges, err = state.GetSupplyChanges(constants.PrimaryNetworkID)
+	require.NoError(err)
+	require.Equal(newSupplyChanges, returnedBaseSupplyChanges)
+}
+
 func TestDiffCurrentValidator(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
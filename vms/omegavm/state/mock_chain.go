@@ -487,6 +487,21 @@ func (mr *MockChainMockRecorder) GetSubnets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnets", reflect.TypeOf((*MockChain)(nil).GetSubnets))
 }
 
+// GetSupplyChanges mocks base method.
+func (m *MockChain) GetSupplyChanges(arg0 ids.ID) (SupplyChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupplyChanges", arg0)
+	ret0, _ := ret[0].(SupplyChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupplyChanges indicates an expected call of GetSupplyChanges.
+func (mr *MockChainMockRecorder) GetSupplyChanges(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupplyChanges", reflect.TypeOf((*MockChain)(nil).GetSupplyChanges), arg0)
+}
+
 // GetTimestamp mocks base method.
 func (m *MockChain) GetTimestamp() time.Time {
 	m.ctrl.T.Helper()
@@ -654,6 +669,30 @@ func (mr *MockChainMockRecorder) SetStakerAccumulatedMintRate(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStakerAccumulatedMintRate", reflect.TypeOf((*MockChain)(nil).SetStakerAccumulatedMintRate), arg0)
 }
 
+// SetSubnetOwner mocks base method.
+func (m *MockChain) SetSubnetOwner(arg0 ids.ID, arg1 fx.Owner) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetOwner", arg0, arg1)
+}
+
+// SetSubnetOwner indicates an expected call of SetSubnetOwner.
+func (mr *MockChainMockRecorder) SetSubnetOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetOwner", reflect.TypeOf((*MockChain)(nil).SetSubnetOwner), arg0, arg1)
+}
+
+// SetSupplyChanges mocks base method.
+func (m *MockChain) SetSupplyChanges(arg0 ids.ID, arg1 SupplyChanges) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSupplyChanges", arg0, arg1)
+}
+
+// SetSupplyChanges indicates an expected call of SetSupplyChanges.
+func (mr *MockChainMockRecorder) SetSupplyChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSupplyChanges", reflect.TypeOf((*MockChain)(nil).SetSupplyChanges), arg0, arg1)
+}
+
 // SetTimestamp mocks base method.
 func (m *MockChain) SetTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
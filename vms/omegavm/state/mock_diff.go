@@ -501,6 +501,21 @@ func (mr *MockDiffMockRecorder) GetSubnets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnets", reflect.TypeOf((*MockDiff)(nil).GetSubnets))
 }
 
+// GetSupplyChanges mocks base method.
+func (m *MockDiff) GetSupplyChanges(arg0 ids.ID) (SupplyChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupplyChanges", arg0)
+	ret0, _ := ret[0].(SupplyChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupplyChanges indicates an expected call of GetSupplyChanges.
+func (mr *MockDiffMockRecorder) GetSupplyChanges(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupplyChanges", reflect.TypeOf((*MockDiff)(nil).GetSupplyChanges), arg0)
+}
+
 // GetTimestamp mocks base method.
 func (m *MockDiff) GetTimestamp() time.Time {
 	m.ctrl.T.Helper()
@@ -668,6 +683,30 @@ func (mr *MockDiffMockRecorder) SetStakerAccumulatedMintRate(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStakerAccumulatedMintRate", reflect.TypeOf((*MockDiff)(nil).SetStakerAccumulatedMintRate), arg0)
 }
 
+// SetSubnetOwner mocks base method.
+func (m *MockDiff) SetSubnetOwner(arg0 ids.ID, arg1 fx.Owner) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetOwner", arg0, arg1)
+}
+
+// SetSubnetOwner indicates an expected call of SetSubnetOwner.
+func (mr *MockDiffMockRecorder) SetSubnetOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetOwner", reflect.TypeOf((*MockDiff)(nil).SetSubnetOwner), arg0, arg1)
+}
+
+// SetSupplyChanges mocks base method.
+func (m *MockDiff) SetSupplyChanges(arg0 ids.ID, arg1 SupplyChanges) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSupplyChanges", arg0, arg1)
+}
+
+// SetSupplyChanges indicates an expected call of SetSupplyChanges.
+func (mr *MockDiffMockRecorder) SetSupplyChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSupplyChanges", reflect.TypeOf((*MockDiff)(nil).SetSupplyChanges), arg0, arg1)
+}
+
 // SetTimestamp mocks base method.
 func (m *MockDiff) SetTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
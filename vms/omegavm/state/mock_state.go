@@ -16,8 +16,10 @@ import (
 
 	database "github.com/DioneProtocol/odysseygo/database"
 	ids "github.com/DioneProtocol/odysseygo/ids"
+	uptime "github.com/DioneProtocol/odysseygo/snow/uptime"
 	validators "github.com/DioneProtocol/odysseygo/snow/validators"
 	logging "github.com/DioneProtocol/odysseygo/utils/logging"
+	set "github.com/DioneProtocol/odysseygo/utils/set"
 	dione "github.com/DioneProtocol/odysseygo/vms/components/dione"
 	blocks "github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
 	fx "github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
@@ -145,6 +147,30 @@ func (mr *MockStateMockRecorder) AddTx(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTx", reflect.TypeOf((*MockState)(nil).AddTx), arg0, arg1)
 }
 
+// AddConsumedAtomicUTXO mocks base method.
+func (m *MockState) AddConsumedAtomicUTXO(arg0, arg1 ids.ID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddConsumedAtomicUTXO", arg0, arg1)
+}
+
+// AddConsumedAtomicUTXO indicates an expected call of AddConsumedAtomicUTXO.
+func (mr *MockStateMockRecorder) AddConsumedAtomicUTXO(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddConsumedAtomicUTXO", reflect.TypeOf((*MockState)(nil).AddConsumedAtomicUTXO), arg0, arg1)
+}
+
+// AddTxReceipt mocks base method.
+func (m *MockState) AddTxReceipt(arg0 ids.ID, arg1 *TxReceipt) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddTxReceipt", arg0, arg1)
+}
+
+// AddTxReceipt indicates an expected call of AddTxReceipt.
+func (mr *MockStateMockRecorder) AddTxReceipt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTxReceipt", reflect.TypeOf((*MockState)(nil).AddTxReceipt), arg0, arg1)
+}
+
 // AddUTXO mocks base method.
 func (m *MockState) AddUTXO(arg0 *dione.UTXO) {
 	m.ctrl.T.Helper()
@@ -332,6 +358,21 @@ func (mr *MockStateMockRecorder) GetChains(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChains", reflect.TypeOf((*MockState)(nil).GetChains), arg0)
 }
 
+// GetConsumedAtomicUTXO mocks base method.
+func (m *MockState) GetConsumedAtomicUTXO(arg0 ids.ID) (ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumedAtomicUTXO", arg0)
+	ret0, _ := ret[0].(ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumedAtomicUTXO indicates an expected call of GetConsumedAtomicUTXO.
+func (mr *MockStateMockRecorder) GetConsumedAtomicUTXO(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumedAtomicUTXO", reflect.TypeOf((*MockState)(nil).GetConsumedAtomicUTXO), arg0)
+}
+
 // GetCurrentAccumulatedFee mocks base method.
 func (m *MockState) GetCurrentAccumulatedFee() (uint64, error) {
 	m.ctrl.T.Helper()
@@ -481,6 +522,21 @@ func (mr *MockStateMockRecorder) GetLastAccumulatedFee() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastAccumulatedFee", reflect.TypeOf((*MockState)(nil).GetLastAccumulatedFee))
 }
 
+// GetMaintenanceWindows mocks base method.
+func (m *MockState) GetMaintenanceWindows(arg0 ids.NodeID) ([]uptime.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindows", arg0)
+	ret0, _ := ret[0].([]uptime.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindows indicates an expected call of GetMaintenanceWindows.
+func (mr *MockStateMockRecorder) GetMaintenanceWindows(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindows", reflect.TypeOf((*MockState)(nil).GetMaintenanceWindows), arg0)
+}
+
 // GetPendingDelegatorIterator mocks base method.
 func (m *MockState) GetPendingDelegatorIterator(arg0 ids.ID, arg1 ids.NodeID) (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -586,6 +642,21 @@ func (mr *MockStateMockRecorder) GetStakerAccumulatedMintRate() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakerAccumulatedMintRate", reflect.TypeOf((*MockState)(nil).GetStakerAccumulatedMintRate))
 }
 
+// GetStakerTxIDs mocks base method.
+func (m *MockState) GetStakerTxIDs(arg0 ids.ShortID) ([]ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStakerTxIDs", arg0)
+	ret0, _ := ret[0].([]ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStakerTxIDs indicates an expected call of GetStakerTxIDs.
+func (mr *MockStateMockRecorder) GetStakerTxIDs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakerTxIDs", reflect.TypeOf((*MockState)(nil).GetStakerTxIDs), arg0)
+}
+
 // GetStartTime mocks base method.
 func (m *MockState) GetStartTime(arg0 ids.NodeID, arg1 ids.ID) (time.Time, error) {
 	m.ctrl.T.Helper()
@@ -661,6 +732,21 @@ func (mr *MockStateMockRecorder) GetSubnets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnets", reflect.TypeOf((*MockState)(nil).GetSubnets))
 }
 
+// GetSupplyChanges mocks base method.
+func (m *MockState) GetSupplyChanges(arg0 ids.ID) (SupplyChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupplyChanges", arg0)
+	ret0, _ := ret[0].(SupplyChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupplyChanges indicates an expected call of GetSupplyChanges.
+func (mr *MockStateMockRecorder) GetSupplyChanges(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupplyChanges", reflect.TypeOf((*MockState)(nil).GetSupplyChanges), arg0)
+}
+
 // GetTimestamp mocks base method.
 func (m *MockState) GetTimestamp() time.Time {
 	m.ctrl.T.Helper()
@@ -691,6 +777,36 @@ func (mr *MockStateMockRecorder) GetTx(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTx", reflect.TypeOf((*MockState)(nil).GetTx), arg0)
 }
 
+// GetTxBlockID mocks base method.
+func (m *MockState) GetTxBlockID(arg0 ids.ID) (ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxBlockID", arg0)
+	ret0, _ := ret[0].(ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxBlockID indicates an expected call of GetTxBlockID.
+func (mr *MockStateMockRecorder) GetTxBlockID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxBlockID", reflect.TypeOf((*MockState)(nil).GetTxBlockID), arg0)
+}
+
+// GetTxReceipt mocks base method.
+func (m *MockState) GetTxReceipt(arg0 ids.ID) (*TxReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxReceipt", arg0)
+	ret0, _ := ret[0].(*TxReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxReceipt indicates an expected call of GetTxReceipt.
+func (mr *MockStateMockRecorder) GetTxReceipt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxReceipt", reflect.TypeOf((*MockState)(nil).GetTxReceipt), arg0)
+}
+
 // GetUTXO mocks base method.
 func (m *MockState) GetUTXO(arg0 ids.ID) (*dione.UTXO, error) {
 	m.ctrl.T.Helper()
@@ -858,6 +974,20 @@ func (mr *MockStateMockRecorder) SetLastAccumulatedFee(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastAccumulatedFee", reflect.TypeOf((*MockState)(nil).SetLastAccumulatedFee), arg0)
 }
 
+// SetMaintenanceWindows mocks base method.
+func (m *MockState) SetMaintenanceWindows(arg0 ids.NodeID, arg1 []uptime.MaintenanceWindow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaintenanceWindows", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMaintenanceWindows indicates an expected call of SetMaintenanceWindows.
+func (mr *MockStateMockRecorder) SetMaintenanceWindows(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaintenanceWindows", reflect.TypeOf((*MockState)(nil).SetMaintenanceWindows), arg0, arg1)
+}
+
 // SetStakeSyncTimestamp mocks base method.
 func (m *MockState) SetStakeSyncTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
@@ -882,6 +1012,30 @@ func (mr *MockStateMockRecorder) SetStakerAccumulatedMintRate(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStakerAccumulatedMintRate", reflect.TypeOf((*MockState)(nil).SetStakerAccumulatedMintRate), arg0)
 }
 
+// SetSubnetOwner mocks base method.
+func (m *MockState) SetSubnetOwner(arg0 ids.ID, arg1 fx.Owner) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSubnetOwner", arg0, arg1)
+}
+
+// SetSubnetOwner indicates an expected call of SetSubnetOwner.
+func (mr *MockStateMockRecorder) SetSubnetOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubnetOwner", reflect.TypeOf((*MockState)(nil).SetSubnetOwner), arg0, arg1)
+}
+
+// SetSupplyChanges mocks base method.
+func (m *MockState) SetSupplyChanges(arg0 ids.ID, arg1 SupplyChanges) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSupplyChanges", arg0, arg1)
+}
+
+// SetSupplyChanges indicates an expected call of SetSupplyChanges.
+func (mr *MockStateMockRecorder) SetSupplyChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSupplyChanges", reflect.TypeOf((*MockState)(nil).SetSupplyChanges), arg0, arg1)
+}
+
 // SetTimestamp mocks base method.
 func (m *MockState) SetTimestamp(arg0 time.Time) {
 	m.ctrl.T.Helper()
@@ -923,6 +1077,20 @@ func (mr *MockStateMockRecorder) ShouldPrune() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShouldPrune", reflect.TypeOf((*MockState)(nil).ShouldPrune))
 }
 
+// TrackSubnet mocks base method.
+func (m *MockState) TrackSubnet(arg0 ids.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrackSubnet", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrackSubnet indicates an expected call of TrackSubnet.
+func (mr *MockStateMockRecorder) TrackSubnet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackSubnet", reflect.TypeOf((*MockState)(nil).TrackSubnet), arg0)
+}
+
 // UTXOIDs mocks base method.
 func (m *MockState) UTXOIDs(arg0 []byte, arg1 ids.ID, arg2 int) ([]ids.ID, error) {
 	m.ctrl.T.Helper()
@@ -938,6 +1106,20 @@ func (mr *MockStateMockRecorder) UTXOIDs(arg0, arg1, arg2 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UTXOIDs", reflect.TypeOf((*MockState)(nil).UTXOIDs), arg0, arg1, arg2)
 }
 
+// ValidatedSubnets mocks base method.
+func (m *MockState) ValidatedSubnets() set.Set[ids.ID] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatedSubnets")
+	ret0, _ := ret[0].(set.Set[ids.ID])
+	return ret0
+}
+
+// ValidatedSubnets indicates an expected call of ValidatedSubnets.
+func (mr *MockStateMockRecorder) ValidatedSubnets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatedSubnets", reflect.TypeOf((*MockState)(nil).ValidatedSubnets))
+}
+
 // ValidatorSet mocks base method.
 func (m *MockState) ValidatorSet(arg0 ids.ID, arg1 validators.Set) error {
 	m.ctrl.T.Helper()
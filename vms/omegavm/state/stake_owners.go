@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+// stakeOwners indexes the current and pending stakers by the addresses that own
+// their staked outputs, so that the stake of an address can be looked up
+// without iterating over every staker.
+type stakeOwners struct {
+	// owner -> txIDs of the stakers that have a staked output owned by owner
+	txIDs map[ids.ShortID]set.Set[ids.ID]
+	// txID -> owners of the staked outputs of the staker
+	owners map[ids.ID]set.Set[ids.ShortID]
+	// txIDs of the stakers that were added but whose txs haven't been indexed
+	// yet
+	unindexed set.Set[ids.ID]
+}
+
+func newStakeOwners() *stakeOwners {
+	return &stakeOwners{
+		txIDs:  make(map[ids.ShortID]set.Set[ids.ID]),
+		owners: make(map[ids.ID]set.Set[ids.ShortID]),
+	}
+}
+
+// addUnindexed marks the staker of [txID] to be indexed once its tx is added.
+func (s *stakeOwners) addUnindexed(txID ids.ID) {
+	s.unindexed.Add(txID)
+}
+
+// add indexes the staked outputs of [tx]. Txs that don't stake any outputs are
+// ignored.
+func (s *stakeOwners) add(txID ids.ID, tx *txs.Tx) {
+	s.unindexed.Remove(txID)
+
+	staker, ok := tx.Unsigned.(txs.PermissionlessStaker)
+	if !ok {
+		return
+	}
+
+	var owners set.Set[ids.ShortID]
+	for _, output := range staker.Stake() {
+		out := output.Out
+		if lockedOut, ok := out.(*stakeable.LockOut); ok {
+			out = lockedOut.TransferableOut
+		}
+		secpOut, ok := out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			continue
+		}
+		owners.Add(secpOut.Addrs...)
+	}
+	if owners.Len() == 0 {
+		return
+	}
+
+	s.owners[txID] = owners
+	for owner := range owners {
+		txIDs := s.txIDs[owner]
+		txIDs.Add(txID)
+		s.txIDs[owner] = txIDs
+	}
+}
+
+func (s *stakeOwners) remove(txID ids.ID) {
+	s.unindexed.Remove(txID)
+
+	owners := s.owners[txID]
+	delete(s.owners, txID)
+	for owner := range owners {
+		txIDs := s.txIDs[owner]
+		txIDs.Remove(txID)
+		if txIDs.Len() == 0 {
+			delete(s.txIDs, owner)
+		}
+	}
+}
+
+func (s *stakeOwners) get(owner ids.ShortID) []ids.ID {
+	return s.txIDs[owner].List()
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/hashing"
 	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/utils/timer"
 	"github.com/DioneProtocol/odysseygo/utils/wrappers"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
@@ -68,6 +69,7 @@ var (
 
 	blockIDPrefix                       = []byte("blockID")
 	blockPrefix                         = []byte("block")
+	txBlockIDPrefix                     = []byte("txBlockID")
 	validatorsPrefix                    = []byte("validators")
 	currentPrefix                       = []byte("current")
 	pendingPrefix                       = []byte("pending")
@@ -80,13 +82,18 @@ var (
 	flatValidatorWeightDiffsPrefix      = []byte("flatValidatorDiffs")
 	flatValidatorPublicKeyDiffsPrefix   = []byte("flatPublicKeyDiffs")
 	txPrefix                            = []byte("tx")
+	txReceiptPrefix                     = []byte("txReceipt")
+	consumedAtomicUTXOPrefix            = []byte("consumedAtomicUTXO")
 	rewardUTXOsPrefix                   = []byte("rewardUTXOs")
 	utxoPrefix                          = []byte("utxo")
 	subnetPrefix                        = []byte("subnet")
+	subnetOwnerPrefix                   = []byte("subnetOwner")
 	transformedSubnetPrefix             = []byte("transformedSubnet")
 	supplyPrefix                        = []byte("supply")
+	supplyChangesPrefix                 = []byte("supplyChanges")
 	chainPrefix                         = []byte("chain")
 	singletonPrefix                     = []byte("singleton")
+	maintenanceWindowPrefix             = []byte("maintenanceWindow")
 
 	timestampKey      = []byte("timestamp")
 	currentSupplyKey  = []byte("current supply")
@@ -122,6 +129,12 @@ type Chain interface {
 	GetCurrentSupply(subnetID ids.ID) (uint64, error)
 	SetCurrentSupply(subnetID ids.ID, cs uint64)
 
+	// GetSupplyChanges returns the changes made to the supply of [subnetID]
+	// since they started being tracked. If they weren't tracked yet, the
+	// current supply is returned as the base.
+	GetSupplyChanges(subnetID ids.ID) (SupplyChanges, error)
+	SetSupplyChanges(subnetID ids.ID, changes SupplyChanges)
+
 	GetRewardUTXOs(txID ids.ID) ([]*dione.UTXO, error)
 	AddRewardUTXO(txID ids.ID, utxo *dione.UTXO)
 
@@ -129,6 +142,7 @@ type Chain interface {
 	AddSubnet(createSubnetTx *txs.Tx)
 
 	GetSubnetOwner(subnetID ids.ID) (fx.Owner, error)
+	SetSubnetOwner(subnetID ids.ID, owner fx.Owner)
 
 	GetSubnetTransformation(subnetID ids.ID) (*txs.Tx, error)
 	AddSubnetTransformation(transformSubnetTx *txs.Tx)
@@ -162,12 +176,42 @@ type State interface {
 	// Invariant: [block] is an accepted block.
 	AddStatelessBlock(block blocks.Block)
 
+	// GetTxBlockID returns the ID of the accepted block that contains [txID].
+	GetTxBlockID(txID ids.ID) (ids.ID, error)
+
+	// GetTxReceipt returns the receipt recorded when [txID] was accepted in a
+	// standard block.
+	GetTxReceipt(txID ids.ID) (*TxReceipt, error)
+
+	// Invariant: [txID] was accepted in a standard block.
+	AddTxReceipt(txID ids.ID, receipt *TxReceipt)
+
+	// GetConsumedAtomicUTXO returns the ID of the accepted tx that imported
+	// the atomic UTXO [utxoID] from shared memory.
+	GetConsumedAtomicUTXO(utxoID ids.ID) (ids.ID, error)
+
+	// Invariant: [txID] was accepted and imported [utxoID].
+	AddConsumedAtomicUTXO(utxoID ids.ID, txID ids.ID)
+
+	// GetStakerTxIDs returns the IDs of the txs of the current and pending
+	// stakers that have a staked output owned by [owner].
+	GetStakerTxIDs(owner ids.ShortID) ([]ids.ID, error)
+
 	GetBlockIDAtHeight(height uint64) (ids.ID, error)
 
 	// ValidatorSet adds all the validators and delegators of [subnetID] into
 	// [vdrs].
 	ValidatorSet(subnetID ids.ID, vdrs validators.Set) error
 
+	// TrackSubnet adds [subnetID] to the tracked subnets and registers its
+	// current validator set with the node. The validator set is kept up to
+	// date from then on.
+	TrackSubnet(subnetID ids.ID) error
+
+	// ValidatedSubnets returns the subnets, other than the primary network,
+	// that this node is a current or pending validator of.
+	ValidatedSubnets() set.Set[ids.ID]
+
 	// ApplyValidatorWeightDiffs iterates from [startHeight] towards the genesis
 	// block until it has applied all of the diffs up to and including
 	// [endHeight]. Applying the diffs modifies [validators].
@@ -241,6 +285,16 @@ type stateBlk struct {
 	Status choices.Status `serialize:"true"`
 }
 
+type maintenanceWindows struct {
+	Windows []maintenanceWindow `serialize:"true"`
+}
+
+type maintenanceWindow struct {
+	Start      uint64        `serialize:"true"` // Unix time in seconds
+	End        uint64        `serialize:"true"` // Unix time in seconds
+	UpDuration time.Duration `serialize:"true"`
+}
+
 /*
  * VMDB
  * |-. validators
@@ -286,8 +340,14 @@ type stateBlk struct {
  * | '-- height -> blockID
  * |-. blocks
  * | '-- blockID -> block bytes
+ * |-. txBlockIDs
+ * | '-- txID -> blockID
  * |-. txs
  * | '-- txID -> tx bytes + tx status
+ * |-. txReceipts
+ * | '-- txID -> fee + num consumed + num produced
+ * |-. consumedAtomicUTXOs
+ * | '-- utxoID -> txID
  * |- rewardUTXOs
  * | '-. txID
  * |   '-. list
@@ -301,6 +361,10 @@ type stateBlk struct {
  * | '-. subnetID
  * |   '-. list
  * |     '-- txID -> nil
+ * |-. maintenanceWindows
+ * | '-- nodeID -> maintenance windows
+ * |-. supplyChanges
+ * | '-- subnetID -> base + minted + burned
  * '-. singletons
  *   |-- initializedKey -> nil
  *   |-- prunedKey -> nil
@@ -322,6 +386,7 @@ type state struct {
 
 	currentStakers *baseStakers
 	pendingStakers *baseStakers
+	stakeOwners    *stakeOwners
 
 	currentHeight uint64
 
@@ -333,6 +398,8 @@ type state struct {
 	blockCache  cache.Cacher[ids.ID, blocks.Block] // cache of blockID -> Block. If the entry is nil, it is not in the database
 	blockDB     database.Database
 
+	txBlockIDDB database.Database
+
 	validatorsDB                 database.Database
 	currentValidatorsDB          database.Database
 	currentValidatorBaseDB       database.Database
@@ -362,6 +429,12 @@ type state struct {
 	txCache  cache.Cacher[ids.ID, *txAndStatus] // txID -> {*txs.Tx, Status}. If the entry is nil, it isn't in the database
 	txDB     database.Database
 
+	addedTxReceipts map[ids.ID]*TxReceipt // map of txID -> receipt
+	txReceiptDB     database.Database
+
+	addedConsumedAtomicUTXOs map[ids.ID]ids.ID // map of utxoID -> txID
+	consumedAtomicUTXODB     database.Database
+
 	addedRewardUTXOs map[ids.ID][]*dione.UTXO            // map of txID -> []*UTXO
 	rewardUTXOsCache cache.Cacher[ids.ID, []*dione.UTXO] // txID -> []*UTXO
 	rewardUTXODB     database.Database
@@ -375,6 +448,10 @@ type state struct {
 	subnetBaseDB  database.Database
 	subnetDB      linkeddb.LinkedDB
 
+	subnetOwners     map[ids.ID]fx.Owner            // map of subnetID -> owner
+	subnetOwnerCache cache.Cacher[ids.ID, fx.Owner] // cache of subnetID -> owner
+	subnetOwnerDB    database.Database
+
 	transformedSubnets     map[ids.ID]*txs.Tx            // map of subnetID -> transformSubnetTx
 	transformedSubnetCache cache.Cacher[ids.ID, *txs.Tx] // cache of subnetID -> transformSubnetTx if the entry is nil, it is not in the database
 	transformedSubnetDB    database.Database
@@ -383,11 +460,19 @@ type state struct {
 	supplyCache      cache.Cacher[ids.ID, *uint64] // cache of subnetID -> current supply if the entry is nil, it is not in the database
 	supplyDB         database.Database
 
+	modifiedSupplyChanges map[ids.ID]SupplyChanges // map of subnetID -> supply changes
+	supplyChangesDB       database.Database
+
 	addedChains  map[ids.ID][]*txs.Tx                    // maps subnetID -> the newly added chains to the subnet
 	chainCache   cache.Cacher[ids.ID, []*txs.Tx]         // cache of subnetID -> the chains after all local modifications []*txs.Tx
 	chainDBCache cache.Cacher[ids.ID, linkeddb.LinkedDB] // cache of subnetID -> linkedDB
 	chainDB      database.Database
 
+	// map of nodeID -> maintenance windows. If the entry is empty, the windows
+	// have been removed.
+	modifiedMaintenanceWindows map[ids.NodeID][]uptime.MaintenanceWindow
+	maintenanceWindowDB        database.Database
+
 	// The persisted fields represent the current database value
 	timestamp, persistedTimestamp         time.Time
 	currentSupply, persistedCurrentSupply uint64
@@ -602,6 +687,15 @@ func newState(
 
 	subnetBaseDB := prefixdb.New(subnetPrefix, baseDB)
 
+	subnetOwnerCache, err := metercacher.New[ids.ID, fx.Owner](
+		"subnet_owner_cache",
+		metricsReg,
+		&cache.LRU[ids.ID, fx.Owner]{Size: execCfg.SubnetOwnerCacheSize},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	transformedSubnetCache, err := metercacher.New(
 		"transformed_subnet_cache",
 		metricsReg,
@@ -656,8 +750,11 @@ func newState(
 		blockCache:  blockCache,
 		blockDB:     prefixdb.New(blockPrefix, baseDB),
 
+		txBlockIDDB: prefixdb.New(txBlockIDPrefix, baseDB),
+
 		currentStakers: newBaseStakers(),
 		pendingStakers: newBaseStakers(),
+		stakeOwners:    newStakeOwners(),
 
 		validatorsDB:                    validatorsDB,
 		currentValidatorsDB:             currentValidatorsDB,
@@ -687,6 +784,12 @@ func newState(
 		txDB:     prefixdb.New(txPrefix, baseDB),
 		txCache:  txCache,
 
+		addedTxReceipts: make(map[ids.ID]*TxReceipt),
+		txReceiptDB:     prefixdb.New(txReceiptPrefix, baseDB),
+
+		addedConsumedAtomicUTXOs: make(map[ids.ID]ids.ID),
+		consumedAtomicUTXODB:     prefixdb.New(consumedAtomicUTXOPrefix, baseDB),
+
 		addedRewardUTXOs: make(map[ids.ID][]*dione.UTXO),
 		rewardUTXODB:     rewardUTXODB,
 		rewardUTXOsCache: rewardUTXOsCache,
@@ -698,6 +801,10 @@ func newState(
 		subnetBaseDB: subnetBaseDB,
 		subnetDB:     linkeddb.NewDefault(subnetBaseDB),
 
+		subnetOwners:     make(map[ids.ID]fx.Owner),
+		subnetOwnerCache: subnetOwnerCache,
+		subnetOwnerDB:    prefixdb.New(subnetOwnerPrefix, baseDB),
+
 		transformedSubnets:     make(map[ids.ID]*txs.Tx),
 		transformedSubnetCache: transformedSubnetCache,
 		transformedSubnetDB:    prefixdb.New(transformedSubnetPrefix, baseDB),
@@ -706,11 +813,17 @@ func newState(
 		supplyCache:      supplyCache,
 		supplyDB:         prefixdb.New(supplyPrefix, baseDB),
 
+		modifiedSupplyChanges: make(map[ids.ID]SupplyChanges),
+		supplyChangesDB:       prefixdb.New(supplyChangesPrefix, baseDB),
+
 		addedChains:  make(map[ids.ID][]*txs.Tx),
 		chainDB:      prefixdb.New(chainPrefix, baseDB),
 		chainCache:   chainCache,
 		chainDBCache: chainDBCache,
 
+		modifiedMaintenanceWindows: make(map[ids.NodeID][]uptime.MaintenanceWindow),
+		maintenanceWindowDB:        prefixdb.New(maintenanceWindowPrefix, baseDB),
+
 		singletonDB: prefixdb.New(singletonPrefix, baseDB),
 
 		stakerAccumulatedMintRate:          new(big.Int),
@@ -724,6 +837,17 @@ func (s *state) GetCurrentValidator(subnetID ids.ID, nodeID ids.NodeID) (*Staker
 	return s.currentStakers.GetValidator(subnetID, nodeID)
 }
 
+func (s *state) GetStakerTxIDs(owner ids.ShortID) ([]ids.ID, error) {
+	for txID := range s.stakeOwners.unindexed {
+		tx, _, err := s.GetTx(txID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get staker tx %s: %w", txID, err)
+		}
+		s.stakeOwners.add(txID, tx)
+	}
+	return s.stakeOwners.get(owner), nil
+}
+
 func (s *state) PutCurrentValidator(staker *Staker) {
 	s.currentStakers.PutValidator(staker)
 }
@@ -861,6 +985,29 @@ func (s *state) AddSubnet(createSubnetTx *txs.Tx) {
 }
 
 func (s *state) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
+	if owner, exists := s.subnetOwners[subnetID]; exists {
+		return owner, nil
+	}
+
+	if owner, cached := s.subnetOwnerCache.Get(subnetID); cached {
+		return owner, nil
+	}
+
+	ownerBytes, err := s.subnetOwnerDB.Get(subnetID[:])
+	if err == nil {
+		var owner fx.Owner
+		if _, err := txs.GenesisCodec.Unmarshal(ownerBytes, &owner); err != nil {
+			return nil, err
+		}
+		s.subnetOwnerCache.Put(subnetID, owner)
+		return owner, nil
+	}
+	if err != database.ErrNotFound {
+		return nil, err
+	}
+
+	// If the subnet's ownership was never transferred, the owner is the one
+	// specified when the subnet was created.
 	subnetIntf, _, err := s.GetTx(subnetID)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -876,9 +1023,14 @@ func (s *state) GetSubnetOwner(subnetID ids.ID) (fx.Owner, error) {
 		return nil, fmt.Errorf("%q %w", subnetID, errIsNotSubnet)
 	}
 
+	s.subnetOwnerCache.Put(subnetID, subnet.Owner)
 	return subnet.Owner, nil
 }
 
+func (s *state) SetSubnetOwner(subnetID ids.ID, owner fx.Owner) {
+	s.subnetOwners[subnetID] = owner
+}
+
 func (s *state) GetSubnetTransformation(subnetID ids.ID) (*txs.Tx, error) {
 	if tx, exists := s.transformedSubnets[subnetID]; exists {
 		return tx, nil
@@ -1006,6 +1158,37 @@ func (s *state) AddTx(tx *txs.Tx, status status.Status) {
 	}
 }
 
+func (s *state) GetTxReceipt(txID ids.ID) (*TxReceipt, error) {
+	if receipt, exists := s.addedTxReceipts[txID]; exists {
+		return receipt, nil
+	}
+	receiptBytes, err := s.txReceiptDB.Get(txID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := &TxReceipt{}
+	if _, err := txs.GenesisCodec.Unmarshal(receiptBytes, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (s *state) AddTxReceipt(txID ids.ID, receipt *TxReceipt) {
+	s.addedTxReceipts[txID] = receipt
+}
+
+func (s *state) GetConsumedAtomicUTXO(utxoID ids.ID) (ids.ID, error) {
+	if txID, exists := s.addedConsumedAtomicUTXOs[utxoID]; exists {
+		return txID, nil
+	}
+	return database.GetID(s.consumedAtomicUTXODB, utxoID[:])
+}
+
+func (s *state) AddConsumedAtomicUTXO(utxoID ids.ID, txID ids.ID) {
+	s.addedConsumedAtomicUTXOs[utxoID] = txID
+}
+
 func (s *state) GetRewardUTXOs(txID ids.ID) ([]*dione.UTXO, error) {
 	if utxos, exists := s.addedRewardUTXOs[txID]; exists {
 		return utxos, nil
@@ -1069,6 +1252,43 @@ func (s *state) GetStartTime(nodeID ids.NodeID, subnetID ids.ID) (time.Time, err
 	return staker.StartTime, nil
 }
 
+func (s *state) GetMaintenanceWindows(nodeID ids.NodeID) ([]uptime.MaintenanceWindow, error) {
+	if windows, modified := s.modifiedMaintenanceWindows[nodeID]; modified {
+		result := make([]uptime.MaintenanceWindow, len(windows))
+		copy(result, windows)
+		return result, nil
+	}
+
+	windowsBytes, err := s.maintenanceWindowDB.Get(nodeID[:])
+	if err == database.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stored := maintenanceWindows{}
+	if _, err := txs.GenesisCodec.Unmarshal(windowsBytes, &stored); err != nil {
+		return nil, fmt.Errorf("failed to parse maintenance windows: %w", err)
+	}
+	windows := make([]uptime.MaintenanceWindow, len(stored.Windows))
+	for i, window := range stored.Windows {
+		windows[i] = uptime.MaintenanceWindow{
+			Start:      time.Unix(int64(window.Start), 0),
+			End:        time.Unix(int64(window.End), 0),
+			UpDuration: window.UpDuration,
+		}
+	}
+	return windows, nil
+}
+
+func (s *state) SetMaintenanceWindows(nodeID ids.NodeID, windows []uptime.MaintenanceWindow) error {
+	stored := make([]uptime.MaintenanceWindow, len(windows))
+	copy(stored, windows)
+	s.modifiedMaintenanceWindows[nodeID] = stored
+	return nil
+}
+
 func (s *state) GetTimestamp() time.Time {
 	return s.timestamp
 }
@@ -1140,6 +1360,32 @@ func (s *state) SetCurrentSupply(subnetID ids.ID, cs uint64) {
 	}
 }
 
+func (s *state) GetSupplyChanges(subnetID ids.ID) (SupplyChanges, error) {
+	if changes, ok := s.modifiedSupplyChanges[subnetID]; ok {
+		return changes, nil
+	}
+
+	changesBytes, err := s.supplyChangesDB.Get(subnetID[:])
+	if err == database.ErrNotFound {
+		// The changes of the supply are tracked from now on.
+		supply, err := s.GetCurrentSupply(subnetID)
+		return SupplyChanges{Base: supply}, err
+	}
+	if err != nil {
+		return SupplyChanges{}, err
+	}
+
+	changes := SupplyChanges{}
+	if _, err := txs.GenesisCodec.Unmarshal(changesBytes, &changes); err != nil {
+		return SupplyChanges{}, fmt.Errorf("failed to parse supply changes: %w", err)
+	}
+	return changes, nil
+}
+
+func (s *state) SetSupplyChanges(subnetID ids.ID, changes SupplyChanges) {
+	s.modifiedSupplyChanges[subnetID] = changes
+}
+
 func (s *state) ValidatorSet(subnetID ids.ID, vdrs validators.Set) error {
 	for nodeID, validator := range s.currentStakers.validators[subnetID] {
 		staker := validator.validator
@@ -1340,6 +1586,9 @@ func (s *state) syncGenesis(genesisBlk blocks.Block, genesis *genesis.State) err
 	s.SetTimestamp(time.Unix(int64(genesis.Timestamp), 0))
 	s.SetStakeSyncTimestamp(s.GetTimestamp())
 	s.SetCurrentSupply(constants.PrimaryNetworkID, genesis.InitialSupply)
+	s.SetSupplyChanges(constants.PrimaryNetworkID, SupplyChanges{
+		Base: genesis.InitialSupply,
+	})
 	s.AddStatelessBlock(genesisBlk)
 
 	// Persist UTXOs that exist at genesis
@@ -1392,11 +1641,47 @@ func (s *state) load() error {
 		s.loadMetadata(),
 		s.loadCurrentValidators(),
 		s.loadPendingValidators(),
-		s.initValidatorSets(),
 	)
+	if s.cfg.TrackValidatedSubnets {
+		s.trackValidatedSubnets()
+	}
+	errs.Add(s.initValidatorSets())
 	return errs.Err
 }
 
+// trackValidatedSubnets adds the subnets that this node is a current or
+// pending validator of to the tracked subnets.
+//
+// Invariant: trackValidatedSubnets requires loadCurrentValidators and
+// loadPendingValidators to have already been called.
+func (s *state) trackValidatedSubnets() {
+	for subnetID := range s.ValidatedSubnets() {
+		if s.cfg.TrackedSubnets.Contains(subnetID) {
+			continue
+		}
+
+		s.ctx.Log.Info("tracking validated subnet",
+			zap.Stringer("subnetID", subnetID),
+		)
+		s.cfg.TrackedSubnets.Add(subnetID)
+	}
+}
+
+func (s *state) ValidatedSubnets() set.Set[ids.ID] {
+	var subnetIDs set.Set[ids.ID]
+	for _, stakers := range []*baseStakers{s.currentStakers, s.pendingStakers} {
+		for subnetID, subnetStakers := range stakers.validators {
+			if subnetID == constants.PrimaryNetworkID {
+				continue
+			}
+			if staker, ok := subnetStakers[s.ctx.NodeID]; ok && staker.validator != nil {
+				subnetIDs.Add(subnetID)
+			}
+		}
+	}
+	return subnetIDs
+}
+
 func (s *state) loadMetadata() error {
 	timestamp, err := database.GetTimestamp(s.singletonDB, timestampKey)
 	if err != nil {
@@ -1526,6 +1811,7 @@ func (s *state) loadCurrentValidators() error {
 		validator.validator = staker
 
 		s.currentStakers.stakers.ReplaceOrInsert(staker)
+		s.stakeOwners.add(txID, tx)
 
 		s.validatorState.LoadValidatorMetadata(staker.NodeID, staker.SubnetID, metadata)
 	}
@@ -1569,6 +1855,7 @@ func (s *state) loadCurrentValidators() error {
 		validator.validator = staker
 
 		s.currentStakers.stakers.ReplaceOrInsert(staker)
+		s.stakeOwners.add(txID, tx)
 
 		s.validatorState.LoadValidatorMetadata(staker.NodeID, staker.SubnetID, metadata)
 	}
@@ -1616,6 +1903,7 @@ func (s *state) loadCurrentValidators() error {
 			validator.delegators.ReplaceOrInsert(staker)
 
 			s.currentStakers.stakers.ReplaceOrInsert(staker)
+			s.stakeOwners.add(txID, tx)
 		}
 	}
 
@@ -1664,6 +1952,7 @@ func (s *state) loadPendingValidators() error {
 			validator.validator = staker
 
 			s.pendingStakers.stakers.ReplaceOrInsert(staker)
+			s.stakeOwners.add(txID, tx)
 		}
 	}
 
@@ -1702,6 +1991,7 @@ func (s *state) loadPendingValidators() error {
 			validator.delegators.ReplaceOrInsert(staker)
 
 			s.pendingStakers.stakers.ReplaceOrInsert(staker)
+			s.stakeOwners.add(txID, tx)
 		}
 	}
 
@@ -1738,23 +2028,43 @@ func (s *state) initValidatorSets() error {
 	s.metrics.SetTotalStake(primaryValidators.Weight())
 
 	for subnetID := range s.cfg.TrackedSubnets {
-		subnetValidators := validators.NewSet()
-		err := s.ValidatorSet(subnetID, subnetValidators)
-		if err != nil {
+		if err := s.initSubnetValidatorSet(subnetID); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		if !s.cfg.Validators.Add(subnetID, subnetValidators) {
-			return fmt.Errorf("%w: %s", errDuplicateValidatorSet, subnetID)
-		}
+func (s *state) initSubnetValidatorSet(subnetID ids.ID) error {
+	subnetValidators := validators.NewSet()
+	err := s.ValidatorSet(subnetID, subnetValidators)
+	if err != nil {
+		return err
+	}
+
+	if !s.cfg.Validators.Add(subnetID, subnetValidators) {
+		return fmt.Errorf("%w: %s", errDuplicateValidatorSet, subnetID)
+	}
+
+	vl := validators.NewLogger(s.ctx.Log, s.bootstrapped, subnetID, s.ctx.NodeID)
+	subnetValidators.RegisterCallbackListener(vl)
+	return nil
+}
 
-		vl := validators.NewLogger(s.ctx.Log, s.bootstrapped, subnetID, s.ctx.NodeID)
-		subnetValidators.RegisterCallbackListener(vl)
+func (s *state) TrackSubnet(subnetID ids.ID) error {
+	if subnetID == constants.PrimaryNetworkID || s.cfg.TrackedSubnets.Contains(subnetID) {
+		return nil
+	}
+	if err := s.initSubnetValidatorSet(subnetID); err != nil {
+		return err
 	}
+	s.cfg.TrackedSubnets.Add(subnetID)
 	return nil
 }
 
 func (s *state) write(updateValidators bool, height uint64) error {
+	s.updateStakeOwners() // Must be called before the staker diffs are written
+
 	errs := wrappers.Errs{}
 	errs.Add(
 		s.writeBlocks(),
@@ -1762,12 +2072,17 @@ func (s *state) write(updateValidators bool, height uint64) error {
 		s.writePendingStakers(),
 		s.WriteValidatorMetadata(s.currentValidatorList, s.currentSubnetValidatorList), // Must be called after writeCurrentStakers
 		s.writeTXs(),
+		s.writeTxReceipts(),
+		s.writeConsumedAtomicUTXOs(),
 		s.writeRewardUTXOs(),
 		s.writeUTXOs(),
 		s.writeSubnets(),
+		s.writeSubnetOwners(),
 		s.writeTransformedSubnets(),
 		s.writeSubnetSupplies(),
+		s.writeSupplyChanges(),
 		s.writeChains(),
+		s.writeMaintenanceWindows(), // Must be called after writeCurrentStakers
 		s.writeMetadata(),
 	)
 	return errs.Err
@@ -1788,15 +2103,21 @@ func (s *state) Close() error {
 		s.currentValidatorsDB.Close(),
 		s.validatorsDB.Close(),
 		s.txDB.Close(),
+		s.txReceiptDB.Close(),
+		s.consumedAtomicUTXODB.Close(),
 		s.rewardUTXODB.Close(),
 		s.utxoDB.Close(),
 		s.subnetBaseDB.Close(),
+		s.subnetOwnerDB.Close(),
 		s.transformedSubnetDB.Close(),
 		s.supplyDB.Close(),
+		s.supplyChangesDB.Close(),
 		s.chainDB.Close(),
+		s.maintenanceWindowDB.Close(),
 		s.singletonDB.Close(),
 		s.blockDB.Close(),
 		s.blockIDDB.Close(),
+		s.txBlockIDDB.Close(),
 	)
 	return errs.Err
 }
@@ -1946,6 +2267,13 @@ func (s *state) writeBlocks() error {
 		if err := s.blockDB.Put(blkID[:], blkBytes); err != nil {
 			return fmt.Errorf("failed to write block %s: %w", blkID, err)
 		}
+
+		for _, tx := range blk.Txs() {
+			txID := tx.ID()
+			if err := database.PutID(s.txBlockIDDB, txID[:], blkID); err != nil {
+				return fmt.Errorf("failed to index tx %s: %w", txID, err)
+			}
+		}
 	}
 	return nil
 }
@@ -1985,6 +2313,17 @@ func (s *state) GetStatelessBlock(blockID ids.ID) (blocks.Block, error) {
 	return blk, nil
 }
 
+func (s *state) GetTxBlockID(txID ids.ID) (ids.ID, error) {
+	for blkID, blk := range s.addedBlocks {
+		for _, tx := range blk.Txs() {
+			if tx.ID() == txID {
+				return blkID, nil
+			}
+		}
+	}
+	return database.GetID(s.txBlockIDDB, txID[:])
+}
+
 func (s *state) GetBlockIDAtHeight(height uint64) (ids.ID, error) {
 	if blkID, exists := s.addedBlockIDs[height]; exists {
 		return blkID, nil
@@ -2012,6 +2351,42 @@ func (s *state) GetBlockIDAtHeight(height uint64) (ids.ID, error) {
 	return blkID, nil
 }
 
+// updateStakeOwners applies the staker diffs that haven't been written yet to
+// the stake owners index. Removals are applied first so that a staker moving
+// from the pending to the current set remains indexed. The txs of the added
+// stakers are indexed lazily by GetStakerTxIDs.
+func (s *state) updateStakeOwners() {
+	stakers := []*baseStakers{s.currentStakers, s.pendingStakers}
+	for _, stakers := range stakers {
+		for _, validatorDiffs := range stakers.validatorDiffs {
+			for _, validatorDiff := range validatorDiffs {
+				if validatorDiff.validatorStatus == deleted {
+					s.stakeOwners.remove(validatorDiff.validator.TxID)
+				}
+				for txID := range validatorDiff.deletedDelegators {
+					s.stakeOwners.remove(txID)
+				}
+			}
+		}
+	}
+
+	for _, stakers := range stakers {
+		for _, validatorDiffs := range stakers.validatorDiffs {
+			for _, validatorDiff := range validatorDiffs {
+				if validatorDiff.validatorStatus == added {
+					s.stakeOwners.addUnindexed(validatorDiff.validator.TxID)
+				}
+				if validatorDiff.addedDelegators != nil {
+					validatorDiff.addedDelegators.Ascend(func(delegator *Staker) bool {
+						s.stakeOwners.addUnindexed(delegator.TxID)
+						return true
+					})
+				}
+			}
+		}
+	}
+}
+
 func (s *state) writeCurrentStakers(updateValidators bool, height uint64) error {
 	heightBytes := database.PackUInt64(height)
 	rawNestedPublicKeyDiffDB := prefixdb.New(heightBytes, s.nestedValidatorPublicKeyDiffsDB)
@@ -2140,6 +2515,12 @@ func (s *state) writeCurrentStakers(updateValidators bool, height uint64) error
 				}
 
 				s.validatorState.DeleteValidatorMetadata(nodeID, subnetID)
+
+				// The maintenance windows of a validator only excuse downtime
+				// during its staking period, so they expire with it.
+				if subnetID == constants.PrimaryNetworkID {
+					s.modifiedMaintenanceWindows[nodeID] = nil
+				}
 			}
 
 			err := writeCurrentDelegatorDiff(
@@ -2352,6 +2733,35 @@ func (s *state) writeTXs() error {
 	return nil
 }
 
+func (s *state) writeTxReceipts() error {
+	for txID, receipt := range s.addedTxReceipts {
+		txID := txID
+
+		receiptBytes, err := txs.GenesisCodec.Marshal(txs.Version, receipt)
+		if err != nil {
+			return fmt.Errorf("failed to serialize tx receipt: %w", err)
+		}
+
+		delete(s.addedTxReceipts, txID)
+		if err := s.txReceiptDB.Put(txID[:], receiptBytes); err != nil {
+			return fmt.Errorf("failed to add tx receipt: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *state) writeConsumedAtomicUTXOs() error {
+	for utxoID, txID := range s.addedConsumedAtomicUTXOs {
+		utxoID := utxoID
+
+		delete(s.addedConsumedAtomicUTXOs, utxoID)
+		if err := database.PutID(s.consumedAtomicUTXODB, utxoID[:], txID); err != nil {
+			return fmt.Errorf("failed to add consumed atomic UTXO: %w", err)
+		}
+	}
+	return nil
+}
+
 func (s *state) writeRewardUTXOs() error {
 	for txID, utxos := range s.addedRewardUTXOs {
 		delete(s.addedRewardUTXOs, txID)
@@ -2402,6 +2812,24 @@ func (s *state) writeSubnets() error {
 	return nil
 }
 
+func (s *state) writeSubnetOwners() error {
+	for subnetID, owner := range s.subnetOwners {
+		owner := owner
+		delete(s.subnetOwners, subnetID)
+
+		ownerBytes, err := txs.GenesisCodec.Marshal(txs.Version, &owner)
+		if err != nil {
+			return fmt.Errorf("failed to marshal subnet owner: %w", err)
+		}
+
+		s.subnetOwnerCache.Put(subnetID, owner)
+		if err := s.subnetOwnerDB.Put(subnetID[:], ownerBytes); err != nil {
+			return fmt.Errorf("failed to write subnet owner: %w", err)
+		}
+	}
+	return nil
+}
+
 func (s *state) writeTransformedSubnets() error {
 	for subnetID, tx := range s.transformedSubnets {
 		txID := tx.ID()
@@ -2430,6 +2858,22 @@ func (s *state) writeSubnetSupplies() error {
 	return nil
 }
 
+func (s *state) writeSupplyChanges() error {
+	for subnetID, changes := range s.modifiedSupplyChanges {
+		subnetID := subnetID
+		delete(s.modifiedSupplyChanges, subnetID)
+
+		changesBytes, err := txs.GenesisCodec.Marshal(txs.Version, &changes)
+		if err != nil {
+			return fmt.Errorf("failed to marshal supply changes: %w", err)
+		}
+		if err := s.supplyChangesDB.Put(subnetID[:], changesBytes); err != nil {
+			return fmt.Errorf("failed to write supply changes: %w", err)
+		}
+	}
+	return nil
+}
+
 func (s *state) writeChains() error {
 	for subnetID, chains := range s.addedChains {
 		for _, chain := range chains {
@@ -2445,6 +2889,39 @@ func (s *state) writeChains() error {
 	return nil
 }
 
+func (s *state) writeMaintenanceWindows() error {
+	for nodeID, windows := range s.modifiedMaintenanceWindows {
+		nodeID := nodeID
+		delete(s.modifiedMaintenanceWindows, nodeID)
+
+		if len(windows) == 0 {
+			if err := s.maintenanceWindowDB.Delete(nodeID[:]); err != nil {
+				return fmt.Errorf("failed to delete maintenance windows: %w", err)
+			}
+			continue
+		}
+
+		stored := maintenanceWindows{
+			Windows: make([]maintenanceWindow, len(windows)),
+		}
+		for i, window := range windows {
+			stored.Windows[i] = maintenanceWindow{
+				Start:      uint64(window.Start.Unix()),
+				End:        uint64(window.End.Unix()),
+				UpDuration: window.UpDuration,
+			}
+		}
+		windowsBytes, err := txs.GenesisCodec.Marshal(txs.Version, &stored)
+		if err != nil {
+			return fmt.Errorf("failed to marshal maintenance windows: %w", err)
+		}
+		if err := s.maintenanceWindowDB.Put(nodeID[:], windowsBytes); err != nil {
+			return fmt.Errorf("failed to write maintenance windows: %w", err)
+		}
+	}
+	return nil
+}
+
 func (s *state) writeMetadata() error {
 	if !s.persistedTimestamp.Equal(s.timestamp) {
 		if err := database.PutTimestamp(s.singletonDB, timestampKey, s.timestamp); err != nil {
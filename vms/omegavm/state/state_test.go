@@ -20,11 +20,14 @@ import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/choices"
+	"github.com/DioneProtocol/odysseygo/snow/uptime"
 	"github.com/DioneProtocol/odysseygo/snow/validators"
 	"github.com/DioneProtocol/odysseygo/utils"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
 	"github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/utils/units"
 	"github.com/DioneProtocol/odysseygo/utils/wrappers"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
@@ -33,6 +36,8 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/genesis"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/metrics"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 )
@@ -660,3 +665,299 @@ func TestParsedStateBlock(t *testing.T) {
 		require.Equal(blk.ID(), gotBlk.ID())
 	}
 }
+
+func TestStateTxBlockID(t *testing.T) {
+	require := require.New(t)
+	s, db := newInitializedState(require)
+
+	blk, err := blocks.NewBanffStandardBlock(time.Now(), ids.GenerateTestID(), 1, []*txs.Tx{
+		{
+			Unsigned: &txs.RewardValidatorTx{
+				TxID: ids.GenerateTestID(),
+			},
+		},
+	})
+	require.NoError(err)
+	txID := blk.Txs()[0].ID()
+
+	_, err = s.GetTxBlockID(txID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	s.AddStatelessBlock(blk)
+
+	blkID, err := s.GetTxBlockID(txID)
+	require.NoError(err)
+	require.Equal(blk.ID(), blkID)
+
+	require.NoError(s.Commit())
+
+	s = newStateFromDB(require, db)
+	blkID, err = s.GetTxBlockID(txID)
+	require.NoError(err)
+	require.Equal(blk.ID(), blkID)
+}
+
+func TestStateTxReceipts(t *testing.T) {
+	require := require.New(t)
+	s, db := newInitializedState(require)
+
+	txID := ids.GenerateTestID()
+	_, err := s.GetTxReceipt(txID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	receipt := &TxReceipt{
+		Fee:         1_000,
+		NumConsumed: 2,
+		NumProduced: 3,
+	}
+	s.AddTxReceipt(txID, receipt)
+
+	gotReceipt, err := s.GetTxReceipt(txID)
+	require.NoError(err)
+	require.Equal(receipt, gotReceipt)
+
+	require.NoError(s.Commit())
+
+	s = newStateFromDB(require, db)
+	gotReceipt, err = s.GetTxReceipt(txID)
+	require.NoError(err)
+	require.Equal(receipt, gotReceipt)
+}
+
+func TestStateStakerTxIDs(t *testing.T) {
+	require := require.New(t)
+	s, db := newInitializedState(require)
+
+	owner := ids.GenerateTestShortID()
+	validatorTx := &txs.Tx{Unsigned: &txs.AddValidatorTx{
+		Validator: txs.Validator{
+			NodeID: ids.GenerateTestNodeID(),
+			Start:  uint64(initialTime.Unix()),
+			End:    uint64(initialValidatorEndTime.Unix()),
+			Wght:   units.Dione,
+		},
+		StakeOuts: []*dione.TransferableOutput{
+			{
+				Asset: dione.Asset{ID: initialTxID},
+				Out: &stakeable.LockOut{
+					TransferableOut: &secp256k1fx.TransferOutput{
+						Amt: units.Dione,
+						OutputOwners: secp256k1fx.OutputOwners{
+							Threshold: 1,
+							Addrs:     []ids.ShortID{owner},
+						},
+					},
+				},
+			},
+		},
+		RewardsOwner:     &secp256k1fx.OutputOwners{},
+		DelegationShares: reward.PercentDenominator,
+	}}
+	require.NoError(validatorTx.Initialize(txs.Codec))
+	txID := validatorTx.ID()
+	stakerTx := validatorTx.Unsigned.(txs.Staker)
+
+	pendingStaker, err := NewPendingStaker(txID, stakerTx)
+	require.NoError(err)
+	s.AddTx(validatorTx, status.Committed)
+	s.PutPendingValidator(pendingStaker)
+	require.NoError(s.Commit())
+
+	txIDs, err := s.GetStakerTxIDs(owner)
+	require.NoError(err)
+	require.Equal([]ids.ID{txID}, txIDs)
+
+	txIDs, err = s.GetStakerTxIDs(ids.GenerateTestShortID())
+	require.NoError(err)
+	require.Empty(txIDs)
+
+	// Promoting the staker keeps it indexed.
+	currentStaker, err := NewCurrentStakerWithRewardRate(txID, stakerTx, 0, new(big.Int), new(big.Int))
+	require.NoError(err)
+	s.DeletePendingValidator(pendingStaker)
+	s.PutCurrentValidator(currentStaker)
+	require.NoError(s.Commit())
+
+	txIDs, err = s.GetStakerTxIDs(owner)
+	require.NoError(err)
+	require.Equal([]ids.ID{txID}, txIDs)
+
+	// The index is rebuilt when the state is loaded.
+	s = newStateFromDB(require, db)
+	require.NoError(s.(*state).load())
+	txIDs, err = s.GetStakerTxIDs(owner)
+	require.NoError(err)
+	require.Equal([]ids.ID{txID}, txIDs)
+
+	s.DeleteCurrentValidator(currentStaker)
+	require.NoError(s.Commit())
+
+	txIDs, err = s.GetStakerTxIDs(owner)
+	require.NoError(err)
+	require.Empty(txIDs)
+}
+
+func TestStateSubnetOwner(t *testing.T) {
+	require := require.New(t)
+	s, db := newInitializedState(require)
+
+	var (
+		originalOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+		newOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+	)
+
+	createSubnetTx, err := txs.NewSigned(&txs.CreateSubnetTx{
+		BaseTx: txs.BaseTx{},
+		Owner:  originalOwner,
+	}, txs.Codec, nil)
+	require.NoError(err)
+	subnetID := createSubnetTx.ID()
+
+	s.AddTx(createSubnetTx, status.Committed)
+	s.AddSubnet(createSubnetTx)
+
+	owner, err := s.GetSubnetOwner(subnetID)
+	require.NoError(err)
+	require.Equal(originalOwner, owner)
+
+	s.SetSubnetOwner(subnetID, newOwner)
+
+	owner, err = s.GetSubnetOwner(subnetID)
+	require.NoError(err)
+	require.Equal(newOwner, owner)
+
+	require.NoError(s.Commit())
+
+	s = newStateFromDB(require, db)
+	owner, err = s.GetSubnetOwner(subnetID)
+	require.NoError(err)
+	require.Equal(newOwner, owner)
+}
+
+func TestStateMaintenanceWindows(t *testing.T) {
+	require := require.New(t)
+	s, db := newInitializedState(require)
+
+	staker := &Staker{
+		TxID:             ids.GenerateTestID(),
+		NodeID:           ids.GenerateTestNodeID(),
+		SubnetID:         constants.PrimaryNetworkID,
+		Weight:           units.Dione,
+		StartTime:        initialTime,
+		EndTime:          initialValidatorEndTime,
+		MintRate:         new(big.Int),
+		FeePerWeightPaid: new(big.Int),
+	}
+	s.PutCurrentValidator(staker)
+	require.NoError(s.Commit())
+
+	windows, err := s.GetMaintenanceWindows(staker.NodeID)
+	require.NoError(err)
+	require.Empty(windows)
+
+	expectedWindows := []uptime.MaintenanceWindow{
+		{
+			Start:      initialTime.Add(time.Hour),
+			End:        initialTime.Add(2 * time.Hour),
+			UpDuration: 30 * time.Minute,
+		},
+	}
+	require.NoError(s.SetMaintenanceWindows(staker.NodeID, expectedWindows))
+
+	windows, err = s.GetMaintenanceWindows(staker.NodeID)
+	require.NoError(err)
+	require.Equal(expectedWindows, windows)
+
+	require.NoError(s.Commit())
+
+	windows, err = newStateFromDB(require, db).GetMaintenanceWindows(staker.NodeID)
+	require.NoError(err)
+	require.Equal(expectedWindows, windows)
+
+	// The windows expire once the validator is removed.
+	s.DeleteCurrentValidator(staker)
+	require.NoError(s.Commit())
+
+	windows, err = newStateFromDB(require, db).GetMaintenanceWindows(staker.NodeID)
+	require.NoError(err)
+	require.Empty(windows)
+}
+
+func TestStateTrackValidatedSubnets(t *testing.T) {
+	require := require.New(t)
+	s, _ := newUninitializedState(require)
+	st := s.(*state)
+
+	nodeID := ids.GenerateTestNodeID()
+	st.ctx = &snow.Context{
+		NodeID: nodeID,
+		Log:    logging.NoLog{},
+	}
+
+	var (
+		trackedSubnetID   = ids.GenerateTestID()
+		currentSubnetID   = ids.GenerateTestID()
+		pendingSubnetID   = ids.GenerateTestID()
+		unrelatedSubnetID = ids.GenerateTestID()
+	)
+	st.cfg.TrackedSubnets = set.Of(trackedSubnetID)
+
+	st.currentStakers.PutValidator(&Staker{
+		TxID:     ids.GenerateTestID(),
+		NodeID:   nodeID,
+		SubnetID: currentSubnetID,
+	})
+	st.currentStakers.PutValidator(&Staker{
+		TxID:     ids.GenerateTestID(),
+		NodeID:   nodeID,
+		SubnetID: constants.PrimaryNetworkID,
+	})
+	st.pendingStakers.PutValidator(&Staker{
+		TxID:     ids.GenerateTestID(),
+		NodeID:   nodeID,
+		SubnetID: pendingSubnetID,
+	})
+	st.currentStakers.PutValidator(&Staker{
+		TxID:     ids.GenerateTestID(),
+		NodeID:   ids.GenerateTestNodeID(),
+		SubnetID: unrelatedSubnetID,
+	})
+
+	st.trackValidatedSubnets()
+	require.Equal(set.Of(trackedSubnetID, currentSubnetID, pendingSubnetID), st.cfg.TrackedSubnets)
+}
+
+func TestStateTrackSubnet(t *testing.T) {
+	require := require.New(t)
+	s, _ := newUninitializedState(require)
+	st := s.(*state)
+	st.ctx = &snow.Context{
+		NodeID: ids.GenerateTestNodeID(),
+		Log:    logging.NoLog{},
+	}
+
+	subnetID := ids.GenerateTestID()
+	staker := &Staker{
+		TxID:     ids.GenerateTestID(),
+		NodeID:   ids.GenerateTestNodeID(),
+		SubnetID: subnetID,
+		Weight:   1,
+	}
+	st.currentStakers.PutValidator(staker)
+
+	require.NoError(st.TrackSubnet(subnetID))
+	require.True(st.cfg.TrackedSubnets.Contains(subnetID))
+
+	vdrs, ok := st.cfg.Validators.Get(subnetID)
+	require.True(ok)
+	require.Equal(staker.Weight, vdrs.GetWeight(staker.NodeID))
+
+	// Tracking the subnet again doesn't register another validator set
+	require.NoError(st.TrackSubnet(subnetID))
+}
@@ -0,0 +1,18 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+// SupplyChanges are the changes made to the supply of a subnet since they
+// started being tracked. The current supply of the subnet is expected to equal
+// Base + Minted - Burned.
+type SupplyChanges struct {
+	// Base is the supply of the subnet when its changes started being tracked.
+	// This is the initial supply of subnets created after tracking began.
+	Base uint64 `serialize:"true"`
+	// Minted is the amount of staking rewards added to the supply.
+	Minted uint64 `serialize:"true"`
+	// Burned is the amount of staking rewards removed from the supply because
+	// they weren't paid out.
+	Burned uint64 `serialize:"true"`
+}
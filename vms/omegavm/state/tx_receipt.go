@@ -0,0 +1,15 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+// TxReceipt describes the outcome of executing a decision tx that was accepted
+// in a standard block.
+type TxReceipt struct {
+	// Fee is the amount of DIONE burned by the tx.
+	Fee uint64 `serialize:"true"`
+	// NumConsumed is the number of UTXOs consumed by the tx.
+	NumConsumed uint32 `serialize:"true"`
+	// NumProduced is the number of UTXOs produced by the tx.
+	NumProduced uint32 `serialize:"true"`
+}
@@ -0,0 +1,247 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/math"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+)
+
+const auditLockRetryFrequency = 100 * time.Millisecond
+
+var (
+	errSupplyBelowInitial   = errors.New("current supply is below the initial supply")
+	errSupplyAboveMaximum   = errors.New("current supply is above the maximum supply")
+	errSupplyMismatch       = errors.New("current supply doesn't match the minted and burned rewards")
+	errStakedWeightMismatch = errors.New("staked weight doesn't match the validator set")
+)
+
+// runStateAudits periodically audits the state until [ctx] is cancelled.
+func (vm *VM) runStateAudits(ctx context.Context, frequency time.Duration) {
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !vm.lockForAudit(ctx) {
+			return
+		}
+		// The validator set is only maintained after bootstrapping.
+		if !vm.bootstrapped.Get() {
+			vm.ctx.Lock.Unlock()
+			continue
+		}
+
+		start := time.Now()
+		mismatches, err := vm.auditState()
+		vm.ctx.Lock.Unlock()
+
+		if err != nil {
+			vm.ctx.Log.Warn("failed to audit state",
+				zap.Error(err),
+			)
+			continue
+		}
+
+		vm.metrics.MarkStateAudit(len(mismatches))
+		for _, mismatch := range mismatches {
+			vm.ctx.Log.Error("state audit found an inconsistency",
+				zap.Error(mismatch),
+			)
+		}
+		vm.ctx.Log.Debug("finished state audit",
+			zap.Int("numMismatches", len(mismatches)),
+			zap.Duration("duration", time.Since(start)),
+		)
+	}
+}
+
+// lockForAudit grabs the context lock and reports whether it did. It gives up
+// once [ctx] is cancelled, as Shutdown holds the context lock while waiting
+// for the audits to exit.
+func (vm *VM) lockForAudit(ctx context.Context) bool {
+	ticker := time.NewTicker(auditLockRetryFrequency)
+	defer ticker.Stop()
+
+	for !vm.ctx.Lock.TryLock() {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+	return true
+}
+
+// auditState re-derives aggregate values from the state and returns every
+// invariant that they violate. An error is only returned if the state
+// couldn't be read.
+//
+// Invariant: assumes the context lock is held.
+func (vm *VM) auditState() ([]error, error) {
+	supplyMismatches, err := vm.auditSupplies()
+	if err != nil {
+		return nil, err
+	}
+	stakeMismatches, err := vm.auditStakedWeights()
+	if err != nil {
+		return nil, err
+	}
+	return append(supplyMismatches, stakeMismatches...), nil
+}
+
+// auditSupplies verifies that the current supply of every subnet lies between
+// its initial supply and its maximum supply, and that it equals the supply the
+// subnet started with plus the minted rewards minus the burned rewards.
+func (vm *VM) auditSupplies() ([]error, error) {
+	var mismatches []error
+
+	supply, err := vm.state.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return nil, err
+	}
+	mismatches, err = vm.auditSupplyChanges(mismatches, constants.PrimaryNetworkID, supply)
+	if err != nil {
+		return nil, err
+	}
+	if supply < vm.initialSupply {
+		mismatches = append(mismatches, fmt.Errorf("%w: subnet %s has supply %d < %d",
+			errSupplyBelowInitial,
+			constants.PrimaryNetworkID,
+			supply,
+			vm.initialSupply,
+		))
+	}
+
+	subnets, err := vm.state.GetSubnets()
+	if err != nil {
+		return nil, err
+	}
+	for _, subnet := range subnets {
+		subnetID := subnet.ID()
+		transformSubnetIntf, err := vm.state.GetSubnetTransformation(subnetID)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		transformSubnet, ok := transformSubnetIntf.Unsigned.(*txs.TransformSubnetTx)
+		if !ok {
+			return nil, fmt.Errorf("expected tx type *txs.TransformSubnetTx but got %T", transformSubnetIntf.Unsigned)
+		}
+
+		supply, err := vm.state.GetCurrentSupply(subnetID)
+		if err != nil {
+			return nil, err
+		}
+		mismatches, err = vm.auditSupplyChanges(mismatches, subnetID, supply)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case supply < transformSubnet.InitialSupply:
+			mismatches = append(mismatches, fmt.Errorf("%w: subnet %s has supply %d < %d",
+				errSupplyBelowInitial,
+				subnetID,
+				supply,
+				transformSubnet.InitialSupply,
+			))
+		case supply > transformSubnet.MaximumSupply:
+			mismatches = append(mismatches, fmt.Errorf("%w: subnet %s has supply %d > %d",
+				errSupplyAboveMaximum,
+				subnetID,
+				supply,
+				transformSubnet.MaximumSupply,
+			))
+		}
+	}
+	return mismatches, nil
+}
+
+// auditSupplyChanges appends a mismatch to [mismatches] if [supply] isn't the
+// supply derived from the tracked changes to the supply of [subnetID].
+func (vm *VM) auditSupplyChanges(mismatches []error, subnetID ids.ID, supply uint64) ([]error, error) {
+	supplyChanges, err := vm.state.GetSupplyChanges(subnetID)
+	if err != nil {
+		return nil, err
+	}
+	expectedSupply, err := math.Add64(supplyChanges.Base, supplyChanges.Minted)
+	if err == nil {
+		expectedSupply, err = math.Sub(expectedSupply, supplyChanges.Burned)
+	}
+	if err != nil || supply != expectedSupply {
+		mismatches = append(mismatches, fmt.Errorf("%w: subnet %s has supply %d but started at %d with %d minted and %d burned",
+			errSupplyMismatch,
+			subnetID,
+			supply,
+			supplyChanges.Base,
+			supplyChanges.Minted,
+			supplyChanges.Burned,
+		))
+	}
+	return mismatches, nil
+}
+
+// auditStakedWeights verifies that the weight of every tracked validator set
+// equals the sum of the weights of the current stakers of its subnet.
+func (vm *VM) auditStakedWeights() ([]error, error) {
+	stakedWeights := map[ids.ID]uint64{
+		constants.PrimaryNetworkID: 0,
+	}
+	for subnetID := range vm.TrackedSubnets {
+		stakedWeights[subnetID] = 0
+	}
+
+	stakerIterator, err := vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return nil, err
+	}
+	defer stakerIterator.Release()
+
+	for stakerIterator.Next() {
+		staker := stakerIterator.Value()
+		weight, ok := stakedWeights[staker.SubnetID]
+		if !ok {
+			continue
+		}
+		weight, err = math.Add64(weight, staker.Weight)
+		if err != nil {
+			return nil, err
+		}
+		stakedWeights[staker.SubnetID] = weight
+	}
+
+	var mismatches []error
+	for subnetID, stakedWeight := range stakedWeights {
+		var validatorWeight uint64
+		if vdrs, ok := vm.Validators.Get(subnetID); ok {
+			validatorWeight = vdrs.Weight()
+		}
+		if stakedWeight != validatorWeight {
+			mismatches = append(mismatches, fmt.Errorf("%w: subnet %s has %d staked but a validator weight of %d",
+				errStakedWeightMismatch,
+				subnetID,
+				stakedWeight,
+				validatorWeight,
+			))
+		}
+	}
+	return mismatches, nil
+}
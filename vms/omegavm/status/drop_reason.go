@@ -0,0 +1,112 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package status
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+)
+
+// List of possible drop reason values:
+// - [DropReasonUnknown] The tx wasn't dropped or the reason wasn't recorded
+// - [DropReasonInvalid] The tx failed verification for an unclassified reason
+// - [DropReasonInsufficientFunds] The tx doesn't consume enough funds
+// - [DropReasonMissingInputs] The tx consumes UTXOs that don't exist
+// - [DropReasonUnauthorized] The tx isn't signed by the required keys
+// - [DropReasonInvalidStakeAmount] The tx stakes an amount outside the allowed range
+// - [DropReasonInvalidStakingPeriod] The tx stakes for a period outside the allowed range
+// - [DropReasonDuplicateValidator] The tx adds a validator that already exists
+const (
+	DropReasonUnknown              DropReason = 0
+	DropReasonInvalid              DropReason = 1
+	DropReasonInsufficientFunds    DropReason = 2
+	DropReasonMissingInputs        DropReason = 3
+	DropReasonUnauthorized         DropReason = 4
+	DropReasonInvalidStakeAmount   DropReason = 5
+	DropReasonInvalidStakingPeriod DropReason = 6
+	DropReasonDuplicateValidator   DropReason = 7
+)
+
+var (
+	errUnknownDropReason = errors.New("unknown drop reason")
+
+	_ json.Marshaler    = DropReason(0)
+	_ verify.Verifiable = DropReason(0)
+	_ fmt.Stringer      = DropReason(0)
+)
+
+// DropReason is a machine-readable classification of why a tx was dropped
+type DropReason uint32
+
+func (r DropReason) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + r.String() + `"`), r.Verify()
+}
+
+func (r *DropReason) UnmarshalJSON(b []byte) error {
+	switch string(b) {
+	case `"Unknown"`:
+		*r = DropReasonUnknown
+	case `"Invalid"`:
+		*r = DropReasonInvalid
+	case `"InsufficientFunds"`:
+		*r = DropReasonInsufficientFunds
+	case `"MissingInputs"`:
+		*r = DropReasonMissingInputs
+	case `"Unauthorized"`:
+		*r = DropReasonUnauthorized
+	case `"InvalidStakeAmount"`:
+		*r = DropReasonInvalidStakeAmount
+	case `"InvalidStakingPeriod"`:
+		*r = DropReasonInvalidStakingPeriod
+	case `"DuplicateValidator"`:
+		*r = DropReasonDuplicateValidator
+	case "null":
+	default:
+		return errUnknownDropReason
+	}
+	return nil
+}
+
+// Verify that this is a valid drop reason.
+func (r DropReason) Verify() error {
+	switch r {
+	case DropReasonUnknown,
+		DropReasonInvalid,
+		DropReasonInsufficientFunds,
+		DropReasonMissingInputs,
+		DropReasonUnauthorized,
+		DropReasonInvalidStakeAmount,
+		DropReasonInvalidStakingPeriod,
+		DropReasonDuplicateValidator:
+		return nil
+	default:
+		return errUnknownDropReason
+	}
+}
+
+func (r DropReason) String() string {
+	switch r {
+	case DropReasonUnknown:
+		return "Unknown"
+	case DropReasonInvalid:
+		return "Invalid"
+	case DropReasonInsufficientFunds:
+		return "InsufficientFunds"
+	case DropReasonMissingInputs:
+		return "MissingInputs"
+	case DropReasonUnauthorized:
+		return "Unauthorized"
+	case DropReasonInvalidStakeAmount:
+		return "InvalidStakeAmount"
+	case DropReasonInvalidStakingPeriod:
+		return "InvalidStakingPeriod"
+	case DropReasonDuplicateValidator:
+		return "DuplicateValidator"
+	default:
+		return "Invalid drop reason"
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package status
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var dropReasons = []DropReason{
+	DropReasonUnknown,
+	DropReasonInvalid,
+	DropReasonInsufficientFunds,
+	DropReasonMissingInputs,
+	DropReasonUnauthorized,
+	DropReasonInvalidStakeAmount,
+	DropReasonInvalidStakingPeriod,
+	DropReasonDuplicateValidator,
+}
+
+func TestDropReasonJSON(t *testing.T) {
+	require := require.New(t)
+
+	for _, reason := range dropReasons {
+		reasonJSON, err := json.Marshal(reason)
+		require.NoError(err)
+
+		var parsedReason DropReason
+		require.NoError(json.Unmarshal(reasonJSON, &parsedReason))
+		require.Equal(reason, parsedReason)
+	}
+
+	{
+		reason := DropReason(math.MaxInt32)
+		_, err := json.Marshal(reason)
+		require.ErrorIs(err, errUnknownDropReason)
+	}
+
+	{
+		reason := DropReasonInvalid
+		require.NoError(json.Unmarshal([]byte("null"), &reason))
+		require.Equal(DropReasonInvalid, reason)
+	}
+
+	{
+		var reason DropReason
+		err := json.Unmarshal([]byte(`"not a drop reason"`), &reason)
+		require.ErrorIs(err, errUnknownDropReason)
+	}
+}
+
+func TestDropReasonVerify(t *testing.T) {
+	require := require.New(t)
+
+	for _, reason := range dropReasons {
+		require.NoError(reason.Verify(), "%s failed verification", reason)
+	}
+
+	badReason := DropReason(math.MaxInt32)
+	require.ErrorIs(badReason.Verify(), errUnknownDropReason)
+	require.Equal("Invalid drop reason", badReason.String())
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/pubsub"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+)
+
+// txDecidedCheckFrequency is how often the chain is checked for newly
+// accepted blocks.
+const txDecidedCheckFrequency = 100 * time.Millisecond
+
+var _ pubsub.Filterer = (*txDecidedFilterer)(nil)
+
+// TxDecided is published to the subscribers of a tx ID once the block that
+// contains the tx is accepted.
+type TxDecided struct {
+	TxID    ids.ID        `json:"txID"`
+	Status  status.Status `json:"status"`
+	BlockID ids.ID        `json:"blockID"`
+	Height  json.Uint64   `json:"height"`
+}
+
+type txDecidedFilterer struct {
+	event TxDecided
+}
+
+func (f *txDecidedFilterer) Filter(filters []pubsub.Filter) ([]bool, interface{}) {
+	resp := make([]bool, len(filters))
+	for i, filter := range filters {
+		resp[i] = filter.Check(f.event.TxID[:])
+	}
+	return resp, f.event
+}
+
+// runTxDecidedNotifications publishes a TxDecided event for every tx in the
+// blocks accepted after it is started, until [ctx] is cancelled.
+func (vm *VM) runTxDecidedNotifications(ctx context.Context) {
+	ticker := time.NewTicker(txDecidedCheckFrequency)
+	defer ticker.Stop()
+
+	vm.ctx.Lock.Lock()
+	notifiedHeight, err := vm.lastAcceptedHeight()
+	vm.ctx.Lock.Unlock()
+	if err != nil {
+		vm.ctx.Log.Warn("failed to start tx decided notifications",
+			zap.Error(err),
+		)
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		vm.ctx.Lock.Lock()
+		// Clients can't be waiting on txs that are accepted while
+		// bootstrapping, so those blocks are skipped.
+		if !vm.bootstrapped.Get() {
+			height, err := vm.lastAcceptedHeight()
+			if err == nil {
+				notifiedHeight = height
+			}
+			vm.ctx.Lock.Unlock()
+			continue
+		}
+
+		notifiedHeight, err = vm.notifyTxsDecided(notifiedHeight)
+		vm.ctx.Lock.Unlock()
+
+		if err != nil {
+			vm.ctx.Log.Warn("failed to publish tx decided notifications",
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// notifyTxsDecided publishes a TxDecided event for every tx in the accepted
+// blocks above [notifiedHeight]. Returns the height of the last block whose
+// txs have been published.
+//
+// Invariant: assumes the context lock is held.
+func (vm *VM) notifyTxsDecided(notifiedHeight uint64) (uint64, error) {
+	lastAcceptedHeight, err := vm.lastAcceptedHeight()
+	if err != nil {
+		return notifiedHeight, err
+	}
+
+	for height := notifiedHeight + 1; height <= lastAcceptedHeight; height++ {
+		blkID, err := vm.state.GetBlockIDAtHeight(height)
+		if err != nil {
+			return notifiedHeight, fmt.Errorf("couldn't get block at height %d: %w", height, err)
+		}
+		blk, err := vm.state.GetStatelessBlock(blkID)
+		if err != nil {
+			return notifiedHeight, fmt.Errorf("couldn't get block %s: %w", blkID, err)
+		}
+
+		for _, tx := range blk.Txs() {
+			txID := tx.ID()
+			_, txStatus, err := vm.state.GetTx(txID)
+			if err != nil {
+				return notifiedHeight, fmt.Errorf("couldn't get tx %s: %w", txID, err)
+			}
+			vm.events.Publish(&txDecidedFilterer{
+				event: TxDecided{
+					TxID:    txID,
+					Status:  txStatus,
+					BlockID: blkID,
+					Height:  json.Uint64(height),
+				},
+			})
+		}
+		notifiedHeight = height
+	}
+	return notifiedHeight, nil
+}
+
+func (vm *VM) lastAcceptedHeight() (uint64, error) {
+	lastAcceptedID := vm.state.GetLastAccepted()
+	lastAccepted, err := vm.state.GetStatelessBlock(lastAcceptedID)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't get last accepted block %s: %w", lastAcceptedID, err)
+	}
+	return lastAccepted.Height(), nil
+}
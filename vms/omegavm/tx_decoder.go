@@ -0,0 +1,142 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"context"
+
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/txdecoder"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+)
+
+var (
+	_ txdecoder.Decoder = (*VM)(nil)
+	_ txs.Visitor       = (*txDescriber)(nil)
+)
+
+// DecodeTx implements the txdecoder.Decoder interface
+func (vm *VM) DecodeTx(_ context.Context, txBytes []byte) (*txdecoder.Description, error) {
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	describer := &txDescriber{
+		builder: txdecoder.Builder{
+			Formatter: dione.NewAddressManager(vm.ctx),
+			Description: txdecoder.Description{
+				TxID:    tx.ID(),
+				ChainID: vm.ctx.ChainID,
+			},
+		},
+	}
+	if err := tx.Unsigned.Visit(describer); err != nil {
+		return nil, err
+	}
+	return &describer.builder.Description, nil
+}
+
+// txDescriber populates a txdecoder.Description from an O-chain transaction.
+type txDescriber struct {
+	builder txdecoder.Builder
+}
+
+func (d *txDescriber) AddValidatorTx(tx *txs.AddValidatorTx) error {
+	if err := d.describeStaker("AddValidatorTx", &tx.BaseTx, tx.StakeOuts); err != nil {
+		return err
+	}
+	return d.builder.AddOwner("rewardsOwner", tx.RewardsOwner)
+}
+
+func (d *txDescriber) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx) error {
+	return d.describeBaseTx("AddSubnetValidatorTx", &tx.BaseTx)
+}
+
+func (d *txDescriber) AddDelegatorTx(tx *txs.AddDelegatorTx) error {
+	if err := d.describeStaker("AddDelegatorTx", &tx.BaseTx, tx.StakeOuts); err != nil {
+		return err
+	}
+	return d.builder.AddOwner("rewardsOwner", tx.DelegationRewardsOwner)
+}
+
+func (d *txDescriber) CreateChainTx(tx *txs.CreateChainTx) error {
+	return d.describeBaseTx("CreateChainTx", &tx.BaseTx)
+}
+
+func (d *txDescriber) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
+	if err := d.describeBaseTx("CreateSubnetTx", &tx.BaseTx); err != nil {
+		return err
+	}
+	return d.builder.AddOwner("subnetOwner", tx.Owner)
+}
+
+func (d *txDescriber) ImportTx(tx *txs.ImportTx) error {
+	if err := d.describeBaseTx("ImportTx", &tx.BaseTx); err != nil {
+		return err
+	}
+	d.builder.AddInputs(&tx.SourceChain, tx.ImportedInputs)
+	return nil
+}
+
+func (d *txDescriber) ExportTx(tx *txs.ExportTx) error {
+	if err := d.describeBaseTx("ExportTx", &tx.BaseTx); err != nil {
+		return err
+	}
+	return d.builder.AddOutputs(&tx.DestinationChain, false, tx.ExportedOutputs)
+}
+
+func (d *txDescriber) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
+	d.builder.Description.Type = "AdvanceTimeTx"
+	return nil
+}
+
+func (d *txDescriber) RewardValidatorTx(*txs.RewardValidatorTx) error {
+	d.builder.Description.Type = "RewardValidatorTx"
+	return nil
+}
+
+func (d *txDescriber) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidatorTx) error {
+	return d.describeBaseTx("RemoveSubnetValidatorTx", &tx.BaseTx)
+}
+
+func (d *txDescriber) TransformSubnetTx(tx *txs.TransformSubnetTx) error {
+	return d.describeBaseTx("TransformSubnetTx", &tx.BaseTx)
+}
+
+func (d *txDescriber) AddPermissionlessValidatorTx(tx *txs.AddPermissionlessValidatorTx) error {
+	if err := d.describeStaker("AddPermissionlessValidatorTx", &tx.BaseTx, tx.StakeOuts); err != nil {
+		return err
+	}
+	if err := d.builder.AddOwner("validationRewardsOwner", tx.ValidatorRewardsOwner); err != nil {
+		return err
+	}
+	return d.builder.AddOwner("delegationRewardsOwner", tx.DelegatorRewardsOwner)
+}
+
+func (d *txDescriber) AddPermissionlessDelegatorTx(tx *txs.AddPermissionlessDelegatorTx) error {
+	if err := d.describeStaker("AddPermissionlessDelegatorTx", &tx.BaseTx, tx.StakeOuts); err != nil {
+		return err
+	}
+	return d.builder.AddOwner("rewardsOwner", tx.DelegationRewardsOwner)
+}
+
+func (d *txDescriber) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
+	if err := d.describeBaseTx("TransferSubnetOwnershipTx", &tx.BaseTx); err != nil {
+		return err
+	}
+	return d.builder.AddOwner("subnetOwner", tx.Owner)
+}
+
+func (d *txDescriber) describeBaseTx(txType string, tx *txs.BaseTx) error {
+	d.builder.Description.Type = txType
+	return d.builder.AddBaseTx(&tx.BaseTx)
+}
+
+func (d *txDescriber) describeStaker(txType string, tx *txs.BaseTx, stakeOuts []*dione.TransferableOutput) error {
+	if err := d.describeBaseTx(txType, tx); err != nil {
+		return err
+	}
+	return d.builder.AddOutputs(nil, true, stakeOuts)
+}
@@ -11,12 +11,14 @@ import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/utils"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/utils/math"
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/utxo"
@@ -30,6 +32,8 @@ var (
 	_ Builder = (*builder)(nil)
 
 	ErrNoFunds = errors.New("no spendable funds were found")
+
+	errNotTransformSubnetTx = errors.New("subnet transformation isn't a TransformSubnetTx")
 )
 
 type Builder interface {
@@ -92,6 +96,59 @@ type DecisionTxBuilder interface {
 		keys []*secp256k1.PrivateKey,
 		changeAddr ids.ShortID,
 	) (*txs.Tx, error)
+
+	// subnetID: ID of the subnet whose ownership is transferred
+	// threshold: [threshold] of [ownerAddrs] needed to manage this subnet
+	// ownerAddrs: new control addresses of the subnet
+	// keys: keys to pay the fee and authorize the transfer
+	// changeAddr: address to send change to, if there is any
+	NewTransferSubnetOwnershipTx(
+		subnetID ids.ID,
+		threshold uint32,
+		ownerAddrs []ids.ShortID,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	// subnetID: ID of the subnet to transform into a permissionless subnet
+	// assetID: asset staked to validate the subnet
+	// initialSupply: current supply of [assetID]
+	// maxSupply: maximum supply of [assetID]. The difference with
+	//            [initialSupply] is burned to pay the staking rewards
+	// minConsumptionRate, maxConsumptionRate: bounds of the reward rate
+	// minValidatorStake, maxValidatorStake: bounds of a validator's weight
+	// minValidatorStakeDuration, maxValidatorStakeDuration: bounds of a
+	//                                                       validation period
+	// minDelegatorStakeDuration, maxDelegatorStakeDuration: bounds of a
+	//                                                       delegation period
+	// minDelegationFee: minimum fee a validator must charge its delegators
+	// minDelegatorStake: minimum amount a delegator must stake
+	// maxValidatorWeightFactor: maximum weight of a validator, including
+	//                           delegations, as a multiple of its own stake
+	// uptimeRequirement: minimum uptime a validator needs to be rewarded
+	// keys: keys to pay the fee, burn the rewards and authorize the
+	//       transformation
+	// changeAddr: address to send change to, if there is any
+	NewTransformSubnetTx(
+		subnetID ids.ID,
+		assetID ids.ID,
+		initialSupply uint64,
+		maxSupply uint64,
+		minConsumptionRate uint64,
+		maxConsumptionRate uint64,
+		minValidatorStake uint64,
+		maxValidatorStake uint64,
+		minValidatorStakeDuration time.Duration,
+		maxValidatorStakeDuration time.Duration,
+		minDelegatorStakeDuration time.Duration,
+		maxDelegatorStakeDuration time.Duration,
+		minDelegationFee uint32,
+		minDelegatorStake uint64,
+		maxValidatorWeightFactor byte,
+		uptimeRequirement uint32,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
 }
 
 type ProposalTxBuilder interface {
@@ -159,6 +216,50 @@ type ProposalTxBuilder interface {
 		changeAddr ids.ShortID,
 	) (*txs.Tx, error)
 
+	// stakeAmount: amount of the subnet's staking asset the validator stakes
+	// startTime: unix time they start validating
+	// endTime: unix time they stop validating
+	// nodeID: ID of the node we want to validate with
+	// subnetID: ID of the permissionless subnet to validate
+	// pop: BLS proof of possession of the validator, or [signer.Empty]
+	// validationRewardAddress: address to send validation rewards to
+	// delegationRewardAddress: address to send delegation fees to
+	// shares: 10,000 times percentage of reward taken from delegators
+	// keys: Keys providing the staked tokens
+	// changeAddr: Address to send change to, if there is any
+	NewAddPermissionlessValidatorTx(
+		stakeAmount,
+		startTime,
+		endTime uint64,
+		nodeID ids.NodeID,
+		subnetID ids.ID,
+		pop signer.Signer,
+		validationRewardAddress ids.ShortID,
+		delegationRewardAddress ids.ShortID,
+		shares uint32,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
+	// stakeAmount: amount of the subnet's staking asset the delegator stakes
+	// startTime: unix time they start delegating
+	// endTime: unix time they stop delegating
+	// nodeID: ID of the node we are delegating to
+	// subnetID: ID of the permissionless subnet the node validates
+	// rewardAddress: address to send reward to, if applicable
+	// keys: keys providing the staked tokens
+	// changeAddr: address to send change to, if there is any
+	NewAddPermissionlessDelegatorTx(
+		stakeAmount,
+		startTime,
+		endTime uint64,
+		nodeID ids.NodeID,
+		subnetID ids.ID,
+		rewardAddress ids.ShortID,
+		keys []*secp256k1.PrivateKey,
+		changeAddr ids.ShortID,
+	) (*txs.Tx, error)
+
 	// newAdvanceTimeTx creates a new tx that, if it is accepted and followed by a
 	// Commit block, will set the chain's timestamp to [timestamp].
 	NewAdvanceTimeTx(timestamp time.Time) (*txs.Tx, error)
@@ -429,6 +530,120 @@ func (b *builder) NewCreateSubnetTx(
 	return tx, tx.SyntacticVerify(b.ctx)
 }
 
+func (b *builder) NewTransferSubnetOwnershipTx(
+	subnetID ids.ID,
+	threshold uint32,
+	ownerAddrs []ids.ShortID,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	ins, outs, _, signers, err := b.Spend(b.state, keys, 0, b.cfg.TxFee, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	subnetAuth, subnetSigners, err := b.Authorize(b.state, subnetID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's subnet restrictions: %w", err)
+	}
+	signers = append(signers, subnetSigners)
+
+	// Sort control addresses
+	utils.Sort(ownerAddrs)
+
+	// Create the tx
+	utx := &txs.TransferSubnetOwnershipTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Subnet:     subnetID,
+		SubnetAuth: subnetAuth,
+		Owner: &secp256k1fx.OutputOwners{
+			Threshold: threshold,
+			Addrs:     ownerAddrs,
+		},
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *builder) NewTransformSubnetTx(
+	subnetID ids.ID,
+	assetID ids.ID,
+	initialSupply uint64,
+	maxSupply uint64,
+	minConsumptionRate uint64,
+	maxConsumptionRate uint64,
+	minValidatorStake uint64,
+	maxValidatorStake uint64,
+	minValidatorStakeDuration time.Duration,
+	maxValidatorStakeDuration time.Duration,
+	minDelegatorStakeDuration time.Duration,
+	maxDelegatorStakeDuration time.Duration,
+	minDelegationFee uint32,
+	minDelegatorStake uint64,
+	maxValidatorWeightFactor byte,
+	uptimeRequirement uint32,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	toBurn := map[ids.ID]uint64{
+		b.ctx.DIONEAssetID: b.cfg.TransformSubnetTxFee,
+	}
+	if maxSupply > initialSupply {
+		// The rewards are burned up front and minted back as they are paid
+		toBurn[assetID] = maxSupply - initialSupply
+	}
+	ins, outs, signers, err := b.Burn(b.state, keys, toBurn, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+
+	subnetAuth, subnetSigners, err := b.Authorize(b.state, subnetID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't authorize tx's subnet restrictions: %w", err)
+	}
+	signers = append(signers, subnetSigners)
+
+	// Create the tx
+	utx := &txs.TransformSubnetTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         outs,
+		}},
+		Subnet:                    subnetID,
+		AssetID:                   assetID,
+		InitialSupply:             initialSupply,
+		MaximumSupply:             maxSupply,
+		MinConsumptionRate:        minConsumptionRate,
+		MaxConsumptionRate:        maxConsumptionRate,
+		MinValidatorStake:         minValidatorStake,
+		MaxValidatorStake:         maxValidatorStake,
+		MinValidatorStakeDuration: uint32(minValidatorStakeDuration / time.Second),
+		MaxValidatorStakeDuration: uint32(maxValidatorStakeDuration / time.Second),
+		MinDelegatorStakeDuration: uint32(minDelegatorStakeDuration / time.Second),
+		MaxDelegatorStakeDuration: uint32(maxDelegatorStakeDuration / time.Second),
+		MinDelegationFee:          minDelegationFee,
+		MinDelegatorStake:         minDelegatorStake,
+		MaxValidatorWeightFactor:  maxValidatorWeightFactor,
+		UptimeRequirement:         uptimeRequirement,
+		SubnetAuth:                subnetAuth,
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
 func (b *builder) NewAddValidatorTx(
 	stakeAmount,
 	startTime,
@@ -595,6 +810,137 @@ func (b *builder) NewRemoveSubnetValidatorTx(
 	return tx, tx.SyntacticVerify(b.ctx)
 }
 
+func (b *builder) NewAddPermissionlessValidatorTx(
+	stakeAmount,
+	startTime,
+	endTime uint64,
+	nodeID ids.NodeID,
+	subnetID ids.ID,
+	pop signer.Signer,
+	validationRewardAddress ids.ShortID,
+	delegationRewardAddress ids.ShortID,
+	shares uint32,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	stakeAssetID, err := b.getStakeAssetID(subnetID)
+	if err != nil {
+		return nil, err
+	}
+	fee := b.cfg.AddSubnetValidatorFee
+	if subnetID == constants.PrimaryNetworkID {
+		fee = b.cfg.AddPrimaryNetworkValidatorFee
+	}
+	ins, unstakedOuts, stakedOuts, signers, err := b.SpendAsset(b.state, keys, stakeAssetID, stakeAmount, fee, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+	// Create the tx
+	utx := &txs.AddPermissionlessValidatorTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         unstakedOuts,
+		}},
+		Validator: txs.Validator{
+			NodeID: nodeID,
+			Start:  startTime,
+			End:    endTime,
+			Wght:   stakeAmount,
+		},
+		Subnet:    subnetID,
+		Signer:    pop,
+		StakeOuts: stakedOuts,
+		ValidatorRewardsOwner: &secp256k1fx.OutputOwners{
+			Locktime:  0,
+			Threshold: 1,
+			Addrs:     []ids.ShortID{validationRewardAddress},
+		},
+		DelegatorRewardsOwner: &secp256k1fx.OutputOwners{
+			Locktime:  0,
+			Threshold: 1,
+			Addrs:     []ids.ShortID{delegationRewardAddress},
+		},
+		DelegationShares: shares,
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+func (b *builder) NewAddPermissionlessDelegatorTx(
+	stakeAmount,
+	startTime,
+	endTime uint64,
+	nodeID ids.NodeID,
+	subnetID ids.ID,
+	rewardAddress ids.ShortID,
+	keys []*secp256k1.PrivateKey,
+	changeAddr ids.ShortID,
+) (*txs.Tx, error) {
+	stakeAssetID, err := b.getStakeAssetID(subnetID)
+	if err != nil {
+		return nil, err
+	}
+	fee := b.cfg.AddSubnetDelegatorFee
+	if subnetID == constants.PrimaryNetworkID {
+		fee = b.cfg.AddPrimaryNetworkDelegatorFee
+	}
+	ins, unlockedOuts, lockedOuts, signers, err := b.SpendAsset(b.state, keys, stakeAssetID, stakeAmount, fee, changeAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't generate tx inputs/outputs: %w", err)
+	}
+	// Create the tx
+	utx := &txs.AddPermissionlessDelegatorTx{
+		BaseTx: txs.BaseTx{BaseTx: dione.BaseTx{
+			NetworkID:    b.ctx.NetworkID,
+			BlockchainID: b.ctx.ChainID,
+			Ins:          ins,
+			Outs:         unlockedOuts,
+		}},
+		Validator: txs.Validator{
+			NodeID: nodeID,
+			Start:  startTime,
+			End:    endTime,
+			Wght:   stakeAmount,
+		},
+		Subnet:    subnetID,
+		StakeOuts: lockedOuts,
+		DelegationRewardsOwner: &secp256k1fx.OutputOwners{
+			Locktime:  0,
+			Threshold: 1,
+			Addrs:     []ids.ShortID{rewardAddress},
+		},
+	}
+	tx, err := txs.NewSigned(utx, txs.Codec, signers)
+	if err != nil {
+		return nil, err
+	}
+	return tx, tx.SyntacticVerify(b.ctx)
+}
+
+// getStakeAssetID returns the asset that must be staked on [subnetID]. The
+// primary network is staked with DIONE and permissionless subnets with the
+// asset of their TransformSubnetTx.
+func (b *builder) getStakeAssetID(subnetID ids.ID) (ids.ID, error) {
+	if subnetID == constants.PrimaryNetworkID {
+		return b.ctx.DIONEAssetID, nil
+	}
+
+	transformSubnetIntf, err := b.state.GetSubnetTransformation(subnetID)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("couldn't get transformation of subnet %s: %w", subnetID, err)
+	}
+	transformSubnet, ok := transformSubnetIntf.Unsigned.(*txs.TransformSubnetTx)
+	if !ok {
+		return ids.Empty, errNotTransformSubnetTx
+	}
+	return transformSubnet.AssetID, nil
+}
+
 func (b *builder) NewAdvanceTimeTx(timestamp time.Time) (*txs.Tx, error) {
 	utx := &txs.AdvanceTimeTx{Time: uint64(timestamp.Unix())}
 	tx, err := txs.NewSigned(utx, txs.Codec, nil)
@@ -13,6 +13,7 @@ import (
 
 	ids "github.com/DioneProtocol/odysseygo/ids"
 	secp256k1 "github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	signer "github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 	txs "github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -55,6 +56,36 @@ func (mr *MockBuilderMockRecorder) NewAddDelegatorTx(arg0, arg1, arg2, arg3, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAddDelegatorTx", reflect.TypeOf((*MockBuilder)(nil).NewAddDelegatorTx), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
+// NewAddPermissionlessDelegatorTx mocks base method.
+func (m *MockBuilder) NewAddPermissionlessDelegatorTx(arg0, arg1, arg2 uint64, arg3 ids.NodeID, arg4 ids.ID, arg5 ids.ShortID, arg6 []*secp256k1.PrivateKey, arg7 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAddPermissionlessDelegatorTx", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewAddPermissionlessDelegatorTx indicates an expected call of NewAddPermissionlessDelegatorTx.
+func (mr *MockBuilderMockRecorder) NewAddPermissionlessDelegatorTx(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAddPermissionlessDelegatorTx", reflect.TypeOf((*MockBuilder)(nil).NewAddPermissionlessDelegatorTx), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
+// NewAddPermissionlessValidatorTx mocks base method.
+func (m *MockBuilder) NewAddPermissionlessValidatorTx(arg0, arg1, arg2 uint64, arg3 ids.NodeID, arg4 ids.ID, arg5 signer.Signer, arg6, arg7 ids.ShortID, arg8 uint32, arg9 []*secp256k1.PrivateKey, arg10 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAddPermissionlessValidatorTx", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewAddPermissionlessValidatorTx indicates an expected call of NewAddPermissionlessValidatorTx.
+func (mr *MockBuilderMockRecorder) NewAddPermissionlessValidatorTx(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAddPermissionlessValidatorTx", reflect.TypeOf((*MockBuilder)(nil).NewAddPermissionlessValidatorTx), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10)
+}
+
 // NewAddSubnetValidatorTx mocks base method.
 func (m *MockBuilder) NewAddSubnetValidatorTx(arg0, arg1, arg2 uint64, arg3 ids.NodeID, arg4 ids.ID, arg5 []*secp256k1.PrivateKey, arg6 ids.ShortID) (*txs.Tx, error) {
 	m.ctrl.T.Helper()
@@ -204,3 +235,33 @@ func (mr *MockBuilderMockRecorder) NewRewardValidatorTxWithFee(arg0, arg1 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRewardValidatorTxWithFee", reflect.TypeOf((*MockBuilder)(nil).NewRewardValidatorTxWithFee), arg0, arg1)
 }
+
+// NewTransferSubnetOwnershipTx mocks base method.
+func (m *MockBuilder) NewTransferSubnetOwnershipTx(arg0 ids.ID, arg1 uint32, arg2 []ids.ShortID, arg3 []*secp256k1.PrivateKey, arg4 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTransferSubnetOwnershipTx", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewTransferSubnetOwnershipTx indicates an expected call of NewTransferSubnetOwnershipTx.
+func (mr *MockBuilderMockRecorder) NewTransferSubnetOwnershipTx(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTransferSubnetOwnershipTx", reflect.TypeOf((*MockBuilder)(nil).NewTransferSubnetOwnershipTx), arg0, arg1, arg2, arg3, arg4)
+}
+
+// NewTransformSubnetTx mocks base method.
+func (m *MockBuilder) NewTransformSubnetTx(arg0, arg1 ids.ID, arg2, arg3, arg4, arg5, arg6, arg7 uint64, arg8, arg9, arg10, arg11 time.Duration, arg12 uint32, arg13 uint64, arg14 byte, arg15 uint32, arg16 []*secp256k1.PrivateKey, arg17 ids.ShortID) (*txs.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTransformSubnetTx", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13, arg14, arg15, arg16, arg17)
+	ret0, _ := ret[0].(*txs.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewTransformSubnetTx indicates an expected call of NewTransformSubnetTx.
+func (mr *MockBuilderMockRecorder) NewTransformSubnetTx(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13, arg14, arg15, arg16, arg17 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTransformSubnetTx", reflect.TypeOf((*MockBuilder)(nil).NewTransformSubnetTx), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9, arg10, arg11, arg12, arg13, arg14, arg15, arg16, arg17)
+}
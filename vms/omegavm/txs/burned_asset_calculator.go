@@ -124,6 +124,10 @@ func (*BurnedAssetCalculator) RewardValidatorTx(*RewardValidatorTx) error {
 	return nil
 }
 
+func (b *BurnedAssetCalculator) TransferSubnetOwnershipTx(tx *TransferSubnetOwnershipTx) error {
+	return b.setDifference(&tx.BaseTx.BaseTx)
+}
+
 func (b *BurnedAssetCalculator) TransformSubnetTx(tx *TransformSubnetTx) error {
 	return b.setDifference(&tx.BaseTx.BaseTx)
 }
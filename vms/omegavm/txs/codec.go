@@ -41,6 +41,14 @@ func init() {
 		c.SkipRegistrations(5)
 
 		errs.Add(RegisterUnsignedTxsTypes(c))
+
+		// Skip the positions of the Banff blocks.
+		c.SkipRegistrations(4)
+
+		errs.Add(
+			RegisterSubnetOwnershipTxsTypes(c),
+			RegisterRestakeTypes(c),
+		)
 	}
 	errs.Add(
 		Codec.RegisterCodec(Version, c),
@@ -97,3 +105,17 @@ func RegisterUnsignedTxsTypes(targetCodec linearcodec.Codec) error {
 	)
 	return errs.Err
 }
+
+// RegisterSubnetOwnershipTxsTypes registers the tx types that were added after
+// the Banff block types. They must be registered after the Banff block types to
+// keep the type IDs of the previously registered types stable.
+func RegisterSubnetOwnershipTxsTypes(targetCodec linearcodec.Codec) error {
+	return targetCodec.RegisterType(&TransferSubnetOwnershipTx{})
+}
+
+// RegisterRestakeTypes registers the types that allow rewards to be locked for
+// restaking. They must be registered after the subnet ownership tx types to
+// keep the type IDs of the previously registered types stable.
+func RegisterRestakeTypes(targetCodec linearcodec.Codec) error {
+	return targetCodec.RegisterType(&stakeable.LockOwner{})
+}
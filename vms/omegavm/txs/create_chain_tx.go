@@ -58,8 +58,6 @@ func (tx *CreateChainTx) SyntacticVerify(ctx *snow.Context) error {
 		return nil
 	case tx.SubnetID == constants.PrimaryNetworkID:
 		return ErrCantValidatePrimaryNetwork
-	case len(tx.ChainName) > MaxNameLen:
-		return errNameTooLong
 	case tx.VMID == ids.Empty:
 		return errInvalidVMID
 	case !utils.IsSortedAndUnique(tx.FxIDs):
@@ -68,12 +66,9 @@ func (tx *CreateChainTx) SyntacticVerify(ctx *snow.Context) error {
 		return errGenesisTooLong
 	}
 
-	for _, r := range tx.ChainName {
-		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsNumber(r) || r == ' ') {
-			return errIllegalNameCharacter
-		}
+	if err := VerifyChainName(tx.ChainName); err != nil {
+		return err
 	}
-
 	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
 		return err
 	}
@@ -88,3 +83,17 @@ func (tx *CreateChainTx) SyntacticVerify(ctx *snow.Context) error {
 func (tx *CreateChainTx) Visit(visitor Visitor) error {
 	return visitor.CreateChainTx(tx)
 }
+
+// VerifyChainName returns an error if [name] can't be the name of a new chain.
+// Names are limited to [MaxNameLen] ASCII letters, digits and spaces.
+func VerifyChainName(name string) error {
+	if len(name) > MaxNameLen {
+		return errNameTooLong
+	}
+	for _, r := range name {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsNumber(r) || r == ' ') {
+			return errIllegalNameCharacter
+		}
+	}
+	return nil
+}
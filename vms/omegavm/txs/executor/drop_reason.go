@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"errors"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/utxo"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+// dropReasons maps verification errors to the reason reported for txs that
+// are dropped because of them. The first match wins.
+var dropReasons = []struct {
+	reason status.DropReason
+	errs   []error
+}{
+	{
+		reason: status.DropReasonInsufficientFunds,
+		errs: []error{
+			dione.ErrInsufficientFunds,
+			utxo.ErrInsufficientFunds,
+			utxo.ErrInsufficientUnlockedFunds,
+			utxo.ErrInsufficientLockedFunds,
+		},
+	},
+	{
+		reason: status.DropReasonMissingInputs,
+		errs: []error{
+			database.ErrNotFound,
+		},
+	},
+	{
+		reason: status.DropReasonUnauthorized,
+		errs: []error{
+			secp256k1fx.ErrWrongSig,
+			secp256k1fx.ErrTooFewSigners,
+			secp256k1fx.ErrTooManySigners,
+			secp256k1fx.ErrTimelocked,
+			secp256k1fx.ErrInputCredentialSignersMismatch,
+			errUnauthorizedSubnetModification,
+		},
+	},
+	{
+		reason: status.DropReasonInvalidStakeAmount,
+		errs: []error{
+			ErrWeightTooSmall,
+			ErrWeightTooLarge,
+			ErrStakeOverflow,
+			ErrOverDelegated,
+		},
+	},
+	{
+		reason: status.DropReasonInvalidStakingPeriod,
+		errs: []error{
+			ErrStakeTooShort,
+			ErrStakeTooLong,
+			ErrFutureStakeTime,
+			ErrTimestampNotBeforeStartTime,
+			ErrPeriodMismatch,
+		},
+	},
+	{
+		reason: status.DropReasonDuplicateValidator,
+		errs: []error{
+			ErrAlreadyValidator,
+			ErrDuplicateValidator,
+		},
+	},
+}
+
+// ClassifyDropReason returns the reason reported for a tx that was dropped
+// because it failed verification with [err].
+func ClassifyDropReason(err error) status.DropReason {
+	if err == nil {
+		return status.DropReasonUnknown
+	}
+	for _, dropReason := range dropReasons {
+		for _, reasonErr := range dropReason.errs {
+			if errors.Is(err, reasonErr) {
+				return dropReason.reason
+			}
+		}
+	}
+	return status.DropReasonInvalid
+}
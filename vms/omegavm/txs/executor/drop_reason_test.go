@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/utxo"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestClassifyDropReason(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected status.DropReason
+	}{
+		{
+			name:     "nil",
+			err:      nil,
+			expected: status.DropReasonUnknown,
+		},
+		{
+			name:     "unclassified",
+			err:      errors.New("unclassified"),
+			expected: status.DropReasonInvalid,
+		},
+		{
+			name:     "insufficient funds",
+			err:      fmt.Errorf("%w: %w", ErrFlowCheckFailed, utxo.ErrInsufficientFunds),
+			expected: status.DropReasonInsufficientFunds,
+		},
+		{
+			name:     "missing utxo",
+			err:      fmt.Errorf("failed to read consumed UTXO: %w", database.ErrNotFound),
+			expected: status.DropReasonMissingInputs,
+		},
+		{
+			name:     "wrong signature",
+			err:      fmt.Errorf("%w: %w", ErrFlowCheckFailed, secp256k1fx.ErrWrongSig),
+			expected: status.DropReasonUnauthorized,
+		},
+		{
+			name:     "weight too small",
+			err:      ErrWeightTooSmall,
+			expected: status.DropReasonInvalidStakeAmount,
+		},
+		{
+			name:     "stake too short",
+			err:      ErrStakeTooShort,
+			expected: status.DropReasonInvalidStakingPeriod,
+		},
+		{
+			name:     "duplicate validator",
+			err:      fmt.Errorf("%s: %w", "node", ErrDuplicateValidator),
+			expected: status.DropReasonDuplicateValidator,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, ClassifyDropReason(test.err))
+		})
+	}
+}
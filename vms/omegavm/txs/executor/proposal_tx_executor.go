@@ -14,7 +14,9 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/math"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 )
@@ -100,6 +102,10 @@ func (*ProposalTxExecutor) AddPermissionlessDelegatorTx(*txs.AddPermissionlessDe
 	return ErrWrongTxType
 }
 
+func (*ProposalTxExecutor) TransferSubnetOwnershipTx(*txs.TransferSubnetOwnershipTx) error {
+	return ErrWrongTxType
+}
+
 func (e *ProposalTxExecutor) AddValidatorTx(tx *txs.AddValidatorTx) error {
 	// AddValidatorTx is a proposal transaction until the Banff fork
 	// activation. Following the activation, AddValidatorTxs must be issued into
@@ -393,13 +399,9 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 		// Provide the reward here
 		if stakerToRemove.PotentialReward > 0 {
 			validationRewardsOwner := uStakerTx.ValidationRewardsOwner()
-			outIntf, err := e.Fx.CreateOutput(stakerToRemove.PotentialReward, validationRewardsOwner)
+			out, err := e.createRewardOutput(currentChainTime, stakerToRemove.PotentialReward, validationRewardsOwner)
 			if err != nil {
-				return fmt.Errorf("failed to create output: %w", err)
-			}
-			out, ok := outIntf.(verify.State)
-			if !ok {
-				return ErrInvalidState
+				return err
 			}
 
 			utxo := &dione.UTXO{
@@ -428,13 +430,9 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 
 		if delegateeReward > 0 {
 			delegationRewardsOwner := uStakerTx.DelegationRewardsOwner()
-			outIntf, err := e.Fx.CreateOutput(delegateeReward, delegationRewardsOwner)
+			out, err := e.createRewardOutput(currentChainTime, delegateeReward, delegationRewardsOwner)
 			if err != nil {
-				return fmt.Errorf("failed to create output: %w", err)
-			}
-			out, ok := outIntf.(verify.State)
-			if !ok {
-				return ErrInvalidState
+				return err
 			}
 
 			onCommitUtxo := &dione.UTXO{
@@ -533,13 +531,9 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 		// Reward the delegator here
 		if delegatorReward > 0 {
 			rewardsOwner := uStakerTx.RewardsOwner()
-			outIntf, err := e.Fx.CreateOutput(delegatorReward, rewardsOwner)
+			out, err := e.createRewardOutput(currentChainTime, delegatorReward, rewardsOwner)
 			if err != nil {
-				return fmt.Errorf("failed to create output: %w", err)
-			}
-			out, ok := outIntf.(verify.State)
-			if !ok {
-				return ErrInvalidState
+				return err
 			}
 			utxo := &dione.UTXO{
 				UTXOID: dione.UTXOID{
@@ -586,13 +580,9 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 				// For any validators who started prior to [CortinaTime], we issue the
 				// [delegateeReward] immediately.
 				delegationRewardsOwner := vdrTx.DelegationRewardsOwner()
-				outIntf, err := e.Fx.CreateOutput(delegateeReward, delegationRewardsOwner)
+				out, err := e.createRewardOutput(currentChainTime, delegateeReward, delegationRewardsOwner)
 				if err != nil {
-					return fmt.Errorf("failed to create output: %w", err)
-				}
-				out, ok := outIntf.(verify.State)
-				if !ok {
-					return ErrInvalidState
+					return err
 				}
 				utxo := &dione.UTXO{
 					UTXOID: dione.UTXOID{
@@ -628,6 +618,17 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 		}
 		e.OnAbortState.SetCurrentSupply(stakerToRemove.SubnetID, newSupply)
 
+		supplyChanges, err := e.OnAbortState.GetSupplyChanges(stakerToRemove.SubnetID)
+		if err != nil {
+			return err
+		}
+		burned, err := math.Add64(supplyChanges.Burned, stakerToRemove.PotentialReward)
+		if err != nil {
+			return err
+		}
+		supplyChanges.Burned = burned
+		e.OnAbortState.SetSupplyChanges(stakerToRemove.SubnetID, supplyChanges)
+
 		transformSubnetIntf, err := e.OnCommitState.GetSubnetTransformation(stakerToRemove.SubnetID)
 		if err != nil {
 			return err
@@ -695,6 +696,36 @@ func GetNextStakerChangeTime(state state.Chain) (time.Time, error) {
 	}
 }
 
+// createRewardOutput creates the output paying [amount] of rewards to [owner].
+// Rewards paid to a [stakeable.LockOwner] are locked until its locktime, so
+// that they can only be restaked before then. Locked rewards are only paid
+// once the Durango upgrade is activated at [chainTime].
+func (e *ProposalTxExecutor) createRewardOutput(chainTime time.Time, amount uint64, owner fx.Owner) (verify.State, error) {
+	lockOwner, locked := owner.(*stakeable.LockOwner)
+	if locked {
+		if !e.Config.IsDurangoActivated(chainTime) {
+			return nil, fmt.Errorf("%w: rewards owner with a restake locktime", ErrDurangoUpgradeNotActive)
+		}
+		owner = lockOwner.Owner
+	}
+
+	outIntf, err := e.Fx.CreateOutput(amount, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create output: %w", err)
+	}
+	out, ok := outIntf.(dione.TransferableOut)
+	if !ok {
+		return nil, ErrInvalidState
+	}
+	if !locked {
+		return out, nil
+	}
+	return &stakeable.LockOut{
+		Locktime:        lockOwner.Locktime,
+		TransferableOut: out,
+	}, nil
+}
+
 // GetValidator returns information about the given validator, which may be a
 // current validator or pending validator.
 func GetValidator(state state.Chain, subnetID ids.ID, nodeID ids.NodeID) (*state.Staker, error) {
@@ -15,7 +15,9 @@ import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/utils/hashing"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
@@ -894,3 +896,46 @@ func TestProposalTxExecuteAddValidator(t *testing.T) {
 		require.ErrorIs(err, ErrFlowCheckFailed)
 	}
 }
+
+func TestCreateRewardOutput(t *testing.T) {
+	require := require.New(t)
+
+	chainTime := defaultGenesisTime
+	e := &ProposalTxExecutor{
+		Backend: &Backend{
+			Config: &config.Config{
+				DurangoTime: chainTime,
+			},
+			Fx: &secp256k1fx.Fx{},
+		},
+	}
+	owner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+	lockOwner := &stakeable.LockOwner{
+		Locktime: 1337,
+		Owner:    owner,
+	}
+
+	out, err := e.createRewardOutput(chainTime, 100, owner)
+	require.NoError(err)
+	require.Equal(&secp256k1fx.TransferOutput{
+		Amt:          100,
+		OutputOwners: *owner,
+	}, out)
+
+	out, err = e.createRewardOutput(chainTime, 100, lockOwner)
+	require.NoError(err)
+	require.Equal(&stakeable.LockOut{
+		Locktime: 1337,
+		TransferableOut: &secp256k1fx.TransferOutput{
+			Amt:          100,
+			OutputOwners: *owner,
+		},
+	}, out)
+
+	// Locked rewards aren't paid prior to durango
+	_, err = e.createRewardOutput(chainTime.Add(-1), 100, lockOwner)
+	require.ErrorIs(err, ErrDurangoUpgradeNotActive)
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/math"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 )
@@ -74,6 +76,18 @@ func verifySubnetValidatorPrimaryNetworkRequirements(chainState state.Chain, sub
 	return nil
 }
 
+// verifyRewardsOwners verifies that none of [owners] locks its rewards for
+// restaking before the Durango upgrade is activated at [timestamp].
+func verifyRewardsOwners(backend *Backend, timestamp time.Time, owners ...fx.Owner) error {
+	for _, owner := range owners {
+		_, locked := owner.(*stakeable.LockOwner)
+		if locked && !backend.Config.IsDurangoActivated(timestamp) {
+			return fmt.Errorf("%w: rewards owner with a restake locktime", ErrDurangoUpgradeNotActive)
+		}
+	}
+	return nil
+}
+
 // verifyAddValidatorTx carries out the validation for an AddValidatorTx.
 // It returns the tx outputs that should be returned if this validator is not
 // added to the staking set.
@@ -135,6 +149,10 @@ func verifyAddValidatorTx(
 		)
 	}
 
+	if err := verifyRewardsOwners(backend, currentTimestamp, tx.RewardsOwner); err != nil {
+		return nil, err
+	}
+
 	_, err := GetValidator(chainState, constants.PrimaryNetworkID, tx.Validator.NodeID)
 	if err == nil {
 		return nil, fmt.Errorf(
@@ -384,6 +402,10 @@ func verifyAddDelegatorTx(
 		)
 	}
 
+	if err := verifyRewardsOwners(backend, currentTimestamp, tx.DelegationRewardsOwner); err != nil {
+		return nil, err
+	}
+
 	primaryNetworkValidator, err := GetValidator(chainState, constants.PrimaryNetworkID, tx.Validator.NodeID)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -477,6 +499,10 @@ func verifyAddPermissionlessValidatorTx(
 		)
 	}
 
+	if err := verifyRewardsOwners(backend, currentTimestamp, tx.ValidatorRewardsOwner, tx.DelegatorRewardsOwner); err != nil {
+		return err
+	}
+
 	validatorRules, err := getValidatorRules(backend, chainState, tx.Subnet)
 	if err != nil {
 		return err
@@ -644,6 +670,10 @@ func verifyAddPermissionlessDelegatorTx(
 		)
 	}
 
+	if err := verifyRewardsOwners(backend, currentTimestamp, tx.DelegationRewardsOwner); err != nil {
+		return err
+	}
+
 	delegatorRules, err := getDelegatorRules(backend, chainState, tx.Subnet)
 	if err != nil {
 		return err
@@ -26,6 +26,8 @@ var (
 
 	errEmptyNodeID              = errors.New("validator nodeID cannot be empty")
 	errMaxStakeDurationTooLarge = errors.New("max stake duration must be less than or equal to the global max stake duration")
+
+	ErrDurangoUpgradeNotActive = errors.New("attempting to use a Durango-upgrade feature prior to activation")
 )
 
 type StandardTxExecutor struct {
@@ -434,6 +436,9 @@ func (e *StandardTxExecutor) TransformSubnetTx(tx *txs.TransformSubnetTx) error
 	// Transform the new subnet in the database
 	e.State.AddSubnetTransformation(e.Tx)
 	e.State.SetCurrentSupply(tx.Subnet, tx.InitialSupply)
+	e.State.SetSupplyChanges(tx.Subnet, state.SupplyChanges{
+		Base: tx.InitialSupply,
+	})
 	return nil
 }
 
@@ -493,3 +498,47 @@ func (e *StandardTxExecutor) AddPermissionlessDelegatorTx(tx *txs.AddPermissionl
 
 	return nil
 }
+
+// Verifies a [*txs.TransferSubnetOwnershipTx] and, if it passes, executes it on
+// [e.State]. The subnet must still be a PoA subnet and the tx must be
+// authorized by the subnet's current owner. This transaction will result in
+// [tx.Owner] becoming the owner of [tx.Subnet]. It is rejected prior to the
+// Durango upgrade.
+func (e *StandardTxExecutor) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
+	if !e.Config.IsDurangoActivated(e.State.GetTimestamp()) {
+		return ErrDurangoUpgradeNotActive
+	}
+
+	if err := e.Tx.SyntacticVerify(e.Ctx); err != nil {
+		return err
+	}
+
+	baseTxCreds, err := verifyPoASubnetAuthorization(e.Backend, e.State, e.Tx, tx.Subnet, tx.SubnetAuth)
+	if err != nil {
+		return err
+	}
+
+	// Verify the flowcheck
+	if err := e.FlowChecker.VerifySpend(
+		tx,
+		e.State,
+		tx.Ins,
+		tx.Outs,
+		baseTxCreds,
+		map[ids.ID]uint64{
+			e.Ctx.DIONEAssetID: e.Config.TxFee,
+		},
+	); err != nil {
+		return err
+	}
+
+	txID := e.Tx.ID()
+
+	// Consume the UTXOS
+	dione.Consume(e.State, tx.Ins)
+	// Produce the UTXOS
+	dione.Produce(e.State, txID, tx.Outs)
+	// Replace the owner of the subnet
+	e.State.SetSubnetOwner(tx.Subnet, tx.Owner)
+	return nil
+}
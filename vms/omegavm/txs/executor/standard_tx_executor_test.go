@@ -26,6 +26,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
@@ -96,6 +97,118 @@ func TestStandardTxExecutorAddValidatorTxEmptyID(t *testing.T) {
 	}
 }
 
+func TestStandardTxExecutorTransferSubnetOwnershipTxPreDurango(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, true /*=postBanff*/, true /*=postCortina*/)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	tx, err := env.txBuilder.NewTransferSubnetOwnershipTx(
+		testSubnet1.ID(),
+		1,
+		[]ids.ShortID{ids.GenerateTestShortID()},
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	require.NoError(err)
+
+	chainTime := env.state.GetTimestamp()
+	tests := []struct {
+		durangoTime   time.Time
+		expectedError error
+	}{
+		{ // Case: Before durango
+			durangoTime:   chainTime.Add(1),
+			expectedError: ErrDurangoUpgradeNotActive,
+		},
+		{ // Case: At durango
+			durangoTime:   chainTime,
+			expectedError: nil,
+		},
+	}
+	for _, test := range tests {
+		env.config.DurangoTime = test.durangoTime
+
+		stateDiff, err := state.NewDiff(lastAcceptedID, env)
+		require.NoError(err)
+
+		executor := StandardTxExecutor{
+			Backend: &env.backend,
+			State:   stateDiff,
+			Tx:      tx,
+		}
+		err = tx.Unsigned.Visit(&executor)
+		require.ErrorIs(err, test.expectedError)
+	}
+}
+
+func TestStandardTxExecutorAddValidatorTxLockOwnerPreDurango(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, true /*=postBanff*/, true /*=postCortina*/)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	chainTime := env.state.GetTimestamp()
+	startTime := chainTime.Add(1 * time.Second)
+
+	tx, err := env.txBuilder.NewAddValidatorTx(
+		env.config.MinValidatorStake,
+		uint64(startTime.Unix()),
+		uint64(startTime.Add(defaultMinValidatorStakingDuration).Unix()),
+		ids.GenerateTestNodeID(),
+		ids.GenerateTestShortID(),
+		reward.PercentDenominator,
+		[]*secp256k1.PrivateKey{preFundedKeys[0]},
+		ids.ShortEmpty, // change addr
+	)
+	require.NoError(err)
+
+	// Lock the rewards for restaking and re-sign the tx
+	utx := tx.Unsigned.(*txs.AddValidatorTx)
+	utx.RewardsOwner = &stakeable.LockOwner{
+		Locktime: uint64(startTime.Add(defaultMaxValidatorStakingDuration).Unix()),
+		Owner:    utx.RewardsOwner,
+	}
+	signers := make([][]*secp256k1.PrivateKey, len(utx.Ins))
+	for i := range signers {
+		signers[i] = []*secp256k1.PrivateKey{preFundedKeys[0]}
+	}
+	tx, err = txs.NewSigned(utx, txs.Codec, signers)
+	require.NoError(err)
+
+	tests := []struct {
+		durangoTime   time.Time
+		expectedError error
+	}{
+		{ // Case: Before durango
+			durangoTime:   chainTime.Add(1),
+			expectedError: ErrDurangoUpgradeNotActive,
+		},
+		{ // Case: At durango
+			durangoTime:   chainTime,
+			expectedError: nil,
+		},
+	}
+	for _, test := range tests {
+		env.config.DurangoTime = test.durangoTime
+
+		stateDiff, err := state.NewDiff(lastAcceptedID, env)
+		require.NoError(err)
+
+		executor := StandardTxExecutor{
+			Backend: &env.backend,
+			State:   stateDiff,
+			Tx:      tx,
+		}
+		err = tx.Unsigned.Visit(&executor)
+		require.ErrorIs(err, test.expectedError)
+	}
+}
+
 func TestStandardTxExecutorAddDelegator(t *testing.T) {
 	dummyHeight := uint64(1)
 	rewardAddress := preFundedKeys[0].PublicKey().Address()
@@ -1577,6 +1690,9 @@ func TestStandardExecutorTransformSubnetTx(t *testing.T) {
 				).Return(nil).Times(1)
 				env.state.EXPECT().AddSubnetTransformation(env.tx)
 				env.state.EXPECT().SetCurrentSupply(env.unsignedTx.Subnet, env.unsignedTx.InitialSupply)
+				env.state.EXPECT().SetSupplyChanges(env.unsignedTx.Subnet, state.SupplyChanges{
+					Base: env.unsignedTx.InitialSupply,
+				})
 				env.state.EXPECT().DeleteUTXO(gomock.Any()).Times(len(env.unsignedTx.Ins))
 				env.state.EXPECT().AddUTXO(gomock.Any()).Times(len(env.unsignedTx.Outs))
 				e := &StandardTxExecutor{
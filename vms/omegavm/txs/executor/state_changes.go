@@ -11,6 +11,7 @@ import (
 
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/math"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
@@ -65,6 +66,7 @@ type StateChanges interface {
 
 type stateChanges struct {
 	updatedSupplies           map[ids.ID]uint64
+	updatedSupplyChanges      map[ids.ID]state.SupplyChanges
 	currentValidatorsToAdd    []*state.Staker
 	currentDelegatorsToAdd    []*state.Staker
 	pendingValidatorsToRemove []*state.Staker
@@ -80,6 +82,9 @@ func (s *stateChanges) Apply(stateDiff state.Diff) {
 	for subnetID, supply := range s.updatedSupplies {
 		stateDiff.SetCurrentSupply(subnetID, supply)
 	}
+	for subnetID, supplyChanges := range s.updatedSupplyChanges {
+		stateDiff.SetSupplyChanges(subnetID, supplyChanges)
+	}
 	for _, currentValidatorToAdd := range s.currentValidatorsToAdd {
 		stateDiff.PutCurrentValidator(currentValidatorToAdd)
 	}
@@ -202,6 +207,27 @@ func (s *stateChanges) updateFeePerWeight(backend *Backend, parentState state.Ch
 	return nil
 }
 
+// addMinted records that [amount] of rewards were added to the supply of
+// [subnetID].
+func (s *stateChanges) addMinted(parentState state.Chain, subnetID ids.ID, amount uint64) error {
+	supplyChanges, ok := s.updatedSupplyChanges[subnetID]
+	if !ok {
+		var err error
+		supplyChanges, err = parentState.GetSupplyChanges(subnetID)
+		if err != nil {
+			return err
+		}
+	}
+
+	minted, err := math.Add64(supplyChanges.Minted, amount)
+	if err != nil {
+		return err
+	}
+	supplyChanges.Minted = minted
+	s.updatedSupplyChanges[subnetID] = supplyChanges
+	return nil
+}
+
 // AdvanceTimeTo does not modify [parentState].
 // Instead it returns all the StateChanges caused by advancing the chain time to
 // the [newChainTime].
@@ -217,7 +243,8 @@ func AdvanceTimeTo(
 	defer pendingStakerIterator.Release()
 
 	changes := &stateChanges{
-		updatedSupplies: make(map[ids.ID]uint64),
+		updatedSupplies:      make(map[ids.ID]uint64),
+		updatedSupplyChanges: make(map[ids.ID]state.SupplyChanges),
 	}
 
 	// Add to the staker set any pending stakers whose start time is at or
@@ -289,6 +316,9 @@ func AdvanceTimeTo(
 			// Invariant: [rewards.Calculate] can never return a [potentialReward]
 			//            such that [supply + potentialReward > maximumSupply].
 			changes.updatedSupplies[stakerToRemove.SubnetID] = supply + potentialReward
+			if err := changes.addMinted(parentState, stakerToRemove.SubnetID, potentialReward); err != nil {
+				return nil, err
+			}
 		}
 
 		switch stakerToRemove.Priority {
@@ -346,6 +376,9 @@ func AdvanceTimeTo(
 
 			stakerToRemove.PotentialReward = mint + fee
 			changes.updatedSupplies[stakerToRemove.SubnetID] = supply + stakerToRemove.PotentialReward
+			if err := changes.addMinted(parentState, stakerToRemove.SubnetID, stakerToRemove.PotentialReward); err != nil {
+				return nil, err
+			}
 		}
 
 		// Invariant: Permissioned stakers are encountered first for a given
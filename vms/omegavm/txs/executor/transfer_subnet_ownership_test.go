@@ -0,0 +1,102 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+func TestTransferSubnetOwnershipTx(t *testing.T) {
+	require := require.New(t)
+	env := newEnvironment(t, true /*=postBanff*/, true /*=postCortina*/)
+	env.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(shutdownEnvironment(env))
+	}()
+
+	subnetID := testSubnet1.ID()
+	newOwnerKey := preFundedKeys[3]
+	newOwnerAddr := newOwnerKey.PublicKey().Address()
+
+	transferTx, err := env.txBuilder.NewTransferSubnetOwnershipTx(
+		subnetID,
+		1,
+		[]ids.ShortID{newOwnerAddr},
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	// Built before the transfer is accepted, so that it is authorized by the
+	// previous owner.
+	staleTransferTx, err := env.txBuilder.NewTransferSubnetOwnershipTx(
+		subnetID,
+		1,
+		[]ids.ShortID{testSubnet1ControlKeys[0].PublicKey().Address()},
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	stateDiff, err := state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor := StandardTxExecutor{
+		Backend: &env.backend,
+		State:   stateDiff,
+		Tx:      transferTx,
+	}
+	require.NoError(transferTx.Unsigned.Visit(&executor))
+
+	stateDiff.Apply(env.state)
+	require.NoError(env.state.Commit())
+
+	owner, err := env.state.GetSubnetOwner(subnetID)
+	require.NoError(err)
+	require.Equal(
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{newOwnerAddr},
+		},
+		owner,
+	)
+
+	// The previous owner can no longer modify the subnet.
+	stateDiff, err = state.NewDiff(lastAcceptedID, env)
+	require.NoError(err)
+
+	executor = StandardTxExecutor{
+		Backend: &env.backend,
+		State:   stateDiff,
+		Tx:      staleTransferTx,
+	}
+	err = staleTransferTx.Unsigned.Visit(&executor)
+	require.ErrorIs(err, errUnauthorizedSubnetModification)
+
+	// The new owner can.
+	chainTx, err := env.txBuilder.NewCreateChainTx(
+		subnetID,
+		nil,
+		ids.GenerateTestID(),
+		nil,
+		"chain name",
+		[]*secp256k1.PrivateKey{newOwnerKey},
+		ids.ShortEmpty,
+	)
+	require.NoError(err)
+
+	executor = StandardTxExecutor{
+		Backend: &env.backend,
+		State:   stateDiff,
+		Tx:      chainTx,
+	}
+	require.NoError(chainTx.Unsigned.Visit(&executor))
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package mempool
+
+import (
+	"errors"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/linkedhashmap"
+	"github.com/DioneProtocol/odysseygo/utils/wrappers"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
+)
+
+// maxDroppedTxReasonLen is the maximum number of bytes of a drop reason's
+// message that is persisted.
+const maxDroppedTxReasonLen = 1024
+
+// droppedTx records why a tx was dropped
+type droppedTx struct {
+	// Order in which the tx was dropped, used to restore the history's order
+	// after a restart
+	seq    uint64
+	reason status.DropReason
+	err    error
+}
+
+// droppedTxs is a bounded history of the most recently dropped txs. The
+// history is persisted so that dropped txs are still reported as dropped
+// after a restart.
+type droppedTxs struct {
+	db      database.Database
+	maxSize int
+	nextSeq uint64
+
+	// Key: Tx ID
+	// Value: Why the tx was dropped
+	// Ordered from least to most recently dropped.
+	txs linkedhashmap.LinkedHashmap[ids.ID, *droppedTx]
+}
+
+func newDroppedTxs(db database.Database, maxSize int) (*droppedTxs, error) {
+	d := &droppedTxs{
+		db:      db,
+		maxSize: maxSize,
+		txs:     linkedhashmap.New[ids.ID, *droppedTx](),
+	}
+
+	type persistedTx struct {
+		txID ids.ID
+		tx   *droppedTx
+	}
+	var persistedTxs []persistedTx
+
+	it := db.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		txID, err := ids.ToID(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		tx, err := parseDroppedTx(it.Value())
+		if err != nil {
+			return nil, err
+		}
+		persistedTxs = append(persistedTxs, persistedTx{
+			txID: txID,
+			tx:   tx,
+		})
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(persistedTxs, func(a, b persistedTx) bool {
+		return a.tx.seq < b.tx.seq
+	})
+	for _, persistedTx := range persistedTxs {
+		d.txs.Put(persistedTx.txID, persistedTx.tx)
+		d.nextSeq = persistedTx.tx.seq + 1
+	}
+	return d, d.evictOldest()
+}
+
+// Put records that [txID] was dropped because of [err].
+func (d *droppedTxs) Put(txID ids.ID, reason status.DropReason, err error) error {
+	tx := &droppedTx{
+		seq:    d.nextSeq,
+		reason: reason,
+		err:    err,
+	}
+	d.nextSeq++
+	d.txs.Put(txID, tx)
+
+	if err := d.db.Put(txID[:], tx.bytes()); err != nil {
+		return err
+	}
+	return d.evictOldest()
+}
+
+func (d *droppedTxs) Get(txID ids.ID) (*droppedTx, bool) {
+	return d.txs.Get(txID)
+}
+
+// Delete removes [txID] from the history.
+func (d *droppedTxs) Delete(txID ids.ID) error {
+	if _, ok := d.txs.Get(txID); !ok {
+		return nil
+	}
+	d.txs.Delete(txID)
+	return d.db.Delete(txID[:])
+}
+
+// evictOldest removes the least recently dropped txs until the history fits
+// in [maxSize].
+func (d *droppedTxs) evictOldest() error {
+	for d.txs.Len() > d.maxSize {
+		txID, _, _ := d.txs.Oldest()
+		if err := d.Delete(txID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx *droppedTx) bytes() []byte {
+	reason := tx.err.Error()
+	if len(reason) > maxDroppedTxReasonLen {
+		reason = reason[:maxDroppedTxReasonLen]
+	}
+
+	p := wrappers.Packer{
+		Bytes: make([]byte, wrappers.LongLen+wrappers.IntLen+wrappers.ShortLen+len(reason)),
+	}
+	p.PackLong(tx.seq)
+	p.PackInt(uint32(tx.reason))
+	p.PackStr(reason)
+	return p.Bytes
+}
+
+func parseDroppedTx(b []byte) (*droppedTx, error) {
+	p := wrappers.Packer{Bytes: b}
+	tx := &droppedTx{
+		seq:    p.UnpackLong(),
+		reason: status.DropReason(p.UnpackInt()),
+	}
+	tx.err = errors.New(p.UnpackStr())
+	return tx, p.Err
+}
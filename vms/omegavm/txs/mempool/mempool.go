@@ -6,14 +6,18 @@ package mempool
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
-	"github.com/DioneProtocol/odysseygo/cache"
+	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/utils/units"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/executor"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/txheap"
 )
 
@@ -22,8 +26,9 @@ const (
 	// allowed into the mempool.
 	targetTxSize = 64 * units.KiB
 
-	// droppedTxIDsCacheSize is the maximum number of dropped txIDs to cache
-	droppedTxIDsCacheSize = 64
+	// maxDroppedTxs is the maximum number of dropped txs whose drop reason is
+	// remembered
+	maxDroppedTxs = 1024
 
 	initialConsumedUTXOsSize = 512
 
@@ -55,6 +60,12 @@ type Mempool interface {
 	Get(txID ids.ID) *txs.Tx
 	Remove(txs []*txs.Tx)
 
+	// List returns all the txs currently in the mempool, decision txs first.
+	List() []*txs.Tx
+	// GetAddTime returns the time [txID] was added to the mempool. It returns
+	// false if [txID] isn't in the mempool.
+	GetAddTime(txID ids.ID) (time.Time, bool)
+
 	// Following Banff activation, all mempool transactions,
 	// (both decision and staker) are included into Standard blocks.
 	// HasTxs allow to check for availability of any mempool transaction.
@@ -69,12 +80,16 @@ type Mempool interface {
 	// It's guaranteed that the returned tx, if not nil, is a StakerTx.
 	PeekStakerTx() *txs.Tx
 
-	// Note: dropped txs are added to droppedTxIDs but not
+	// Note: dropped txs are added to droppedTxs but not
 	// not evicted from unissued decision/staker txs.
 	// This allows previously dropped txs to be possibly
 	// reissued.
 	MarkDropped(txID ids.ID, reason error)
 	GetDropReason(txID ids.ID) error
+	// GetDropReasonCode returns the classification of the reason [txID] was
+	// dropped. It returns [status.DropReasonUnknown] if [txID] wasn't recently
+	// dropped.
+	GetDropReasonCode(txID ids.ID) status.DropReason
 }
 
 // Transactions from clients that have not yet been put into blocks and added to
@@ -89,18 +104,25 @@ type mempool struct {
 	unissuedDecisionTxs txheap.Heap
 	unissuedStakerTxs   txheap.Heap
 
-	// Key: Tx ID
-	// Value: Verification error
-	droppedTxIDs *cache.LRU[ids.ID, error]
+	// Recently dropped txs and the reasons they were dropped
+	droppedTxs *droppedTxs
 
 	consumedUTXOs set.Set[ids.ID]
 
+	// Key: Tx ID
+	// Value: Time the tx was added to the mempool
+	addTimes map[ids.ID]time.Time
+	clock    mockable.Clock
+
 	blkTimer BlockTimer
 }
 
+// NewMempool returns a mempool that persists the reasons txs were dropped in
+// [droppedTxsDB].
 func NewMempool(
 	namespace string,
 	registerer prometheus.Registerer,
+	droppedTxsDB database.Database,
 	blkTimer BlockTimer,
 ) (Mempool, error) {
 	bytesAvailableMetric := prometheus.NewGauge(prometheus.GaugeOpts{
@@ -130,14 +152,20 @@ func NewMempool(
 		return nil, err
 	}
 
+	droppedTxs, err := newDroppedTxs(droppedTxsDB, maxDroppedTxs)
+	if err != nil {
+		return nil, err
+	}
+
 	bytesAvailableMetric.Set(maxMempoolSize)
 	return &mempool{
 		bytesAvailableMetric: bytesAvailableMetric,
 		bytesAvailable:       maxMempoolSize,
 		unissuedDecisionTxs:  unissuedDecisionTxs,
 		unissuedStakerTxs:    unissuedStakerTxs,
-		droppedTxIDs:         &cache.LRU[ids.ID, error]{Size: droppedTxIDsCacheSize},
+		droppedTxs:           droppedTxs,
 		consumedUTXOs:        set.NewSet[ids.ID](initialConsumedUTXOsSize),
+		addTimes:             make(map[ids.ID]time.Time),
 		dropIncoming:         false, // enable tx adding by default
 		blkTimer:             blkTimer,
 	}, nil
@@ -191,7 +219,10 @@ func (m *mempool) Add(tx *txs.Tx) error {
 	m.consumedUTXOs.Union(inputs)
 
 	// An explicitly added tx must not be marked as dropped.
-	m.droppedTxIDs.Evict(txID)
+	//
+	// Note: Failing to update the persisted history only affects how the tx
+	// is reported after a restart.
+	_ = m.droppedTxs.Delete(txID)
 
 	m.blkTimer.ResetBlockTimer()
 	return nil
@@ -219,13 +250,22 @@ func (m *mempool) Remove(txsToRemove []*txs.Tx) {
 	}
 }
 
+func (m *mempool) List() []*txs.Tx {
+	txs := m.unissuedDecisionTxs.List()
+	return append(txs, m.unissuedStakerTxs.List()...)
+}
+
+func (m *mempool) GetAddTime(txID ids.ID) (time.Time, bool) {
+	addTime, ok := m.addTimes[txID]
+	return addTime, ok
+}
+
 func (m *mempool) HasTxs() bool {
 	return m.unissuedDecisionTxs.Len() > 0 || m.unissuedStakerTxs.Len() > 0
 }
 
 func (m *mempool) PeekTxs(maxTxsBytes int) []*txs.Tx {
-	txs := m.unissuedDecisionTxs.List()
-	txs = append(txs, m.unissuedStakerTxs.List()...)
+	txs := m.List()
 
 	size := 0
 	for i, tx := range txs {
@@ -276,18 +316,33 @@ func (m *mempool) PeekStakerTx() *txs.Tx {
 }
 
 func (m *mempool) MarkDropped(txID ids.ID, reason error) {
-	m.droppedTxIDs.Put(txID, reason)
+	// Note: Failing to update the persisted history only affects how the tx
+	// is reported after a restart.
+	_ = m.droppedTxs.Put(txID, executor.ClassifyDropReason(reason), reason)
 }
 
 func (m *mempool) GetDropReason(txID ids.ID) error {
-	err, _ := m.droppedTxIDs.Get(txID)
-	return err
+	tx, ok := m.droppedTxs.Get(txID)
+	if !ok {
+		return nil
+	}
+	return tx.err
+}
+
+func (m *mempool) GetDropReasonCode(txID ids.ID) status.DropReason {
+	tx, ok := m.droppedTxs.Get(txID)
+	if !ok {
+		return status.DropReasonUnknown
+	}
+	return tx.reason
 }
 
 func (m *mempool) register(tx *txs.Tx) {
 	txBytes := tx.Bytes()
 	m.bytesAvailable -= len(txBytes)
 	m.bytesAvailableMetric.Set(float64(m.bytesAvailable))
+
+	m.addTimes[tx.ID()] = m.clock.Time()
 }
 
 func (m *mempool) deregister(tx *txs.Tx) {
@@ -297,4 +352,6 @@ func (m *mempool) deregister(tx *txs.Tx) {
 
 	inputs := tx.Unsigned.InputIDs()
 	m.consumedUTXOs.Difference(inputs)
+
+	delete(m.addTimes, tx.ID())
 }
@@ -5,6 +5,7 @@ package mempool
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"testing"
 	"time"
@@ -13,10 +14,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/DioneProtocol/odysseygo/database/memdb"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/executor"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 )
 
@@ -26,7 +30,11 @@ type noopBlkTimer struct{}
 
 func (*noopBlkTimer) ResetBlockTimer() {}
 
-var preFundedKeys = secp256k1.TestKeys()
+var (
+	preFundedKeys = secp256k1.TestKeys()
+
+	errTest = errors.New("test error")
+)
 
 // shows that valid tx is not added to mempool if this would exceed its maximum
 // size
@@ -34,7 +42,7 @@ func TestBlockBuilderMaxMempoolSizeHandling(t *testing.T) {
 	require := require.New(t)
 
 	registerer := prometheus.NewRegistry()
-	mpool, err := NewMempool("mempool", registerer, &noopBlkTimer{})
+	mpool, err := NewMempool("mempool", registerer, memdb.New(), &noopBlkTimer{})
 	require.NoError(err)
 
 	decisionTxs, err := createTestDecisionTxs(1)
@@ -58,7 +66,7 @@ func TestDecisionTxsInMempool(t *testing.T) {
 	require := require.New(t)
 
 	registerer := prometheus.NewRegistry()
-	mpool, err := NewMempool("mempool", registerer, &noopBlkTimer{})
+	mpool, err := NewMempool("mempool", registerer, memdb.New(), &noopBlkTimer{})
 	require.NoError(err)
 
 	decisionTxs, err := createTestDecisionTxs(2)
@@ -110,7 +118,7 @@ func TestProposalTxsInMempool(t *testing.T) {
 	require := require.New(t)
 
 	registerer := prometheus.NewRegistry()
-	mpool, err := NewMempool("mempool", registerer, &noopBlkTimer{})
+	mpool, err := NewMempool("mempool", registerer, memdb.New(), &noopBlkTimer{})
 	require.NoError(err)
 
 	// The proposal txs are ordered by decreasing start time. This means after
@@ -171,6 +179,116 @@ func TestProposalTxsInMempool(t *testing.T) {
 	}
 }
 
+func TestMempoolListAndAddTime(t *testing.T) {
+	require := require.New(t)
+
+	registerer := prometheus.NewRegistry()
+	mpool, err := NewMempool("mempool", registerer, memdb.New(), &noopBlkTimer{})
+	require.NoError(err)
+
+	now := time.Unix(1607133207, 0)
+	mpool.(*mempool).clock.Set(now)
+
+	decisionTxs, err := createTestDecisionTxs(1)
+	require.NoError(err)
+	proposalTxs, err := createTestProposalTxs(1)
+	require.NoError(err)
+	decisionTx := decisionTxs[0]
+	proposalTx := proposalTxs[0]
+
+	_, ok := mpool.GetAddTime(decisionTx.ID())
+	require.False(ok)
+	require.Empty(mpool.List())
+
+	require.NoError(mpool.Add(proposalTx))
+	mpool.(*mempool).clock.Set(now.Add(time.Second))
+	require.NoError(mpool.Add(decisionTx))
+
+	// decision txs are listed before staker txs
+	require.Equal([]*txs.Tx{decisionTx, proposalTx}, mpool.List())
+
+	addTime, ok := mpool.GetAddTime(proposalTx.ID())
+	require.True(ok)
+	require.Equal(now, addTime)
+	addTime, ok = mpool.GetAddTime(decisionTx.ID())
+	require.True(ok)
+	require.Equal(now.Add(time.Second), addTime)
+
+	mpool.Remove([]*txs.Tx{proposalTx})
+	require.Equal([]*txs.Tx{decisionTx}, mpool.List())
+	_, ok = mpool.GetAddTime(proposalTx.ID())
+	require.False(ok)
+}
+
+func TestDroppedTxsPersisted(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	mpool, err := NewMempool("mempool", prometheus.NewRegistry(), db, &noopBlkTimer{})
+	require.NoError(err)
+
+	decisionTxs, err := createTestDecisionTxs(2)
+	require.NoError(err)
+	droppedTxID := decisionTxs[0].ID()
+	reissuedTx := decisionTxs[1]
+
+	require.Equal(status.DropReasonUnknown, mpool.GetDropReasonCode(droppedTxID))
+
+	dropErr := fmt.Errorf("%w: too short", executor.ErrStakeTooShort)
+	mpool.MarkDropped(droppedTxID, dropErr)
+	mpool.MarkDropped(reissuedTx.ID(), errTest)
+	require.ErrorIs(mpool.GetDropReason(droppedTxID), executor.ErrStakeTooShort)
+	require.Equal(status.DropReasonInvalidStakingPeriod, mpool.GetDropReasonCode(droppedTxID))
+
+	// Re-adding a tx clears its drop reason
+	require.NoError(mpool.Add(reissuedTx))
+	require.NoError(mpool.GetDropReason(reissuedTx.ID()))
+
+	// The drop reasons are remembered across restarts
+	mpool, err = NewMempool("mempool", prometheus.NewRegistry(), db, &noopBlkTimer{})
+	require.NoError(err)
+	reason := mpool.GetDropReason(droppedTxID)
+	require.ErrorContains(reason, dropErr.Error())
+	require.Equal(status.DropReasonInvalidStakingPeriod, mpool.GetDropReasonCode(droppedTxID))
+	require.NoError(mpool.GetDropReason(reissuedTx.ID()))
+}
+
+func TestDroppedTxsBounded(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	history, err := newDroppedTxs(db, 2)
+	require.NoError(err)
+
+	txIDs := []ids.ID{
+		ids.GenerateTestID(),
+		ids.GenerateTestID(),
+		ids.GenerateTestID(),
+	}
+	for _, txID := range txIDs {
+		require.NoError(history.Put(txID, status.DropReasonInvalid, errTest))
+	}
+
+	// The least recently dropped tx is evicted from memory and disk
+	_, ok := history.Get(txIDs[0])
+	require.False(ok)
+	has, err := db.Has(txIDs[0][:])
+	require.NoError(err)
+	require.False(has)
+
+	// The order of the history is restored after a restart
+	history, err = newDroppedTxs(db, 2)
+	require.NoError(err)
+	require.NoError(history.Put(ids.GenerateTestID(), status.DropReasonInvalid, errTest))
+
+	_, ok = history.Get(txIDs[1])
+	require.False(ok)
+	tx, ok := history.Get(txIDs[2])
+	require.True(ok)
+	require.Equal(status.DropReasonInvalid, tx.reason)
+	require.ErrorContains(tx.err, errTest.Error())
+}
+
 func createTestDecisionTxs(count int) ([]*txs.Tx, error) {
 	decisionTxs := make([]*txs.Tx, 0, count)
 	for i := uint32(0); i < uint32(count); i++ {
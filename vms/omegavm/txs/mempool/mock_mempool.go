@@ -9,8 +9,10 @@ package mempool
 
 import (
 	reflect "reflect"
+	time "time"
 
 	ids "github.com/DioneProtocol/odysseygo/ids"
+	status "github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	txs "github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -90,6 +92,21 @@ func (mr *MockMempoolMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMempool)(nil).Get), arg0)
 }
 
+// GetAddTime mocks base method.
+func (m *MockMempool) GetAddTime(arg0 ids.ID) (time.Time, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddTime", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetAddTime indicates an expected call of GetAddTime.
+func (mr *MockMempoolMockRecorder) GetAddTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddTime", reflect.TypeOf((*MockMempool)(nil).GetAddTime), arg0)
+}
+
 // GetDropReason mocks base method.
 func (m *MockMempool) GetDropReason(arg0 ids.ID) error {
 	m.ctrl.T.Helper()
@@ -104,6 +121,20 @@ func (mr *MockMempoolMockRecorder) GetDropReason(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropReason", reflect.TypeOf((*MockMempool)(nil).GetDropReason), arg0)
 }
 
+// GetDropReasonCode mocks base method.
+func (m *MockMempool) GetDropReasonCode(arg0 ids.ID) status.DropReason {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropReasonCode", arg0)
+	ret0, _ := ret[0].(status.DropReason)
+	return ret0
+}
+
+// GetDropReasonCode indicates an expected call of GetDropReasonCode.
+func (mr *MockMempoolMockRecorder) GetDropReasonCode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropReasonCode", reflect.TypeOf((*MockMempool)(nil).GetDropReasonCode), arg0)
+}
+
 // Has mocks base method.
 func (m *MockMempool) Has(arg0 ids.ID) bool {
 	m.ctrl.T.Helper()
@@ -146,6 +177,20 @@ func (mr *MockMempoolMockRecorder) HasTxs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasTxs", reflect.TypeOf((*MockMempool)(nil).HasTxs))
 }
 
+// List mocks base method.
+func (m *MockMempool) List() []*txs.Tx {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]*txs.Tx)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockMempoolMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMempool)(nil).List))
+}
+
 // MarkDropped mocks base method.
 func (m *MockMempool) MarkDropped(arg0 ids.ID, arg1 error) {
 	m.ctrl.T.Helper()
@@ -67,6 +67,11 @@ func (r *remover) AddPermissionlessDelegatorTx(*txs.AddPermissionlessDelegatorTx
 	return nil
 }
 
+func (r *remover) TransferSubnetOwnershipTx(*txs.TransferSubnetOwnershipTx) error {
+	r.m.removeDecisionTxs([]*txs.Tx{r.tx})
+	return nil
+}
+
 func (*remover) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
 	// this tx is never in mempool
 	return nil
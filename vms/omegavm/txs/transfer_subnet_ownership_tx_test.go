@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/components/verify"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
+)
+
+var errInvalidOwner = errors.New("invalid owner")
+
+func TestTransferSubnetOwnershipTxSerialization(t *testing.T) {
+	require := require.New(t)
+
+	unsignedTx := &TransferSubnetOwnershipTx{
+		BaseTx: BaseTx{
+			BaseTx: dione.BaseTx{
+				NetworkID:    constants.UnitTestID,
+				BlockchainID: constants.OmegaChainID,
+				Outs:         []*dione.TransferableOutput{},
+				Ins:          []*dione.TransferableInput{},
+				Memo:         []byte{},
+			},
+		},
+		Subnet: ids.GenerateTestID(),
+		SubnetAuth: &secp256k1fx.Input{
+			SigIndices: []uint32{0, 1},
+		},
+		Owner: &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs: []ids.ShortID{
+				ids.GenerateTestShortID(),
+			},
+		},
+	}
+
+	var unsignedTxIntf UnsignedTx = unsignedTx
+	txBytes, err := Codec.Marshal(Version, &unsignedTxIntf)
+	require.NoError(err)
+
+	var parsedTxIntf UnsignedTx
+	_, err = Codec.Unmarshal(txBytes, &parsedTxIntf)
+	require.NoError(err)
+	require.IsType(&TransferSubnetOwnershipTx{}, parsedTxIntf)
+
+	parsedTx := parsedTxIntf.(*TransferSubnetOwnershipTx)
+	require.Equal(unsignedTx.Subnet, parsedTx.Subnet)
+	require.Equal(unsignedTx.SubnetAuth, parsedTx.SubnetAuth)
+	require.Equal(unsignedTx.Owner, parsedTx.Owner)
+}
+
+func TestTransferSubnetOwnershipTxSyntacticVerify(t *testing.T) {
+	type test struct {
+		name        string
+		txFunc      func(*gomock.Controller) *TransferSubnetOwnershipTx
+		expectedErr error
+	}
+
+	var (
+		networkID = uint32(1337)
+		chainID   = ids.GenerateTestID()
+	)
+
+	ctx := &snow.Context{
+		ChainID:   chainID,
+		NetworkID: networkID,
+	}
+
+	// A BaseTx that already passed syntactic verification.
+	verifiedBaseTx := BaseTx{
+		SyntacticallyVerified: true,
+	}
+
+	// A BaseTx that passes syntactic verification.
+	validBaseTx := BaseTx{
+		BaseTx: dione.BaseTx{
+			NetworkID:    networkID,
+			BlockchainID: chainID,
+		},
+	}
+
+	// A BaseTx that fails syntactic verification.
+	invalidBaseTx := BaseTx{}
+
+	tests := []test{
+		{
+			name: "nil tx",
+			txFunc: func(*gomock.Controller) *TransferSubnetOwnershipTx {
+				return nil
+			},
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			txFunc: func(*gomock.Controller) *TransferSubnetOwnershipTx {
+				return &TransferSubnetOwnershipTx{BaseTx: verifiedBaseTx}
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "primary network",
+			txFunc: func(*gomock.Controller) *TransferSubnetOwnershipTx {
+				return &TransferSubnetOwnershipTx{
+					BaseTx: validBaseTx,
+					Subnet: constants.PrimaryNetworkID,
+				}
+			},
+			expectedErr: ErrTransferPrimaryNetworkOwnership,
+		},
+		{
+			name: "invalid BaseTx",
+			txFunc: func(*gomock.Controller) *TransferSubnetOwnershipTx {
+				return &TransferSubnetOwnershipTx{
+					BaseTx: invalidBaseTx,
+					Subnet: ids.GenerateTestID(),
+				}
+			},
+			expectedErr: dione.ErrWrongNetworkID,
+		},
+		{
+			name: "invalid subnetAuth",
+			txFunc: func(ctrl *gomock.Controller) *TransferSubnetOwnershipTx {
+				invalidSubnetAuth := verify.NewMockVerifiable(ctrl)
+				invalidSubnetAuth.EXPECT().Verify().Return(errInvalidSubnetAuth)
+				return &TransferSubnetOwnershipTx{
+					BaseTx:     validBaseTx,
+					Subnet:     ids.GenerateTestID(),
+					SubnetAuth: invalidSubnetAuth,
+				}
+			},
+			expectedErr: errInvalidSubnetAuth,
+		},
+		{
+			name: "invalid owner",
+			txFunc: func(ctrl *gomock.Controller) *TransferSubnetOwnershipTx {
+				validSubnetAuth := verify.NewMockVerifiable(ctrl)
+				validSubnetAuth.EXPECT().Verify().Return(nil)
+				invalidOwner := fx.NewMockOwner(ctrl)
+				invalidOwner.EXPECT().Verify().Return(errInvalidOwner)
+				return &TransferSubnetOwnershipTx{
+					BaseTx:     validBaseTx,
+					Subnet:     ids.GenerateTestID(),
+					SubnetAuth: validSubnetAuth,
+					Owner:      invalidOwner,
+				}
+			},
+			expectedErr: errInvalidOwner,
+		},
+		{
+			name: "passes verification",
+			txFunc: func(ctrl *gomock.Controller) *TransferSubnetOwnershipTx {
+				validSubnetAuth := verify.NewMockVerifiable(ctrl)
+				validSubnetAuth.EXPECT().Verify().Return(nil)
+				validOwner := fx.NewMockOwner(ctrl)
+				validOwner.EXPECT().Verify().Return(nil)
+				return &TransferSubnetOwnershipTx{
+					BaseTx:     validBaseTx,
+					Subnet:     ids.GenerateTestID(),
+					SubnetAuth: validSubnetAuth,
+					Owner:      validOwner,
+				}
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+
+			tx := tt.txFunc(ctrl)
+			err := tx.SyntacticVerify(ctx)
+			require.ErrorIs(err, tt.expectedErr)
+			if tt.expectedErr != nil {
+				return
+			}
+			require.True(tx.SyntacticallyVerified)
+		})
+	}
+}
@@ -69,6 +69,59 @@ type Spender interface {
 		error,
 	)
 
+	// SpendAsset is like Spend, but stakes [amount] of [stakeAssetID] rather
+	// than DIONE. The fee is still paid in DIONE.
+	SpendAsset(
+		utxoReader dione.UTXOReader,
+		keys []*secp256k1.PrivateKey,
+		stakeAssetID ids.ID,
+		amount uint64,
+		fee uint64,
+		changeAddr ids.ShortID,
+	) (
+		[]*dione.TransferableInput, // inputs
+		[]*dione.TransferableOutput, // returnedOutputs
+		[]*dione.TransferableOutput, // stakedOutputs
+		[][]*secp256k1.PrivateKey, // signers
+		error,
+	)
+
+	// Burn consumes enough funds to burn the amount of each asset in [toBurn].
+	// Nothing is staked.
+	// Returns:
+	// - [inputs] the inputs that should be consumed to fund the burn
+	// - [returnedOutputs] the change outputs that should be returned to the
+	//                     UTXO set
+	// - [signers] the proof of ownership of the funds being moved
+	Burn(
+		utxoReader dione.UTXOReader,
+		keys []*secp256k1.PrivateKey,
+		toBurn map[ids.ID]uint64,
+		changeAddr ids.ShortID,
+	) (
+		[]*dione.TransferableInput, // inputs
+		[]*dione.TransferableOutput, // returnedOutputs
+		[][]*secp256k1.PrivateKey, // signers
+		error,
+	)
+
+	// SpendFromAddrs is like Spend, but only requires the addresses that own
+	// the funds rather than their keys. In place of the keys, it returns the
+	// addresses that must sign each input.
+	SpendFromAddrs(
+		utxoReader dione.UTXOReader,
+		addrs set.Set[ids.ShortID],
+		amount uint64,
+		fee uint64,
+		changeAddr ids.ShortID,
+	) (
+		[]*dione.TransferableInput, // inputs
+		[]*dione.TransferableOutput, // returnedOutputs
+		[]*dione.TransferableOutput, // stakedOutputs
+		[][]ids.ShortID, // signers
+		error,
+	)
+
 	// Authorize an operation on behalf of the named subnet with the provided
 	// keys.
 	Authorize(
@@ -158,6 +211,23 @@ func (h *handler) Spend(
 	[]*dione.TransferableOutput, // stakedOutputs
 	[][]*secp256k1.PrivateKey, // signers
 	error,
+) {
+	return h.SpendAsset(utxoReader, keys, h.ctx.DIONEAssetID, amount, fee, changeAddr)
+}
+
+func (h *handler) SpendAsset(
+	utxoReader dione.UTXOReader,
+	keys []*secp256k1.PrivateKey,
+	stakeAssetID ids.ID,
+	amount uint64,
+	fee uint64,
+	changeAddr ids.ShortID,
+) (
+	[]*dione.TransferableInput, // inputs
+	[]*dione.TransferableOutput, // returnedOutputs
+	[]*dione.TransferableOutput, // stakedOutputs
+	[][]*secp256k1.PrivateKey, // signers
+	error,
 ) {
 	addrs := set.NewSet[ids.ShortID](len(keys)) // The addresses controlled by [keys]
 	for _, key := range keys {
@@ -169,28 +239,117 @@ func (h *handler) Spend(
 	}
 
 	kc := secp256k1fx.NewKeychain(keys...) // Keychain consumes UTXOs and creates new ones
+	toBurn := map[ids.ID]uint64{
+		h.ctx.DIONEAssetID: fee,
+	}
+	return spend(h, utxos, kc.Spend, stakeAssetID, amount, toBurn, changeAddr)
+}
+
+func (h *handler) Burn(
+	utxoReader dione.UTXOReader,
+	keys []*secp256k1.PrivateKey,
+	toBurn map[ids.ID]uint64,
+	changeAddr ids.ShortID,
+) (
+	[]*dione.TransferableInput, // inputs
+	[]*dione.TransferableOutput, // returnedOutputs
+	[][]*secp256k1.PrivateKey, // signers
+	error,
+) {
+	addrs := set.NewSet[ids.ShortID](len(keys)) // The addresses controlled by [keys]
+	for _, key := range keys {
+		addrs.Add(key.PublicKey().Address())
+	}
+	utxos, err := dione.GetAllUTXOs(utxoReader, addrs) // The UTXOs controlled by [keys]
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("couldn't get UTXOs: %w", err)
+	}
+
+	kc := secp256k1fx.NewKeychain(keys...) // Keychain consumes UTXOs and creates new ones
+	ins, returnedOuts, _, signers, err := spend(h, utxos, kc.Spend, h.ctx.DIONEAssetID, 0, toBurn, changeAddr)
+	return ins, returnedOuts, signers, err
+}
 
+func (h *handler) SpendFromAddrs(
+	utxoReader dione.UTXOReader,
+	addrs set.Set[ids.ShortID],
+	amount uint64,
+	fee uint64,
+	changeAddr ids.ShortID,
+) (
+	[]*dione.TransferableInput, // inputs
+	[]*dione.TransferableOutput, // returnedOutputs
+	[]*dione.TransferableOutput, // stakedOutputs
+	[][]ids.ShortID, // signers
+	error,
+) {
+	utxos, err := dione.GetAllUTXOs(utxoReader, addrs) // The UTXOs controlled by [addrs]
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("couldn't get UTXOs: %w", err)
+	}
+
+	spendOut := func(out verify.Verifiable, time uint64) (verify.Verifiable, []ids.ShortID, error) {
+		transferOut, ok := out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil, nil, fmt.Errorf("can't spend UTXO because it is unexpected type %T", out)
+		}
+		sigIndices, signers, ok := MatchAddrs(&transferOut.OutputOwners, addrs, time)
+		if !ok {
+			return nil, nil, errCantSign
+		}
+		return &secp256k1fx.TransferInput{
+			Amt: transferOut.Amt,
+			Input: secp256k1fx.Input{
+				SigIndices: sigIndices,
+			},
+		}, signers, nil
+	}
+	toBurn := map[ids.ID]uint64{
+		h.ctx.DIONEAssetID: fee,
+	}
+	return spend(h, utxos, spendOut, h.ctx.DIONEAssetID, amount, toBurn, changeAddr)
+}
+
+// spend selects the [utxos] that stake [amount] of [stakeAssetID] and burn
+// the amount of each asset in [toBurn]. [spendOut] is used to create the input
+// that consumes an output along with the signers of the input, so the signers
+// may either be keys or the addresses of the keys.
+func spend[S any](
+	h *handler,
+	utxos []*dione.UTXO,
+	spendOut func(out verify.Verifiable, time uint64) (verify.Verifiable, []S, error),
+	stakeAssetID ids.ID,
+	amount uint64,
+	toBurn map[ids.ID]uint64,
+	changeAddr ids.ShortID,
+) (
+	[]*dione.TransferableInput, // inputs
+	[]*dione.TransferableOutput, // returnedOutputs
+	[]*dione.TransferableOutput, // stakedOutputs
+	[][]S, // signers
+	error,
+) {
 	// Minimum time this transaction will be issued at
 	now := uint64(h.clk.Time().Unix())
 
 	ins := []*dione.TransferableInput{}
 	returnedOuts := []*dione.TransferableOutput{}
 	stakedOuts := []*dione.TransferableOutput{}
-	signers := [][]*secp256k1.PrivateKey{}
+	signers := [][]S{}
 
-	// Amount of DIONE that has been staked
+	// Amount of [stakeAssetID] that has been staked
 	amountStaked := uint64(0)
 
 	// Consume locked UTXOs
 	for _, utxo := range utxos {
-		// If we have consumed more DIONE than we are trying to stake, then we
-		// have no need to consume more locked DIONE
+		// If we have consumed more than we are trying to stake, then we have no
+		// need to consume more locked funds
 		if amountStaked >= amount {
 			break
 		}
 
-		if assetID := utxo.AssetID(); assetID != h.ctx.DIONEAssetID {
-			continue // We only care about staking DIONE, so ignore other assets
+		if assetID := utxo.AssetID(); assetID != stakeAssetID {
+			continue // We only care about staking [stakeAssetID], so ignore other assets
 		}
 
 		out, ok := utxo.Out.(*stakeable.LockOut)
@@ -211,7 +370,7 @@ func (h *handler) Spend(
 			continue
 		}
 
-		inIntf, inSigners, err := kc.Spend(out.TransferableOut, now)
+		inIntf, inSigners, err := spendOut(out.TransferableOut, now)
 		if err != nil {
 			// We couldn't spend the output, so move on to the next one
 			continue
@@ -239,7 +398,7 @@ func (h *handler) Spend(
 		// Add the input to the consumed inputs
 		ins = append(ins, &dione.TransferableInput{
 			UTXOID: utxo.UTXOID,
-			Asset:  dione.Asset{ID: h.ctx.DIONEAssetID},
+			Asset:  dione.Asset{ID: stakeAssetID},
 			In: &stakeable.LockIn{
 				Locktime:       out.Locktime,
 				TransferableIn: in,
@@ -248,7 +407,7 @@ func (h *handler) Spend(
 
 		// Add the output to the staked outputs
 		stakedOuts = append(stakedOuts, &dione.TransferableOutput{
-			Asset: dione.Asset{ID: h.ctx.DIONEAssetID},
+			Asset: dione.Asset{ID: stakeAssetID},
 			Out: &stakeable.LockOut{
 				Locktime: out.Locktime,
 				TransferableOut: &secp256k1fx.TransferOutput{
@@ -262,7 +421,7 @@ func (h *handler) Spend(
 			// This input provided more value than was needed to be locked.
 			// Some of it must be returned
 			returnedOuts = append(returnedOuts, &dione.TransferableOutput{
-				Asset: dione.Asset{ID: h.ctx.DIONEAssetID},
+				Asset: dione.Asset{ID: stakeAssetID},
 				Out: &stakeable.LockOut{
 					Locktime: out.Locktime,
 					TransferableOut: &secp256k1fx.TransferOutput{
@@ -277,19 +436,28 @@ func (h *handler) Spend(
 		signers = append(signers, inSigners)
 	}
 
-	// Amount of DIONE that has been burned
-	amountBurned := uint64(0)
+	// Amount of each asset that still needs to be burned
+	remainingToBurn := make(map[ids.ID]uint64, len(toBurn))
+	for assetID, amountToBurn := range toBurn {
+		if amountToBurn > 0 {
+			remainingToBurn[assetID] = amountToBurn
+		}
+	}
 
 	for _, utxo := range utxos {
-		// If we have consumed more DIONE than we are trying to stake,
-		// and we have burned more DIONE than we need to,
-		// then we have no need to consume more DIONE
-		if amountBurned >= fee && amountStaked >= amount {
+		// If we have consumed more than we are trying to stake, and we have
+		// burned everything we need to, then we have no need to consume more
+		// funds
+		if len(remainingToBurn) == 0 && amountStaked >= amount {
 			break
 		}
 
-		if assetID := utxo.AssetID(); assetID != h.ctx.DIONEAssetID {
-			continue // We only care about burning DIONE, so ignore other assets
+		assetID := utxo.AssetID()
+		isStakeAsset := assetID == stakeAssetID
+		needsBurn := remainingToBurn[assetID] > 0
+		needsStake := isStakeAsset && amountStaked < amount
+		if !needsBurn && !needsStake {
+			continue // We only care about burning [toBurn] and staking [stakeAssetID], so ignore other assets
 		}
 
 		out := utxo.Out
@@ -304,7 +472,7 @@ func (h *handler) Spend(
 			out = inner.TransferableOut
 		}
 
-		inIntf, inSigners, err := kc.Spend(out, now)
+		inIntf, inSigners, err := spendOut(out, now)
 		if err != nil {
 			// We couldn't spend this UTXO, so we skip to the next one
 			continue
@@ -321,31 +489,39 @@ func (h *handler) Spend(
 
 		// Burn any value that should be burned
 		amountToBurn := math.Min(
-			fee-amountBurned, // Amount we still need to burn
-			remainingValue,   // Amount available to burn
+			remainingToBurn[assetID], // Amount we still need to burn
+			remainingValue,           // Amount available to burn
 		)
-		amountBurned += amountToBurn
+		if needsBurn {
+			remainingToBurn[assetID] -= amountToBurn
+			if remainingToBurn[assetID] == 0 {
+				delete(remainingToBurn, assetID)
+			}
+		}
 		remainingValue -= amountToBurn
 
 		// Stake any value that should be staked
-		amountToStake := math.Min(
-			amount-amountStaked, // Amount we still need to stake
-			remainingValue,      // Amount available to stake
-		)
+		amountToStake := uint64(0)
+		if isStakeAsset {
+			amountToStake = math.Min(
+				amount-amountStaked, // Amount we still need to stake
+				remainingValue,      // Amount available to stake
+			)
+		}
 		amountStaked += amountToStake
 		remainingValue -= amountToStake
 
 		// Add the input to the consumed inputs
 		ins = append(ins, &dione.TransferableInput{
 			UTXOID: utxo.UTXOID,
-			Asset:  dione.Asset{ID: h.ctx.DIONEAssetID},
+			Asset:  dione.Asset{ID: assetID},
 			In:     in,
 		})
 
 		if amountToStake > 0 {
 			// Some of this input was put for staking
 			stakedOuts = append(stakedOuts, &dione.TransferableOutput{
-				Asset: dione.Asset{ID: h.ctx.DIONEAssetID},
+				Asset: dione.Asset{ID: assetID},
 				Out: &secp256k1fx.TransferOutput{
 					Amt: amountToStake,
 					OutputOwners: secp256k1fx.OutputOwners{
@@ -360,7 +536,7 @@ func (h *handler) Spend(
 		if remainingValue > 0 {
 			// This input had extra value, so some of it must be returned
 			returnedOuts = append(returnedOuts, &dione.TransferableOutput{
-				Asset: dione.Asset{ID: h.ctx.DIONEAssetID},
+				Asset: dione.Asset{ID: assetID},
 				Out: &secp256k1fx.TransferOutput{
 					Amt: remainingValue,
 					OutputOwners: secp256k1fx.OutputOwners{
@@ -376,10 +552,16 @@ func (h *handler) Spend(
 		signers = append(signers, inSigners)
 	}
 
-	if amountBurned < fee || amountStaked < amount {
+	if amountStaked < amount {
 		return nil, nil, nil, nil, fmt.Errorf(
-			"%w (unlocked, locked) (%d, %d) but need (%d, %d)",
-			ErrInsufficientFunds, amountBurned, amountStaked, fee, amount,
+			"%w: staked %d of %s but need %d",
+			ErrInsufficientFunds, amountStaked, stakeAssetID, amount,
+		)
+	}
+	if len(remainingToBurn) != 0 {
+		return nil, nil, nil, nil, fmt.Errorf(
+			"%w: still need to burn %v",
+			ErrInsufficientFunds, remainingToBurn,
 		)
 	}
 
@@ -429,6 +611,28 @@ func (h *handler) Authorize(
 	return &secp256k1fx.Input{SigIndices: indices}, signers, nil
 }
 
+// MatchAddrs returns the signature indices and the addresses that are needed
+// for [addrs] to spend an output owned by [owners] at [time]. This mirrors
+// secp256k1fx.Keychain.Match without requiring the keys.
+func MatchAddrs(
+	owners *secp256k1fx.OutputOwners,
+	addrs set.Set[ids.ShortID],
+	time uint64,
+) ([]uint32, []ids.ShortID, bool) {
+	if time < owners.Locktime {
+		return nil, nil, false
+	}
+	sigIndices := make([]uint32, 0, owners.Threshold)
+	signers := make([]ids.ShortID, 0, owners.Threshold)
+	for i := uint32(0); i < uint32(len(owners.Addrs)) && uint32(len(signers)) < owners.Threshold; i++ {
+		if addr := owners.Addrs[i]; addrs.Contains(addr) {
+			sigIndices = append(sigIndices, i)
+			signers = append(signers, addr)
+		}
+	}
+	return sigIndices, signers, uint32(len(signers)) == owners.Threshold
+}
+
 func (h *handler) VerifySpend(
 	tx txs.UnsignedTx,
 	utxoDB dione.UTXOGetter,
@@ -1101,3 +1101,94 @@ func TestVerifySpendUTXOs(t *testing.T) {
 		})
 	}
 }
+
+func TestSpendAsset(t *testing.T) {
+	require := require.New(t)
+
+	h := &handler{
+		ctx: snow.DefaultContextTest(),
+		clk: &mockable.Clock{},
+		fx:  &secp256k1fx.Fx{},
+	}
+	h.ctx.DIONEAssetID = ids.GenerateTestID()
+	customAssetID := ids.GenerateTestID()
+
+	factory := secp256k1.Factory{}
+	key, err := factory.NewPrivateKey()
+	require.NoError(err)
+	addr := key.PublicKey().Address()
+	owners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}
+	newUTXO := func(assetID ids.ID, amount uint64) *dione.UTXO {
+		return &dione.UTXO{
+			UTXOID: dione.UTXOID{TxID: ids.GenerateTestID()},
+			Asset:  dione.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          amount,
+				OutputOwners: owners,
+			},
+		}
+	}
+	utxos := []*dione.UTXO{
+		newUTXO(h.ctx.DIONEAssetID, 7),
+		newUTXO(ids.GenerateTestID(), 100),
+		newUTXO(customAssetID, 15),
+		newUTXO(h.ctx.DIONEAssetID, 100),
+	}
+
+	kc := secp256k1fx.NewKeychain(key)
+	ins, returnedOuts, stakedOuts, signers, err := spend(h, utxos, kc.Spend, customAssetID, 10, map[ids.ID]uint64{h.ctx.DIONEAssetID: 5}, addr)
+	require.NoError(err)
+	require.Len(ins, 2)
+	require.Len(signers, 2)
+
+	consumed := map[ids.ID]uint64{}
+	for _, in := range ins {
+		consumed[in.AssetID()] += in.In.Amount()
+	}
+	require.Equal(map[ids.ID]uint64{
+		h.ctx.DIONEAssetID: 7,
+		customAssetID:      15,
+	}, consumed)
+
+	returned := map[ids.ID]uint64{}
+	for _, out := range returnedOuts {
+		returned[out.AssetID()] += out.Out.Amount()
+	}
+	require.Equal(map[ids.ID]uint64{
+		h.ctx.DIONEAssetID: 2,
+		customAssetID:      5,
+	}, returned)
+
+	require.Len(stakedOuts, 1)
+	require.Equal(customAssetID, stakedOuts[0].AssetID())
+	require.Equal(uint64(10), stakedOuts[0].Out.Amount())
+
+	_, _, _, _, err = spend(h, utxos, kc.Spend, customAssetID, 16, map[ids.ID]uint64{h.ctx.DIONEAssetID: 5}, addr)
+	require.ErrorIs(err, ErrInsufficientFunds)
+
+	// Burning multiple assets shouldn't stake anything
+	toBurn := map[ids.ID]uint64{
+		h.ctx.DIONEAssetID: 5,
+		customAssetID:      10,
+	}
+	ins, returnedOuts, stakedOuts, _, err = spend(h, utxos, kc.Spend, h.ctx.DIONEAssetID, 0, toBurn, addr)
+	require.NoError(err)
+	require.Len(ins, 2)
+	require.Empty(stakedOuts)
+
+	returned = map[ids.ID]uint64{}
+	for _, out := range returnedOuts {
+		returned[out.AssetID()] += out.Out.Amount()
+	}
+	require.Equal(map[ids.ID]uint64{
+		h.ctx.DIONEAssetID: 2,
+		customAssetID:      5,
+	}, returned)
+
+	toBurn[customAssetID] = 16
+	_, _, _, _, err = spend(h, utxos, kc.Spend, h.ctx.DIONEAssetID, 0, toBurn, addr)
+	require.ErrorIs(err, ErrInsufficientFunds)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/gorilla/rpc/v2"
 
@@ -15,10 +16,15 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/DioneProtocol/odysseygo/cache"
+	"github.com/DioneProtocol/odysseygo/chains/atomic"
 	"github.com/DioneProtocol/odysseygo/codec"
 	"github.com/DioneProtocol/odysseygo/codec/linearcodec"
+	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/database/prefixdb"
 	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/network/p2p"
+	"github.com/DioneProtocol/odysseygo/pubsub"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
 	"github.com/DioneProtocol/odysseygo/snow/engine/common"
@@ -32,6 +38,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/timer/mockable"
 	"github.com/DioneProtocol/odysseygo/utils/wrappers"
 	"github.com/DioneProtocol/odysseygo/version"
+	"github.com/DioneProtocol/odysseygo/vms/components/acceptance"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/api"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
@@ -44,6 +51,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs/mempool"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/utxo"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp/aggregator"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 
 	blockbuilder "github.com/DioneProtocol/odysseygo/vms/omegavm/blocks/builder"
@@ -53,13 +61,25 @@ import (
 	pvalidators "github.com/DioneProtocol/odysseygo/vms/omegavm/validators"
 )
 
+// warpSignatureHandlerID is the p2p protocol used to request the signatures of
+// warp messages from the O-chain validators.
+const warpSignatureHandlerID = 0
+
 var (
 	_ block.ChainVM              = (*VM)(nil)
 	_ secp256k1fx.VM             = (*VM)(nil)
 	_ validators.State           = (*VM)(nil)
 	_ validators.SubnetConnector = (*VM)(nil)
 
+	_ block.BlockProposerAwareChainVM = (*VM)(nil)
+
 	errMissingValidatorSet = errors.New("missing validator set")
+	errNotASubnet          = errors.New("not a subnet")
+	errUnexpectedBlockType = errors.New("unexpected block type")
+
+	acceptTimesPrefix = []byte("acceptTimes")
+	mempoolPrefix     = []byte("mempool")
+	droppedTxsPrefix  = []byte("droppedTxs")
 )
 
 type VM struct {
@@ -87,11 +107,41 @@ type VM struct {
 	// Bootstrapped remembers if this chain has finished bootstrapping or not
 	bootstrapped utils.Atomic[bool]
 
-	txBuilder txbuilder.Builder
-	manager   blockexecutor.Manager
+	txBuilder   txbuilder.Builder
+	utxoHandler utxo.Handler
+	manager     blockexecutor.Manager
+
+	// Records the local time that blocks were accepted at
+	acceptTimes acceptance.Tracker
+
+	// Resolves the proposervm blocks that wrapped accepted blocks. Nil if this
+	// VM isn't wrapped by the proposervm.
+	blockProposers block.BlockProposerGetter
+
+	// Persists the mempool across restarts
+	mempoolDB database.Database
+	// Txs read from [mempoolDB] that haven't been re-issued yet
+	persistedTxs []*txs.Tx
 
 	// TODO: Remove after v1.11.x is activated
 	pruned utils.Atomic[bool]
+
+	// Supply of the primary network at genesis
+	initialSupply uint64
+
+	// Publishes notifications of decided txs and of stakers that are about to
+	// stop staking.
+	events *pubsub.Server
+
+	// Aggregates the signatures of the primary network validators over warp
+	// messages sent by the O-chain
+	warpAggregator *aggregator.Aggregator
+
+	// Cancels the background tasks started in Initialize
+	onShutdown func()
+	// Tracks the running state audits, which must exit before the state is
+	// closed
+	auditsWG sync.WaitGroup
 }
 
 // Initialize this blockchain.
@@ -141,6 +191,7 @@ func (vm *VM) Initialize(
 		return err
 	}
 	mintCalculator := reward.NewMintCalculator(vm.MintConfig, genesisState.InitialSupply)
+	vm.initialSupply = genesisState.InitialSupply
 
 	vm.state, err = state.New(
 		vm.dbManager.Current().Database,
@@ -160,7 +211,7 @@ func (vm *VM) Initialize(
 	validatorManager := pvalidators.NewManager(chainCtx.Log, vm.Config, vm.state, vm.metrics, &vm.clock)
 	vm.State = validatorManager
 	vm.atomicUtxosManager = dione.NewAtomicUTXOManager(chainCtx.SharedMemory, txs.Codec)
-	utxoHandler := utxo.NewHandler(vm.ctx, &vm.clock, vm.fx)
+	vm.utxoHandler = utxo.NewHandler(vm.ctx, &vm.clock, vm.fx)
 	vm.uptimeManager = uptime.NewManager(vm.state)
 	vm.UptimeLockedCalculator.SetCalculator(&vm.bootstrapped, &chainCtx.Lock, vm.uptimeManager)
 
@@ -171,7 +222,7 @@ func (vm *VM) Initialize(
 		vm.fx,
 		vm.state,
 		vm.atomicUtxosManager,
-		utxoHandler,
+		vm.utxoHandler,
 	)
 
 	txExecutorBackend := &txexecutor.Backend{
@@ -179,7 +230,7 @@ func (vm *VM) Initialize(
 		Ctx:          vm.ctx,
 		Clk:          &vm.clock,
 		Fx:           vm.fx,
-		FlowChecker:  utxoHandler,
+		FlowChecker:  vm.utxoHandler,
 		Uptimes:      vm.uptimeManager,
 		Rewards:      rewards,
 		Mint:         mintCalculator,
@@ -188,18 +239,56 @@ func (vm *VM) Initialize(
 
 	// Note: There is a circular dependency between the mempool and block
 	//       builder which is broken by passing in the vm.
-	mempool, err := mempool.NewMempool("mempool", registerer, vm)
+	mempool, err := mempool.NewMempool(
+		"mempool",
+		registerer,
+		prefixdb.New(droppedTxsPrefix, vm.dbManager.Current().Database),
+		vm,
+	)
 	if err != nil {
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
 
+	vm.acceptTimes, err = acceptance.NewTracker(
+		prefixdb.New(acceptTimesPrefix, vm.dbManager.Current().Database),
+		"",
+		registerer,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create accept times tracker: %w", err)
+	}
+
 	vm.manager = blockexecutor.NewManager(
 		mempool,
 		vm.metrics,
 		vm.state,
 		txExecutorBackend,
 		validatorManager,
+		vm.acceptTimes,
+	)
+	router := p2p.NewRouter(chainCtx.Log, appSender, registerer, "p2p")
+	warpClient, err := router.RegisterAppProtocol(
+		warpSignatureHandlerID,
+		aggregator.NewSignatureHandler(
+			&warpVerifier{
+				lock:  &chainCtx.Lock,
+				state: vm.state,
+			},
+			chainCtx.WarpSigner,
+		),
+		nil, // only specific validators are requested
 	)
+	if err != nil {
+		return fmt.Errorf("failed to register warp signature handler: %w", err)
+	}
+	// The aggregation is driven by API calls, which don't hold the context
+	// lock, so the validator sets are read under the lock.
+	vm.warpAggregator = aggregator.New(
+		chainCtx.Log,
+		validators.NewLockedState(&chainCtx.Lock, validatorManager),
+		aggregator.NewNetworkSignatureGetter(warpClient),
+	)
+
 	vm.Builder = blockbuilder.New(
 		mempool,
 		vm.txBuilder,
@@ -207,8 +296,14 @@ func (vm *VM) Initialize(
 		vm.manager,
 		toEngine,
 		appSender,
+		router,
 	)
 
+	vm.mempoolDB = prefixdb.New(mempoolPrefix, vm.dbManager.Current().Database)
+	if err := vm.loadPersistedMempool(); err != nil {
+		return fmt.Errorf("failed to load persisted mempool: %w", err)
+	}
+
 	// Create all of the chains that the database says exist
 	if err := vm.initBlockchains(); err != nil {
 		return fmt.Errorf(
@@ -225,6 +320,24 @@ func (vm *VM) Initialize(
 		return err
 	}
 
+	backgroundCtx, cancel := context.WithCancel(context.Background())
+	vm.onShutdown = cancel
+	if execConfig.StateAuditFrequency > 0 {
+		vm.auditsWG.Add(1)
+		go func() {
+			defer vm.auditsWG.Done()
+			vm.runStateAudits(backgroundCtx, execConfig.StateAuditFrequency)
+		}()
+	}
+	vm.events = pubsub.New(chainCtx.Log)
+	go vm.runTxDecidedNotifications(backgroundCtx)
+	if execConfig.StakeEndingLeadTime > 0 {
+		go vm.runStakeEndingNotifications(backgroundCtx, execConfig.StakeEndingLeadTime)
+	}
+	if execConfig.MempoolRegossipFrequency > 0 {
+		go vm.runMempoolRegossip(backgroundCtx, execConfig.MempoolRegossipFrequency)
+	}
+
 	shouldPrune, err := vm.state.ShouldPrune()
 	if err != nil {
 		return fmt.Errorf(
@@ -296,6 +409,111 @@ func (vm *VM) createSubnet(subnetID ids.ID) error {
 	return nil
 }
 
+// TrackSubnet starts tracking [subnetID] while the node is running. The
+// subnet's validator set is registered with the node and its chains are
+// created.
+func (vm *VM) TrackSubnet(subnetID ids.ID) error {
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	if subnetID == constants.PrimaryNetworkID || vm.TrackedSubnets.Contains(subnetID) {
+		return nil
+	}
+
+	subnetTx, _, err := vm.state.GetTx(subnetID)
+	if err != nil {
+		return fmt.Errorf("problem retrieving subnet %s: %w", subnetID, err)
+	}
+	if _, ok := subnetTx.Unsigned.(*txs.CreateSubnetTx); !ok {
+		return fmt.Errorf("%w: %s", errNotASubnet, subnetID)
+	}
+
+	if err := vm.state.TrackSubnet(subnetID); err != nil {
+		return err
+	}
+
+	vm.ctx.Log.Info("tracking subnet",
+		zap.Stringer("subnetID", subnetID),
+	)
+
+	// Uptimes of the subnet's validators are tracked once the O-chain is
+	// bootstrapped, so a subnet tracked after that must be started here.
+	if vm.bootstrapped.Get() {
+		vdrIDs, err := validators.NodeIDs(vm.Validators, subnetID)
+		if err != nil {
+			return err
+		}
+		if err := vm.uptimeManager.StartTracking(vdrIDs, subnetID); err != nil {
+			return err
+		}
+		if err := vm.state.Commit(); err != nil {
+			return err
+		}
+	}
+
+	// Without sybil protection, the chains of every subnet are already
+	// created.
+	if !vm.SybilProtectionEnabled {
+		return nil
+	}
+	return vm.createSubnet(subnetID)
+}
+
+// RepairAtomicUTXOs removes [utxoIDs], exported to this chain from
+// [sourceChainID], from shared memory if they were imported by an accepted tx.
+// UTXOs that weren't imported, or that aren't in shared memory, are left
+// untouched. Returns the IDs of the removed UTXOs.
+//
+// Only imports accepted after the node started indexing consumed atomic UTXOs
+// are detected.
+func (vm *VM) RepairAtomicUTXOs(sourceChainID ids.ID, utxoIDs []ids.ID) ([]ids.ID, error) {
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	if sourceChainID == vm.ctx.ChainID {
+		return nil, errNoSourceChain
+	}
+
+	var (
+		removed     []ids.ID
+		removedKeys [][]byte
+	)
+	for _, utxoID := range utxoIDs {
+		_, err := vm.state.GetConsumedAtomicUTXO(utxoID)
+		if err == database.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("couldn't check if UTXO %s was consumed: %w", utxoID, err)
+		}
+
+		utxoID := utxoID
+		if _, err := vm.ctx.SharedMemory.Get(sourceChainID, [][]byte{utxoID[:]}); err != nil {
+			continue
+		}
+		removed = append(removed, utxoID)
+		removedKeys = append(removedKeys, utxoID[:])
+	}
+	if len(removedKeys) == 0 {
+		return removed, nil
+	}
+
+	err := vm.ctx.SharedMemory.Apply(map[ids.ID]*atomic.Requests{
+		sourceChainID: {
+			RemoveRequests: removedKeys,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't remove orphaned UTXOs: %w", err)
+	}
+
+	vm.ctx.Log.Info("removed orphaned atomic UTXOs",
+		zap.Stringer("sourceChain", sourceChainID),
+		zap.Int("numUTXOs", len(removed)),
+	)
+	return removed, nil
+}
+
 // onBootstrapStarted marks this VM as bootstrapping
 func (vm *VM) onBootstrapStarted() error {
 	vm.bootstrapped.Set(false)
@@ -335,6 +553,8 @@ func (vm *VM) onNormalOperationsStarted() error {
 		return err
 	}
 
+	vm.reissuePersistedMempool()
+
 	// Start the block builder
 	vm.Builder.ResetBlockTimer()
 	return nil
@@ -357,6 +577,10 @@ func (vm *VM) Shutdown(context.Context) error {
 		return nil
 	}
 
+	if vm.onShutdown != nil {
+		vm.onShutdown()
+	}
+	vm.auditsWG.Wait()
 	vm.Builder.Shutdown()
 
 	if vm.bootstrapped.Get() {
@@ -383,6 +607,14 @@ func (vm *VM) Shutdown(context.Context) error {
 		}
 	}
 
+	// If the persisted txs were never re-issued, the mempool doesn't contain
+	// them, so they are left on disk for the next restart.
+	if len(vm.persistedTxs) == 0 {
+		if err := vm.persistMempool(); err != nil {
+			return err
+		}
+	}
+
 	errs := wrappers.Errs{}
 	errs.Add(
 		vm.state.Close(),
@@ -401,6 +633,23 @@ func (vm *VM) ParseBlock(_ context.Context, b []byte) (snowman.Block, error) {
 	return vm.manager.NewBlock(statelessBlk), nil
 }
 
+// DropTxs removes the txs of [blk], which this VM built, from the mempool and
+// marks them as dropped for [reason], so they aren't included in the next block
+// it builds.
+func (vm *VM) DropTxs(_ context.Context, blk snowman.Block, reason error) error {
+	txsBlk, ok := blk.(interface{ Txs() []*txs.Tx })
+	if !ok {
+		return fmt.Errorf("%w: %T", errUnexpectedBlockType, blk)
+	}
+
+	blkTxs := txsBlk.Txs()
+	vm.Builder.Remove(blkTxs)
+	for _, tx := range blkTxs {
+		vm.Builder.MarkDropped(tx.ID(), reason)
+	}
+	return nil
+}
+
 func (vm *VM) GetBlock(_ context.Context, blkID ids.ID) (snowman.Block, error) {
 	return vm.manager.GetBlock(blkID)
 }
@@ -416,6 +665,11 @@ func (vm *VM) SetPreference(_ context.Context, blkID ids.ID) error {
 	return nil
 }
 
+// SetBlockProposerGetter is called by the proposervm wrapping this VM
+func (vm *VM) SetBlockProposerGetter(blockProposers block.BlockProposerGetter) {
+	vm.blockProposers = blockProposers
+}
+
 func (*VM) Version(context.Context) (string, error) {
 	return version.Current.String(), nil
 }
@@ -442,10 +696,30 @@ func (vm *VM) CreateHandlers(context.Context) (map[string]*common.HTTPHandler, e
 		return nil, err
 	}
 
+	warpServer := rpc.NewServer()
+	warpServer.RegisterCodec(json.NewCodec(), "application/json")
+	warpServer.RegisterCodec(json.NewCodec(), "application/json;charset=UTF-8")
+	if err := warpServer.RegisterService(
+		aggregator.NewService(vm.ctx.Log, constants.PrimaryNetworkID, vm.warpAggregator),
+		"warp",
+	); err != nil {
+		return nil, err
+	}
+
 	return map[string]*common.HTTPHandler{
 		"": {
 			Handler: server,
 		},
+		"/events": {
+			LockOptions: common.NoLock,
+			Handler:     vm.events,
+		},
+		// The signatures are requested over the network, and the responses
+		// are handled with the context lock held.
+		"/warp": {
+			LockOptions: common.NoLock,
+			Handler:     warpServer,
+		},
 	}, nil
 }
 
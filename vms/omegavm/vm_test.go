@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -18,10 +19,12 @@ import (
 	"github.com/DioneProtocol/odysseygo/chains/atomic"
 	"github.com/DioneProtocol/odysseygo/database"
 	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/database/memdb"
 	"github.com/DioneProtocol/odysseygo/database/prefixdb"
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/message"
 	"github.com/DioneProtocol/odysseygo/proto/pb/p2p"
+	"github.com/DioneProtocol/odysseygo/pubsub"
 	"github.com/DioneProtocol/odysseygo/snow"
 	"github.com/DioneProtocol/odysseygo/snow/choices"
 	"github.com/DioneProtocol/odysseygo/snow/consensus/snowball"
@@ -38,6 +41,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/snow/validators"
 	"github.com/DioneProtocol/odysseygo/subnets"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/secp256k1"
 	"github.com/DioneProtocol/odysseygo/utils/formatting"
 	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
@@ -55,8 +59,10 @@ import (
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/blocks"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/config"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/reward"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/status"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
 	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 
 	smcon "github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
@@ -148,6 +154,11 @@ func defaultContext(t *testing.T) *snow.Context {
 
 	ctx.BCLookup = aliaser
 
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+	ctx.PublicKey = bls.PublicFromSecretKey(sk)
+	ctx.WarpSigner = warp.NewSigner(sk, ctx.NetworkID, ctx.ChainID)
+
 	ctx.ValidatorState = &validators.TestState{
 		GetSubnetIDF: func(_ context.Context, chainID ids.ID) (ids.ID, error) {
 			subnetID, ok := map[ids.ID]ids.ID{
@@ -353,6 +364,54 @@ func defaultVM(t *testing.T) (*VM, database.Database, *mutableSharedMemory) {
 	appSender.SendAppGossipF = func(context.Context, []byte) error {
 		return nil
 	}
+	// This node is the only one reachable: requests sent to it are handled by
+	// the VM, as the node's sender does, and requests that aren't answered
+	// fail as if they timed out. As in the node, app messages are delivered
+	// without holding the context lock.
+	var (
+		pendingRequestsLock sync.Mutex
+		pendingRequests     = set.Set[uint32]{}
+	)
+	appSender.SendAppRequestF = func(_ context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, request []byte) error {
+		for nodeID := range nodeIDs {
+			nodeID := nodeID
+			go func() {
+				if nodeID == vm.ctx.NodeID {
+					pendingRequestsLock.Lock()
+					pendingRequests.Add(requestID)
+					pendingRequestsLock.Unlock()
+
+					if err := vm.AppRequest(context.Background(), nodeID, requestID, time.Now().Add(time.Minute), request); err != nil {
+						t.Error(err)
+					}
+
+					pendingRequestsLock.Lock()
+					answered := !pendingRequests.Contains(requestID)
+					pendingRequests.Remove(requestID)
+					pendingRequestsLock.Unlock()
+					if answered {
+						return
+					}
+				}
+				if err := vm.AppRequestFailed(context.Background(), nodeID, requestID); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+		return nil
+	}
+	appSender.SendAppResponseF = func(_ context.Context, nodeID ids.NodeID, requestID uint32, response []byte) error {
+		pendingRequestsLock.Lock()
+		pendingRequests.Remove(requestID)
+		pendingRequestsLock.Unlock()
+
+		go func() {
+			if err := vm.AppResponse(context.Background(), nodeID, requestID, response); err != nil {
+				t.Error(err)
+			}
+		}()
+		return nil
+	}
 
 	require.NoError(vm.Initialize(
 		context.Background(),
@@ -389,6 +448,49 @@ func defaultVM(t *testing.T) (*VM, database.Database, *mutableSharedMemory) {
 	return vm, baseDBManager.Current().Database, msm
 }
 
+// Ensure the mempool is persisted and re-issued across restarts
+func TestMempoolPersistence(t *testing.T) {
+	require := require.New(t)
+	vm, _, _ := defaultVM(t)
+	vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(vm.Shutdown(context.Background()))
+		vm.ctx.Lock.Unlock()
+	}()
+
+	tx, err := vm.txBuilder.NewCreateSubnetTx(
+		1,
+		[]ids.ShortID{keys[0].PublicKey().Address()},
+		[]*secp256k1.PrivateKey{keys[0]},
+		keys[0].PublicKey().Address(),
+	)
+	require.NoError(err)
+	require.NoError(vm.Builder.AddUnverifiedTx(tx))
+	require.NoError(vm.persistMempool())
+
+	// Simulate a restart by dropping the tx from the in-memory mempool
+	vm.Builder.Remove([]*txs.Tx{tx})
+	require.False(vm.Builder.Has(tx.ID()))
+
+	require.NoError(vm.loadPersistedMempool())
+	require.Len(vm.persistedTxs, 1)
+	require.Equal(tx.ID(), vm.persistedTxs[0].ID())
+
+	vm.reissuePersistedMempool()
+	require.Empty(vm.persistedTxs)
+	require.True(vm.Builder.Has(tx.ID()))
+
+	// Once the tx is accepted, it is no longer persisted
+	blk, err := vm.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+	require.NoError(vm.persistMempool())
+
+	require.NoError(vm.loadPersistedMempool())
+	require.Empty(vm.persistedTxs)
+}
+
 // Ensure genesis state is parsed from bytes and stored correctly
 func TestGenesis(t *testing.T) {
 	require := require.New(t)
@@ -546,6 +648,7 @@ func TestInvalidAddValidatorCommit(t *testing.T) {
 	txID := statelessBlk.Txs()[0].ID()
 	reason := vm.Builder.GetDropReason(txID)
 	require.ErrorIs(reason, txexecutor.ErrTimestampNotBeforeStartTime)
+	require.Equal(status.DropReasonInvalidStakingPeriod, vm.Builder.GetDropReasonCode(txID))
 }
 
 // Reject attempt to add validator to primary network
@@ -1589,7 +1692,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 	chainRouter := &router.ChainRouter{}
 
 	metrics := prometheus.NewRegistry()
-	mc, err := message.NewCreator(logging.NoLog{}, metrics, "dummyNamespace", constants.DefaultNetworkCompressionType, 10*time.Second)
+	mc, err := message.NewCreator(logging.NoLog{}, metrics, "dummyNamespace", constants.DefaultNetworkCompressionType, constants.DefaultNetworkCompressionThreshold, 10*time.Second)
 	require.NoError(err)
 
 	require.NoError(chainRouter.Initialize(
@@ -1699,6 +1802,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 		vm,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		tracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -1717,8 +1821,10 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 			OptimalProcessing:     1,
 			MaxOutstandingItems:   1,
 			MaxItemProcessingTime: 1,
+			MaxProcessingDepth:    1,
 		},
 		Consensus: consensus,
+		Journal:   memdb.New(),
 	}
 	engine, err := smeng.New(engineConfig)
 	require.NoError(err)
@@ -2350,3 +2456,167 @@ func TestRemovePermissionedValidatorDuringAddPending(t *testing.T) {
 	_, err = vm.state.GetPendingValidator(createSubnetTx.ID(), ids.NodeID(id))
 	require.ErrorIs(err, database.ErrNotFound)
 }
+
+func TestStateAudit(t *testing.T) {
+	require := require.New(t)
+	vm, _, _ := defaultVM(t)
+	vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(vm.Shutdown(context.Background()))
+		vm.ctx.Lock.Unlock()
+	}()
+
+	mismatches, err := vm.auditState()
+	require.NoError(err)
+	require.Empty(mismatches)
+
+	// Corrupt the validator set so that it no longer matches the stakers
+	nodeID := ids.NodeID(keys[0].PublicKey().Address())
+	require.NoError(validators.AddWeight(vm.Validators, constants.PrimaryNetworkID, nodeID, 1))
+
+	mismatches, err = vm.auditState()
+	require.NoError(err)
+	require.Len(mismatches, 1)
+	require.ErrorIs(mismatches[0], errStakedWeightMismatch)
+
+	// Corrupt the supply so that it no longer matches the minted rewards
+	require.NoError(validators.RemoveWeight(vm.Validators, constants.PrimaryNetworkID, nodeID, 1))
+	vm.state.SetCurrentSupply(constants.PrimaryNetworkID, vm.initialSupply+1)
+
+	mismatches, err = vm.auditState()
+	require.NoError(err)
+	require.Len(mismatches, 1)
+	require.ErrorIs(mismatches[0], errSupplyMismatch)
+
+	vm.state.SetSupplyChanges(constants.PrimaryNetworkID, state.SupplyChanges{
+		Base:   vm.initialSupply,
+		Minted: 2,
+		Burned: 1,
+	})
+
+	mismatches, err = vm.auditState()
+	require.NoError(err)
+	require.Empty(mismatches)
+
+	// Corrupt the supply so that it is below the genesis supply
+	vm.state.SetCurrentSupply(constants.PrimaryNetworkID, vm.initialSupply-1)
+
+	mismatches, err = vm.auditState()
+	require.NoError(err)
+	require.Len(mismatches, 2)
+	require.ErrorIs(mismatches[0], errSupplyMismatch)
+	require.ErrorIs(mismatches[1], errSupplyBelowInitial)
+}
+
+func TestStakeEndingNotifications(t *testing.T) {
+	require := require.New(t)
+	vm, _, _ := defaultVM(t)
+	vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(vm.Shutdown(context.Background()))
+		vm.ctx.Lock.Unlock()
+	}()
+
+	nodeID := ids.NodeID(keys[0].PublicKey().Address())
+	staker, err := vm.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	require.NoError(err)
+
+	// The genesis validators aren't ending within the lead time
+	leadTime := staker.EndTime.Sub(vm.state.GetTimestamp())
+	notified, err := vm.notifyStakesEnding(nil, leadTime-time.Second)
+	require.NoError(err)
+	require.Empty(notified)
+
+	// All the genesis validators end at the same time
+	notified, err = vm.notifyStakesEnding(nil, leadTime)
+	require.NoError(err)
+	require.Len(notified, len(keys))
+	require.Contains(notified, staker.TxID)
+
+	// Subscribers are notified of the validators they are interested in
+	filterer, err := vm.newStakeEndingFilterer(staker)
+	require.NoError(err)
+
+	byNodeID := pubsub.NewFilterParam()
+	require.NoError(byNodeID.Add(nodeID.Bytes()))
+	byRewardOwner := pubsub.NewFilterParam()
+	require.NoError(byRewardOwner.Add(keys[0].PublicKey().Address().Bytes()))
+	uninterested := pubsub.NewFilterParam()
+	require.NoError(uninterested.Add(ids.GenerateTestNodeID().Bytes()))
+
+	shouldNotify, event := filterer.Filter([]pubsub.Filter{
+		byNodeID,
+		byRewardOwner,
+		uninterested,
+	})
+	require.Equal([]bool{true, true, false}, shouldNotify)
+	require.Equal(StakeEnding{
+		TxID:     staker.TxID,
+		NodeID:   nodeID,
+		SubnetID: constants.PrimaryNetworkID,
+		Weight:   json.Uint64(staker.Weight),
+		EndTime:  json.Uint64(staker.EndTime.Unix()),
+	}, event)
+}
+
+func TestTxDecidedNotifications(t *testing.T) {
+	require := require.New(t)
+	vm, _, _ := defaultVM(t)
+	vm.ctx.Lock.Lock()
+	defer func() {
+		require.NoError(vm.Shutdown(context.Background()))
+		vm.ctx.Lock.Unlock()
+	}()
+
+	notifiedHeight, err := vm.lastAcceptedHeight()
+	require.NoError(err)
+
+	tx, err := vm.txBuilder.NewCreateChainTx(
+		testSubnet1.ID(),
+		nil,
+		ids.ID{'t', 'e', 's', 't', 'v', 'm'},
+		nil,
+		"name",
+		[]*secp256k1.PrivateKey{testSubnet1ControlKeys[0], testSubnet1ControlKeys[1]},
+		ids.ShortEmpty, // change addr
+	)
+	require.NoError(err)
+	require.NoError(vm.Builder.AddUnverifiedTx(tx))
+
+	blk, err := vm.Builder.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+
+	notifiedHeight, err = vm.notifyTxsDecided(notifiedHeight)
+	require.NoError(err)
+	require.Equal(blk.Height(), notifiedHeight)
+
+	// Only subscribers of the tx are notified
+	filterer := &txDecidedFilterer{
+		event: TxDecided{
+			TxID:    tx.ID(),
+			Status:  status.Committed,
+			BlockID: blk.ID(),
+			Height:  json.Uint64(blk.Height()),
+		},
+	}
+	byTxID := pubsub.NewFilterParam()
+	txID := tx.ID()
+	require.NoError(byTxID.Add(txID[:]))
+	uninterested := pubsub.NewFilterParam()
+	otherTxID := ids.GenerateTestID()
+	require.NoError(uninterested.Add(otherTxID[:]))
+
+	shouldNotify, event := filterer.Filter([]pubsub.Filter{
+		byTxID,
+		uninterested,
+	})
+	require.Equal([]bool{true, false}, shouldNotify)
+	require.Equal(filterer.event, event)
+
+	// Blocks are only published once
+	notifiedHeight, err = vm.notifyTxsDecided(notifiedHeight)
+	require.NoError(err)
+	require.Equal(blk.Height(), notifiedHeight)
+}
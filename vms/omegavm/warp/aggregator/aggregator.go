@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+)
+
+// SignatureGetter fetches the signature of an unsigned warp message from a
+// validator.
+type SignatureGetter interface {
+	// GetSignature returns the BLS signature of [msg] by [nodeID].
+	GetSignature(
+		ctx context.Context,
+		nodeID ids.NodeID,
+		msg *warp.UnsignedMessage,
+	) (*bls.Signature, error)
+}
+
+// Result of aggregating the signatures of a subnet's validators over a warp
+// message.
+type Result struct {
+	// Weight of the validators whose signatures were aggregated
+	SignatureWeight uint64
+	// Total weight of the subnet's validators
+	TotalWeight uint64
+	// Message signed by the aggregated signature
+	Message *warp.Message
+}
+
+// Aggregator requests signature shares of warp messages from a subnet's
+// validators and aggregates them into a single signature.
+type Aggregator struct {
+	log    logging.Logger
+	state  warp.ValidatorState
+	client SignatureGetter
+}
+
+func New(
+	log logging.Logger,
+	state warp.ValidatorState,
+	client SignatureGetter,
+) *Aggregator {
+	return &Aggregator{
+		log:    log,
+		state:  state,
+		client: client,
+	}
+}
+
+// AggregateSignatures requests a signature of [msg] from each validator of
+// [subnetID] at [oChainHeight] and aggregates the valid ones.
+//
+// Returns an error if the signers hold less than [quorumNum]/[quorumDen] of
+// the subnet's weight.
+func (a *Aggregator) AggregateSignatures(
+	ctx context.Context,
+	msg *warp.UnsignedMessage,
+	subnetID ids.ID,
+	oChainHeight uint64,
+	quorumNum uint64,
+	quorumDen uint64,
+) (*Result, error) {
+	vdrs, totalWeight, err := warp.GetCanonicalValidatorSet(ctx, a.state, oChainHeight, subnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		sigs = make([]*bls.Signature, len(vdrs))
+		wg   sync.WaitGroup
+	)
+	for i, vdr := range vdrs {
+		wg.Add(1)
+		go func(i int, vdr *warp.Validator) {
+			defer wg.Done()
+			sigs[i] = a.getSignature(ctx, vdr, msg)
+		}(i, vdr)
+	}
+	wg.Wait()
+
+	var (
+		signers         = set.NewBits()
+		signerSigs      = make([]*bls.Signature, 0, len(sigs))
+		signatureWeight uint64
+	)
+	for i, sig := range sigs {
+		if sig == nil {
+			continue
+		}
+		signers.Add(i)
+		signerSigs = append(signerSigs, sig)
+		signatureWeight += vdrs[i].Weight // Impossible to overflow here
+	}
+
+	if err := warp.VerifyWeight(signatureWeight, totalWeight, quorumNum, quorumDen); err != nil {
+		return nil, err
+	}
+
+	aggregateSig, err := bls.AggregateSignatures(signerSigs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate signatures: %w", err)
+	}
+
+	bitSetSig := &warp.BitSetSignature{
+		Signers: signers.Bytes(),
+	}
+	copy(bitSetSig.Signature[:], bls.SignatureToBytes(aggregateSig))
+
+	signedMsg, err := warp.NewMessage(msg, bitSetSig)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		SignatureWeight: signatureWeight,
+		TotalWeight:     totalWeight,
+		Message:         signedMsg,
+	}, nil
+}
+
+// getSignature returns a valid signature of [msg] by [vdr], or nil if none of
+// the nodes registered with [vdr]'s public key provided one.
+func (a *Aggregator) getSignature(
+	ctx context.Context,
+	vdr *warp.Validator,
+	msg *warp.UnsignedMessage,
+) *bls.Signature {
+	msgBytes := msg.Bytes()
+	for _, nodeID := range vdr.NodeIDs {
+		sig, err := a.client.GetSignature(ctx, nodeID, msg)
+		if err != nil {
+			a.log.Debug("failed to get signature",
+				zap.Stringer("nodeID", nodeID),
+				zap.Stringer("messageID", msg.ID()),
+				zap.Error(err),
+			)
+			continue
+		}
+		if !bls.Verify(vdr.PublicKey, sig, msgBytes) {
+			a.log.Debug("dropping invalid signature",
+				zap.Stringer("nodeID", nodeID),
+				zap.Stringer("messageID", msg.ID()),
+			)
+			continue
+		}
+		return sig
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+)
+
+const oChainHeight = 1337
+
+var (
+	_ SignatureGetter = testSignatureGetter(nil)
+
+	errTest = errors.New("non-nil error")
+)
+
+type testSignatureGetter map[ids.NodeID]func(*warp.UnsignedMessage) (*bls.Signature, error)
+
+func (t testSignatureGetter) GetSignature(_ context.Context, nodeID ids.NodeID, msg *warp.UnsignedMessage) (*bls.Signature, error) {
+	getSignature, ok := t[nodeID]
+	if !ok {
+		return nil, errTest
+	}
+	return getSignature(msg)
+}
+
+type testValidator struct {
+	nodeID ids.NodeID
+	sk     *bls.SecretKey
+	weight uint64
+}
+
+func newTestValidator(t *testing.T, weight uint64) testValidator {
+	sk, err := bls.NewSecretKey()
+	require.NoError(t, err)
+	return testValidator{
+		nodeID: ids.GenerateTestNodeID(),
+		sk:     sk,
+		weight: weight,
+	}
+}
+
+func (v testValidator) sign(msg *warp.UnsignedMessage) (*bls.Signature, error) {
+	return bls.Sign(v.sk, msg.Bytes()), nil
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	require := require.New(t)
+
+	subnetID := ids.GenerateTestID()
+	vdrs := []testValidator{
+		newTestValidator(t, 40),
+		newTestValidator(t, 30),
+		newTestValidator(t, 20),
+		newTestValidator(t, 10),
+	}
+	vdrSet := make(map[ids.NodeID]*validators.GetValidatorOutput, len(vdrs))
+	for _, vdr := range vdrs {
+		vdrSet[vdr.nodeID] = &validators.GetValidatorOutput{
+			NodeID:    vdr.nodeID,
+			PublicKey: bls.PublicFromSecretKey(vdr.sk),
+			Weight:    vdr.weight,
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	state := validators.NewMockState(ctrl)
+	state.EXPECT().GetValidatorSet(gomock.Any(), uint64(oChainHeight), subnetID).Return(vdrSet, nil).AnyTimes()
+	state.EXPECT().GetSubnetID(gomock.Any(), gomock.Any()).Return(subnetID, nil).AnyTimes()
+
+	client := testSignatureGetter{
+		vdrs[0].nodeID: vdrs[0].sign,
+		vdrs[1].nodeID: vdrs[1].sign,
+		// Signs with the wrong key
+		vdrs[2].nodeID: vdrs[3].sign,
+		// vdrs[3] doesn't respond
+	}
+
+	msg, err := warp.NewUnsignedMessage(constants.UnitTestID, ids.GenerateTestID(), []byte("payload"))
+	require.NoError(err)
+
+	aggregator := New(logging.NoLog{}, state, client)
+
+	result, err := aggregator.AggregateSignatures(context.Background(), msg, subnetID, oChainHeight, 70, 100)
+	require.NoError(err)
+	require.Equal(uint64(70), result.SignatureWeight)
+	require.Equal(uint64(100), result.TotalWeight)
+	require.NoError(result.Message.Signature.Verify(
+		context.Background(),
+		&result.Message.UnsignedMessage,
+		constants.UnitTestID,
+		state,
+		oChainHeight,
+		70,
+		100,
+	))
+
+	numSigners, err := result.Message.Signature.NumSigners()
+	require.NoError(err)
+	require.Equal(2, numSigners)
+
+	_, err = aggregator.AggregateSignatures(context.Background(), msg, subnetID, oChainHeight, 71, 100)
+	require.ErrorIs(err, warp.ErrInsufficientWeight)
+}
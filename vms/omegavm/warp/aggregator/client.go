@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package aggregator
+
+import (
+	"context"
+
+	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/utils/rpc"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+)
+
+var _ Client = (*client)(nil)
+
+// Client for requesting aggregated warp signatures from a chain's [Service]
+type Client interface {
+	// GetAggregateSignature returns [msg] signed by the aggregate signature of
+	// the validators of the chain's subnet at [oChainHeight].
+	GetAggregateSignature(
+		ctx context.Context,
+		msg *warp.UnsignedMessage,
+		oChainHeight uint64,
+		quorumNum uint64,
+		quorumDen uint64,
+		options ...rpc.Option,
+	) (*Result, error)
+}
+
+type client struct {
+	requester rpc.EndpointRequester
+}
+
+// NewClient returns a Client for the warp endpoint of [chain]
+func NewClient(uri, chain string) Client {
+	return &client{requester: rpc.NewEndpointRequester(
+		uri + "/ext/bc/" + chain + "/warp",
+	)}
+}
+
+func (c *client) GetAggregateSignature(
+	ctx context.Context,
+	msg *warp.UnsignedMessage,
+	oChainHeight uint64,
+	quorumNum uint64,
+	quorumDen uint64,
+	options ...rpc.Option,
+) (*Result, error) {
+	msgStr, err := formatting.Encode(formatting.Hex, msg.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	res := &GetAggregateSignatureReply{}
+	err = c.requester.SendRequest(ctx, "warp.getAggregateSignature", &GetAggregateSignatureArgs{
+		Message:      msgStr,
+		Encoding:     formatting.Hex,
+		OChainHeight: json.Uint64(oChainHeight),
+		QuorumNum:    json.Uint64(quorumNum),
+		QuorumDen:    json.Uint64(quorumDen),
+	}, res, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	signedMsgBytes, err := formatting.Decode(res.Encoding, res.SignedMessage)
+	if err != nil {
+		return nil, err
+	}
+	signedMsg, err := warp.ParseMessage(signedMsgBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		SignatureWeight: uint64(res.SignatureWeight),
+		TotalWeight:     uint64(res.TotalWeight),
+		Message:         signedMsg,
+	}, nil
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package aggregator
+
+import (
+	"context"
+	"time"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/network/p2p"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+)
+
+var (
+	_ p2p.Handler     = (*SignatureHandler)(nil)
+	_ SignatureGetter = (*NetworkSignatureGetter)(nil)
+	_ AppRequester    = (*p2p.Client)(nil)
+)
+
+// Verifier is implemented by the VM to decide which warp messages this node is
+// willing to sign.
+type Verifier interface {
+	// Verify returns nil if this node should sign [msg].
+	Verify(ctx context.Context, msg *warp.UnsignedMessage) error
+}
+
+// SignatureHandler serves requests for this node's signature of warp messages.
+// The request is the unsigned message and the response is the signature.
+//
+// Requests for messages rejected by the verifier or the signer are dropped.
+type SignatureHandler struct {
+	p2p.Handler
+
+	verifier Verifier
+	signer   warp.Signer
+}
+
+func NewSignatureHandler(verifier Verifier, signer warp.Signer) *SignatureHandler {
+	return &SignatureHandler{
+		Handler:  p2p.NoOpHandler{},
+		verifier: verifier,
+		signer:   signer,
+	}
+}
+
+func (h *SignatureHandler) AppRequest(
+	ctx context.Context,
+	_ ids.NodeID,
+	_ time.Time,
+	requestBytes []byte,
+) ([]byte, error) {
+	msg, err := warp.ParseUnsignedMessage(requestBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.verifier.Verify(ctx, msg); err != nil {
+		return nil, err
+	}
+	return h.signer.Sign(msg)
+}
+
+// AppRequester issues requests to specific nodes. It is implemented by
+// [p2p.Client].
+type AppRequester interface {
+	AppRequest(
+		ctx context.Context,
+		nodeIDs set.Set[ids.NodeID],
+		appRequestBytes []byte,
+		onResponse p2p.AppResponseCallback,
+	) error
+}
+
+// NetworkSignatureGetter requests signatures from the [SignatureHandler]s of
+// the other nodes.
+type NetworkSignatureGetter struct {
+	client AppRequester
+}
+
+func NewNetworkSignatureGetter(client AppRequester) *NetworkSignatureGetter {
+	return &NetworkSignatureGetter{
+		client: client,
+	}
+}
+
+type signatureResponse struct {
+	sig *bls.Signature
+	err error
+}
+
+// GetSignature blocks until [nodeID] responds, the request fails, or [ctx] is
+// cancelled.
+func (s *NetworkSignatureGetter) GetSignature(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	msg *warp.UnsignedMessage,
+) (*bls.Signature, error) {
+	responses := make(chan signatureResponse, 1)
+	onResponse := func(_ context.Context, _ ids.NodeID, responseBytes []byte, err error) {
+		if err != nil {
+			responses <- signatureResponse{err: err}
+			return
+		}
+		sig, err := bls.SignatureFromBytes(responseBytes)
+		responses <- signatureResponse{
+			sig: sig,
+			err: err,
+		}
+	}
+	if err := s.client.AppRequest(ctx, set.Of(nodeID), msg.Bytes(), onResponse); err != nil {
+		return nil, err
+	}
+
+	select {
+	case response := <-responses:
+		return response.sig, response.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
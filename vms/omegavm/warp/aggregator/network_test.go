@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package aggregator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/network/p2p"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/utils/crypto/bls"
+	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+)
+
+var (
+	_ Verifier     = testVerifier(nil)
+	_ AppRequester = (*testAppRequester)(nil)
+)
+
+type testVerifier func(*warp.UnsignedMessage) error
+
+func (t testVerifier) Verify(_ context.Context, msg *warp.UnsignedMessage) error {
+	return t(msg)
+}
+
+// testAppRequester routes requests directly to a handler
+type testAppRequester struct {
+	handler p2p.Handler
+}
+
+func (t *testAppRequester) AppRequest(
+	ctx context.Context,
+	nodeIDs set.Set[ids.NodeID],
+	appRequestBytes []byte,
+	onResponse p2p.AppResponseCallback,
+) error {
+	for nodeID := range nodeIDs {
+		response, err := t.handler.AppRequest(ctx, nodeID, time.Time{}, appRequestBytes)
+		if err != nil {
+			onResponse(ctx, nodeID, nil, p2p.ErrAppRequestFailed)
+			continue
+		}
+		onResponse(ctx, nodeID, response, nil)
+	}
+	return nil
+}
+
+func TestNetworkSignatureGetter(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+
+	chainID := ids.GenerateTestID()
+	signer := warp.NewSigner(sk, constants.UnitTestID, chainID)
+
+	allowedMsg, err := warp.NewUnsignedMessage(constants.UnitTestID, chainID, []byte("allowed"))
+	require.NoError(err)
+	rejectedMsg, err := warp.NewUnsignedMessage(constants.UnitTestID, chainID, []byte("rejected"))
+	require.NoError(err)
+	otherChainMsg, err := warp.NewUnsignedMessage(constants.UnitTestID, ids.GenerateTestID(), []byte("allowed"))
+	require.NoError(err)
+
+	verifier := testVerifier(func(msg *warp.UnsignedMessage) error {
+		if string(msg.Payload) != "allowed" {
+			return errTest
+		}
+		return nil
+	})
+	getter := NewNetworkSignatureGetter(&testAppRequester{
+		handler: NewSignatureHandler(verifier, signer),
+	})
+
+	nodeID := ids.GenerateTestNodeID()
+	sig, err := getter.GetSignature(context.Background(), nodeID, allowedMsg)
+	require.NoError(err)
+	require.True(bls.Verify(bls.PublicFromSecretKey(sk), sig, allowedMsg.Bytes()))
+
+	_, err = getter.GetSignature(context.Background(), nodeID, rejectedMsg)
+	require.ErrorIs(err, p2p.ErrAppRequestFailed)
+
+	_, err = getter.GetSignature(context.Background(), nodeID, otherChainMsg)
+	require.ErrorIs(err, p2p.ErrAppRequestFailed)
+}
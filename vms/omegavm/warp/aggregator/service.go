@@ -0,0 +1,100 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package aggregator
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/utils/formatting"
+	"github.com/DioneProtocol/odysseygo/utils/json"
+	"github.com/DioneProtocol/odysseygo/utils/logging"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+)
+
+var errInvalidQuorum = errors.New("quorum numerator must be positive and at most the denominator")
+
+// Service exposes the signature aggregation of a chain's warp messages over
+// JSON-RPC. VMs are expected to register it alongside their other handlers.
+type Service struct {
+	log        logging.Logger
+	subnetID   ids.ID
+	aggregator *Aggregator
+}
+
+// NewService returns a service aggregating signatures from the validators of
+// [subnetID].
+func NewService(log logging.Logger, subnetID ids.ID, aggregator *Aggregator) *Service {
+	return &Service{
+		log:        log,
+		subnetID:   subnetID,
+		aggregator: aggregator,
+	}
+}
+
+type GetAggregateSignatureArgs struct {
+	// Unsigned warp message
+	Message      string              `json:"message"`
+	Encoding     formatting.Encoding `json:"encoding"`
+	OChainHeight json.Uint64         `json:"oChainHeight"`
+	QuorumNum    json.Uint64         `json:"quorumNum"`
+	QuorumDen    json.Uint64         `json:"quorumDen"`
+}
+
+type GetAggregateSignatureReply struct {
+	// Signed warp message
+	SignedMessage   string              `json:"signedMessage"`
+	Encoding        formatting.Encoding `json:"encoding"`
+	SignatureWeight json.Uint64         `json:"signatureWeight"`
+	TotalWeight     json.Uint64         `json:"totalWeight"`
+}
+
+// GetAggregateSignature requests the signature of a warp message from the
+// validators of the subnet at the provided O-chain height and returns the
+// message signed by the aggregate of their signatures.
+func (s *Service) GetAggregateSignature(r *http.Request, args *GetAggregateSignatureArgs, reply *GetAggregateSignatureReply) error {
+	s.log.Debug("API called",
+		zap.String("service", "warp"),
+		zap.String("method", "getAggregateSignature"),
+		zap.Uint64("oChainHeight", uint64(args.OChainHeight)),
+	)
+
+	if args.QuorumNum == 0 || args.QuorumNum > args.QuorumDen {
+		return errInvalidQuorum
+	}
+
+	msgBytes, err := formatting.Decode(args.Encoding, args.Message)
+	if err != nil {
+		return fmt.Errorf("problem decoding message: %w", err)
+	}
+	msg, err := warp.ParseUnsignedMessage(msgBytes)
+	if err != nil {
+		return fmt.Errorf("problem parsing message: %w", err)
+	}
+
+	result, err := s.aggregator.AggregateSignatures(
+		r.Context(),
+		msg,
+		s.subnetID,
+		uint64(args.OChainHeight),
+		uint64(args.QuorumNum),
+		uint64(args.QuorumDen),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to aggregate signatures: %w", err)
+	}
+
+	reply.SignedMessage, err = formatting.Encode(args.Encoding, result.Message.Bytes())
+	if err != nil {
+		return fmt.Errorf("problem encoding message: %w", err)
+	}
+	reply.Encoding = args.Encoding
+	reply.SignatureWeight = json.Uint64(result.SignatureWeight)
+	reply.TotalWeight = json.Uint64(result.TotalWeight)
+	return nil
+}
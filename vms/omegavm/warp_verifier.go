@@ -0,0 +1,46 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/state"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp/aggregator"
+)
+
+var (
+	_ aggregator.Verifier = (*warpVerifier)(nil)
+
+	errInvalidWarpPayload = errors.New("warp payload isn't a block ID")
+)
+
+// warpVerifier lets the O-chain validators attest that an O-chain block was
+// accepted. The payload of the signed message is the ID of the block.
+type warpVerifier struct {
+	// Signature requests are handled without holding the context lock, so it
+	// is grabbed before reading [state].
+	lock  sync.Locker
+	state state.State
+}
+
+func (v *warpVerifier) Verify(_ context.Context, msg *warp.UnsignedMessage) error {
+	blkID, err := ids.ToID(msg.Payload)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidWarpPayload, err)
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if _, err := v.state.GetStatelessBlock(blkID); err != nil {
+		return fmt.Errorf("couldn't get accepted block %s: %w", blkID, err)
+	}
+	return nil
+}
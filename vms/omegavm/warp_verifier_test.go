@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package omegavm
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/validators"
+	"github.com/DioneProtocol/odysseygo/utils/constants"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/warp/aggregator"
+)
+
+func TestWarpGetAggregateSignature(t *testing.T) {
+	require := require.New(t)
+	vm, _, _ := defaultVM(t)
+	vm.ctx.Lock.Lock()
+	defer func() {
+		vm.ctx.Lock.Lock()
+		require.NoError(validators.RemoveWeight(vm.Validators, constants.PrimaryNetworkID, vm.ctx.NodeID, defaultWeight))
+		require.NoError(vm.Shutdown(context.Background()))
+		vm.ctx.Lock.Unlock()
+	}()
+
+	// Make this node a primary network validator with a BLS key. It isn't
+	// staking, so it's removed before shutting down the VM.
+	require.NoError(validators.Add(
+		vm.Validators,
+		constants.PrimaryNetworkID,
+		vm.ctx.NodeID,
+		vm.ctx.PublicKey,
+		ids.GenerateTestID(),
+		defaultWeight,
+	))
+
+	height, err := vm.GetCurrentHeight(context.Background())
+	require.NoError(err)
+	vdrSet, err := vm.GetValidatorSet(context.Background(), height, constants.PrimaryNetworkID)
+	require.NoError(err)
+	var totalWeight uint64
+	for _, vdr := range vdrSet {
+		totalWeight += vdr.Weight
+	}
+
+	handlers, err := vm.CreateHandlers(context.Background())
+	require.NoError(err)
+	server := httptest.NewServer(handlers["/warp"].Handler)
+	defer server.Close()
+	client := aggregator.NewClient(server.URL, "O")
+
+	acceptedBlkID := vm.manager.LastAccepted()
+	acceptedMsg, err := warp.NewUnsignedMessage(
+		vm.ctx.NetworkID,
+		vm.ctx.ChainID,
+		acceptedBlkID[:],
+	)
+	require.NoError(err)
+	unknownBlkID := ids.GenerateTestID()
+	unknownMsg, err := warp.NewUnsignedMessage(
+		vm.ctx.NetworkID,
+		vm.ctx.ChainID,
+		unknownBlkID[:],
+	)
+	require.NoError(err)
+
+	// The signature requests grab the context lock while they are handled
+	vm.ctx.Lock.Unlock()
+
+	result, err := client.GetAggregateSignature(
+		context.Background(),
+		acceptedMsg,
+		height,
+		defaultWeight,
+		totalWeight,
+	)
+	require.NoError(err)
+	require.Equal(defaultWeight, result.SignatureWeight)
+	require.Equal(totalWeight, result.TotalWeight)
+	require.Equal(acceptedMsg.Bytes(), result.Message.UnsignedMessage.Bytes())
+	require.NoError(result.Message.Signature.Verify(
+		context.Background(),
+		&result.Message.UnsignedMessage,
+		vm.ctx.NetworkID,
+		validators.NewLockedState(&vm.ctx.Lock, vm.State),
+		height,
+		defaultWeight,
+		totalWeight,
+	))
+
+	// Messages that don't reference an accepted block aren't signed
+	_, err = client.GetAggregateSignature(
+		context.Background(),
+		unknownMsg,
+		height,
+		defaultWeight,
+		totalWeight,
+	)
+	require.ErrorContains(err, "failed to aggregate signatures")
+}
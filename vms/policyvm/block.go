@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package policyvm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
+	"github.com/DioneProtocol/odysseygo/snow/engine/snowman/block"
+)
+
+var (
+	_ snowman.Block           = (*policyBlock)(nil)
+	_ snowman.OracleBlock     = (*policyBlock)(nil)
+	_ block.WithVerifyContext = (*policyBlock)(nil)
+
+	errExpectedBlockWithVerifyContext = errors.New("expected block.WithVerifyContext")
+)
+
+type policyBlock struct {
+	snowman.Block
+
+	vm *blockVM
+}
+
+func (b *policyBlock) Verify(ctx context.Context) error {
+	if err := b.Block.Verify(ctx); err != nil {
+		return err
+	}
+	return b.verifyPolicies(ctx)
+}
+
+func (b *policyBlock) Options(ctx context.Context) ([2]snowman.Block, error) {
+	oracleBlock, ok := b.Block.(snowman.OracleBlock)
+	if !ok {
+		return [2]snowman.Block{}, snowman.ErrNotOracle
+	}
+
+	blks, err := oracleBlock.Options(ctx)
+	if err != nil {
+		return [2]snowman.Block{}, err
+	}
+	return [2]snowman.Block{
+		b.vm.wrap(blks[0]),
+		b.vm.wrap(blks[1]),
+	}, nil
+}
+
+func (b *policyBlock) ShouldVerifyWithContext(ctx context.Context) (bool, error) {
+	blkWithCtx, ok := b.Block.(block.WithVerifyContext)
+	if !ok {
+		return false, nil
+	}
+	return blkWithCtx.ShouldVerifyWithContext(ctx)
+}
+
+func (b *policyBlock) VerifyWithContext(ctx context.Context, blockCtx *block.Context) error {
+	blkWithCtx, ok := b.Block.(block.WithVerifyContext)
+	if !ok {
+		return fmt.Errorf("%w but got %T", errExpectedBlockWithVerifyContext, b.Block)
+	}
+
+	if err := blkWithCtx.VerifyWithContext(ctx, blockCtx); err != nil {
+		return err
+	}
+	return b.verifyPolicies(ctx)
+}
+
+// verifyPolicies returns an error if the block, which the VM verified,
+// violates any of the policies. The block is rejected in the VM if it does, so
+// that the VM doesn't keep processing a block that consensus never will.
+func (b *policyBlock) verifyPolicies(ctx context.Context) error {
+	for _, policy := range b.vm.policies {
+		err := policy.Verify(ctx, b.Block)
+		if err == nil {
+			continue
+		}
+
+		b.vm.policyFailures.Inc()
+		if rejectErr := b.Block.Reject(ctx); rejectErr != nil {
+			b.vm.ctx.Log.Error("failed to reject block that violates a policy",
+				zap.Stringer("blkID", b.ID()),
+				zap.Error(rejectErr),
+			)
+		}
+		return fmt.Errorf("block %s violates policy: %w", b.ID(), err)
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package policyvm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.uber.org/zap"
+
+	"github.com/DioneProtocol/odysseygo/api/metrics"
+	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
+	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/snow/engine/snowman/block"
+)
+
+var (
+	_ block.ChainVM                      = (*blockVM)(nil)
+	_ block.BuildBlockWithContextChainVM = (*blockVM)(nil)
+	_ block.BatchedChainVM               = (*blockVM)(nil)
+	_ block.StateSyncableVM              = (*blockVM)(nil)
+	_ block.BlockProposerAwareChainVM    = (*blockVM)(nil)
+)
+
+// TxDropper is optionally implemented by the wrapped VM to drop the txs of a
+// block it built, so that they aren't included in the blocks it builds next.
+type TxDropper interface {
+	// DropTxs drops the txs of [blk], which the VM built, for [reason].
+	DropTxs(ctx context.Context, blk snowman.Block, reason error) error
+}
+
+// blockVM requires the blocks of the wrapped VM to follow [policies], in
+// addition to the VM's own rules.
+type blockVM struct {
+	block.ChainVM
+	buildBlockVM block.BuildBlockWithContextChainVM
+	batchedVM    block.BatchedChainVM
+	ssVM         block.StateSyncableVM
+	txDropper    TxDropper
+
+	policies []Policy
+
+	ctx            *snow.Context
+	policyFailures prometheus.Counter
+}
+
+func NewBlockVM(vm block.ChainVM, policies []Policy) block.ChainVM {
+	buildBlockVM, _ := vm.(block.BuildBlockWithContextChainVM)
+	batchedVM, _ := vm.(block.BatchedChainVM)
+	ssVM, _ := vm.(block.StateSyncableVM)
+	txDropper, _ := vm.(TxDropper)
+	return &blockVM{
+		ChainVM:      vm,
+		buildBlockVM: buildBlockVM,
+		batchedVM:    batchedVM,
+		ssVM:         ssVM,
+		txDropper:    txDropper,
+		policies:     policies,
+	}
+}
+
+func (vm *blockVM) Initialize(
+	ctx context.Context,
+	chainCtx *snow.Context,
+	db manager.Manager,
+	genesisBytes,
+	upgradeBytes,
+	configBytes []byte,
+	toEngine chan<- common.Message,
+	fxs []*common.Fx,
+	appSender common.AppSender,
+) error {
+	vm.ctx = chainCtx
+	vm.policyFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "policy_failures",
+		Help: "Number of blocks that passed verification by the VM but violated a policy",
+	})
+
+	registerer := prometheus.NewRegistry()
+	if err := registerer.Register(vm.policyFailures); err != nil {
+		return err
+	}
+
+	optionalGatherer := metrics.NewOptionalGatherer()
+	multiGatherer := metrics.NewMultiGatherer()
+	if err := multiGatherer.Register("policyvm", registerer); err != nil {
+		return err
+	}
+	if err := multiGatherer.Register("", optionalGatherer); err != nil {
+		return err
+	}
+	if err := chainCtx.Metrics.Register(multiGatherer); err != nil {
+		return err
+	}
+	chainCtx.Metrics = optionalGatherer
+
+	return vm.ChainVM.Initialize(ctx, chainCtx, db, genesisBytes, upgradeBytes, configBytes, toEngine, fxs, appSender)
+}
+
+func (vm *blockVM) BuildBlock(ctx context.Context) (snowman.Block, error) {
+	blk, err := vm.ChainVM.BuildBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return vm.wrapBuilt(ctx, blk)
+}
+
+func (vm *blockVM) ParseBlock(ctx context.Context, b []byte) (snowman.Block, error) {
+	blk, err := vm.ChainVM.ParseBlock(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	return vm.wrap(blk), nil
+}
+
+func (vm *blockVM) GetBlock(ctx context.Context, id ids.ID) (snowman.Block, error) {
+	blk, err := vm.ChainVM.GetBlock(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return vm.wrap(blk), nil
+}
+
+func (vm *blockVM) BuildBlockWithContext(ctx context.Context, blockCtx *block.Context) (snowman.Block, error) {
+	if vm.buildBlockVM == nil {
+		return vm.BuildBlock(ctx)
+	}
+
+	blk, err := vm.buildBlockVM.BuildBlockWithContext(ctx, blockCtx)
+	if err != nil {
+		return nil, err
+	}
+	return vm.wrapBuilt(ctx, blk)
+}
+
+func (vm *blockVM) GetAncestors(
+	ctx context.Context,
+	blkID ids.ID,
+	maxBlocksNum int,
+	maxBlocksSize int,
+	maxBlocksRetrivalTime time.Duration,
+) ([][]byte, error) {
+	if vm.batchedVM == nil {
+		return nil, block.ErrRemoteVMNotImplemented
+	}
+	return vm.batchedVM.GetAncestors(
+		ctx,
+		blkID,
+		maxBlocksNum,
+		maxBlocksSize,
+		maxBlocksRetrivalTime,
+	)
+}
+
+func (vm *blockVM) BatchedParseBlock(ctx context.Context, blks [][]byte) ([]snowman.Block, error) {
+	if vm.batchedVM == nil {
+		return nil, block.ErrRemoteVMNotImplemented
+	}
+
+	blocks, err := vm.batchedVM.BatchedParseBlock(ctx, blks)
+	wrappedBlocks := make([]snowman.Block, len(blocks))
+	for i, blk := range blocks {
+		wrappedBlocks[i] = vm.wrap(blk)
+	}
+	return wrappedBlocks, err
+}
+
+func (vm *blockVM) StateSyncEnabled(ctx context.Context) (bool, error) {
+	if vm.ssVM == nil {
+		return false, nil
+	}
+	return vm.ssVM.StateSyncEnabled(ctx)
+}
+
+func (vm *blockVM) GetOngoingSyncStateSummary(ctx context.Context) (block.StateSummary, error) {
+	if vm.ssVM == nil {
+		return nil, block.ErrStateSyncableVMNotImplemented
+	}
+	return vm.ssVM.GetOngoingSyncStateSummary(ctx)
+}
+
+func (vm *blockVM) GetLastStateSummary(ctx context.Context) (block.StateSummary, error) {
+	if vm.ssVM == nil {
+		return nil, block.ErrStateSyncableVMNotImplemented
+	}
+	return vm.ssVM.GetLastStateSummary(ctx)
+}
+
+func (vm *blockVM) ParseStateSummary(ctx context.Context, summaryBytes []byte) (block.StateSummary, error) {
+	if vm.ssVM == nil {
+		return nil, block.ErrStateSyncableVMNotImplemented
+	}
+	return vm.ssVM.ParseStateSummary(ctx, summaryBytes)
+}
+
+func (vm *blockVM) GetStateSummary(ctx context.Context, height uint64) (block.StateSummary, error) {
+	if vm.ssVM == nil {
+		return nil, block.ErrStateSyncableVMNotImplemented
+	}
+	return vm.ssVM.GetStateSummary(ctx, height)
+}
+
+func (vm *blockVM) SetBlockProposerGetter(getter block.BlockProposerGetter) {
+	if proposerAwareVM, ok := vm.ChainVM.(block.BlockProposerAwareChainVM); ok {
+		proposerAwareVM.SetBlockProposerGetter(getter)
+	}
+}
+
+// wrapBuilt returns an error if [blk], which the VM built, violates any of the
+// policies. The txs of such a block are dropped, if the VM supports it, so that
+// the VM doesn't keep building a block that would be rejected.
+func (vm *blockVM) wrapBuilt(ctx context.Context, blk snowman.Block) (snowman.Block, error) {
+	for _, policy := range vm.policies {
+		err := policy.Verify(ctx, blk)
+		if err == nil {
+			continue
+		}
+
+		vm.policyFailures.Inc()
+		if vm.txDropper != nil {
+			if dropErr := vm.txDropper.DropTxs(ctx, blk, err); dropErr != nil {
+				vm.ctx.Log.Error("failed to drop txs of built block that violates a policy",
+					zap.Stringer("blkID", blk.ID()),
+					zap.Error(dropErr),
+				)
+			}
+		}
+		return nil, fmt.Errorf("built block %s violates policy: %w", blk.ID(), err)
+	}
+	return vm.wrap(blk), nil
+}
+
+func (vm *blockVM) wrap(blk snowman.Block) snowman.Block {
+	return &policyBlock{
+		Block: blk,
+		vm:    vm,
+	}
+}
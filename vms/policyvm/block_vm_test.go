@@ -0,0 +1,247 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package policyvm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database/manager"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow"
+	"github.com/DioneProtocol/odysseygo/snow/choices"
+	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
+	"github.com/DioneProtocol/odysseygo/snow/engine/common"
+	"github.com/DioneProtocol/odysseygo/snow/engine/snowman/block"
+)
+
+var errTest = errors.New("non-nil error")
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		numPolicies int
+		expectedErr error
+	}{
+		{
+			name:        "no policies",
+			config:      "",
+			numPolicies: 0,
+		},
+		{
+			name:        "builtin policy",
+			config:      `[{"name":"maxBlockSize","config":{"maxSize":1024}}]`,
+			numPolicies: 1,
+		},
+		{
+			name:        "invalid builtin policy config",
+			config:      `[{"name":"maxBlockSize","config":{"maxSize":0}}]`,
+			expectedErr: errNoMaxBlockSize,
+		},
+		{
+			name:        "unknown policy",
+			config:      `[{"name":"unknown"}]`,
+			expectedErr: errUnknownPolicy,
+		},
+		{
+			name:        "name and plugin",
+			config:      `[{"name":"maxBlockSize","plugin":"policy.so"}]`,
+			expectedErr: errAmbiguousPolicy,
+		},
+		{
+			name:        "neither name nor plugin",
+			config:      `[{}]`,
+			expectedErr: errAmbiguousPolicy,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			policies, err := New([]byte(test.config))
+			require.ErrorIs(err, test.expectedErr)
+			require.Len(policies, test.numPolicies)
+		})
+	}
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	err := Register(MaxBlockSizeName, newMaxBlockSize)
+	require.ErrorIs(t, err, errDuplicatePolicy)
+}
+
+func TestBlockVMVerifiesPolicies(t *testing.T) {
+	require := require.New(t)
+
+	policies, err := New([]byte(`[{"name":"maxBlockSize","config":{"maxSize":4}}]`))
+	require.NoError(err)
+
+	var (
+		smallBlk = &snowman.TestBlock{
+			TestDecidable: choices.TestDecidable{
+				IDV:     ids.GenerateTestID(),
+				StatusV: choices.Processing,
+			},
+			BytesV: []byte{1, 2, 3, 4},
+		}
+		largeBlk = &snowman.TestBlock{
+			TestDecidable: choices.TestDecidable{
+				IDV:     ids.GenerateTestID(),
+				StatusV: choices.Processing,
+			},
+			BytesV: []byte{1, 2, 3, 4, 5},
+		}
+		invalidBlk = &snowman.TestBlock{
+			TestDecidable: choices.TestDecidable{
+				IDV:     ids.GenerateTestID(),
+				StatusV: choices.Processing,
+			},
+			VerifyV: errTest,
+			BytesV:  []byte{1, 2, 3, 4, 5},
+		}
+	)
+
+	innerVM := &block.TestVM{}
+	innerVM.InitializeF = func(
+		context.Context,
+		*snow.Context,
+		manager.Manager,
+		[]byte,
+		[]byte,
+		[]byte,
+		chan<- common.Message,
+		[]*common.Fx,
+		common.AppSender,
+	) error {
+		return nil
+	}
+	innerVM.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		for _, blk := range []*snowman.TestBlock{smallBlk, largeBlk, invalidBlk} {
+			if blk.ID() == ids.ID(b) {
+				return blk, nil
+			}
+		}
+		return nil, errTest
+	}
+
+	vm := NewBlockVM(innerVM, policies)
+	require.NoError(vm.Initialize(
+		context.Background(),
+		snow.DefaultContextTest(),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	))
+
+	blk, err := vm.ParseBlock(context.Background(), smallBlk.IDV[:])
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.Equal(choices.Processing, smallBlk.Status())
+
+	// A block that the VM considers valid is rejected if it violates a policy
+	blk, err = vm.ParseBlock(context.Background(), largeBlk.IDV[:])
+	require.NoError(err)
+	err = blk.Verify(context.Background())
+	require.ErrorIs(err, errBlockTooLarge)
+	require.Equal(choices.Rejected, largeBlk.Status())
+
+	// Policies aren't applied to blocks that the VM considers invalid
+	blk, err = vm.ParseBlock(context.Background(), invalidBlk.IDV[:])
+	require.NoError(err)
+	err = blk.Verify(context.Background())
+	require.ErrorIs(err, errTest)
+	require.Equal(choices.Processing, invalidBlk.Status())
+}
+
+type testTxDropperVM struct {
+	*block.TestVM
+
+	droppedBlk snowman.Block
+	dropReason error
+}
+
+func (vm *testTxDropperVM) DropTxs(_ context.Context, blk snowman.Block, reason error) error {
+	vm.droppedBlk = blk
+	vm.dropReason = reason
+	return nil
+}
+
+func TestBlockVMBuildBlockVerifiesPolicies(t *testing.T) {
+	require := require.New(t)
+
+	policies, err := New([]byte(`[{"name":"maxBlockSize","config":{"maxSize":4}}]`))
+	require.NoError(err)
+
+	var (
+		smallBlk = &snowman.TestBlock{
+			TestDecidable: choices.TestDecidable{
+				IDV:     ids.GenerateTestID(),
+				StatusV: choices.Processing,
+			},
+			BytesV: []byte{1, 2, 3, 4},
+		}
+		largeBlk = &snowman.TestBlock{
+			TestDecidable: choices.TestDecidable{
+				IDV:     ids.GenerateTestID(),
+				StatusV: choices.Processing,
+			},
+			BytesV: []byte{1, 2, 3, 4, 5},
+		}
+	)
+
+	innerVM := &testTxDropperVM{
+		TestVM: &block.TestVM{},
+	}
+	innerVM.InitializeF = func(
+		context.Context,
+		*snow.Context,
+		manager.Manager,
+		[]byte,
+		[]byte,
+		[]byte,
+		chan<- common.Message,
+		[]*common.Fx,
+		common.AppSender,
+	) error {
+		return nil
+	}
+	nextBlk := largeBlk
+	innerVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+		return nextBlk, nil
+	}
+
+	vm := NewBlockVM(innerVM, policies)
+	require.NoError(vm.Initialize(
+		context.Background(),
+		snow.DefaultContextTest(),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	))
+
+	// A built block that violates a policy isn't returned, and its txs are
+	// dropped so that the VM doesn't build it again
+	_, err = vm.BuildBlock(context.Background())
+	require.ErrorIs(err, errBlockTooLarge)
+	require.Equal(largeBlk, innerVM.droppedBlk)
+	require.ErrorIs(innerVM.dropReason, errBlockTooLarge)
+	require.Equal(choices.Processing, largeBlk.Status())
+
+	nextBlk = smallBlk
+	blk, err := vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.Equal(smallBlk.ID(), blk.ID())
+}
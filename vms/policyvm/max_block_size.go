@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package policyvm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
+)
+
+// MaxBlockSizeName is the name of the builtin policy that limits the size of
+// blocks.
+const MaxBlockSizeName = "maxBlockSize"
+
+var (
+	errNoMaxBlockSize = errors.New("maxSize must be positive")
+	errBlockTooLarge  = errors.New("block exceeds the maximum size")
+
+	_ Policy = (*maxBlockSize)(nil)
+)
+
+type maxBlockSizeConfig struct {
+	MaxSize int `json:"maxSize"`
+}
+
+type maxBlockSize struct {
+	maxSize int
+}
+
+func newMaxBlockSize(configBytes []byte) (Policy, error) {
+	config := maxBlockSizeConfig{}
+	if len(configBytes) > 0 {
+		if err := json.Unmarshal(configBytes, &config); err != nil {
+			return nil, err
+		}
+	}
+	if config.MaxSize <= 0 {
+		return nil, errNoMaxBlockSize
+	}
+	return &maxBlockSize{
+		maxSize: config.MaxSize,
+	}, nil
+}
+
+func (p *maxBlockSize) Verify(_ context.Context, blk snowman.Block) error {
+	if size := len(blk.Bytes()); size > p.maxSize {
+		return fmt.Errorf("%w: %d > %d", errBlockTooLarge, size, p.maxSize)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package policyvm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"plugin"
+	"sync"
+
+	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
+)
+
+// PluginSymbol is the name of the Factory that a policy plugin must export.
+const PluginSymbol = "NewPolicy"
+
+var (
+	errDuplicatePolicy      = errors.New("duplicate policy")
+	errUnknownPolicy        = errors.New("unknown policy")
+	errAmbiguousPolicy      = errors.New("exactly one of name and plugin must be set")
+	errInvalidPluginFactory = errors.New("invalid plugin factory")
+
+	factoriesLock sync.RWMutex
+	factories     = map[string]Factory{
+		MaxBlockSizeName: newMaxBlockSize,
+	}
+)
+
+// Policy is an additional rule that blocks of a chain must follow. It allows a
+// subnet to enforce rules, such as compliance requirements, without forking
+// the VM that the subnet's chain runs.
+type Policy interface {
+	// Verify returns an error if [blk] violates the policy. Verify is only
+	// called on blocks that passed the VM's verification or that the VM built.
+	//
+	// Verify must be deterministic, or nodes that apply the same policies
+	// may disagree on which blocks are valid.
+	Verify(ctx context.Context, blk snowman.Block) error
+}
+
+// Factory returns a Policy configured with the JSON encoded [config].
+type Factory func(config []byte) (Policy, error)
+
+// Register makes the policy created by [factory] available under [name].
+func Register(name string, factory Factory) error {
+	factoriesLock.Lock()
+	defer factoriesLock.Unlock()
+
+	if _, ok := factories[name]; ok {
+		return fmt.Errorf("%w: %q", errDuplicatePolicy, name)
+	}
+	factories[name] = factory
+	return nil
+}
+
+// Config describes a policy to apply to a chain. The policy is either
+// registered under [Name] or loaded from the Go plugin at [Plugin], which must
+// export a Factory named [PluginSymbol].
+type Config struct {
+	Name   string          `json:"name"`
+	Plugin string          `json:"plugin"`
+	Config json.RawMessage `json:"config"`
+}
+
+// New returns the policies described by the JSON encoded list of Configs in
+// [configBytes].
+func New(configBytes []byte) ([]Policy, error) {
+	if len(configBytes) == 0 {
+		return nil, nil
+	}
+
+	var configs []Config
+	if err := json.Unmarshal(configBytes, &configs); err != nil {
+		return nil, fmt.Errorf("couldn't parse policies: %w", err)
+	}
+
+	policies := make([]Policy, len(configs))
+	for i, config := range configs {
+		factory, err := config.factory()
+		if err != nil {
+			return nil, err
+		}
+
+		policies[i], err = factory(config.Config)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create policy %d: %w", i, err)
+		}
+	}
+	return policies, nil
+}
+
+func (c *Config) factory() (Factory, error) {
+	switch {
+	case c.Name != "" && c.Plugin == "":
+		factoriesLock.RLock()
+		defer factoriesLock.RUnlock()
+
+		factory, ok := factories[c.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", errUnknownPolicy, c.Name)
+		}
+		return factory, nil
+	case c.Name == "" && c.Plugin != "":
+		p, err := plugin.Open(c.Plugin)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't open policy plugin %q: %w", c.Plugin, err)
+		}
+		symbol, err := p.Lookup(PluginSymbol)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't find %s in policy plugin %q: %w", PluginSymbol, c.Plugin, err)
+		}
+		factory, ok := symbol.(func([]byte) (Policy, error))
+		if !ok {
+			return nil, fmt.Errorf("%w: %s in %q has type %T", errInvalidPluginFactory, PluginSymbol, c.Plugin, symbol)
+		}
+		return factory, nil
+	default:
+		return nil, errAmbiguousPolicy
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/engine/snowman/block"
+)
+
+var (
+	_ block.BlockProposerGetter = (*VM)(nil)
+
+	errUnexpectedInnerBlock = errors.New("unexpected inner block")
+)
+
+// GetBlockProposer returns the proposer of the post-fork block that wrapped the
+// accepted inner block [blkID]. Options don't have a proposer of their own, so
+// they are attributed to the proposer of their parent.
+//
+// vm.ctx.Lock should be held
+func (vm *VM) GetBlockProposer(ctx context.Context, blkID ids.ID, height uint64) (*block.BlockProposer, error) {
+	proBlkID, err := vm.GetBlockIDAtHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	if proBlkID == blkID {
+		// The block was accepted before the fork, so it isn't wrapped.
+		return nil, database.ErrNotFound
+	}
+
+	blk, err := vm.getPostForkBlock(ctx, proBlkID)
+	if err != nil {
+		return nil, err
+	}
+	if innerBlkID := blk.getInnerBlk().ID(); innerBlkID != blkID {
+		return nil, fmt.Errorf("%w: expected %s at height %d but got %s",
+			errUnexpectedInnerBlock,
+			blkID,
+			height,
+			innerBlkID,
+		)
+	}
+
+	if _, ok := blk.(*postForkOption); ok {
+		blk, err = vm.getPostForkBlock(ctx, blk.Parent())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	signedBlk, ok := blk.(*postForkBlock)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errUnexpectedBlockType, blk)
+	}
+	return &block.BlockProposer{
+		NodeID:    signedBlk.Proposer(),
+		Timestamp: signedBlk.Timestamp(),
+	}, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/DioneProtocol/odysseygo/database"
+	"github.com/DioneProtocol/odysseygo/ids"
+	"github.com/DioneProtocol/odysseygo/snow/choices"
+	"github.com/DioneProtocol/odysseygo/snow/consensus/snowman"
+	"github.com/DioneProtocol/odysseygo/vms/proposervm/proposer"
+)
+
+func TestGetBlockProposer(t *testing.T) {
+	require := require.New(t)
+
+	coreVM, _, proVM, coreGenBlk, _ := initTestProposerVM(t, time.Time{}, 0) // enable ProBlks
+	defer func() {
+		require.NoError(proVM.Shutdown(context.Background()))
+	}()
+
+	for proVM.VerifyHeightIndex(context.Background()) != nil {
+		time.Sleep(time.Millisecond)
+	}
+
+	coreBlk := &snowman.TestBlock{
+		TestDecidable: choices.TestDecidable{
+			IDV:     ids.GenerateTestID(),
+			StatusV: choices.Processing,
+		},
+		BytesV:     []byte{1},
+		ParentV:    coreGenBlk.ID(),
+		HeightV:    coreGenBlk.Height() + 1,
+		TimestampV: coreGenBlk.Timestamp().Add(proposer.MaxDelay),
+	}
+	coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+		return coreBlk, nil
+	}
+	coreVM.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		switch blkID {
+		case coreGenBlk.ID():
+			return coreGenBlk, nil
+		case coreBlk.ID():
+			return coreBlk, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+	coreVM.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		switch {
+		case bytes.Equal(b, coreGenBlk.Bytes()):
+			return coreGenBlk, nil
+		case bytes.Equal(b, coreBlk.Bytes()):
+			return coreBlk, nil
+		default:
+			return nil, errUnknownBlock
+		}
+	}
+	coreVM.GetBlockIDAtHeightF = func(_ context.Context, height uint64) (ids.ID, error) {
+		switch height {
+		case coreGenBlk.Height():
+			return coreGenBlk.ID(), nil
+		case coreBlk.Height():
+			return coreBlk.ID(), nil
+		default:
+			return ids.Empty, database.ErrNotFound
+		}
+	}
+
+	builtBlk, err := proVM.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(builtBlk.Verify(context.Background()))
+	require.NoError(builtBlk.Accept(context.Background()))
+
+	require.IsType(&postForkBlock{}, builtBlk)
+	proBlk := builtBlk.(*postForkBlock)
+
+	blkProposer, err := proVM.GetBlockProposer(context.Background(), coreBlk.ID(), coreBlk.Height())
+	require.NoError(err)
+	require.Equal(proBlk.Proposer(), blkProposer.NodeID)
+	require.Equal(proBlk.Timestamp(), blkProposer.Timestamp)
+
+	// The genesis block was accepted before the fork
+	_, err = proVM.GetBlockProposer(context.Background(), coreGenBlk.ID(), coreGenBlk.Height())
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// A block that isn't the accepted inner block at its height
+	_, err = proVM.GetBlockProposer(context.Background(), ids.GenerateTestID(), coreBlk.Height())
+	require.ErrorIs(err, errUnexpectedInnerBlock)
+}
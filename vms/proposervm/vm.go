@@ -234,6 +234,10 @@ func (vm *VM) Initialize(
 	vm.context = context
 	vm.onShutdown = cancel
 
+	if proposerAwareVM, ok := vm.ChainVM.(block.BlockProposerAwareChainVM); ok {
+		proposerAwareVM.SetBlockProposerGetter(vm)
+	}
+
 	err = vm.ChainVM.Initialize(
 		ctx,
 		chainCtx,
@@ -139,6 +139,51 @@ func (kc *Keychain) Match(owners *OutputOwners, time uint64) ([]uint32, []*secp2
 	return sigs, keys, uint32(len(keys)) == owners.Threshold
 }
 
+// MatchPartial is like Match, but also matches [owners] if the keychain holds
+// at least one, but fewer than the threshold, of the keys. The signatures
+// that the keychain can't provide are assigned to the first other addresses
+// of [owners] and their keys are nil, so that they can be provided later by
+// the co-owners of the output.
+func (kc *Keychain) MatchPartial(owners *OutputOwners, time uint64) ([]uint32, []*secp256k1.PrivateKey, bool) {
+	if sigs, keys, ok := kc.Match(owners, time); ok || len(keys) == 0 {
+		return sigs, keys, ok
+	}
+
+	numAddrs := uint32(len(owners.Addrs))
+	if numAddrs < owners.Threshold {
+		return nil, nil, false
+	}
+
+	// Mark the addresses the keychain controls, then fill the remaining
+	// signatures with the first other addresses.
+	selected := make([]bool, numAddrs)
+	numSelected := uint32(0)
+	for i := uint32(0); i < numAddrs && numSelected < owners.Threshold; i++ {
+		if _, exists := kc.get(owners.Addrs[i]); exists {
+			selected[i] = true
+			numSelected++
+		}
+	}
+	for i := uint32(0); i < numAddrs && numSelected < owners.Threshold; i++ {
+		if !selected[i] {
+			selected[i] = true
+			numSelected++
+		}
+	}
+
+	sigs := make([]uint32, 0, owners.Threshold)
+	keys := make([]*secp256k1.PrivateKey, 0, owners.Threshold)
+	for i, isSelected := range selected {
+		if !isSelected {
+			continue
+		}
+		key, _ := kc.get(owners.Addrs[i])
+		sigs = append(sigs, uint32(i))
+		keys = append(keys, key)
+	}
+	return sigs, keys, true
+}
+
 // PrefixedString returns the key chain as a string representation with [prefix]
 // added before every line.
 func (kc *Keychain) PrefixedString(prefix string) string {
@@ -123,6 +123,53 @@ func TestKeychainMatch(t *testing.T) {
 	require.Equal(sks[1].PublicKey().Address(), keys[0].PublicKey().Address())
 }
 
+func TestKeychainMatchPartial(t *testing.T) {
+	require := require.New(t)
+	kc := NewKeychain()
+
+	sks := []*secp256k1.PrivateKey{}
+	for _, keyStr := range keys {
+		skBytes, err := formatting.Decode(formatting.HexNC, keyStr)
+		require.NoError(err)
+
+		sk, err := kc.factory.ToPrivateKey(skBytes)
+		require.NoError(err)
+		sks = append(sks, sk)
+	}
+
+	owners := OutputOwners{
+		Locktime:  1,
+		Threshold: 2,
+		Addrs: []ids.ShortID{
+			sks[0].PublicKey().Address(),
+			sks[1].PublicKey().Address(),
+			sks[2].PublicKey().Address(),
+		},
+	}
+
+	// None of the keys are held
+	_, _, ok := kc.MatchPartial(&owners, 1)
+	require.False(ok)
+
+	kc.Add(sks[2])
+
+	// The output is still locked
+	_, _, ok = kc.MatchPartial(&owners, 0)
+	require.False(ok)
+
+	indices, keys, ok := kc.MatchPartial(&owners, 1)
+	require.True(ok)
+	require.Equal([]uint32{0, 2}, indices)
+	require.Equal([]*secp256k1.PrivateKey{nil, sks[2]}, keys)
+
+	kc.Add(sks[1])
+
+	indices, keys, ok = kc.MatchPartial(&owners, 1)
+	require.True(ok)
+	require.Equal([]uint32{1, 2}, indices)
+	require.Equal([]*secp256k1.PrivateKey{sks[1], sks[2]}, keys)
+}
+
 func TestKeychainSpendMint(t *testing.T) {
 	require := require.New(t)
 	kc := NewKeychain()
@@ -0,0 +1,12 @@
+// Copyright (C) 2019-2023, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tracedvm
+
+import "github.com/DioneProtocol/odysseygo/snow/engine/snowman/block"
+
+func (vm *blockVM) SetBlockProposerGetter(getter block.BlockProposerGetter) {
+	if proposerAwareVM, ok := vm.ChainVM.(block.BlockProposerAwareChainVM); ok {
+		proposerAwareVM.SetBlockProposerGetter(getter)
+	}
+}
@@ -25,6 +25,7 @@ var (
 	_ block.BuildBlockWithContextChainVM = (*blockVM)(nil)
 	_ block.BatchedChainVM               = (*blockVM)(nil)
 	_ block.StateSyncableVM              = (*blockVM)(nil)
+	_ block.BlockProposerAwareChainVM    = (*blockVM)(nil)
 )
 
 type blockVM struct {
@@ -193,8 +193,14 @@ func (b *builder) NewBaseTx(
 	outputs []*dione.TransferableOutput,
 	options ...common.Option,
 ) (*txs.BaseTx, error) {
+	ops := common.NewOptions(options)
+	txFee, err := math.Add64(b.backend.BaseTxFee(), ops.Tip())
+	if err != nil {
+		return nil, err
+	}
+
 	toBurn := map[ids.ID]uint64{
-		b.backend.DIONEAssetID(): b.backend.BaseTxFee(),
+		b.backend.DIONEAssetID(): txFee,
 	}
 	for _, out := range outputs {
 		assetID := out.AssetID()
@@ -205,7 +211,6 @@ func (b *builder) NewBaseTx(
 		toBurn[assetID] = amountToBurn
 	}
 
-	ops := common.NewOptions(options)
 	inputs, changeOutputs, err := b.spend(toBurn, ops)
 	if err != nil {
 		return nil, err
@@ -229,10 +234,15 @@ func (b *builder) NewCreateAssetTx(
 	initialState map[uint32][]verify.State,
 	options ...common.Option,
 ) (*txs.CreateAssetTx, error) {
+	ops := common.NewOptions(options)
+	txFee, err := math.Add64(b.backend.CreateAssetTxFee(), ops.Tip())
+	if err != nil {
+		return nil, err
+	}
+
 	toBurn := map[ids.ID]uint64{
-		b.backend.DIONEAssetID(): b.backend.CreateAssetTxFee(),
+		b.backend.DIONEAssetID(): txFee,
 	}
-	ops := common.NewOptions(options)
 	inputs, outputs, err := b.spend(toBurn, ops)
 	if err != nil {
 		return nil, err
@@ -270,10 +280,15 @@ func (b *builder) NewOperationTx(
 	operations []*txs.Operation,
 	options ...common.Option,
 ) (*txs.OperationTx, error) {
+	ops := common.NewOptions(options)
+	txFee, err := math.Add64(b.backend.BaseTxFee(), ops.Tip())
+	if err != nil {
+		return nil, err
+	}
+
 	toBurn := map[ids.ID]uint64{
-		b.backend.DIONEAssetID(): b.backend.BaseTxFee(),
+		b.backend.DIONEAssetID(): txFee,
 	}
-	ops := common.NewOptions(options)
 	inputs, outputs, err := b.spend(toBurn, ops)
 	if err != nil {
 		return nil, err
@@ -353,12 +368,15 @@ func (b *builder) NewImportTx(
 	if err != nil {
 		return nil, err
 	}
+	txFee, err := math.Add64(b.backend.BaseTxFee(), ops.Tip())
+	if err != nil {
+		return nil, err
+	}
 
 	var (
 		addrs           = ops.Addresses(b.addrs)
 		minIssuanceTime = ops.MinIssuanceTime()
 		dioneAssetID    = b.backend.DIONEAssetID()
-		txFee           = b.backend.BaseTxFee()
 
 		importedInputs  = make([]*dione.TransferableInput, 0, len(utxos))
 		importedAmounts = make(map[ids.ID]uint64)
@@ -454,8 +472,14 @@ func (b *builder) NewExportTx(
 	outputs []*dione.TransferableOutput,
 	options ...common.Option,
 ) (*txs.ExportTx, error) {
+	ops := common.NewOptions(options)
+	txFee, err := math.Add64(b.backend.BaseTxFee(), ops.Tip())
+	if err != nil {
+		return nil, err
+	}
+
 	toBurn := map[ids.ID]uint64{
-		b.backend.DIONEAssetID(): b.backend.BaseTxFee(),
+		b.backend.DIONEAssetID(): txFee,
 	}
 	for _, out := range outputs {
 		assetID := out.AssetID()
@@ -466,7 +490,6 @@ func (b *builder) NewExportTx(
 		toBurn[assetID] = amountToBurn
 	}
 
-	ops := common.NewOptions(options)
 	inputs, changeOutputs, err := b.spend(toBurn, ops)
 	if err != nil {
 		return nil, err
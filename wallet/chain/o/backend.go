@@ -13,6 +13,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
 	"github.com/DioneProtocol/odysseygo/wallet/subnet/primary/common"
 )
@@ -35,13 +36,30 @@ type backend struct {
 	txsLock sync.RWMutex
 	// txID -> tx
 	txs map[ids.ID]*txs.Tx
+
+	subnetOwnerLock sync.RWMutex
+	// subnetID -> current owner, tracked separately from [txs] because the
+	// owner may have been changed by a TransferSubnetOwnershipTx
+	subnetOwner map[ids.ID]fx.Owner
 }
 
-func NewBackend(ctx Context, utxos common.ChainUTXOs, txs map[ids.ID]*txs.Tx) Backend {
+// NewBackend returns a O-chain wallet backend. [subnetOwner] may be nil, in
+// which case the owners of the subnets are read from their CreateSubnetTx
+// until a TransferSubnetOwnershipTx is accepted.
+func NewBackend(
+	ctx Context,
+	utxos common.ChainUTXOs,
+	txs map[ids.ID]*txs.Tx,
+	subnetOwner map[ids.ID]fx.Owner,
+) Backend {
+	if subnetOwner == nil {
+		subnetOwner = make(map[ids.ID]fx.Owner)
+	}
 	return &backend{
-		Context:    ctx,
-		ChainUTXOs: utxos,
-		txs:        txs,
+		Context:     ctx,
+		ChainUTXOs:  utxos,
+		txs:         txs,
+		subnetOwner: subnetOwner,
 	}
 }
 
@@ -97,3 +115,29 @@ func (b *backend) GetTx(_ stdcontext.Context, txID ids.ID) (*txs.Tx, error) {
 	}
 	return tx, nil
 }
+
+func (b *backend) GetSubnetOwner(ctx stdcontext.Context, subnetID ids.ID) (fx.Owner, error) {
+	b.subnetOwnerLock.RLock()
+	owner, exists := b.subnetOwner[subnetID]
+	b.subnetOwnerLock.RUnlock()
+	if exists {
+		return owner, nil
+	}
+
+	subnetTx, err := b.GetTx(ctx, subnetID)
+	if err != nil {
+		return nil, err
+	}
+	subnet, ok := subnetTx.Unsigned.(*txs.CreateSubnetTx)
+	if !ok {
+		return nil, errWrongTxType
+	}
+	return subnet.Owner, nil
+}
+
+func (b *backend) setSubnetOwner(subnetID ids.ID, owner fx.Owner) {
+	b.subnetOwnerLock.Lock()
+	defer b.subnetOwnerLock.Unlock()
+
+	b.subnetOwner[subnetID] = owner
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/DioneProtocol/odysseygo/utils/math"
 	"github.com/DioneProtocol/odysseygo/utils/set"
 	"github.com/DioneProtocol/odysseygo/vms/components/dione"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/signer"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/stakeable"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
@@ -256,7 +257,7 @@ type Builder interface {
 type BuilderBackend interface {
 	Context
 	UTXOs(ctx stdcontext.Context, sourceChainID ids.ID) ([]*dione.UTXO, error)
-	GetTx(ctx stdcontext.Context, txID ids.ID) (*txs.Tx, error)
+	GetSubnetOwner(ctx stdcontext.Context, subnetID ids.ID) (fx.Owner, error)
 }
 
 type builder struct {
@@ -359,7 +360,7 @@ func (b *builder) NewAddValidatorTx(
 		}},
 		Validator:        *vdr,
 		StakeOuts:        stakeOutputs,
-		RewardsOwner:     rewardsOwner,
+		RewardsOwner:     rewardsOwnerWithOptions(rewardsOwner, ops),
 		DelegationShares: shares,
 	}, nil
 }
@@ -459,7 +460,7 @@ func (b *builder) NewAddDelegatorTx(
 		}},
 		Validator:              *vdr,
 		StakeOuts:              stakeOutputs,
-		DelegationRewardsOwner: rewardsOwner,
+		DelegationRewardsOwner: rewardsOwnerWithOptions(rewardsOwner, ops),
 	}, nil
 }
 
@@ -777,8 +778,8 @@ func (b *builder) NewAddPermissionlessValidatorTx(
 		Subnet:                vdr.Subnet,
 		Signer:                signer,
 		StakeOuts:             stakeOutputs,
-		ValidatorRewardsOwner: validationRewardsOwner,
-		DelegatorRewardsOwner: delegationRewardsOwner,
+		ValidatorRewardsOwner: rewardsOwnerWithOptions(validationRewardsOwner, ops),
+		DelegatorRewardsOwner: rewardsOwnerWithOptions(delegationRewardsOwner, ops),
 		DelegationShares:      shares,
 	}, nil
 }
@@ -817,7 +818,7 @@ func (b *builder) NewAddPermissionlessDelegatorTx(
 		Validator:              vdr.Validator,
 		Subnet:                 vdr.Subnet,
 		StakeOuts:              stakeOutputs,
-		DelegationRewardsOwner: rewardsOwner,
+		DelegationRewardsOwner: rewardsOwnerWithOptions(rewardsOwner, ops),
 	}, nil
 }
 
@@ -1098,20 +1099,15 @@ func (b *builder) spend(
 }
 
 func (b *builder) authorizeSubnet(subnetID ids.ID, options *common.Options) (*secp256k1fx.Input, error) {
-	subnetTx, err := b.backend.GetTx(options.Context(), subnetID)
+	ownerIntf, err := b.backend.GetSubnetOwner(options.Context(), subnetID)
 	if err != nil {
 		return nil, fmt.Errorf(
-			"failed to fetch subnet %q: %w",
+			"failed to fetch subnet owner for %q: %w",
 			subnetID,
 			err,
 		)
 	}
-	subnet, ok := subnetTx.Unsigned.(*txs.CreateSubnetTx)
-	if !ok {
-		return nil, errWrongTxType
-	}
-
-	owner, ok := subnet.Owner.(*secp256k1fx.OutputOwners)
+	owner, ok := ownerIntf.(*secp256k1fx.OutputOwners)
 	if !ok {
 		return nil, errUnknownOwnerType
 	}
@@ -1127,3 +1123,16 @@ func (b *builder) authorizeSubnet(subnetID ids.ID, options *common.Options) (*se
 		SigIndices: inputSigIndices,
 	}, nil
 }
+
+// rewardsOwnerWithOptions returns [owner], with its rewards locked for
+// restaking if a restake locktime was provided in [ops].
+func rewardsOwnerWithOptions(owner *secp256k1fx.OutputOwners, ops *common.Options) fx.Owner {
+	locktime := ops.RestakeLocktime()
+	if locktime == 0 {
+		return owner
+	}
+	return &stakeable.LockOwner{
+		Locktime: locktime,
+		Owner:    owner,
+	}
+}
@@ -507,7 +507,7 @@ func (w *wallet) IssueTx(
 		return w.Backend.AcceptTx(ctx, tx)
 	}
 
-	txStatus, err := w.client.AwaitTxDecided(ctx, txID, ops.PollFrequency())
+	txStatus, err := omegavm.AwaitTxDecided(ctx, w.client, "", txID, ops.PollFrequency())
 	if err != nil {
 		return err
 	}
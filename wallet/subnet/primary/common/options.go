@@ -41,8 +41,12 @@ type Options struct {
 
 	changeOwner *secp256k1fx.OutputOwners
 
+	restakeLocktime uint64
+
 	memo []byte
 
+	tip uint64
+
 	assumeDecided bool
 
 	pollFrequencySet bool
@@ -117,10 +121,18 @@ func (o *Options) ChangeOwner(defaultOwner *secp256k1fx.OutputOwners) *secp256k1
 	return defaultOwner
 }
 
+func (o *Options) RestakeLocktime() uint64 {
+	return o.restakeLocktime
+}
+
 func (o *Options) Memo() []byte {
 	return o.memo
 }
 
+func (o *Options) Tip() uint64 {
+	return o.tip
+}
+
 func (o *Options) AssumeDecided() bool {
 	return o.assumeDecided
 }
@@ -181,12 +193,29 @@ func WithChangeOwner(changeOwner *secp256k1fx.OutputOwners) Option {
 	}
 }
 
+// WithRestakeLocktime locks the rewards of the staker being added until
+// [locktime], so that they can only be restaked before then. The O-chain
+// rejects such stakers until the Durango upgrade is activated.
+func WithRestakeLocktime(locktime uint64) Option {
+	return func(o *Options) {
+		o.restakeLocktime = locktime
+	}
+}
+
 func WithMemo(memo []byte) Option {
 	return func(o *Options) {
 		o.memo = memo
 	}
 }
 
+// WithTip burns [tip] in addition to the required fee, which allows the tx to
+// be prioritized by chains that support tips.
+func WithTip(tip uint64) Option {
+	return func(o *Options) {
+		o.tip = tip
+	}
+}
+
 func WithAssumeDecided() Option {
 	return func(o *Options) {
 		o.assumeDecided = true
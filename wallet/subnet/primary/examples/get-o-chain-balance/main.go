@@ -39,7 +39,7 @@ func main() {
 	log.Printf("fetched state of %s in %s\n", addrStr, time.Since(fetchStartTime))
 
 	oUTXOs := primary.NewChainUTXOs(constants.OmegaChainID, state.UTXOs)
-	oBackend := o.NewBackend(state.OCTX, oUTXOs, make(map[ids.ID]*txs.Tx))
+	oBackend := o.NewBackend(state.OCTX, oUTXOs, make(map[ids.ID]*txs.Tx), nil)
 	oBuilder := o.NewBuilder(addresses, oBackend)
 
 	currentBalances, err := oBuilder.GetBalance()
@@ -9,8 +9,11 @@ import (
 	"github.com/DioneProtocol/odysseygo/ids"
 	"github.com/DioneProtocol/odysseygo/utils/constants"
 	"github.com/DioneProtocol/odysseygo/utils/crypto/keychain"
+	"github.com/DioneProtocol/odysseygo/utils/formatting/address"
 	"github.com/DioneProtocol/odysseygo/utils/set"
+	"github.com/DioneProtocol/odysseygo/vms/omegavm/fx"
 	"github.com/DioneProtocol/odysseygo/vms/omegavm/txs"
+	"github.com/DioneProtocol/odysseygo/vms/secp256k1fx"
 	"github.com/DioneProtocol/odysseygo/wallet/chain/a"
 	"github.com/DioneProtocol/odysseygo/wallet/chain/d"
 	"github.com/DioneProtocol/odysseygo/wallet/chain/o"
@@ -83,7 +86,7 @@ type WalletConfig struct {
 // that reference any of the provided keys. If the UTXOs are modified through an
 // external issuance process, such as another instance of the wallet, the UTXOs
 // may become out of sync. The wallet will also fetch all requested O-chain
-// transactions.
+// transactions, along with the current owners of any subnets they create.
 //
 // The wallet manages all state locally, and performs all tx signing locally.
 func MakeWallet(ctx context.Context, config *WalletConfig) (Wallet, error) {
@@ -116,8 +119,30 @@ func MakeWallet(ctx context.Context, config *WalletConfig) (Wallet, error) {
 		oChainTxs[txID] = tx
 	}
 
+	// The owner of a subnet may have been changed since its creation, so the
+	// current owners are fetched rather than read from the CreateSubnetTxs.
+	subnetOwners := make(map[ids.ID]fx.Owner)
+	for txID, tx := range oChainTxs {
+		if _, ok := tx.Unsigned.(*txs.CreateSubnetTx); !ok {
+			continue
+		}
+		subnet, err := dioneState.OClient.GetSubnet(ctx, txID)
+		if err != nil {
+			return nil, err
+		}
+		addrs, err := address.ParseToIDs(subnet.ControlKeys)
+		if err != nil {
+			return nil, err
+		}
+		subnetOwners[txID] = &secp256k1fx.OutputOwners{
+			Locktime:  uint64(subnet.Locktime),
+			Threshold: uint32(subnet.Threshold),
+			Addrs:     addrs,
+		}
+	}
+
 	oUTXOs := NewChainUTXOs(constants.OmegaChainID, dioneState.UTXOs)
-	oBackend := o.NewBackend(dioneState.OCTX, oUTXOs, oChainTxs)
+	oBackend := o.NewBackend(dioneState.OCTX, oUTXOs, oChainTxs, subnetOwners)
 	oBuilder := o.NewBuilder(dioneAddrs, oBackend)
 	oSigner := o.NewSigner(config.DIONEKeychain, oBackend)
 